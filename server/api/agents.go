@@ -36,8 +36,9 @@ func agentClaudeHomeFor(agentDir string) string {
 	return filepath.Dir(agentDir)
 }
 
-// resourceFileToAgentFile converts a lib.ResourceFile to the API AgentFile type.
-func resourceFileToAgentFile(rf lib.ResourceFile) AgentFile {
+// resourceFileToAgentFile converts a lib.ResourceFile to the API AgentFile type,
+// redacting secrets from the body preview.
+func resourceFileToAgentFile(rf lib.ResourceFile, redactor *lib.Redactor) AgentFile {
 	var color *string
 	if c, ok := rf.Frontmatter["color"].(string); ok && c != "" {
 		color = &c
@@ -53,13 +54,14 @@ func resourceFileToAgentFile(rf lib.ResourceFile) AgentFile {
 		FilePath:    rf.FilePath,
 		Tools:       tools,
 		Color:       color,
-		BodyPreview: lib.TruncateBody(rf.Body, 5),
+		BodyPreview: lib.TruncateBody(redactor.Text(rf.Body), 5),
 		IsEditable:  lib.IsUserOwned(rf.FilePath),
 	}
 }
 
-// resourceFileToAgentDetail converts a lib.ResourceFile to the API AgentDetail type.
-func resourceFileToAgentDetail(rf lib.ResourceFile) AgentDetail {
+// resourceFileToAgentDetail converts a lib.ResourceFile to the API AgentDetail
+// type, redacting secrets from the body.
+func resourceFileToAgentDetail(rf lib.ResourceFile, redactor *lib.Redactor) AgentDetail {
 	var color *string
 	if c, ok := rf.Frontmatter["color"].(string); ok && c != "" {
 		color = &c
@@ -75,7 +77,7 @@ func resourceFileToAgentDetail(rf lib.ResourceFile) AgentDetail {
 		FilePath:    rf.FilePath,
 		Tools:       tools,
 		Color:       color,
-		Body:        rf.Body,
+		Body:        redactor.Text(rf.Body),
 		IsEditable:  lib.IsUserOwned(rf.FilePath),
 	}
 }
@@ -105,9 +107,10 @@ func (h *FieldStationHandler) GetAgents(_ context.Context, request GetAgentsRequ
 		return nil, err
 	}
 
+	redactor := h.redactor()
 	result := make([]AgentFile, len(files))
 	for i, rf := range files {
-		result[i] = resourceFileToAgentFile(rf)
+		result[i] = resourceFileToAgentFile(rf, redactor)
 	}
 	return GetAgents200JSONResponse(result), nil
 }
@@ -136,7 +139,10 @@ func (h *FieldStationHandler) GetAgent(_ context.Context, request GetAgentReques
 	if err != nil {
 		return nil, err
 	}
-	return GetAgent200JSONResponse(resourceFileToAgentDetail(rf)), nil
+	return GetAgent200JSONResponse{
+		Body:    resourceFileToAgentDetail(rf, h.redactor()),
+		Headers: GetAgent200ResponseHeaders{ETag: lib.ContentETag([]byte(rf.Content))},
+	}, nil
 }
 
 // CreateAgent creates a new agent file.
@@ -188,7 +194,7 @@ func (h *FieldStationHandler) CreateAgent(_ context.Context, request CreateAgent
 	if err != nil {
 		return nil, err
 	}
-	return CreateAgent200JSONResponse(resourceFileToAgentFile(rf)), nil
+	return CreateAgent200JSONResponse(resourceFileToAgentFile(rf, h.redactor())), nil
 }
 
 // UpdateAgent updates an existing agent file.
@@ -217,9 +223,18 @@ func (h *FieldStationHandler) UpdateAgent(_ context.Context, request UpdateAgent
 		return nil, fmt.Errorf("agents: cannot write plugin-managed file: %s", filePath)
 	}
 
+	// Restore any redaction placeholders echoed back from GetAgent.
+	agentBody := body.Body
+	if existing, err := lib.GetResource(lib.ResourceTypeAgent, request.Name, agentClaudeHomeFor(agentDir)); err == nil {
+		agentBody, err = h.redactor().RestoreText(body.Body, existing.Body)
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateAgent409JSONResponse(resp), nil
+		}
+	}
+
 	fm := lib.FrontmatterDoc{
 		Frontmatter: map[string]any{},
-		Body:        body.Body,
+		Body:        agentBody,
 	}
 	// Preserve name in frontmatter (use path name as the display name key).
 	fm.Frontmatter["name"] = request.Name
@@ -235,6 +250,10 @@ func (h *FieldStationHandler) UpdateAgent(_ context.Context, request UpdateAgent
 
 	content := lib.SerializeMarkdown(fm)
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateAgent412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
 	// For project-scope agents, ensure the backup is written to the global claudeHome so
 	// it appears in Change History. lib.UpdateResource backs up to its own claudeHome
 	// argument, which for project agents is the project's .claude/ directory.
@@ -247,7 +266,7 @@ func (h *FieldStationHandler) UpdateAgent(_ context.Context, request UpdateAgent
 	if err != nil {
 		return nil, err
 	}
-	return UpdateAgent200JSONResponse(resourceFileToAgentDetail(rf)), nil
+	return UpdateAgent200JSONResponse(resourceFileToAgentDetail(rf, h.redactor())), nil
 }
 
 // DeleteAgent deletes an agent file.
@@ -276,6 +295,10 @@ func (h *FieldStationHandler) DeleteAgent(_ context.Context, request DeleteAgent
 		return nil, fmt.Errorf("agents: cannot delete plugin-managed file: %s", filePath)
 	}
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeleteAgent412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
 	// For project-scope agents, ensure the backup lands in the global claudeHome
 	// so it is visible in Change History.
 	effectiveClaudeHome := agentClaudeHomeFor(agentDir)
@@ -29,6 +29,7 @@ func newTestHandler(t *testing.T) (*api.FieldStationHandler, string) {
 	t.Helper()
 	claudeHome := t.TempDir()
 	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
 	return api.NewHandler(claudeHome, false), claudeHome
 }
 
@@ -248,7 +249,7 @@ func TestGetAgent_GlobalScope_ReturnsAgent(t *testing.T) {
 	detail, ok := resp.(api.GetAgent200JSONResponse)
 	require.True(t, ok)
 	// Name field is the frontmatter display name.
-	assert.Equal(t, "My Agent", detail.Name)
-	assert.Equal(t, "Does things", detail.Description)
-	assert.Contains(t, detail.Body, "Body text")
+	assert.Equal(t, "My Agent", detail.Body.Name)
+	assert.Equal(t, "Does things", detail.Body.Description)
+	assert.Contains(t, detail.Body.Body, "Body text")
 }
@@ -25,13 +25,18 @@ func (h *FieldStationHandler) GetBackups(_ context.Context, _ GetBackupsRequestO
 		if fi, err := os.Stat(backupFilePath); err == nil {
 			size = fi.Size()
 		}
-		result = append(result, BackupFile{
+		entry := BackupFile{
 			Id:           e.ID,
 			FilePath:     backupFilePath,
 			OriginalPath: e.OriginalPath,
 			CreatedAt:    e.Timestamp.UTC().Format("2006-01-02T15:04:05Z07:00"),
 			Size:         size,
-		})
+		}
+		if e.Group != "" {
+			group := e.Group
+			entry.Group = &group
+		}
+		result = append(result, entry)
 	}
 	return GetBackups200JSONResponse(result), nil
 }
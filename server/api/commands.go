@@ -23,7 +23,7 @@ func (h *FieldStationHandler) resolveCommandDir(scope string, projectPath *strin
 
 // listCommandsFromDir reads all commands from the directory, which stores
 // commands as <dir>/<folder>/<name>.md files.
-func listCommandsFromDir(dir string) ([]CommandFile, error) {
+func listCommandsFromDir(dir string, redactor *lib.Redactor) ([]CommandFile, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -60,7 +60,7 @@ func listCommandsFromDir(dir string) ([]CommandFile, error) {
 				FileName:    mdEntry.Name(),
 				FilePath:    filePath,
 				Folder:      folderName,
-				BodyPreview: lib.TruncateBody(string(data), 5),
+				BodyPreview: lib.TruncateBody(redactor.Text(string(data)), 5),
 				IsEditable:  lib.IsUserOwned(filePath),
 			})
 		}
@@ -88,7 +88,7 @@ func (h *FieldStationHandler) GetCommands(_ context.Context, request GetCommands
 		return nil, err
 	}
 
-	commands, err := listCommandsFromDir(commandDir)
+	commands, err := listCommandsFromDir(commandDir, h.redactor())
 	if err != nil {
 		return nil, err
 	}
@@ -128,14 +128,17 @@ func (h *FieldStationHandler) GetCommand(_ context.Context, request GetCommandRe
 		return nil, fmt.Errorf("commands: cannot read %s: %w", filePath, err)
 	}
 
-	return GetCommand200JSONResponse(CommandDetail{
-		Name:       request.Name,
-		FileName:   request.Name + ".md",
-		FilePath:   filePath,
-		Folder:     request.Folder,
-		Body:       string(data),
-		IsEditable: lib.IsUserOwned(filePath),
-	}), nil
+	return GetCommand200JSONResponse{
+		Body: CommandDetail{
+			Name:       request.Name,
+			FileName:   request.Name + ".md",
+			FilePath:   filePath,
+			Folder:     request.Folder,
+			Body:       h.redactor().Text(string(data)),
+			IsEditable: lib.IsUserOwned(filePath),
+		},
+		Headers: GetCommand200ResponseHeaders{ETag: lib.ContentETag(data)},
+	}, nil
 }
 
 // CreateCommand creates a new command file inside the specified folder.
@@ -196,7 +199,7 @@ func (h *FieldStationHandler) CreateCommand(_ context.Context, request CreateCom
 		FileName:    body.Name + ".md",
 		FilePath:    filePath,
 		Folder:      body.Folder,
-		BodyPreview: lib.TruncateBody(body.Body, 5),
+		BodyPreview: lib.TruncateBody(h.redactor().Text(body.Body), 5),
 		IsEditable:  lib.IsUserOwned(filePath),
 	}), nil
 }
@@ -223,10 +226,19 @@ func (h *FieldStationHandler) UpdateCommand(_ context.Context, request UpdateCom
 
 	filePath := filepath.Join(commandDir, request.Folder, request.Name+".md")
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateCommand412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
+	content, err := h.restoreRedactedFile(filePath, body.Body)
+	if resp, ok := redactedWriteConflict(err); ok {
+		return UpdateCommand409JSONResponse(resp), nil
+	}
+
 	// Backup before mutation.
 	lib.BackupFile(filePath, lib.BackupOpUpdate, h.claudeHome)
 
-	if err := lib.WriteFileAtomic(filePath, []byte(body.Body)); err != nil {
+	if err := lib.WriteFileAtomic(filePath, []byte(content)); err != nil {
 		return nil, fmt.Errorf("commands: cannot write %s: %w", filePath, err)
 	}
 
@@ -235,7 +247,7 @@ func (h *FieldStationHandler) UpdateCommand(_ context.Context, request UpdateCom
 		FileName:   request.Name + ".md",
 		FilePath:   filePath,
 		Folder:     request.Folder,
-		Body:       body.Body,
+		Body:       h.redactor().Text(content),
 		IsEditable: lib.IsUserOwned(filePath),
 	}), nil
 }
@@ -264,6 +276,10 @@ func (h *FieldStationHandler) DeleteCommand(_ context.Context, request DeleteCom
 		return nil, fmt.Errorf("commands: cannot stat %s: %w", filePath, err)
 	}
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeleteCommand412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
 	lib.BackupFile(filePath, lib.BackupOpDelete, h.claudeHome)
 
 	if err := os.Remove(filePath); err != nil {
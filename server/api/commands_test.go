@@ -57,7 +57,7 @@ func TestGetCommand_AllowsLegitimateRead(t *testing.T) {
 	require.NoError(t, err, "GetCommand must work for a legitimate request")
 	detail, ok := resp.(api.GetCommand200JSONResponse)
 	require.True(t, ok)
-	assert.Equal(t, "mycmd", detail.Name)
+	assert.Equal(t, "mycmd", detail.Body.Name)
 }
 
 // Issue C: IsUserOwned write guard — CreateCommand must reject plugin-cache targets
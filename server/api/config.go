@@ -2,14 +2,15 @@ package api //nolint:revive // "api" is a meaningful package name for this HTTP
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"path/filepath"
-	"strings"
 
 	"fieldstation/lib"
 )
 
-// GetConfig returns the merged configuration with all layers.
+// GetConfig returns the merged configuration with all layers, with secrets
+// redacted from every layer, the merged view and each key's provenance.
 func (h *FieldStationHandler) GetConfig(_ context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error) {
 	projectPath := ""
 	if request.Params.ProjectId != nil && *request.Params.ProjectId != "" {
@@ -21,33 +22,70 @@ func (h *FieldStationHandler) GetConfig(_ context.Context, request GetConfigRequ
 	}
 
 	result := lib.MergeConfigLayers(projectPath)
+	redactor := h.redactor()
 
 	apiLayers := make([]ConfigLayer, len(result.Layers))
 	for i, layer := range result.Layers {
 		var content *map[string]interface{}
 		if layer.Content != nil {
-			c := map[string]interface{}(layer.Content)
+			c := map[string]interface{}(redactor.Object(layer.Content))
 			content = &c
 		}
+		readOnly := layer.Source.ReadOnly()
 		apiLayers[i] = ConfigLayer{
 			Source:   ConfigLayerSource(layer.Source),
 			FilePath: layer.FilePath,
 			Exists:   layer.Exists,
 			Content:  content,
+			ReadOnly: &readOnly,
+			Etag:     &layer.ETag,
+		}
+		if layer.ParseError != nil {
+			apiLayers[i].ParseError = parseErrorToAPI(layer.ParseError)
 		}
 	}
 
-	merged := map[string]interface{}(result.Merged)
+	merged := map[string]interface{}(redactor.Object(result.Merged))
 	if merged == nil {
 		merged = map[string]interface{}{}
 	}
 
+	provenance := make(map[string]KeyProvenance, len(result.Provenance))
+	for keyPath, p := range result.Provenance {
+		entry := KeyProvenance{
+			Source:   ConfigLayerSource(p.Source),
+			FilePath: p.FilePath,
+			Value:    redactor.AtPath(keyPath, p.Value),
+			Shadowed: shadowedValuesToAPI(p.Shadowed, keyPath, redactor),
+		}
+		if len(p.Contributions) > 0 {
+			contributions := shadowedValuesToAPI(p.Contributions, keyPath, redactor)
+			entry.Contributions = &contributions
+		}
+		provenance[keyPath] = entry
+	}
+
 	return GetConfig200JSONResponse(ConfigResponse{
-		Layers: apiLayers,
-		Merged: merged,
+		Layers:     apiLayers,
+		Merged:     merged,
+		Provenance: &provenance,
 	}), nil
 }
 
+// shadowedValuesToAPI converts lib shadowed values of keyPath to their API
+// representation, redacted.
+func shadowedValuesToAPI(values []lib.ShadowedValue, keyPath string, redactor *lib.Redactor) []ShadowedValue {
+	out := make([]ShadowedValue, len(values))
+	for i, sv := range values {
+		out[i] = ShadowedValue{
+			Source:   ConfigLayerSource(sv.Source),
+			FilePath: sv.FilePath,
+			Value:    redactor.AtPath(keyPath, sv.Value),
+		}
+	}
+	return out
+}
+
 // resolveConfigFilePath resolves the correct settings.json path based on optional
 // decoded projectPath. If projectPath is non-empty, returns the project settings
 // file path; otherwise returns the global settings file path.
@@ -78,13 +116,34 @@ func (h *FieldStationHandler) UpdateConfigSetting(_ context.Context, request Upd
 		return nil, err
 	}
 
-	keyPath := strings.Join(request.Body.KeyPath, ".")
+	keyPath := lib.JoinPath(request.Body.KeyPath...)
 
-	if err := lib.ApplyUpdateSetting(filePath, keyPath, request.Body.Value, h.claudeHome); err != nil {
+	validation := lib.ValidateSettingValue(keyPath, request.Body.Value)
+	if !validation.Valid() {
+		return UpdateConfigSetting400JSONResponse(validationErrorResponse(validation)), nil
+	}
+	if err := lib.CheckManagedPolicy(keyPath); err != nil {
+		return UpdateConfigSetting409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateConfigSetting412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	apply := lib.ApplyUpdateSetting
+	if request.Body.Insert != nil && *request.Body.Insert {
+		apply = lib.ApplyInsertSetting
+	}
+	if err := apply(filePath, keyPath, request.Body.Value, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateConfigSetting422JSONResponse(resp), nil
+		}
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateConfigSetting409JSONResponse(resp), nil
+		}
 		return nil, err
 	}
 
-	return UpdateConfigSetting200JSONResponse(SuccessResponse{Success: true}), nil
+	return UpdateConfigSetting200JSONResponse(settingWriteResponse(validation)), nil
 }
 
 // DeleteConfigSetting deletes a setting from the appropriate settings.json.
@@ -107,52 +166,181 @@ func (h *FieldStationHandler) DeleteConfigSetting(_ context.Context, request Del
 		return nil, err
 	}
 
-	keyPath := strings.Join(request.Body.KeyPath, ".")
+	keyPath := lib.JoinPath(request.Body.KeyPath...)
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeleteConfigSetting412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
 	if err := lib.ApplyDeleteSetting(filePath, keyPath, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return DeleteConfigSetting422JSONResponse(resp), nil
+		}
 		return nil, err
 	}
 
 	return DeleteConfigSetting200JSONResponse(SuccessResponse{Success: true}), nil
 }
 
-// MoveConfigSetting moves a config setting between base and local settings files.
-// When projectId is provided, moves between project-scoped settings files.
+// MoveConfigSetting moves or copies a config setting from one settings layer to
+// one or more others, in any combination of scopes and projects. The legacy
+// direction form moves between the base and local files of a single scope.
+// Every file touched is backed up as one group and written all-or-nothing.
 func (h *FieldStationHandler) MoveConfigSetting(_ context.Context, request MoveConfigSettingRequestObject) (MoveConfigSettingResponseObject, error) {
 	if request.Body == nil {
 		return nil, fmt.Errorf("request body is required")
 	}
 
-	var globalPath, localPath string
-	if request.Body.ProjectId != nil && *request.Body.ProjectId != "" {
-		pp, err := resolveProjectPath(h.claudeHome, *request.Body.ProjectId)
-		if err != nil {
-			return nil, fmt.Errorf("unsafe project id: %w", err)
+	from, to, err := h.settingTransferLocations(request.Body)
+	if err != nil {
+		return nil, err
+	}
+	keyPath := lib.JoinPath(request.Body.KeyPath...)
+	move := request.Body.Copy == nil || !*request.Body.Copy
+
+	if err := lib.CheckManagedPolicy(keyPath); err != nil {
+		return MoveConfigSetting409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	patch, err := lib.PlanSettingTransfer(keyPath, from, to, move)
+	if err != nil {
+		var transferErr *lib.SettingTransferError
+		if errors.As(err, &transferErr) {
+			return MoveConfigSetting409JSONResponse(ErrorResponse{Error: transferErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return MoveConfigSetting422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+
+	// The value is unchanged by a move, but it is validated anyway so that a
+	// value which only passed because it was never checked cannot spread.
+	var validation lib.SettingsValidation
+	for _, file := range patch.Files {
+		if edit := file.Edits[0]; !edit.Delete {
+			validation = lib.ValidateSettingValue(edit.KeyPath, edit.Value)
+			break
 		}
-		globalPath = filepath.Join(pp, ".claude", "settings.json")
-		localPath = filepath.Join(pp, ".claude", "settings.local.json")
-	} else {
-		globalPath = filepath.Join(h.claudeHome, "settings.json")
-		localPath = filepath.Join(h.claudeHome, "settings.local.json")
+	}
+	if !validation.Valid() {
+		return MoveConfigSetting400JSONResponse(validationErrorResponse(validation)), nil
 	}
 
-	keyPath := strings.Join(request.Body.KeyPath, ".")
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, patch.FilePaths()...); failed != nil {
+		return MoveConfigSetting412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	group, err := patch.Apply(h.claudeHome)
+	if err != nil {
+		return nil, err
+	}
 
-	var fromPath, toPath string
-	switch request.Body.Direction {
+	return MoveConfigSetting200JSONResponse(configPatchResponse(patch, group, validation)), nil
+}
+
+// settingTransferLocations resolves the source and destinations of a move
+// request. The direction form maps onto the base and local layers of the scope
+// given by projectId.
+func (h *FieldStationHandler) settingTransferLocations(body *MoveConfigSettingRequest) (lib.SettingLocation, []lib.SettingLocation, error) {
+	if body.From != nil || body.To != nil {
+		if body.From == nil || body.To == nil {
+			return lib.SettingLocation{}, nil, fmt.Errorf("from and to must be given together")
+		}
+		from, err := h.settingLocation(*body.From)
+		if err != nil {
+			return lib.SettingLocation{}, nil, err
+		}
+		to := make([]lib.SettingLocation, len(*body.To))
+		for i, ref := range *body.To {
+			if to[i], err = h.settingLocation(ref); err != nil {
+				return lib.SettingLocation{}, nil, err
+			}
+		}
+		return from, to, nil
+	}
+
+	if body.Direction == nil {
+		return lib.SettingLocation{}, nil, fmt.Errorf("either direction or from and to is required")
+	}
+	base := lib.SettingLocation{Source: lib.ConfigLayerGlobal}
+	local := lib.SettingLocation{Source: lib.ConfigLayerGlobalLocal}
+	if body.ProjectId != nil && *body.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *body.ProjectId)
+		if err != nil {
+			return lib.SettingLocation{}, nil, fmt.Errorf("unsafe project id: %w", err)
+		}
+		base = lib.SettingLocation{Source: lib.ConfigLayerProject, ProjectPath: pp}
+		local = lib.SettingLocation{Source: lib.ConfigLayerProjectLocal, ProjectPath: pp}
+	}
+	switch *body.Direction {
 	case Up:
-		fromPath = globalPath
-		toPath = localPath
+		return base, []lib.SettingLocation{local}, nil
 	case Down:
-		fromPath = localPath
-		toPath = globalPath
+		return local, []lib.SettingLocation{base}, nil
 	default:
-		return nil, fmt.Errorf("invalid direction: %s", request.Body.Direction)
+		return lib.SettingLocation{}, nil, fmt.Errorf("invalid direction: %s", *body.Direction)
+	}
+}
+
+// settingLocation resolves a layer reference, decoding its project ID if any.
+func (h *FieldStationHandler) settingLocation(ref SettingLayerRef) (lib.SettingLocation, error) {
+	loc := lib.SettingLocation{Source: lib.ConfigLayerSource(ref.Layer)}
+	if ref.ProjectId != nil && *ref.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *ref.ProjectId)
+		if err != nil {
+			return lib.SettingLocation{}, fmt.Errorf("unsafe project id: %w", err)
+		}
+		loc.ProjectPath = pp
 	}
+	return loc, nil
+}
 
-	if err := lib.ApplyMoveSetting(fromPath, toPath, keyPath, h.claudeHome); err != nil {
-		return nil, err
+// parseErrorToAPI converts a settings parse failure to its API representation.
+func parseErrorToAPI(e *lib.JSONParseError) *JSONParseError {
+	return &JSONParseError{FilePath: e.FilePath, Line: e.Line, Column: e.Column, Message: e.Message}
+}
+
+// settingsParseErrorResponse reports whether err is a settings file parse
+// failure and, if so, builds the 422 body describing it.
+func settingsParseErrorResponse(err error) (SettingsParseErrorResponse, bool) {
+	var parseErr *lib.JSONParseError
+	if !errors.As(err, &parseErr) {
+		return SettingsParseErrorResponse{}, false
 	}
+	return SettingsParseErrorResponse{
+		Error:      "refusing to overwrite unparseable settings file: " + parseErr.Error(),
+		ParseError: *parseErrorToAPI(parseErr),
+	}, true
+}
 
-	return MoveConfigSetting200JSONResponse(SuccessResponse{Success: true}), nil
+// settingsIssuesToAPI converts schema validation issues to the API representation.
+func settingsIssuesToAPI(issues []lib.SettingsIssue) []ValidationIssue {
+	out := make([]ValidationIssue, len(issues))
+	for i, issue := range issues {
+		out[i] = ValidationIssue{Path: issue.Path, Message: issue.Message}
+	}
+	return out
+}
+
+// validationErrorResponse builds the 400 body for a write rejected by the settings schema.
+func validationErrorResponse(v lib.SettingsValidation) ValidationErrorResponse {
+	resp := ValidationErrorResponse{
+		Error:  (&lib.SettingsValidationError{Issues: v.Errors}).Error(),
+		Issues: settingsIssuesToAPI(v.Errors),
+	}
+	if len(v.Warnings) > 0 {
+		warnings := settingsIssuesToAPI(v.Warnings)
+		resp.Warnings = &warnings
+	}
+	return resp
+}
+
+// settingWriteResponse builds the success body for a settings write, carrying
+// any unknown-key warnings produced by schema validation.
+func settingWriteResponse(v lib.SettingsValidation) SettingWriteResponse {
+	resp := SettingWriteResponse{Success: true}
+	if len(v.Warnings) > 0 {
+		warnings := settingsIssuesToAPI(v.Warnings)
+		resp.Warnings = &warnings
+	}
+	return resp
 }
@@ -9,6 +9,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 	"fieldstation/api"
+	"fieldstation/lib"
 )
 
 // Issue R: UpdateConfigSetting and DeleteConfigSetting must correctly validate paths.
@@ -81,10 +82,11 @@ func TestMoveConfigSetting_GlobalUp_MovesToLocal(t *testing.T) {
 	settingsPath := filepath.Join(claudeHome, "settings.json")
 	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"verbosity":"verbose"}`), 0o600))
 
+	up := api.Up
 	_, err := h.MoveConfigSetting(context.Background(), api.MoveConfigSettingRequestObject{
 		Body: &api.MoveConfigSettingJSONRequestBody{
 			KeyPath:   []string{"verbosity"},
-			Direction: api.Up,
+			Direction: &up,
 		},
 	})
 	require.NoError(t, err)
@@ -95,3 +97,275 @@ func TestMoveConfigSetting_GlobalUp_MovesToLocal(t *testing.T) {
 	require.NoError(t, err)
 	assert.Contains(t, string(data), "verbose")
 }
+
+func TestUpdateConfigSetting_RejectsSchemaViolation(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"model":"opus"}`), 0o600))
+
+	resp, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Body: &api.UpdateConfigSettingJSONRequestBody{
+			KeyPath: []string{"permissions", "allow"},
+			Value:   "Bash(npm test)",
+		},
+	})
+	require.NoError(t, err)
+	bad, ok := resp.(api.UpdateConfigSetting400JSONResponse)
+	require.True(t, ok, "expected 400 response, got %T", resp)
+	require.Len(t, bad.Issues, 1)
+	assert.Equal(t, "permissions.allow", bad.Issues[0].Path)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus"}`, string(data), "rejected write must not touch the file")
+}
+
+func TestUpdateConfigSetting_UnknownKeyWritesWithWarning(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+
+	resp, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Body: &api.UpdateConfigSettingJSONRequestBody{
+			KeyPath: []string{"someFutureSetting"},
+			Value:   true,
+		},
+	})
+	require.NoError(t, err)
+	ok200, ok := resp.(api.UpdateConfigSetting200JSONResponse)
+	require.True(t, ok)
+	require.NotNil(t, ok200.Warnings)
+	assert.Equal(t, "someFutureSetting", (*ok200.Warnings)[0].Path)
+
+	data, err := os.ReadFile(filepath.Join(claudeHome, "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "someFutureSetting")
+}
+
+func TestUpdateConfigSetting_RefusesKeyPinnedByManagedPolicy(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "managed-settings.json"), []byte(`{"model":"policy"}`), 0o600))
+
+	resp, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Body: &api.UpdateConfigSettingJSONRequestBody{
+			KeyPath: []string{"model"},
+			Value:   "mine",
+		},
+	})
+	require.NoError(t, err)
+	conflict, ok := resp.(api.UpdateConfigSetting409JSONResponse)
+	require.True(t, ok, "expected 409 response, got %T", resp)
+	assert.Contains(t, conflict.Error, "managed policy")
+	assert.NoFileExists(t, filepath.Join(claudeHome, "settings.json"))
+}
+
+func TestGetConfig_IncludesReadOnlyManagedLayer(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "managed-settings.json"), []byte(`{"model":"policy"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"mine"}`), 0o600))
+
+	resp, err := h.GetConfig(context.Background(), api.GetConfigRequestObject{})
+	require.NoError(t, err)
+	cr, ok := resp.(api.GetConfig200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "policy", cr.Merged["model"])
+
+	last := cr.Layers[len(cr.Layers)-1]
+	assert.Equal(t, api.ConfigLayerSourceManaged, last.Source)
+	require.NotNil(t, last.ReadOnly)
+	assert.True(t, *last.ReadOnly)
+}
+
+func TestGetConfig_ReturnsProvenance(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"sonnet"}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(`{"model":"opus"}`), 0o600))
+
+	resp, err := h.GetConfig(context.Background(), api.GetConfigRequestObject{})
+	require.NoError(t, err)
+	cr, ok := resp.(api.GetConfig200JSONResponse)
+	require.True(t, ok)
+	require.NotNil(t, cr.Provenance)
+
+	model, ok := (*cr.Provenance)["model"]
+	require.True(t, ok)
+	assert.Equal(t, api.ConfigLayerSourceGlobalLocal, model.Source)
+	assert.Equal(t, "opus", model.Value)
+	require.Len(t, model.Shadowed, 1)
+	assert.Equal(t, "sonnet", model.Shadowed[0].Value)
+}
+
+func TestGetConfig_MergesDenyRulesAcrossLayers(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"permissions":{"deny":["WebFetch"]}}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(`{"permissions":{"deny":["Bash(rm:*)"]}}`), 0o600))
+
+	resp, err := h.GetConfig(context.Background(), api.GetConfigRequestObject{})
+	require.NoError(t, err)
+	cr, ok := resp.(api.GetConfig200JSONResponse)
+	require.True(t, ok)
+
+	perms := cr.Merged["permissions"].(map[string]any)
+	assert.Equal(t, []any{"WebFetch", "Bash(rm:*)"}, perms["deny"])
+
+	deny := (*cr.Provenance)["permissions.deny"]
+	require.NotNil(t, deny.Contributions)
+	require.Len(t, *deny.Contributions, 1)
+	assert.Equal(t, api.ConfigLayerSourceGlobal, (*deny.Contributions)[0].Source)
+}
+
+func TestUpdateConfigSetting_RefusesUnparseableFile(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	original := []byte("{\n  \"model\": \"opus\",\n}\n")
+	require.NoError(t, os.WriteFile(settingsPath, original, 0o600))
+
+	resp, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Body: &api.UpdateConfigSettingJSONRequestBody{KeyPath: []string{"theme"}, Value: "dark"},
+	})
+	require.NoError(t, err)
+	unprocessable, ok := resp.(api.UpdateConfigSetting422JSONResponse)
+	require.True(t, ok, "expected 422, got %T", resp)
+	assert.Equal(t, 3, unprocessable.ParseError.Line)
+	assert.Equal(t, 1, unprocessable.ParseError.Column)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, original, data)
+}
+
+func TestGetConfig_ReportsLayerParseError(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"a":1,}`), 0o600))
+
+	resp, err := h.GetConfig(context.Background(), api.GetConfigRequestObject{})
+	require.NoError(t, err)
+	cr, ok := resp.(api.GetConfig200JSONResponse)
+	require.True(t, ok)
+	require.NotNil(t, cr.Layers[0].ParseError)
+	assert.Equal(t, 8, cr.Layers[0].ParseError.Column)
+}
+
+func TestUpdateConfigSetting_KeyContainingDots(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+
+	_, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Body: &api.UpdateConfigSettingJSONRequestBody{
+			KeyPath: []string{"env", "my.var"},
+			Value:   "1",
+		},
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(filepath.Join(claudeHome, "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"env":{"my.var":"1"}}`, string(data))
+}
+
+func TestUpdateConfigSetting_InsertsAndDeletesArrayElements(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"permissions":{"allow":["Read","Bash(npm run test.unit)"]}}`), 0o600))
+
+	insert := true
+	resp, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Body: &api.UpdateConfigSettingJSONRequestBody{
+			KeyPath: []string{"permissions", "allow", "0"},
+			Value:   "Edit",
+			Insert:  &insert,
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.UpdateConfigSetting200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+
+	_, err = h.DeleteConfigSetting(context.Background(), api.DeleteConfigSettingRequestObject{
+		Body: &api.DeleteConfigSettingJSONRequestBody{KeyPath: []string{"permissions", "allow", "2"}},
+	})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, `{"permissions":{"allow":["Edit","Read"]}}`, string(data))
+}
+
+func TestMoveConfigSetting_CopiesGlobalKeyToSeveralProjects(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"opus"}`), 0o600))
+	projectA, projectB := t.TempDir(), t.TempDir()
+	encodedA := registerProject(t, claudeHome, projectA)
+	encodedB := registerProject(t, claudeHome, projectB)
+
+	copyOnly := true
+	resp, err := h.MoveConfigSetting(context.Background(), api.MoveConfigSettingRequestObject{
+		Body: &api.MoveConfigSettingJSONRequestBody{
+			KeyPath: []string{"model"},
+			From:    &api.SettingLayerRef{Layer: api.ConfigLayerSourceGlobal},
+			To: &[]api.SettingLayerRef{
+				{Layer: api.ConfigLayerSourceProject, ProjectId: &encodedA},
+				{Layer: api.ConfigLayerSourceProjectLocal, ProjectId: &encodedB},
+			},
+			Copy: &copyOnly,
+		},
+	})
+	require.NoError(t, err)
+	ok200, ok := resp.(api.MoveConfigSetting200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, ok200.Files, 2)
+
+	for _, path := range []string{
+		filepath.Join(projectA, ".claude", "settings.json"),
+		filepath.Join(projectB, ".claude", "settings.local.json"),
+		filepath.Join(claudeHome, "settings.json"),
+	} {
+		data, err := os.ReadFile(path) //nolint:gosec // path is from t.TempDir(), safe in tests
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"model":"opus"}`, string(data))
+	}
+}
+
+func TestMoveConfigSetting_DemotesGlobalKeyIntoProject(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"model":"opus","theme":"dark"}`), 0o600))
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+
+	resp, err := h.MoveConfigSetting(context.Background(), api.MoveConfigSettingRequestObject{
+		Body: &api.MoveConfigSettingJSONRequestBody{
+			KeyPath: []string{"theme"},
+			From:    &api.SettingLayerRef{Layer: api.ConfigLayerSourceGlobal},
+			To:      &[]api.SettingLayerRef{{Layer: api.ConfigLayerSourceProject, ProjectId: &encoded}},
+		},
+	})
+	require.NoError(t, err)
+	ok200, ok := resp.(api.MoveConfigSetting200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.NotNil(t, ok200.BackupGroup)
+	backups := lib.ListBackups(claudeHome)
+	require.Len(t, backups, 1, "only the global file existed before the move")
+	assert.Equal(t, *ok200.BackupGroup, backups[0].Group)
+
+	global, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus"}`, string(global))
+	project, err := os.ReadFile(filepath.Join(projectDir, ".claude", "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"theme":"dark"}`, string(project))
+}
+
+func TestMoveConfigSetting_MissingKeyReturns409(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"opus"}`), 0o600))
+
+	resp, err := h.MoveConfigSetting(context.Background(), api.MoveConfigSettingRequestObject{
+		Body: &api.MoveConfigSettingJSONRequestBody{
+			KeyPath: []string{"theme"},
+			From:    &api.SettingLayerRef{Layer: api.ConfigLayerSourceGlobal},
+			To:      &[]api.SettingLayerRef{{Layer: api.ConfigLayerSourceGlobalLocal}},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.MoveConfigSetting409JSONResponse)
+	require.True(t, ok, "expected 409 response, got %T", resp)
+	assert.NoFileExists(t, filepath.Join(claudeHome, "settings.local.json"))
+}
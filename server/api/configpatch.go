@@ -0,0 +1,104 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"fieldstation/lib"
+)
+
+// PatchConfig applies a JSON Patch whose operations may target several settings
+// layers. Every operation is applied in memory and every resulting value is
+// validated before any file is written; the writes themselves are all-or-nothing.
+func (h *FieldStationHandler) PatchConfig(_ context.Context, request PatchConfigRequestObject) (PatchConfigResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+
+	projectPath := ""
+	if request.Body.ProjectId != nil && *request.Body.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *request.Body.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("config: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	ops := make([]lib.PatchOperation, len(request.Body.Operations))
+	for i, op := range request.Body.Operations {
+		ops[i] = lib.PatchOperation{
+			Op:    string(op.Op),
+			Layer: lib.ConfigLayerSource(op.Layer),
+			Path:  op.Path,
+			Value: op.Value,
+		}
+		if op.From != nil {
+			ops[i].From = *op.From
+		}
+		if op.FromLayer != nil {
+			ops[i].FromLayer = lib.ConfigLayerSource(*op.FromLayer)
+		}
+	}
+
+	patch, err := lib.PlanConfigPatch(ops, projectPath)
+	if err != nil {
+		var patchErr *lib.PatchError
+		if errors.As(err, &patchErr) {
+			return PatchConfig409JSONResponse(ErrorResponse{Error: patchErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return PatchConfig422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+
+	var validation lib.SettingsValidation
+	for _, file := range patch.Files {
+		for _, edit := range file.Edits {
+			if err := lib.CheckManagedPolicy(edit.KeyPath); err != nil {
+				return PatchConfig409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+			}
+			if edit.Delete {
+				continue
+			}
+			v := lib.ValidateSettingValue(edit.KeyPath, edit.Value)
+			validation.Errors = append(validation.Errors, v.Errors...)
+			validation.Warnings = append(validation.Warnings, v.Warnings...)
+		}
+	}
+	if !validation.Valid() {
+		return PatchConfig400JSONResponse(validationErrorResponse(validation)), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, patch.FilePaths()...); failed != nil {
+		return PatchConfig412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	group, err := patch.Apply(h.claudeHome)
+	if err != nil {
+		return nil, err
+	}
+
+	return PatchConfig200JSONResponse(configPatchResponse(patch, group, validation)), nil
+}
+
+// configPatchResponse builds the success body for a multi-file settings write,
+// carrying each written file's new ETag, the backup group and any warnings.
+func configPatchResponse(patch *lib.ConfigPatch, group string, validation lib.SettingsValidation) ConfigPatchResponse {
+	resp := ConfigPatchResponse{Success: true, Files: make([]PatchedConfigFile, len(patch.Files))}
+	for i, file := range patch.Files {
+		resp.Files[i] = PatchedConfigFile{
+			Source:   ConfigLayerSource(file.Source),
+			FilePath: file.FilePath,
+			Etag:     lib.ContentETag(file.Updated),
+		}
+	}
+	if group != "" {
+		resp.BackupGroup = &group
+	}
+	if len(validation.Warnings) > 0 {
+		warnings := settingsIssuesToAPI(validation.Warnings)
+		resp.Warnings = &warnings
+	}
+	return resp
+}
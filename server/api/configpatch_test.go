@@ -0,0 +1,119 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+	"fieldstation/lib"
+)
+
+func patchOp(op api.ConfigPatchOperationOp, layer api.ConfigLayerSource, path string, value any) api.ConfigPatchOperation {
+	return api.ConfigPatchOperation{Op: op, Layer: layer, Path: path, Value: value}
+}
+
+func TestPatchConfig_MovesRuleAndAddsEnvAcrossLayers(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"permissions":{"allow":["Bash(npm test)"]}}`), 0o600))
+
+	global := api.ConfigLayerSourceGlobal
+	from := "/permissions/allow/0"
+	move := patchOp(api.Move, api.ConfigLayerSourceProjectLocal, "/permissions/allow/-", nil)
+	move.FromLayer, move.From = &global, &from
+	resp, err := h.PatchConfig(context.Background(), api.PatchConfigRequestObject{
+		Body: &api.PatchConfigJSONRequestBody{
+			ProjectId: &encoded,
+			Operations: []api.ConfigPatchOperation{
+				patchOp(api.Add, api.ConfigLayerSourceProjectLocal, "/permissions", map[string]any{"allow": []any{}}),
+				move,
+				patchOp(api.Add, api.ConfigLayerSourceProjectLocal, "/env", map[string]any{"CI": "1"}),
+			},
+		},
+	})
+	require.NoError(t, err)
+	ok200, ok := resp.(api.PatchConfig200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	require.Len(t, ok200.Files, 2)
+	require.NotNil(t, ok200.BackupGroup)
+
+	localPath := filepath.Join(projectDir, ".claude", "settings.local.json")
+	local, err := os.ReadFile(localPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Bash(npm test)"]},"env":{"CI":"1"}}`, string(local))
+	assert.Equal(t, lib.ContentETag(local), ok200.Files[0].Etag)
+
+	global2, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":[]}}`, string(global2))
+
+	// Only the global file existed, so it is the only one in the backup group.
+	backups := lib.ListBackups(claudeHome)
+	require.Len(t, backups, 1)
+	assert.Equal(t, *ok200.BackupGroup, backups[0].Group)
+}
+
+func TestPatchConfig_SchemaViolationWritesNothing(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"model":"opus"}`), 0o600))
+
+	resp, err := h.PatchConfig(context.Background(), api.PatchConfigRequestObject{
+		Body: &api.PatchConfigJSONRequestBody{
+			Operations: []api.ConfigPatchOperation{
+				patchOp(api.Replace, api.ConfigLayerSourceGlobal, "/model", "sonnet"),
+				patchOp(api.Add, api.ConfigLayerSourceGlobalLocal, "/permissions", map[string]any{"allow": "Bash(ls)"}),
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.PatchConfig400JSONResponse)
+	require.True(t, ok, "expected 400 response, got %T", resp)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, `{"model":"opus"}`, string(data))
+	assert.NoFileExists(t, filepath.Join(claudeHome, "settings.local.json"))
+}
+
+func TestPatchConfig_FailedOperationReturns409(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"opus"}`), 0o600))
+
+	resp, err := h.PatchConfig(context.Background(), api.PatchConfigRequestObject{
+		Body: &api.PatchConfigJSONRequestBody{
+			Operations: []api.ConfigPatchOperation{
+				patchOp(api.Test, api.ConfigLayerSourceGlobal, "/model", "sonnet"),
+				patchOp(api.Remove, api.ConfigLayerSourceGlobal, "/model", nil),
+			},
+		},
+	})
+	require.NoError(t, err)
+	conflict, ok := resp.(api.PatchConfig409JSONResponse)
+	require.True(t, ok, "expected 409 response, got %T", resp)
+	assert.Contains(t, conflict.Error, "patch operation 0")
+}
+
+func TestPatchConfig_StaleIfMatchReturns412(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"opus"}`), 0o600))
+	stale := lib.ContentETag([]byte(`{}`))
+
+	resp, err := h.PatchConfig(context.Background(), api.PatchConfigRequestObject{
+		Params: api.PatchConfigParams{IfMatch: &stale},
+		Body: &api.PatchConfigJSONRequestBody{
+			Operations: []api.ConfigPatchOperation{
+				patchOp(api.Replace, api.ConfigLayerSourceGlobal, "/model", "sonnet"),
+			},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.PatchConfig412JSONResponse)
+	require.True(t, ok, "expected 412 response, got %T", resp)
+}
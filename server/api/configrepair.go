@@ -0,0 +1,100 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+
+	"fieldstation/lib"
+)
+
+// resolveRepairLayerPath resolves the settings file for layer, decoding projectId
+// when the layer is project-scoped.
+func (h *FieldStationHandler) resolveRepairLayerPath(layer ConfigLayerSource, projectID *string) (string, error) {
+	projectPath := ""
+	if projectID != nil && *projectID != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *projectID)
+		if err != nil {
+			return "", fmt.Errorf("config: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+	return lib.ResolveLayerPath(lib.ConfigLayerSource(layer), projectPath)
+}
+
+// GetConfigRepair returns the contents of a settings file alongside a
+// best-effort repaired version when the file fails to parse, both with secrets
+// redacted.
+func (h *FieldStationHandler) GetConfigRepair(_ context.Context, request GetConfigRepairRequestObject) (GetConfigRepairResponseObject, error) {
+	filePath, err := h.resolveRepairLayerPath(request.Params.Layer, request.Params.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	preview := ConfigRepairPreview{Source: request.Params.Layer, FilePath: filePath}
+	data, err := os.ReadFile(filePath) //nolint:gosec // filePath is from ResolveLayerPath, which returns only known config paths
+	if errors.Is(err, os.ErrNotExist) {
+		return GetConfigRepair200JSONResponse(preview), nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("config: read %s: %w", filePath, err)
+	}
+	preview.Exists = true
+	redactor := h.redactor()
+	preview.Original = redactor.Text(string(data))
+	etag := lib.ContentETag(data)
+	preview.Etag = &etag
+
+	var parseErr *lib.JSONParseError
+	if _, err := lib.ParseJSONObject(filePath, data); !errors.As(err, &parseErr) {
+		return GetConfigRepair200JSONResponse(preview), nil
+	}
+	preview.ParseError = parseErrorToAPI(parseErr)
+
+	repaired := lib.RepairJSON(data)
+	repairedText := redactor.Text(string(repaired))
+	preview.Repaired = &repairedText
+	if _, err := lib.ParseJSONObject(filePath, repaired); errors.As(err, &parseErr) {
+		preview.RepairedParseError = parseErrorToAPI(parseErr)
+	}
+	return GetConfigRepair200JSONResponse(preview), nil
+}
+
+// RepairConfigLayer overwrites a settings file that fails to parse with its
+// repaired version, backing up the broken original first. The repaired text is
+// written as produced by lib.RepairJSON so formatting is preserved.
+func (h *FieldStationHandler) RepairConfigLayer(_ context.Context, request RepairConfigLayerRequestObject) (RepairConfigLayerResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	if lib.ConfigLayerSource(request.Body.Layer).ReadOnly() {
+		return RepairConfigLayer409JSONResponse(ErrorResponse{Error: "the managed policy layer is read-only"}), nil
+	}
+	filePath, err := h.resolveRepairLayerPath(request.Body.Layer, request.Body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := os.ReadFile(filePath) //nolint:gosec // filePath is from ResolveLayerPath, which returns only known config paths
+	if err != nil {
+		return nil, fmt.Errorf("config: read %s: %w", filePath, err)
+	}
+	if _, err := lib.ParseJSONObject(filePath, data); err == nil {
+		return RepairConfigLayer409JSONResponse(ErrorResponse{Error: "settings file already parses; nothing to repair"}), nil
+	}
+	repaired := lib.RepairJSON(data)
+	if _, err := lib.ParseJSONObject(filePath, repaired); err != nil {
+		return RepairConfigLayer409JSONResponse(ErrorResponse{Error: "automatic repair failed: " + err.Error()}), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return RepairConfigLayer412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
+	lib.BackupFile(filePath, lib.BackupOpUpdate, h.claudeHome)
+	if err := lib.WriteFileAtomic(filePath, repaired); err != nil {
+		return nil, fmt.Errorf("config: write %s: %w", filePath, err)
+	}
+	return RepairConfigLayer200JSONResponse(SuccessResponse{Success: true}), nil
+}
@@ -0,0 +1,86 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+	"fieldstation/lib"
+)
+
+func TestGetConfigRepair_PreviewsFix(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	broken := "{\n  // pinned model\n  \"model\": \"opus\",\n}\n"
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(broken), 0o600))
+
+	resp, err := h.GetConfigRepair(context.Background(), api.GetConfigRepairRequestObject{
+		Params: api.GetConfigRepairParams{Layer: api.ConfigLayerSourceGlobal},
+	})
+	require.NoError(t, err)
+	preview, ok := resp.(api.GetConfigRepair200JSONResponse)
+	require.True(t, ok)
+	assert.True(t, preview.Exists)
+	assert.Equal(t, broken, preview.Original)
+	require.NotNil(t, preview.ParseError)
+	assert.Equal(t, 2, preview.ParseError.Line)
+	require.NotNil(t, preview.Repaired)
+	assert.Nil(t, preview.RepairedParseError)
+}
+
+func TestGetConfigRepair_ValidFileHasNoRepair(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":"opus"}`), 0o600))
+
+	resp, err := h.GetConfigRepair(context.Background(), api.GetConfigRepairRequestObject{
+		Params: api.GetConfigRepairParams{Layer: api.ConfigLayerSourceGlobal},
+	})
+	require.NoError(t, err)
+	preview := resp.(api.GetConfigRepair200JSONResponse)
+	assert.Nil(t, preview.ParseError)
+	assert.Nil(t, preview.Repaired)
+}
+
+func TestRepairConfigLayer_WritesRepairedFileAndBacksUp(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"model":"opus",}`), 0o600))
+
+	resp, err := h.RepairConfigLayer(context.Background(), api.RepairConfigLayerRequestObject{
+		Body: &api.RepairConfigLayerJSONRequestBody{Layer: api.ConfigLayerSourceGlobal},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.RepairConfigLayer200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+
+	obj, err := lib.ReadJSONFileStrict(settingsPath)
+	require.NoError(t, err)
+	assert.Equal(t, "opus", obj["model"])
+	assert.NotEmpty(t, lib.ListBackups(claudeHome))
+}
+
+func TestRepairConfigLayer_RejectsUnrepairableFile(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"model":`), 0o600))
+
+	resp, err := h.RepairConfigLayer(context.Background(), api.RepairConfigLayerRequestObject{
+		Body: &api.RepairConfigLayerJSONRequestBody{Layer: api.ConfigLayerSourceGlobal},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.RepairConfigLayer409JSONResponse)
+	assert.True(t, ok, "expected 409, got %T", resp)
+}
+
+func TestRepairConfigLayer_RejectsManagedLayer(t *testing.T) {
+	h, _ := newTestHandler(t)
+
+	resp, err := h.RepairConfigLayer(context.Background(), api.RepairConfigLayerRequestObject{
+		Body: &api.RepairConfigLayerJSONRequestBody{Layer: api.ConfigLayerSourceManaged},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.RepairConfigLayer409JSONResponse)
+	assert.True(t, ok, "expected 409, got %T", resp)
+}
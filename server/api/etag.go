@@ -0,0 +1,41 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"encoding/json"
+	"path/filepath"
+
+	"fieldstation/lib"
+)
+
+// preconditionFailedResponse builds the 412 body for a write whose If-Match
+// header no longer matches the file, carrying the file's current content
+// redacted with redactor.
+func preconditionFailedResponse(e *lib.PreconditionFailedError, redactor *lib.Redactor) PreconditionFailedResponse {
+	resp := PreconditionFailedResponse{
+		Error:    e.Error(),
+		FilePath: e.FilePath,
+		Etag:     e.ETag,
+		Exists:   e.Exists,
+	}
+	if e.Exists {
+		content := redactedFileContent(e.FilePath, e.Content, redactor)
+		resp.Content = &content
+	}
+	return resp
+}
+
+// redactedFileContent redacts the content of the file at filePath. A JSON
+// object is redacted as Object does, so values that are only secret because of
+// their key are hidden too; anything else, including JSON that does not parse,
+// as Text does.
+func redactedFileContent(filePath, content string, redactor *lib.Redactor) string {
+	if filepath.Ext(filePath) == ".json" {
+		var obj lib.JsonObject
+		if err := json.Unmarshal([]byte(content), &obj); err == nil && obj != nil {
+			if out, err := json.MarshalIndent(redactor.Object(obj), "", "  "); err == nil {
+				return string(out) + "\n"
+			}
+		}
+	}
+	return redactor.Text(content)
+}
@@ -0,0 +1,168 @@
+package api_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+	"fieldstation/lib"
+)
+
+func layerETag(t *testing.T, h *api.FieldStationHandler, source api.ConfigLayerSource) string {
+	t.Helper()
+	resp, err := h.GetConfig(context.Background(), api.GetConfigRequestObject{})
+	require.NoError(t, err)
+	cr, ok := resp.(api.GetConfig200JSONResponse)
+	require.True(t, ok)
+	for _, layer := range cr.Layers {
+		if layer.Source == source {
+			require.NotNil(t, layer.Etag)
+			return *layer.Etag
+		}
+	}
+	t.Fatalf("layer %s not found", source)
+	return ""
+}
+
+func TestGetMemory_SetsETagHeader(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	writeMemoryFile(t, memoryDir(claudeHome, encoded), "MEMORY.md", "# Memory")
+
+	resp, err := h.GetMemory(context.Background(), api.GetMemoryRequestObject{
+		Filename: "MEMORY.md",
+		Params:   api.GetMemoryParams{ProjectId: encoded},
+	})
+	require.NoError(t, err)
+
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.VisitGetMemoryResponse(w))
+	assert.Equal(t, lib.ContentETag([]byte("# Memory")), w.Header().Get("ETag"))
+}
+
+func TestUpdateMemory_StaleIfMatchReturns412(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	dir := memoryDir(claudeHome, encoded)
+	writeMemoryFile(t, dir, "MEMORY.md", "edited by Claude Code")
+
+	stale := lib.ContentETag([]byte("what the editor loaded"))
+	resp, err := h.UpdateMemory(context.Background(), api.UpdateMemoryRequestObject{
+		Filename: "MEMORY.md",
+		Params:   api.UpdateMemoryParams{IfMatch: &stale},
+		Body: &api.UpdateMemoryJSONRequestBody{
+			Content:   "overwrite",
+			ProjectId: encoded,
+		},
+	})
+	require.NoError(t, err)
+	failed, ok := resp.(api.UpdateMemory412JSONResponse)
+	require.True(t, ok, "expected 412 response, got %T", resp)
+	assert.Equal(t, lib.ContentETag([]byte("edited by Claude Code")), failed.Etag)
+	require.NotNil(t, failed.Content)
+	assert.Equal(t, "edited by Claude Code", *failed.Content)
+
+	got, err := os.ReadFile(filepath.Join(dir, "MEMORY.md")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, "edited by Claude Code", string(got))
+}
+
+func TestUpdateConfigSetting_IfMatch(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"theme":"light"}`), 0o600))
+
+	etag := layerETag(t, h, api.ConfigLayerSourceGlobal)
+	// Simulate Claude Code changing the file after the editor loaded it.
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"theme":"light","model":"opus"}`), 0o600))
+
+	resp, err := h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Params: api.UpdateConfigSettingParams{IfMatch: &etag},
+		Body:   &api.UpdateConfigSettingJSONRequestBody{KeyPath: []string{"theme"}, Value: "dark"},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.UpdateConfigSetting412JSONResponse)
+	require.True(t, ok, "expected 412 response, got %T", resp)
+
+	etag = layerETag(t, h, api.ConfigLayerSourceGlobal)
+	resp, err = h.UpdateConfigSetting(context.Background(), api.UpdateConfigSettingRequestObject{
+		Params: api.UpdateConfigSettingParams{IfMatch: &etag},
+		Body:   &api.UpdateConfigSettingJSONRequestBody{KeyPath: []string{"theme"}, Value: "dark"},
+	})
+	require.NoError(t, err)
+	_, ok = resp.(api.UpdateConfigSetting200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"theme":"dark","model":"opus"}`, string(data))
+}
+
+func TestMoveConfigSetting_IfMatchListsBothFiles(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"verbosity":"verbose"}`), 0o600))
+
+	globalTag := layerETag(t, h, api.ConfigLayerSourceGlobal)
+	up := api.Up
+	move := func(ifMatch string) api.MoveConfigSettingResponseObject {
+		resp, err := h.MoveConfigSetting(context.Background(), api.MoveConfigSettingRequestObject{
+			Params: api.MoveConfigSettingParams{IfMatch: &ifMatch},
+			Body:   &api.MoveConfigSettingJSONRequestBody{KeyPath: []string{"verbosity"}, Direction: &up},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	// Only the source file's tag: the destination is unverified.
+	_, ok := move(globalTag).(api.MoveConfigSetting412JSONResponse)
+	require.True(t, ok)
+
+	localTag := layerETag(t, h, api.ConfigLayerSourceGlobalLocal)
+	_, ok = move(globalTag + ", " + localTag).(api.MoveConfigSetting200JSONResponse)
+	require.True(t, ok)
+}
+
+func TestPreconditionFailed_NeverCarriesStoredSecrets(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	secret := "sk-ant-REDACTED"
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"),
+		[]byte(`{"env":{"ANTHROPIC_API_KEY":"`+secret+`"},"theme":"dark"}`), 0o600))
+
+	bogus := `"bogus"`
+	resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+		Params: api.CreateHookParams{IfMatch: &bogus},
+		Body: &api.CreateHookJSONRequestBody{
+			Scope:    api.CreateHookRequestScopeGlobal,
+			Event:    "Stop",
+			Commands: &[]string{"echo"},
+		},
+	})
+	require.NoError(t, err)
+	failed, ok := resp.(api.CreateHook412JSONResponse)
+	require.True(t, ok, "expected 412 response, got %T", resp)
+	require.NotNil(t, failed.Content)
+	assert.NotContains(t, *failed.Content, secret)
+	assert.Contains(t, *failed.Content, `"theme": "dark"`)
+
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	writeMemoryFile(t, memoryDir(claudeHome, encoded), "MEMORY.md", "key: "+secret)
+	memResp, err := h.UpdateMemory(context.Background(), api.UpdateMemoryRequestObject{
+		Filename: "MEMORY.md",
+		Params:   api.UpdateMemoryParams{IfMatch: &bogus},
+		Body:     &api.UpdateMemoryJSONRequestBody{Content: "overwrite", ProjectId: encoded},
+	})
+	require.NoError(t, err)
+	memFailed, ok := memResp.(api.UpdateMemory412JSONResponse)
+	require.True(t, ok, "expected 412 response, got %T", memResp)
+	require.NotNil(t, memFailed.Content)
+	assert.NotContains(t, *memFailed.Content, secret)
+}
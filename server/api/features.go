@@ -36,11 +36,15 @@ func getFeatureCurrentValue(key string, featureType lib.FeatureType, settings li
 }
 
 // GetFeatures scans the Claude binary and assembles the full feature list with current values.
+// Documented features are switches rather than secrets, so their values are only
+// redacted where they look like secrets; undocumented env vars are redacted as
+// every env value is.
 func (h *FieldStationHandler) GetFeatures(_ context.Context, _ GetFeaturesRequestObject) (GetFeaturesResponseObject, error) {
 	scan := lib.ScanClaudeBinary()
 	allFeatures := lib.AllFeatures()
 	settingsPath := filepath.Join(h.claudeHome, "settings.json")
 	settings := lib.ReadJSONFileSafe(settingsPath)
+	redactor := h.redactor()
 
 	features := make([]Feature, 0, len(allFeatures)+len(scan.EnvVars))
 	seen := make(map[string]struct{}, len(allFeatures)+len(scan.EnvVars))
@@ -48,7 +52,7 @@ func (h *FieldStationHandler) GetFeatures(_ context.Context, _ GetFeaturesReques
 	// Add all registry features first (documented).
 	for _, f := range allFeatures {
 		seen[f.ID] = struct{}{}
-		currentVal := getFeatureCurrentValue(f.ID, f.Type, settings)
+		currentVal := redactor.Value(getFeatureCurrentValue(f.ID, f.Type, settings))
 		var values *[]string
 		if len(f.Options) > 0 {
 			opts := make([]string, len(f.Options))
@@ -75,7 +79,7 @@ func (h *FieldStationHandler) GetFeatures(_ context.Context, _ GetFeaturesReques
 			continue
 		}
 		seen[envVar] = struct{}{}
-		currentVal := getFeatureCurrentValue(envVar, lib.FeatureTypeEnv, settings)
+		currentVal := redactor.AtPath(lib.JoinPath("env", envVar), getFeatureCurrentValue(envVar, lib.FeatureTypeEnv, settings))
 		features = append(features, Feature{
 			Definition: FeatureDefinition{
 				Key:         envVar,
@@ -92,11 +96,13 @@ func (h *FieldStationHandler) GetFeatures(_ context.Context, _ GetFeaturesReques
 	totalDiscovered := len(scan.EnvVars)
 	totalDocumented := len(allFeatures)
 
+	etag := lib.FileETag(settingsPath)
 	resp := FeaturesData{
 		Features:        features,
 		TotalDiscovered: totalDiscovered,
 		TotalDocumented: totalDocumented,
 		Version:         scan.Version,
+		Etag:            &etag,
 	}
 	return GetFeatures200JSONResponse(resp), nil
 }
@@ -114,17 +120,34 @@ func (h *FieldStationHandler) UpdateFeature(_ context.Context, request UpdateFea
 
 	var keyPath string
 	if body.Type == UpdateFeatureRequestTypeEnv {
-		// env features live under settings.env.<KEY>
-		keyPath = "env." + key
+		// env features live under settings.env.<KEY>; JoinPath quotes keys containing dots
+		keyPath = lib.JoinPath("env", key)
 	} else {
 		// setting features live at the dot-path key
 		keyPath = key
 	}
 
+	validation := lib.ValidateSettingValue(keyPath, body.Value)
+	if !validation.Valid() {
+		return UpdateFeature400JSONResponse(validationErrorResponse(validation)), nil
+	}
+	if err := lib.CheckManagedPolicy(keyPath); err != nil {
+		return UpdateFeature409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, settingsPath); failed != nil {
+		return UpdateFeature412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
 	if err := lib.ApplyUpdateSetting(settingsPath, keyPath, body.Value, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateFeature422JSONResponse(resp), nil
+		}
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateFeature409JSONResponse(resp), nil
+		}
 		return nil, fmt.Errorf("failed to update feature %q: %w", key, err)
 	}
-	return UpdateFeature200JSONResponse(SuccessResponse{Success: true}), nil
+	return UpdateFeature200JSONResponse(settingWriteResponse(validation)), nil
 }
 
 // DeleteFeature removes a feature value from the global settings file.
@@ -137,12 +160,18 @@ func (h *FieldStationHandler) DeleteFeature(_ context.Context, request DeleteFea
 	// Look up feature type to determine the correct key path.
 	var keyPath string
 	if feat, ok := lib.GetFeature(key); ok && feat.Type == lib.FeatureTypeEnv {
-		keyPath = "env." + key
+		keyPath = lib.JoinPath("env", key)
 	} else {
 		keyPath = key
 	}
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, settingsPath); failed != nil {
+		return DeleteFeature412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
 	if err := lib.ApplyDeleteSetting(settingsPath, keyPath, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return DeleteFeature422JSONResponse(resp), nil
+		}
 		return nil, fmt.Errorf("failed to delete feature %q: %w", key, err)
 	}
 	return DeleteFeature200JSONResponse(SuccessResponse{Success: true}), nil
@@ -58,3 +58,19 @@ func TestDeleteFeature_RemovesValueFromSettings(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotContains(t, string(data), "CLAUDE_CODE_DISABLE_AUTO_MEMORY")
 }
+
+func TestUpdateFeature_RejectsNonStringEnvValue(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+
+	resp, err := h.UpdateFeature(context.Background(), api.UpdateFeatureRequestObject{
+		Key: "DISABLE_TELEMETRY",
+		Body: &api.UpdateFeatureJSONRequestBody{
+			Type:  api.UpdateFeatureRequestTypeEnv,
+			Value: true,
+		},
+	})
+	require.NoError(t, err)
+	_, isBadRequest := resp.(api.UpdateFeature400JSONResponse)
+	assert.True(t, isBadRequest, "env values must be strings")
+	assert.NoFileExists(t, filepath.Join(claudeHome, "settings.json"))
+}
@@ -20,10 +20,21 @@ import (
 const (
 	ConfigLayerSourceGlobal       ConfigLayerSource = "global"
 	ConfigLayerSourceGlobalLocal  ConfigLayerSource = "global-local"
+	ConfigLayerSourceManaged      ConfigLayerSource = "managed"
 	ConfigLayerSourceProject      ConfigLayerSource = "project"
 	ConfigLayerSourceProjectLocal ConfigLayerSource = "project-local"
 )
 
+// Defines values for ConfigPatchOperationOp.
+const (
+	Add     ConfigPatchOperationOp = "add"
+	Copy    ConfigPatchOperationOp = "copy"
+	Move    ConfigPatchOperationOp = "move"
+	Remove  ConfigPatchOperationOp = "remove"
+	Replace ConfigPatchOperationOp = "replace"
+	Test    ConfigPatchOperationOp = "test"
+)
+
 // Defines values for CreateAgentRequestScope.
 const (
 	CreateAgentRequestScopeGlobal  CreateAgentRequestScope = "global"
@@ -38,8 +49,16 @@ const (
 
 // Defines values for CreateHookRequestScope.
 const (
-	CreateHookRequestScopeGlobal  CreateHookRequestScope = "global"
-	CreateHookRequestScopeProject CreateHookRequestScope = "project"
+	CreateHookRequestScopeGlobal       CreateHookRequestScope = "global"
+	CreateHookRequestScopeGlobalLocal  CreateHookRequestScope = "global-local"
+	CreateHookRequestScopeProject      CreateHookRequestScope = "project"
+	CreateHookRequestScopeProjectLocal CreateHookRequestScope = "project-local"
+)
+
+// Defines values for CreateOutputStyleRequestScope.
+const (
+	CreateOutputStyleRequestScopeGlobal  CreateOutputStyleRequestScope = "global"
+	CreateOutputStyleRequestScopeProject CreateOutputStyleRequestScope = "project"
 )
 
 // Defines values for CreateSkillRequestScope.
@@ -54,6 +73,12 @@ const (
 	DeleteAgentRequestScopeProject DeleteAgentRequestScope = "project"
 )
 
+// Defines values for DeleteOutputStyleRequestScope.
+const (
+	DeleteOutputStyleRequestScopeGlobal  DeleteOutputStyleRequestScope = "global"
+	DeleteOutputStyleRequestScopeProject DeleteOutputStyleRequestScope = "project"
+)
+
 // Defines values for FeatureDefinitionType.
 const (
 	FeatureDefinitionTypeEnv     FeatureDefinitionType = "env"
@@ -63,6 +88,64 @@ const (
 // Defines values for HookCommandType.
 const (
 	HookCommandTypeCommand HookCommandType = "command"
+	HookCommandTypePrompt  HookCommandType = "prompt"
+)
+
+// Defines values for HookSourceSource.
+const (
+	HookSourceSourceGlobal       HookSourceSource = "global"
+	HookSourceSourceGlobalLocal  HookSourceSource = "global-local"
+	HookSourceSourceManaged      HookSourceSource = "managed"
+	HookSourceSourcePlugin       HookSourceSource = "plugin"
+	HookSourceSourceProject      HookSourceSource = "project"
+	HookSourceSourceProjectLocal HookSourceSource = "project-local"
+)
+
+// Defines values for HookTestRequestScope.
+const (
+	HookTestRequestScopeGlobal       HookTestRequestScope = "global"
+	HookTestRequestScopeGlobalLocal  HookTestRequestScope = "global-local"
+	HookTestRequestScopeProject      HookTestRequestScope = "project"
+	HookTestRequestScopeProjectLocal HookTestRequestScope = "project-local"
+)
+
+// Defines values for HookTestResultOutcome.
+const (
+	Block   HookTestResultOutcome = "block"
+	Error   HookTestResultOutcome = "error"
+	Success HookTestResultOutcome = "success"
+	Timeout HookTestResultOutcome = "timeout"
+)
+
+// Defines values for McpApprovalState.
+const (
+	Approved McpApprovalState = "approved"
+	Pending  McpApprovalState = "pending"
+	Rejected McpApprovalState = "rejected"
+)
+
+// Defines values for McpScope.
+const (
+	McpScopeLocal   McpScope = "local"
+	McpScopeProject McpScope = "project"
+	McpScopeUser    McpScope = "user"
+)
+
+// Defines values for McpServerType.
+const (
+	Http  McpServerType = "http"
+	Sse   McpServerType = "sse"
+	Stdio McpServerType = "stdio"
+)
+
+// Defines values for MergedHookEntrySource.
+const (
+	MergedHookEntrySourceGlobal       MergedHookEntrySource = "global"
+	MergedHookEntrySourceGlobalLocal  MergedHookEntrySource = "global-local"
+	MergedHookEntrySourceManaged      MergedHookEntrySource = "managed"
+	MergedHookEntrySourcePlugin       MergedHookEntrySource = "plugin"
+	MergedHookEntrySourceProject      MergedHookEntrySource = "project"
+	MergedHookEntrySourceProjectLocal MergedHookEntrySource = "project-local"
 )
 
 // Defines values for MoveConfigSettingRequestDirection.
@@ -71,6 +154,23 @@ const (
 	Up   MoveConfigSettingRequestDirection = "up"
 )
 
+// Defines values for PermissionBehavior.
+const (
+	Allow PermissionBehavior = "allow"
+	Ask   PermissionBehavior = "ask"
+	Deny  PermissionBehavior = "deny"
+)
+
+// Defines values for PermissionPatternKind.
+const (
+	Domain PermissionPatternKind = "domain"
+	Exact  PermissionPatternKind = "exact"
+	Glob   PermissionPatternKind = "glob"
+	Mcp    PermissionPatternKind = "mcp"
+	Prefix PermissionPatternKind = "prefix"
+	Tool   PermissionPatternKind = "tool"
+)
+
 // Defines values for SearchResultType.
 const (
 	SearchResultTypeAgent   SearchResultType = "agent"
@@ -79,6 +179,22 @@ const (
 	SearchResultTypeSkill   SearchResultType = "skill"
 )
 
+// Defines values for SecretFindingKind.
+const (
+	SecretFindingKindAgent        SecretFindingKind = "agent"
+	SecretFindingKindCommand      SecretFindingKind = "command"
+	SecretFindingKindHook         SecretFindingKind = "hook"
+	SecretFindingKindInstructions SecretFindingKind = "instructions"
+	SecretFindingKindMemory       SecretFindingKind = "memory"
+	SecretFindingKindSettings     SecretFindingKind = "settings"
+	SecretFindingKindSkill        SecretFindingKind = "skill"
+)
+
+// Defines values for StatusLineConfigType.
+const (
+	StatusLineConfigTypeCommand StatusLineConfigType = "command"
+)
+
 // Defines values for UpdateAgentRequestScope.
 const (
 	UpdateAgentRequestScopeGlobal  UpdateAgentRequestScope = "global"
@@ -93,8 +209,10 @@ const (
 
 // Defines values for UpdateHookRequestScope.
 const (
-	UpdateHookRequestScopeGlobal  UpdateHookRequestScope = "global"
-	UpdateHookRequestScopeProject UpdateHookRequestScope = "project"
+	UpdateHookRequestScopeGlobal       UpdateHookRequestScope = "global"
+	UpdateHookRequestScopeGlobalLocal  UpdateHookRequestScope = "global-local"
+	UpdateHookRequestScopeProject      UpdateHookRequestScope = "project"
+	UpdateHookRequestScopeProjectLocal UpdateHookRequestScope = "project-local"
 )
 
 // Defines values for UpdateInstructionsRequestFile.
@@ -109,6 +227,12 @@ const (
 	UpdateInstructionsRequestScopeProject UpdateInstructionsRequestScope = "project"
 )
 
+// Defines values for UpdateOutputStyleRequestScope.
+const (
+	UpdateOutputStyleRequestScopeGlobal  UpdateOutputStyleRequestScope = "global"
+	UpdateOutputStyleRequestScopeProject UpdateOutputStyleRequestScope = "project"
+)
+
 // Defines values for GetAgentsParamsScope.
 const (
 	GetAgentsParamsScopeGlobal  GetAgentsParamsScope = "global"
@@ -127,10 +251,22 @@ const (
 	GetInstructionsParamsScopeProject GetInstructionsParamsScope = "project"
 )
 
+// Defines values for GetOutputStylesParamsScope.
+const (
+	GetOutputStylesParamsScopeGlobal  GetOutputStylesParamsScope = "global"
+	GetOutputStylesParamsScopeProject GetOutputStylesParamsScope = "project"
+)
+
+// Defines values for GetOutputStyleParamsScope.
+const (
+	GetOutputStyleParamsScopeGlobal  GetOutputStyleParamsScope = "global"
+	GetOutputStyleParamsScopeProject GetOutputStyleParamsScope = "project"
+)
+
 // Defines values for GetSkillsParamsScope.
 const (
-	Global  GetSkillsParamsScope = "global"
-	Project GetSkillsParamsScope = "project"
+	GetSkillsParamsScopeGlobal  GetSkillsParamsScope = "global"
+	GetSkillsParamsScopeProject GetSkillsParamsScope = "project"
 )
 
 // AddProjectsRequest defines model for AddProjectsRequest.
@@ -174,14 +310,40 @@ type AuthStatusResponse struct {
 
 // BackupFile defines model for BackupFile.
 type BackupFile struct {
-	CreatedAt            string                 `json:"createdAt"`
-	FilePath             string                 `json:"filePath"`
+	CreatedAt string `json:"createdAt"`
+	FilePath  string `json:"filePath"`
+
+	// Group Shared by the backups of one multi-file write.
+	Group                *string                `json:"group,omitempty"`
 	Id                   string                 `json:"id"`
 	OriginalPath         string                 `json:"originalPath"`
 	Size                 int64                  `json:"size"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// BulkMcpApprovalsRequest defines model for BulkMcpApprovalsRequest.
+type BulkMcpApprovalsRequest struct {
+	Layer *ConfigLayerSource `json:"layer,omitempty"`
+
+	// ProjectIds Only change these projects. Defaults to every registered project.
+	ProjectIds *[]string `json:"projectIds,omitempty"`
+
+	// Servers Only change servers of these names. Defaults to every server in each .mcp.json.
+	Servers              *[]string              `json:"servers,omitempty"`
+	State                McpApprovalState       `json:"state"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// BulkMcpApprovalsResponse defines model for BulkMcpApprovalsResponse.
+type BulkMcpApprovalsResponse struct {
+	// BackupGroup Group ID shared by the backups taken before writing.
+	BackupGroup          *string                    `json:"backupGroup,omitempty"`
+	Files                []PatchedConfigFile        `json:"files"`
+	Projects             []McpApprovalProjectChange `json:"projects"`
+	Success              bool                       `json:"success"`
+	AdditionalProperties map[string]interface{}     `json:"-"`
+}
+
 // CommandDetail defines model for CommandDetail.
 type CommandDetail struct {
 	Body                 string                 `json:"body"`
@@ -206,21 +368,85 @@ type CommandFile struct {
 
 // ConfigLayer defines model for ConfigLayer.
 type ConfigLayer struct {
-	Content              *map[string]interface{} `json:"content"`
-	Exists               bool                    `json:"exists"`
-	FilePath             string                  `json:"filePath"`
-	Source               ConfigLayerSource       `json:"source"`
-	AdditionalProperties map[string]interface{}  `json:"-"`
+	Content *map[string]interface{} `json:"content"`
+
+	// Etag Content hash of the file, for use in If-Match.
+	Etag       *string         `json:"etag,omitempty"`
+	Exists     bool            `json:"exists"`
+	FilePath   string          `json:"filePath"`
+	ParseError *JSONParseError `json:"parseError,omitempty"`
+
+	// ReadOnly True for layers Field Station never writes (managed policy).
+	ReadOnly             *bool                  `json:"readOnly,omitempty"`
+	Source               ConfigLayerSource      `json:"source"`
+	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// ConfigLayerSource defines model for ConfigLayer.Source.
+// ConfigLayerSource defines model for ConfigLayerSource.
 type ConfigLayerSource string
 
+// ConfigPatchOperation defines model for ConfigPatchOperation.
+type ConfigPatchOperation struct {
+	// From JSON Pointer to the source value, for move and copy.
+	From      *string                `json:"from,omitempty"`
+	FromLayer *ConfigLayerSource     `json:"fromLayer,omitempty"`
+	Layer     ConfigLayerSource      `json:"layer"`
+	Op        ConfigPatchOperationOp `json:"op"`
+
+	// Path JSON Pointer (RFC 6901) into the layer's settings object.
+	Path                 string                 `json:"path"`
+	Value                interface{}            `json:"value,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ConfigPatchOperationOp defines model for ConfigPatchOperation.Op.
+type ConfigPatchOperationOp string
+
+// ConfigPatchRequest defines model for ConfigPatchRequest.
+type ConfigPatchRequest struct {
+	Operations []ConfigPatchOperation `json:"operations"`
+
+	// ProjectId Required when any operation targets a project layer.
+	ProjectId            *string                `json:"projectId,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ConfigPatchResponse defines model for ConfigPatchResponse.
+type ConfigPatchResponse struct {
+	// BackupGroup Group ID shared by the backups taken before writing.
+	BackupGroup          *string                `json:"backupGroup,omitempty"`
+	Files                []PatchedConfigFile    `json:"files"`
+	Success              bool                   `json:"success"`
+	Warnings             *[]ValidationIssue     `json:"warnings,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ConfigRepairPreview defines model for ConfigRepairPreview.
+type ConfigRepairPreview struct {
+	// Etag Content hash of the file, for use in If-Match.
+	Etag     *string `json:"etag,omitempty"`
+	Exists   bool    `json:"exists"`
+	FilePath string  `json:"filePath"`
+
+	// Original Current file contents, verbatim.
+	Original   string          `json:"original"`
+	ParseError *JSONParseError `json:"parseError,omitempty"`
+
+	// Repaired Best-effort fixed contents with comments and trailing commas removed. Absent when the file already parses.
+	Repaired             *string                `json:"repaired,omitempty"`
+	RepairedParseError   *JSONParseError        `json:"repairedParseError,omitempty"`
+	Source               ConfigLayerSource      `json:"source"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // ConfigResponse defines model for ConfigResponse.
 type ConfigResponse struct {
-	Layers               []ConfigLayer          `json:"layers"`
-	Merged               map[string]interface{} `json:"merged"`
-	AdditionalProperties map[string]interface{} `json:"-"`
+	Layers []ConfigLayer          `json:"layers"`
+	Merged map[string]interface{} `json:"merged"`
+
+	// Provenance Origin of every leaf key path in merged, keyed by dot-separated path.
+	Provenance           *map[string]KeyProvenance `json:"provenance,omitempty"`
+	AdditionalProperties map[string]interface{}    `json:"-"`
 }
 
 // CreateAgentRequest defines model for CreateAgentRequest.
@@ -253,8 +479,10 @@ type CreateCommandRequestScope string
 
 // CreateHookRequest defines model for CreateHookRequest.
 type CreateHookRequest struct {
-	Commands             []string               `json:"commands"`
+	// Commands Shorthand for hooks made only of command hooks. Ignored when hooks is set.
+	Commands             *[]string              `json:"commands,omitempty"`
 	Event                string                 `json:"event"`
+	Hooks                *[]HookCommand         `json:"hooks,omitempty"`
 	Matcher              *string                `json:"matcher,omitempty"`
 	ProjectId            *string                `json:"projectId,omitempty"`
 	Scope                CreateHookRequestScope `json:"scope"`
@@ -264,6 +492,20 @@ type CreateHookRequest struct {
 // CreateHookRequestScope defines model for CreateHookRequest.Scope.
 type CreateHookRequestScope string
 
+// CreateMcpServerRequest defines model for CreateMcpServerRequest.
+type CreateMcpServerRequest struct {
+	// Config command, args and env apply to stdio servers; url and headers to sse and http servers. Sensitive values are returned as "[REDACTED]"; sending "[REDACTED]" back keeps the stored value.
+	Config McpServerConfig `json:"config"`
+	Name   string          `json:"name"`
+
+	// ProjectId Required for the local and project scopes.
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// Scope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	Scope                McpScope               `json:"scope"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // CreateMemoryRequest defines model for CreateMemoryRequest.
 type CreateMemoryRequest struct {
 	Content              string                 `json:"content"`
@@ -272,6 +514,20 @@ type CreateMemoryRequest struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// CreateOutputStyleRequest defines model for CreateOutputStyleRequest.
+type CreateOutputStyleRequest struct {
+	Body                   string                        `json:"body"`
+	Description            *string                       `json:"description,omitempty"`
+	KeepCodingInstructions *bool                         `json:"keepCodingInstructions,omitempty"`
+	Name                   string                        `json:"name"`
+	ProjectId              *string                       `json:"projectId,omitempty"`
+	Scope                  CreateOutputStyleRequestScope `json:"scope"`
+	AdditionalProperties   map[string]interface{}        `json:"-"`
+}
+
+// CreateOutputStyleRequestScope defines model for CreateOutputStyleRequest.Scope.
+type CreateOutputStyleRequestScope string
+
 // CreateSkillRequest defines model for CreateSkillRequest.
 type CreateSkillRequest struct {
 	Body                 string                  `json:"body"`
@@ -302,6 +558,16 @@ type DeleteConfigSettingRequest struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// DeleteOutputStyleRequest defines model for DeleteOutputStyleRequest.
+type DeleteOutputStyleRequest struct {
+	ProjectId            *string                       `json:"projectId,omitempty"`
+	Scope                DeleteOutputStyleRequestScope `json:"scope"`
+	AdditionalProperties map[string]interface{}        `json:"-"`
+}
+
+// DeleteOutputStyleRequestScope defines model for DeleteOutputStyleRequest.Scope.
+type DeleteOutputStyleRequestScope string
+
 // ErrorResponse defines model for ErrorResponse.
 type ErrorResponse struct {
 	Error                string                 `json:"error"`
@@ -332,6 +598,8 @@ type FeatureDefinitionType string
 
 // FeaturesData defines model for FeaturesData.
 type FeaturesData struct {
+	// Etag Content hash of the global settings.json the current values were read from, for use in If-Match.
+	Etag                 *string                `json:"etag,omitempty"`
 	Features             []Feature              `json:"features"`
 	TotalDiscovered      int                    `json:"totalDiscovered"`
 	TotalDocumented      int                    `json:"totalDocumented"`
@@ -345,9 +613,16 @@ type HealthResponse struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// HookCommand defines model for HookCommand.
+// HookCommand One hook. Keys the API does not model are returned as additional properties and kept when the hook is rewritten.
 type HookCommand struct {
-	Command              string                 `json:"command"`
+	// Command Shell command to run; required when type is command.
+	Command *string `json:"command,omitempty"`
+
+	// Prompt Prompt for the model to evaluate; required when type is prompt.
+	Prompt *string `json:"prompt,omitempty"`
+
+	// Timeout Seconds before the hook is cancelled.
+	Timeout              *int                   `json:"timeout,omitempty"`
 	Type                 HookCommandType        `json:"type"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
@@ -357,39 +632,205 @@ type HookCommandType string
 
 // HookDefinition defines model for HookDefinition.
 type HookDefinition struct {
-	Hooks                []HookCommand          `json:"hooks"`
+	Hooks []HookCommand `json:"hooks"`
+
+	// Id Stable ID derived from the scope, event, matcher and hooks of the definition. It stops matching once the definition is changed, so a stale ID is refused instead of acting on another hook.
+	Id                   string                 `json:"id"`
 	Matcher              *string                `json:"matcher,omitempty"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// HookEventInfo defines model for HookEventInfo.
+type HookEventInfo struct {
+	AcceptsMatcher bool   `json:"acceptsMatcher"`
+	Description    string `json:"description"`
+
+	// Matcher What a matcher is tested against, for events that accept one.
+	Matcher *string `json:"matcher,omitempty"`
+	Name    string  `json:"name"`
+
+	// Payload Sample values of the fields the event adds to the JSON piped into a hook, beyond session_id, transcript_path, cwd, permission_mode and hook_event_name.
+	Payload              map[string]interface{} `json:"payload"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// HookMatcherPreview defines model for HookMatcherPreview.
+type HookMatcherPreview struct {
+	// Matched Names of the known tools the matcher matches.
+	Matched []string `json:"matched"`
+	Matcher string   `json:"matcher"`
+
+	// MatchesAll The matcher is "" or "*", which match every tool without looking at its name.
+	MatchesAll bool                `json:"matchesAll"`
+	McpServers []HookMatcherServer `json:"mcpServers"`
+
+	// Tools Every known tool, built-in tools first, with whether the matcher matches it.
+	Tools                []HookMatcherTool      `json:"tools"`
+	Warnings             []string               `json:"warnings"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// HookMatcherPreviewRequest defines model for HookMatcherPreviewRequest.
+type HookMatcherPreviewRequest struct {
+	// Matcher The matcher to preview. "" and "*" match every tool.
+	Matcher string `json:"matcher"`
+
+	// ProbeMcp Start each stdio MCP server to list its tools. Without it, MCP servers are listed but contribute no tools.
+	ProbeMcp *bool `json:"probeMcp,omitempty"`
+
+	// ProjectId With a project, its local and project MCP servers are included too.
+	ProjectId            *string                `json:"projectId,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// HookMatcherServer defines model for HookMatcherServer.
+type HookMatcherServer struct {
+	// Error Why the server's tools could not be listed, when probeMcp is set.
+	Error *string `json:"error,omitempty"`
+	Name  string  `json:"name"`
+
+	// Probed The server was asked for its tools and answered.
+	Probed bool `json:"probed"`
+
+	// Scope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	Scope                McpScope               `json:"scope"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// HookMatcherTool defines model for HookMatcherTool.
+type HookMatcherTool struct {
+	Matched bool   `json:"matched"`
+	Name    string `json:"name"`
+
+	// Server The MCP server providing the tool; unset for built-in tools.
+	Server               *string                `json:"server,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // HookScope defines model for HookScope.
 type HookScope struct {
-	Hooks                *HooksByEvent          `json:"hooks,omitempty"`
+	// Etag Content hash of the settings file the hooks were read from, for use in If-Match.
+	Etag *string `json:"etag,omitempty"`
+
+	// Hooks Hook definitions keyed by event name. Events Claude Code does not know are included too, and listed in unknownEvents of the enclosing scope.
+	Hooks *HooksByEvent `json:"hooks,omitempty"`
+
+	// UnknownEvents Events in hooks that Claude Code does not know, which never run.
+	UnknownEvents        *[]string              `json:"unknownEvents,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// HookSource defines model for HookSource.
+type HookSource struct {
+	// Error Why the file could not be read; its hooks are left out.
+	Error *string `json:"error,omitempty"`
+
+	// Etag Content hash of the file, for use in If-Match.
+	Etag     *string `json:"etag,omitempty"`
+	FilePath string  `json:"filePath"`
+
+	// Hooks Hook definitions keyed by event name. Events Claude Code does not know are included too, and listed in unknownEvents of the enclosing scope.
+	Hooks *HooksByEvent `json:"hooks,omitempty"`
+
+	// Plugin The plugin, as name@marketplace, when source is plugin.
+	Plugin   *string          `json:"plugin,omitempty"`
+	ReadOnly bool             `json:"readOnly"`
+	Source   HookSourceSource `json:"source"`
+
+	// UnknownEvents Events in hooks that Claude Code does not know, which never run.
+	UnknownEvents        *[]string              `json:"unknownEvents,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// HookSourceSource defines model for HookSource.Source.
+type HookSourceSource string
+
+// HookTestRequest defines model for HookTestRequest.
+type HookTestRequest struct {
+	// Event The event to simulate. Defaults to the event of hookId.
+	Event *string `json:"event,omitempty"`
+
+	// Hook One hook. Keys the API does not model are returned as additional properties and kept when the hook is rewritten.
+	Hook *HookCommand `json:"hook,omitempty"`
+
+	// HookId ID of a saved hook definition, read from scope.
+	HookId *string `json:"hookId,omitempty"`
+
+	// HookIndex Which hook of the definition to run. Defaults to 0.
+	HookIndex *int `json:"hookIndex,omitempty"`
+
+	// ProjectId With a project, the hook runs in the project directory, which is also CLAUDE_PROJECT_DIR; otherwise the home directory.
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// Scope Where hookId is read from. Defaults to global.
+	Scope *HookTestRequestScope `json:"scope,omitempty"`
+
+	// ToolInput tool_input of a PreToolUse or PostToolUse event. Defaults to a sample call of the tool.
+	ToolInput *map[string]interface{} `json:"toolInput,omitempty"`
+
+	// ToolName Tool of a PreToolUse or PostToolUse event. Defaults to Bash.
+	ToolName             *string                `json:"toolName,omitempty"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// HooksByEvent defines model for HooksByEvent.
-type HooksByEvent struct {
-	Notification         *[]HookDefinition      `json:"Notification,omitempty"`
-	PostToolUse          *[]HookDefinition      `json:"PostToolUse,omitempty"`
-	PreToolUse           *[]HookDefinition      `json:"PreToolUse,omitempty"`
-	SessionStart         *[]HookDefinition      `json:"SessionStart,omitempty"`
-	Stop                 *[]HookDefinition      `json:"Stop,omitempty"`
-	SubagentStop         *[]HookDefinition      `json:"SubagentStop,omitempty"`
-	UserPromptSubmit     *[]HookDefinition      `json:"UserPromptSubmit,omitempty"`
+// HookTestRequestScope Where hookId is read from. Defaults to global.
+type HookTestRequestScope string
+
+// HookTestResult defines model for HookTestResult.
+type HookTestResult struct {
+	// Decision stdout parsed as JSON, when it holds an object.
+	Decision *map[string]interface{} `json:"decision,omitempty"`
+
+	// DecisionError Why stdout that looks like JSON could not be parsed.
+	DecisionError *string `json:"decisionError,omitempty"`
+	DurationMs    int     `json:"durationMs"`
+
+	// ExitCode The hook's exit status, or -1 if it timed out.
+	ExitCode int `json:"exitCode"`
+
+	// Input The event JSON piped into the hook.
+	Input map[string]interface{} `json:"input"`
+
+	// Outcome How Claude Code reads the exit status: 0 is success, 2 blocks the action, anything else is a non-blocking error.
+	Outcome HookTestResultOutcome `json:"outcome"`
+	Stderr  string                `json:"stderr"`
+
+	// Stdout What the hook printed, truncated to 64 KiB.
+	Stdout               string                 `json:"stdout"`
+	TimedOut             bool                   `json:"timedOut"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// HookTestResultOutcome How Claude Code reads the exit status: 0 is success, 2 blocks the action, anything else is a non-blocking error.
+type HookTestResultOutcome string
+
+// HooksByEvent Hook definitions keyed by event name. Events Claude Code does not know are included too, and listed in unknownEvents of the enclosing scope.
+type HooksByEvent map[string][]HookDefinition
+
 // HooksResponse defines model for HooksResponse.
 type HooksResponse struct {
-	Global               *HookScope             `json:"global,omitempty"`
-	Project              *HookScope             `json:"project,omitempty"`
-	AdditionalProperties map[string]interface{} `json:"-"`
+	// DisableAllHooks The effective disableAllHooks setting; when true, merged is empty.
+	DisableAllHooks bool       `json:"disableAllHooks"`
+	Global          *HookScope `json:"global,omitempty"`
+
+	// Layers Every source hooks are read from, in the order Claude Code runs them: the four settings layers, the managed policy, then enabled plugins.
+	Layers []HookSource `json:"layers"`
+
+	// ManagedHooksOnly The managed policy sets allowManagedHooksOnly, so merged holds only managed hooks.
+	ManagedHooksOnly bool `json:"managedHooksOnly"`
+
+	// Merged The hooks Claude Code runs for each known event, in order, with the source of each. Identical hooks are listed once, where they first appear.
+	Merged               map[string][]MergedHookEntry `json:"merged"`
+	Project              *HookScope                   `json:"project,omitempty"`
+	AdditionalProperties map[string]interface{}       `json:"-"`
 }
 
 // InstructionsFile defines model for InstructionsFile.
 type InstructionsFile struct {
-	Content              *string                `json:"content"`
+	Content *string `json:"content"`
+
+	// Etag Content hash of the file, for use in If-Match.
+	Etag                 *string                `json:"etag,omitempty"`
 	Exists               bool                   `json:"exists"`
 	FilePath             string                 `json:"filePath"`
 	AdditionalProperties map[string]interface{} `json:"-"`
@@ -402,12 +843,151 @@ type InstructionsResponse struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// JSONParseError defines model for JSONParseError.
+type JSONParseError struct {
+	Column               int                    `json:"column"`
+	FilePath             string                 `json:"filePath"`
+	Line                 int                    `json:"line"`
+	Message              string                 `json:"message"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// KeyProvenance defines model for KeyProvenance.
+type KeyProvenance struct {
+	// Contributions For concat-merged keys (e.g. permissions.allow), each lower-precedence layer's own array that was combined into value, nearest layer first.
+	Contributions *[]ShadowedValue `json:"contributions,omitempty"`
+	FilePath      string           `json:"filePath"`
+
+	// Shadowed Values from lower-precedence layers, nearest layer first.
+	Shadowed             []ShadowedValue        `json:"shadowed"`
+	Source               ConfigLayerSource      `json:"source"`
+	Value                interface{}            `json:"value,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // LoginRequest defines model for LoginRequest.
 type LoginRequest struct {
 	Password             string                 `json:"password"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// McpApproval defines model for McpApproval.
+type McpApproval struct {
+	// FilePath The settings file that decided the state; absent for pending servers.
+	FilePath             *string                `json:"filePath,omitempty"`
+	Name                 string                 `json:"name"`
+	Source               *ConfigLayerSource     `json:"source,omitempty"`
+	State                McpApprovalState       `json:"state"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpApprovalProjectChange defines model for McpApprovalProjectChange.
+type McpApprovalProjectChange struct {
+	// Error Why the project was skipped, e.g. an unparseable settings file.
+	Error     *string `json:"error,omitempty"`
+	FilePath  *string `json:"filePath,omitempty"`
+	Path      string  `json:"path"`
+	ProjectId string  `json:"projectId"`
+
+	// Servers Servers whose recorded state changed.
+	Servers              []string               `json:"servers"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpApprovalState defines model for McpApprovalState.
+type McpApprovalState string
+
+// McpApprovalsResponse defines model for McpApprovalsResponse.
+type McpApprovalsResponse struct {
+	EnableAll            *ShadowedValue         `json:"enableAll,omitempty"`
+	Layers               []PermissionsLayer     `json:"layers"`
+	Servers              []McpApproval          `json:"servers"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpProbeRequest defines model for McpProbeRequest.
+type McpProbeRequest struct {
+	// ProjectId Required for the local and project scopes. With a project, the server runs in the project directory; otherwise in the home directory.
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// Scope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	Scope McpScope `json:"scope"`
+
+	// TimeoutMs How long to wait for the whole handshake, up to 60000. Defaults to 10000.
+	TimeoutMs            *int                   `json:"timeoutMs,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpProbeResult defines model for McpProbeResult.
+type McpProbeResult struct {
+	// Error Why the handshake failed, when ok is false.
+	Error           *string `json:"error,omitempty"`
+	Ok              bool    `json:"ok"`
+	ProtocolVersion *string `json:"protocolVersion,omitempty"`
+	ServerName      *string `json:"serverName,omitempty"`
+	ServerVersion   *string `json:"serverVersion,omitempty"`
+
+	// Stderr What the server wrote to stderr, truncated to 64 KiB.
+	Stderr               *string                `json:"stderr,omitempty"`
+	Tools                []McpTool              `json:"tools"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpScope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+type McpScope string
+
+// McpServer defines model for McpServer.
+type McpServer struct {
+	// Config command, args and env apply to stdio servers; url and headers to sse and http servers. Sensitive values are returned as "[REDACTED]"; sending "[REDACTED]" back keeps the stored value.
+	Config   McpServerConfig `json:"config"`
+	FilePath string          `json:"filePath"`
+	Name     string          `json:"name"`
+
+	// Scope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	Scope                McpScope               `json:"scope"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpServerConfig command, args and env apply to stdio servers; url and headers to sse and http servers. Sensitive values are returned as "[REDACTED]"; sending "[REDACTED]" back keeps the stored value.
+type McpServerConfig struct {
+	Args                 *[]string              `json:"args,omitempty"`
+	Command              *string                `json:"command,omitempty"`
+	Env                  *map[string]string     `json:"env,omitempty"`
+	Headers              *map[string]string     `json:"headers,omitempty"`
+	Type                 McpServerType          `json:"type"`
+	Url                  *string                `json:"url,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpServerFile defines model for McpServerFile.
+type McpServerFile struct {
+	Etag     string `json:"etag"`
+	Exists   bool   `json:"exists"`
+	FilePath string `json:"filePath"`
+
+	// Scope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	Scope                McpScope               `json:"scope"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpServerType defines model for McpServerType.
+type McpServerType string
+
+// McpServersResponse defines model for McpServersResponse.
+type McpServersResponse struct {
+	Files                []McpServerFile        `json:"files"`
+	Servers              []McpServer            `json:"servers"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// McpTool defines model for McpTool.
+type McpTool struct {
+	Description          *string                 `json:"description,omitempty"`
+	InputSchema          *map[string]interface{} `json:"inputSchema,omitempty"`
+	Name                 string                  `json:"name"`
+	AdditionalProperties map[string]interface{}  `json:"-"`
+}
+
 // MemoryDetail defines model for MemoryDetail.
 type MemoryDetail struct {
 	Content              string                 `json:"content"`
@@ -424,66 +1004,345 @@ type MemoryFile struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// MoveConfigSettingRequest defines model for MoveConfigSettingRequest.
+// MergedHookEntry defines model for MergedHookEntry.
+type MergedHookEntry struct {
+	// DefinitionId id of the hook definition the hook belongs to.
+	DefinitionId string `json:"definitionId"`
+	FilePath     string `json:"filePath"`
+
+	// Hook One hook. Keys the API does not model are returned as additional properties and kept when the hook is rewritten.
+	Hook                 HookCommand            `json:"hook"`
+	Matcher              *string                `json:"matcher,omitempty"`
+	Plugin               *string                `json:"plugin,omitempty"`
+	Source               MergedHookEntrySource  `json:"source"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// MergedHookEntrySource defines model for MergedHookEntry.Source.
+type MergedHookEntrySource string
+
+// MoveConfigSettingRequest Either from and to, naming any layers of any projects, or direction, which moves between settings.json and settings.local.json of the scope given by projectId.
 type MoveConfigSettingRequest struct {
-	Direction            MoveConfigSettingRequestDirection `json:"direction"`
-	KeyPath              []string                          `json:"keyPath"`
-	ProjectId            *string                           `json:"projectId,omitempty"`
-	AdditionalProperties map[string]interface{}            `json:"-"`
+	// Copy Leave the key in the source instead of deleting it.
+	Copy                 *bool                              `json:"copy,omitempty"`
+	Direction            *MoveConfigSettingRequestDirection `json:"direction,omitempty"`
+	From                 *SettingLayerRef                   `json:"from,omitempty"`
+	KeyPath              []string                           `json:"keyPath"`
+	ProjectId            *string                            `json:"projectId,omitempty"`
+	To                   *[]SettingLayerRef                 `json:"to,omitempty"`
+	AdditionalProperties map[string]interface{}             `json:"-"`
 }
 
 // MoveConfigSettingRequestDirection defines model for MoveConfigSettingRequest.Direction.
 type MoveConfigSettingRequestDirection string
 
-// PluginFile defines model for PluginFile.
-type PluginFile struct {
-	IsUserOwned          bool                   `json:"isUserOwned"`
-	Name                 string                 `json:"name"`
-	Path                 string                 `json:"path"`
+// OutputStyleDetail defines model for OutputStyleDetail.
+type OutputStyleDetail struct {
+	Body                   string                 `json:"body"`
+	Description            string                 `json:"description"`
+	FileName               string                 `json:"fileName"`
+	FilePath               string                 `json:"filePath"`
+	IsEditable             bool                   `json:"isEditable"`
+	KeepCodingInstructions *bool                  `json:"keepCodingInstructions,omitempty"`
+	Name                   string                 `json:"name"`
+	AdditionalProperties   map[string]interface{} `json:"-"`
+}
+
+// OutputStyleFile defines model for OutputStyleFile.
+type OutputStyleFile struct {
+	BodyPreview            string                 `json:"bodyPreview"`
+	Description            string                 `json:"description"`
+	FileName               string                 `json:"fileName"`
+	FilePath               string                 `json:"filePath"`
+	IsEditable             bool                   `json:"isEditable"`
+	KeepCodingInstructions *bool                  `json:"keepCodingInstructions,omitempty"`
+	Name                   string                 `json:"name"`
+	AdditionalProperties   map[string]interface{} `json:"-"`
+}
+
+// OutputStyleLayer defines model for OutputStyleLayer.
+type OutputStyleLayer struct {
+	Etag     string `json:"etag"`
+	Exists   bool   `json:"exists"`
+	FilePath string `json:"filePath"`
+
+	// OutputStyle The layer's own outputStyle, when it sets one.
+	OutputStyle          *string                `json:"outputStyle,omitempty"`
+	ReadOnly             bool                   `json:"readOnly"`
+	Source               ConfigLayerSource      `json:"source"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// ProjectFile defines model for ProjectFile.
-type ProjectFile struct {
-	Name                 string                 `json:"name"`
-	Path                 string                 `json:"path"`
+// OutputStyleSettingResponse defines model for OutputStyleSettingResponse.
+type OutputStyleSettingResponse struct {
+	// Available The built-in styles, then the global and project custom styles.
+	Available []string       `json:"available"`
+	Effective *ShadowedValue `json:"effective,omitempty"`
+
+	// Layers Every layer, lowest precedence first.
+	Layers               []OutputStyleLayer     `json:"layers"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// ScanProjectResult defines model for ScanProjectResult.
-type ScanProjectResult struct {
-	Name                 string                 `json:"name"`
-	Path                 string                 `json:"path"`
-	Registered           bool                   `json:"registered"`
+// PatchedConfigFile defines model for PatchedConfigFile.
+type PatchedConfigFile struct {
+	// Etag Content hash of the file after the patch.
+	Etag                 string                 `json:"etag"`
+	FilePath             string                 `json:"filePath"`
+	Source               ConfigLayerSource      `json:"source"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// SearchResult defines model for SearchResult.
-type SearchResult struct {
-	Description          *string                `json:"description,omitempty"`
+// PermissionBehavior defines model for PermissionBehavior.
+type PermissionBehavior string
+
+// PermissionDirectory defines model for PermissionDirectory.
+type PermissionDirectory struct {
 	FilePath             string                 `json:"filePath"`
-	Name                 string                 `json:"name"`
-	Preview              string                 `json:"preview"`
-	Type                 SearchResultType       `json:"type"`
+	Path                 string                 `json:"path"`
+	Source               ConfigLayerSource      `json:"source"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
-// SearchResultType defines model for SearchResult.Type.
-type SearchResultType string
+// PermissionEvaluation defines model for PermissionEvaluation.
+type PermissionEvaluation struct {
+	Decision PermissionBehavior `json:"decision"`
+	Reason   string             `json:"reason"`
+	Rule     *PermissionRule    `json:"rule,omitempty"`
 
-// SetupAuthRequest defines model for SetupAuthRequest.
-type SetupAuthRequest struct {
-	Password string `json:"password"`
+	// Subcommands For a compound Bash command, the decision for each part.
+	Subcommands          *[]PermissionSubcommandDecision `json:"subcommands,omitempty"`
+	AdditionalProperties map[string]interface{}          `json:"-"`
 }
 
-// SkillDetail defines model for SkillDetail.
-type SkillDetail struct {
-	Body                 string                 `json:"body"`
-	Description          string                 `json:"description"`
-	FilePath             string                 `json:"filePath"`
-	FolderName           string                 `json:"folderName"`
-	IsEditable           bool                   `json:"isEditable"`
-	Name                 string                 `json:"name"`
+// PermissionEvaluationRequest defines model for PermissionEvaluationRequest.
+type PermissionEvaluationRequest struct {
+	// Input The Bash command, the file path for file tools (relative paths resolve against the project root), the URL for WebFetch, or the specifier for any other tool.
+	Input     *string `json:"input,omitempty"`
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// Tool Tool name, e.g. Bash, Edit, WebFetch or mcp__github__create_issue.
+	Tool                 string                 `json:"tool"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PermissionPatternKind defines model for PermissionPatternKind.
+type PermissionPatternKind string
+
+// PermissionRule defines model for PermissionRule.
+type PermissionRule struct {
+	Behavior PermissionBehavior `json:"behavior"`
+
+	// Error Set when the rule does not parse; tool, kind and pattern are then absent.
+	Error    *string `json:"error,omitempty"`
+	FilePath string  `json:"filePath"`
+
+	// Index Position of the rule in its layer's list.
+	Index     int                    `json:"index"`
+	Kind      *PermissionPatternKind `json:"kind,omitempty"`
+	McpServer *string                `json:"mcpServer,omitempty"`
+
+	// Pattern What is matched: the command without a trailing :*, the domain, the path glob, or the MCP tool name.
+	Pattern *string `json:"pattern,omitempty"`
+
+	// Rule The rule as written in the settings file.
+	Rule   string            `json:"rule"`
+	Source ConfigLayerSource `json:"source"`
+
+	// Specifier Text between the parentheses, if any.
+	Specifier            *string                `json:"specifier,omitempty"`
+	Tool                 *string                `json:"tool,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PermissionRuleRequest defines model for PermissionRuleRequest.
+type PermissionRuleRequest struct {
+	Behavior             PermissionBehavior     `json:"behavior"`
+	Layer                ConfigLayerSource      `json:"layer"`
+	ProjectId            *string                `json:"projectId,omitempty"`
+	Rule                 string                 `json:"rule"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PermissionSubcommandDecision defines model for PermissionSubcommandDecision.
+type PermissionSubcommandDecision struct {
+	Command              string                 `json:"command"`
+	Decision             PermissionBehavior     `json:"decision"`
+	Reason               string                 `json:"reason"`
+	Rule                 *PermissionRule        `json:"rule,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PermissionsLayer defines model for PermissionsLayer.
+type PermissionsLayer struct {
+	Etag                 string                 `json:"etag"`
+	Exists               bool                   `json:"exists"`
+	FilePath             string                 `json:"filePath"`
+	ReadOnly             bool                   `json:"readOnly"`
+	Source               ConfigLayerSource      `json:"source"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PermissionsResponse defines model for PermissionsResponse.
+type PermissionsResponse struct {
+	AdditionalDirectories []PermissionDirectory `json:"additionalDirectories"`
+	DefaultMode           *ShadowedValue        `json:"defaultMode,omitempty"`
+	Layers                []PermissionsLayer    `json:"layers"`
+
+	// Rules Each layer's rules, lowest precedence layer first.
+	Rules                []PermissionRule       `json:"rules"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PluginFile defines model for PluginFile.
+type PluginFile struct {
+	IsUserOwned          bool                   `json:"isUserOwned"`
+	Name                 string                 `json:"name"`
+	Path                 string                 `json:"path"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// PreconditionFailedResponse defines model for PreconditionFailedResponse.
+type PreconditionFailedResponse struct {
+	// Content Current file contents, so the client can show or merge the other writer's changes. Absent when the file no longer exists.
+	Content *string `json:"content,omitempty"`
+	Error   string  `json:"error"`
+
+	// Etag Current content hash of the file.
+	Etag                 string                 `json:"etag"`
+	Exists               bool                   `json:"exists"`
+	FilePath             string                 `json:"filePath"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ProjectFile defines model for ProjectFile.
+type ProjectFile struct {
+	Name                 string                 `json:"name"`
+	Path                 string                 `json:"path"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RepairConfigLayerRequest defines model for RepairConfigLayerRequest.
+type RepairConfigLayerRequest struct {
+	Layer                ConfigLayerSource      `json:"layer"`
+	ProjectId            *string                `json:"projectId,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// RevealRequest defines model for RevealRequest.
+type RevealRequest struct {
+	// KeyPath Dot-separated key path, e.g. env.GITHUB_TOKEN, or github.env.GITHUB_TOKEN for an MCP server.
+	KeyPath string             `json:"keyPath"`
+	Layer   *ConfigLayerSource `json:"layer,omitempty"`
+
+	// McpScope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	McpScope *McpScope `json:"mcpScope,omitempty"`
+
+	// Password Required when authentication is enabled.
+	Password  *string `json:"password,omitempty"`
+	ProjectId *string `json:"projectId,omitempty"`
+}
+
+// RevealResponse defines model for RevealResponse.
+type RevealResponse struct {
+	FilePath string      `json:"filePath"`
+	KeyPath  string      `json:"keyPath"`
+	Value    interface{} `json:"value"`
+}
+
+// ScanProjectResult defines model for ScanProjectResult.
+type ScanProjectResult struct {
+	Name                 string                 `json:"name"`
+	Path                 string                 `json:"path"`
+	Registered           bool                   `json:"registered"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SearchResult defines model for SearchResult.
+type SearchResult struct {
+	Description          *string                `json:"description,omitempty"`
+	FilePath             string                 `json:"filePath"`
+	Name                 string                 `json:"name"`
+	Preview              string                 `json:"preview"`
+	Type                 SearchResultType       `json:"type"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SearchResultType defines model for SearchResult.Type.
+type SearchResultType string
+
+// SecretFinding defines model for SecretFinding.
+type SecretFinding struct {
+	Column   int    `json:"column"`
+	FilePath string `json:"filePath"`
+
+	// Fingerprint Identifies the secret in the ignore file without revealing it
+	Fingerprint string            `json:"fingerprint"`
+	Kind        SecretFindingKind `json:"kind"`
+	Line        int               `json:"line"`
+	Masked      string            `json:"masked"`
+
+	// Pattern Name of the value pattern that matched, "custom" for one from the redaction rules file, or "high-entropy"
+	Pattern string `json:"pattern"`
+}
+
+// SecretFindingKind defines model for SecretFinding.Kind.
+type SecretFindingKind string
+
+// SecretScanResponse defines model for SecretScanResponse.
+type SecretScanResponse struct {
+	FilesScanned int             `json:"filesScanned"`
+	Findings     []SecretFinding `json:"findings"`
+	IgnoreFile   string          `json:"ignoreFile"`
+
+	// Ignored Number of findings suppressed by the ignore file
+	Ignored int `json:"ignored"`
+}
+
+// SettingLayerRef defines model for SettingLayerRef.
+type SettingLayerRef struct {
+	Layer ConfigLayerSource `json:"layer"`
+
+	// ProjectId Required for the project layers.
+	ProjectId            *string                `json:"projectId,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SettingWriteResponse defines model for SettingWriteResponse.
+type SettingWriteResponse struct {
+	Success              bool                   `json:"success"`
+	Warnings             *[]ValidationIssue     `json:"warnings,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SettingsParseErrorResponse defines model for SettingsParseErrorResponse.
+type SettingsParseErrorResponse struct {
+	Error                string                 `json:"error"`
+	ParseError           JSONParseError         `json:"parseError"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SetupAuthRequest defines model for SetupAuthRequest.
+type SetupAuthRequest struct {
+	Password string `json:"password"`
+}
+
+// ShadowedValue defines model for ShadowedValue.
+type ShadowedValue struct {
+	FilePath             string                 `json:"filePath"`
+	Source               ConfigLayerSource      `json:"source"`
+	Value                interface{}            `json:"value,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// SkillDetail defines model for SkillDetail.
+type SkillDetail struct {
+	Body                 string                 `json:"body"`
+	Description          string                 `json:"description"`
+	FilePath             string                 `json:"filePath"`
+	FolderName           string                 `json:"folderName"`
+	IsEditable           bool                   `json:"isEditable"`
+	Name                 string                 `json:"name"`
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
@@ -498,6 +1357,84 @@ type SkillFile struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// StatusLineConfig Keys other than type, command and padding are kept when the setting is rewritten. Secrets in command are returned as "[REDACTED]"; sending the placeholder back keeps the stored secret.
+type StatusLineConfig struct {
+	Command              string                 `json:"command"`
+	Padding              *int                   `json:"padding,omitempty"`
+	Type                 StatusLineConfigType   `json:"type"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// StatusLineConfigType defines model for StatusLineConfig.Type.
+type StatusLineConfigType string
+
+// StatusLineEffective defines model for StatusLineEffective.
+type StatusLineEffective struct {
+	FilePath string            `json:"filePath"`
+	Source   ConfigLayerSource `json:"source"`
+
+	// StatusLine Keys other than type, command and padding are kept when the setting is rewritten. Secrets in command are returned as "[REDACTED]"; sending the placeholder back keeps the stored secret.
+	StatusLine           StatusLineConfig       `json:"statusLine"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// StatusLineLayer defines model for StatusLineLayer.
+type StatusLineLayer struct {
+	// Error Why the layer's statusLine value cannot be used, when it has the wrong shape.
+	Error    *string           `json:"error,omitempty"`
+	Etag     string            `json:"etag"`
+	Exists   bool              `json:"exists"`
+	FilePath string            `json:"filePath"`
+	ReadOnly bool              `json:"readOnly"`
+	Source   ConfigLayerSource `json:"source"`
+
+	// StatusLine Keys other than type, command and padding are kept when the setting is rewritten. Secrets in command are returned as "[REDACTED]"; sending the placeholder back keeps the stored secret.
+	StatusLine           *StatusLineConfig      `json:"statusLine,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// StatusLineResponse defines model for StatusLineResponse.
+type StatusLineResponse struct {
+	Effective *StatusLineEffective `json:"effective,omitempty"`
+
+	// Layers Every layer, lowest precedence first.
+	Layers               []StatusLineLayer      `json:"layers"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// StatusLineTestRequest defines model for StatusLineTestRequest.
+type StatusLineTestRequest struct {
+	// Command The command to run, so an edit can be tried before it is saved. Defaults to the status line in effect. "[REDACTED]" placeholders are filled from the command in effect.
+	Command *string `json:"command,omitempty"`
+
+	// ProjectId With a project, the command runs in the project directory and the status line in effect there is the default; otherwise the home directory.
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// Session Fields laid over the synthetic session JSON, nested objects merged key by key.
+	Session *map[string]interface{} `json:"session,omitempty"`
+
+	// TimeoutMs How long to wait for the command, up to 30000. Defaults to 5000.
+	TimeoutMs            *int                   `json:"timeoutMs,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// StatusLineTestResult defines model for StatusLineTestResult.
+type StatusLineTestResult struct {
+	DurationMs int `json:"durationMs"`
+
+	// ExitCode The command's exit status, or -1 if it timed out.
+	ExitCode int `json:"exitCode"`
+
+	// Input The session JSON piped to the command.
+	Input map[string]interface{} `json:"input"`
+
+	// Output What the command printed, ANSI escape codes included, truncated to 64 KiB.
+	Output               string                 `json:"output"`
+	Stderr               string                 `json:"stderr"`
+	TimedOut             bool                   `json:"timedOut"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // SuccessResponse defines model for SuccessResponse.
 type SuccessResponse struct {
 	Success              bool                   `json:"success"`
@@ -527,6 +1464,8 @@ type UpdateCommandRequest struct {
 
 // UpdateConfigSettingRequest defines model for UpdateConfigSettingRequest.
 type UpdateConfigSettingRequest struct {
+	// Insert When keyPath ends in an array index, insert the value before that element instead of replacing it. Use "-" as the index to append.
+	Insert               *bool                  `json:"insert,omitempty"`
 	KeyPath              []string               `json:"keyPath"`
 	ProjectId            *string                `json:"projectId,omitempty"`
 	Value                interface{}            `json:"value"`
@@ -545,8 +1484,10 @@ type UpdateFeatureRequestType string
 
 // UpdateHookRequest defines model for UpdateHookRequest.
 type UpdateHookRequest struct {
-	Commands             []string               `json:"commands"`
+	// Commands Shorthand for hooks made only of command hooks. Ignored when hooks is set.
+	Commands             *[]string              `json:"commands,omitempty"`
 	Event                string                 `json:"event"`
+	Hooks                *[]HookCommand         `json:"hooks,omitempty"`
 	Matcher              *string                `json:"matcher,omitempty"`
 	ProjectId            *string                `json:"projectId,omitempty"`
 	Scope                UpdateHookRequestScope `json:"scope"`
@@ -571,6 +1512,28 @@ type UpdateInstructionsRequestFile string
 // UpdateInstructionsRequestScope defines model for UpdateInstructionsRequest.Scope.
 type UpdateInstructionsRequestScope string
 
+// UpdateMcpApprovalsRequest defines model for UpdateMcpApprovalsRequest.
+type UpdateMcpApprovalsRequest struct {
+	Layer                *ConfigLayerSource     `json:"layer,omitempty"`
+	ProjectId            string                 `json:"projectId"`
+	Servers              []string               `json:"servers"`
+	State                McpApprovalState       `json:"state"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// UpdateMcpServerRequest defines model for UpdateMcpServerRequest.
+type UpdateMcpServerRequest struct {
+	// Config command, args and env apply to stdio servers; url and headers to sse and http servers. Sensitive values are returned as "[REDACTED]"; sending "[REDACTED]" back keeps the stored value.
+	Config McpServerConfig `json:"config"`
+
+	// ProjectId Required for the local and project scopes.
+	ProjectId *string `json:"projectId,omitempty"`
+
+	// Scope user and local servers live in ~/.claude.json, local ones under the project's entry; project servers live in the project's .mcp.json.
+	Scope                McpScope               `json:"scope"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
 // UpdateMemoryRequest defines model for UpdateMemoryRequest.
 type UpdateMemoryRequest struct {
 	Content              string                 `json:"content"`
@@ -578,6 +1541,47 @@ type UpdateMemoryRequest struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// UpdateOutputStyleRequest defines model for UpdateOutputStyleRequest.
+type UpdateOutputStyleRequest struct {
+	Body                   string                        `json:"body"`
+	Description            *string                       `json:"description,omitempty"`
+	KeepCodingInstructions *bool                         `json:"keepCodingInstructions,omitempty"`
+	ProjectId              *string                       `json:"projectId,omitempty"`
+	Scope                  UpdateOutputStyleRequestScope `json:"scope"`
+	AdditionalProperties   map[string]interface{}        `json:"-"`
+}
+
+// UpdateOutputStyleRequestScope defines model for UpdateOutputStyleRequest.Scope.
+type UpdateOutputStyleRequestScope string
+
+// UpdateOutputStyleSettingRequest Omitting outputStyle removes the setting from the layer.
+type UpdateOutputStyleSettingRequest struct {
+	Layer                ConfigLayerSource      `json:"layer"`
+	OutputStyle          *string                `json:"outputStyle,omitempty"`
+	ProjectId            *string                `json:"projectId,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// UpdatePermissionRuleRequest defines model for UpdatePermissionRuleRequest.
+type UpdatePermissionRuleRequest struct {
+	Behavior             PermissionBehavior     `json:"behavior"`
+	Layer                ConfigLayerSource      `json:"layer"`
+	NewBehavior          *PermissionBehavior    `json:"newBehavior,omitempty"`
+	NewRule              string                 `json:"newRule"`
+	ProjectId            *string                `json:"projectId,omitempty"`
+	Rule                 string                 `json:"rule"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// UpdatePermissionSettingsRequest Omitted fields are left alone; an empty value removes the key.
+type UpdatePermissionSettingsRequest struct {
+	AdditionalDirectories *[]string              `json:"additionalDirectories,omitempty"`
+	DefaultMode           *string                `json:"defaultMode,omitempty"`
+	Layer                 ConfigLayerSource      `json:"layer"`
+	ProjectId             *string                `json:"projectId,omitempty"`
+	AdditionalProperties  map[string]interface{} `json:"-"`
+}
+
 // UpdateSkillRequest defines model for UpdateSkillRequest.
 type UpdateSkillRequest struct {
 	Body                 string                 `json:"body"`
@@ -586,6 +1590,34 @@ type UpdateSkillRequest struct {
 	AdditionalProperties map[string]interface{} `json:"-"`
 }
 
+// UpdateStatusLineRequest Omitting statusLine removes the setting from the layer.
+type UpdateStatusLineRequest struct {
+	Layer     ConfigLayerSource `json:"layer"`
+	ProjectId *string           `json:"projectId,omitempty"`
+
+	// StatusLine Keys other than type, command and padding are kept when the setting is rewritten. Secrets in command are returned as "[REDACTED]"; sending the placeholder back keeps the stored secret.
+	StatusLine           *StatusLineConfig      `json:"statusLine,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ValidationErrorResponse defines model for ValidationErrorResponse.
+type ValidationErrorResponse struct {
+	Error                string                 `json:"error"`
+	Issues               []ValidationIssue      `json:"issues"`
+	Warnings             *[]ValidationIssue     `json:"warnings,omitempty"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// ValidationIssue defines model for ValidationIssue.
+type ValidationIssue struct {
+	Message              string                 `json:"message"`
+	Path                 string                 `json:"path"`
+	AdditionalProperties map[string]interface{} `json:"-"`
+}
+
+// IfMatch defines model for IfMatch.
+type IfMatch = string
+
 // GetAgentsParams defines parameters for GetAgents.
 type GetAgentsParams struct {
 	Scope     *GetAgentsParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
@@ -595,12 +1627,24 @@ type GetAgentsParams struct {
 // GetAgentsParamsScope defines parameters for GetAgents.
 type GetAgentsParamsScope string
 
+// DeleteAgentParams defines parameters for DeleteAgent.
+type DeleteAgentParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetAgentParams defines parameters for GetAgent.
 type GetAgentParams struct {
 	Scope     *string `form:"scope,omitempty" json:"scope,omitempty"`
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
+// UpdateAgentParams defines parameters for UpdateAgent.
+type UpdateAgentParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetCommandsParams defines parameters for GetCommands.
 type GetCommandsParams struct {
 	Scope     *GetCommandsParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
@@ -613,6 +1657,9 @@ type GetCommandsParamsScope string
 // DeleteCommandParams defines parameters for DeleteCommand.
 type DeleteCommandParams struct {
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // GetCommandParams defines parameters for GetCommand.
@@ -620,20 +1667,90 @@ type GetCommandParams struct {
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
+// UpdateCommandParams defines parameters for UpdateCommand.
+type UpdateCommandParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetConfigParams defines parameters for GetConfig.
 type GetConfigParams struct {
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
+// PatchConfigParams defines parameters for PatchConfig.
+type PatchConfigParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetConfigRepairParams defines parameters for GetConfigRepair.
+type GetConfigRepairParams struct {
+	Layer     ConfigLayerSource `form:"layer" json:"layer"`
+	ProjectId *string           `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// RepairConfigLayerParams defines parameters for RepairConfigLayer.
+type RepairConfigLayerParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteConfigSettingParams defines parameters for DeleteConfigSetting.
+type DeleteConfigSettingParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateConfigSettingParams defines parameters for UpdateConfigSetting.
+type UpdateConfigSettingParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// MoveConfigSettingParams defines parameters for MoveConfigSetting.
+type MoveConfigSettingParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteFeatureParams defines parameters for DeleteFeature.
+type DeleteFeatureParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateFeatureParams defines parameters for UpdateFeature.
+type UpdateFeatureParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // GetHooksParams defines parameters for GetHooks.
 type GetHooksParams struct {
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
+// CreateHookParams defines parameters for CreateHook.
+type CreateHookParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // DeleteHookParams defines parameters for DeleteHook.
 type DeleteHookParams struct {
+	// Scope The settings layer the hook is in: global (default), global-local, project or project-local.
 	Scope     *string `form:"scope,omitempty" json:"scope,omitempty"`
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateHookParams defines parameters for UpdateHook.
+type UpdateHookParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // GetInstructionsParams defines parameters for GetInstructions.
@@ -645,6 +1762,49 @@ type GetInstructionsParams struct {
 // GetInstructionsParamsScope defines parameters for GetInstructions.
 type GetInstructionsParamsScope string
 
+// UpdateInstructionsParams defines parameters for UpdateInstructions.
+type UpdateInstructionsParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetMcpApprovalsParams defines parameters for GetMcpApprovals.
+type GetMcpApprovalsParams struct {
+	ProjectId string `form:"projectId" json:"projectId"`
+}
+
+// UpdateMcpApprovalsParams defines parameters for UpdateMcpApprovals.
+type UpdateMcpApprovalsParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetMcpServersParams defines parameters for GetMcpServers.
+type GetMcpServersParams struct {
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// CreateMcpServerParams defines parameters for CreateMcpServer.
+type CreateMcpServerParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// DeleteMcpServerParams defines parameters for DeleteMcpServer.
+type DeleteMcpServerParams struct {
+	Scope     McpScope `form:"scope" json:"scope"`
+	ProjectId *string  `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdateMcpServerParams defines parameters for UpdateMcpServer.
+type UpdateMcpServerParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
 // ListMemoryParams defines parameters for ListMemory.
 type ListMemoryParams struct {
 	ProjectId string `form:"projectId" json:"projectId"`
@@ -653,6 +1813,9 @@ type ListMemoryParams struct {
 // DeleteMemoryParams defines parameters for DeleteMemory.
 type DeleteMemoryParams struct {
 	ProjectId string `form:"projectId" json:"projectId"`
+
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
 // GetMemoryParams defines parameters for GetMemory.
@@ -660,42 +1823,138 @@ type GetMemoryParams struct {
 	ProjectId string `form:"projectId" json:"projectId"`
 }
 
-// ScanProjectsParams defines parameters for ScanProjects.
-type ScanProjectsParams struct {
-	Folder string `form:"folder" json:"folder"`
+// UpdateMemoryParams defines parameters for UpdateMemory.
+type UpdateMemoryParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
-// SearchParams defines parameters for Search.
-type SearchParams struct {
-	Q         string  `form:"q" json:"q"`
-	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+// GetOutputStylesParams defines parameters for GetOutputStyles.
+type GetOutputStylesParams struct {
+	Scope     *GetOutputStylesParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+	ProjectId *string                     `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
-// GetSkillsParams defines parameters for GetSkills.
-type GetSkillsParams struct {
-	Scope     *GetSkillsParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
-	ProjectId *string               `form:"projectId,omitempty" json:"projectId,omitempty"`
+// GetOutputStylesParamsScope defines parameters for GetOutputStyles.
+type GetOutputStylesParamsScope string
+
+// DeleteOutputStyleParams defines parameters for DeleteOutputStyle.
+type DeleteOutputStyleParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
-// GetSkillsParamsScope defines parameters for GetSkills.
-type GetSkillsParamsScope string
+// GetOutputStyleParams defines parameters for GetOutputStyle.
+type GetOutputStyleParams struct {
+	Scope     *GetOutputStyleParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+	ProjectId *string                    `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
 
-// DeleteSkillParams defines parameters for DeleteSkill.
-type DeleteSkillParams struct {
-	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+// GetOutputStyleParamsScope defines parameters for GetOutputStyle.
+type GetOutputStyleParamsScope string
+
+// UpdateOutputStyleParams defines parameters for UpdateOutputStyle.
+type UpdateOutputStyleParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
 }
 
-// GetSkillParams defines parameters for GetSkill.
-type GetSkillParams struct {
+// GetOutputStyleSettingParams defines parameters for GetOutputStyleSetting.
+type GetOutputStyleSettingParams struct {
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
-// WatchParams defines parameters for Watch.
-type WatchParams struct {
+// UpdateOutputStyleSettingParams defines parameters for UpdateOutputStyleSetting.
+type UpdateOutputStyleSettingParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetPermissionsParams defines parameters for GetPermissions.
+type GetPermissionsParams struct {
 	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
 }
 
-// CreateAgentJSONRequestBody defines body for CreateAgent for application/json ContentType.
+// DeletePermissionRuleParams defines parameters for DeletePermissionRule.
+type DeletePermissionRuleParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// AddPermissionRuleParams defines parameters for AddPermissionRule.
+type AddPermissionRuleParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdatePermissionRuleParams defines parameters for UpdatePermissionRule.
+type UpdatePermissionRuleParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// UpdatePermissionSettingsParams defines parameters for UpdatePermissionSettings.
+type UpdatePermissionSettingsParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// ScanProjectsParams defines parameters for ScanProjects.
+type ScanProjectsParams struct {
+	Folder string `form:"folder" json:"folder"`
+}
+
+// SearchParams defines parameters for Search.
+type SearchParams struct {
+	Q         string  `form:"q" json:"q"`
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// GetSkillsParams defines parameters for GetSkills.
+type GetSkillsParams struct {
+	Scope     *GetSkillsParamsScope `form:"scope,omitempty" json:"scope,omitempty"`
+	ProjectId *string               `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// GetSkillsParamsScope defines parameters for GetSkills.
+type GetSkillsParamsScope string
+
+// DeleteSkillParams defines parameters for DeleteSkill.
+type DeleteSkillParams struct {
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetSkillParams defines parameters for GetSkill.
+type GetSkillParams struct {
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// UpdateSkillParams defines parameters for UpdateSkill.
+type UpdateSkillParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// GetStatusLineParams defines parameters for GetStatusLine.
+type GetStatusLineParams struct {
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// UpdateStatusLineParams defines parameters for UpdateStatusLine.
+type UpdateStatusLineParams struct {
+	// IfMatch ETag(s) the client last read. The write is refused with 412 if the file's current content hash is not listed. Writes touching two files accept both tags, comma-separated.
+	IfMatch *IfMatch `json:"If-Match,omitempty"`
+}
+
+// WatchParams defines parameters for Watch.
+type WatchParams struct {
+	ProjectId *string `form:"projectId,omitempty" json:"projectId,omitempty"`
+}
+
+// CreateAgentJSONRequestBody defines body for CreateAgent for application/json ContentType.
 type CreateAgentJSONRequestBody = CreateAgentRequest
 
 // DeleteAgentJSONRequestBody defines body for DeleteAgent for application/json ContentType.
@@ -716,6 +1975,12 @@ type CreateCommandJSONRequestBody = CreateCommandRequest
 // UpdateCommandJSONRequestBody defines body for UpdateCommand for application/json ContentType.
 type UpdateCommandJSONRequestBody = UpdateCommandRequest
 
+// PatchConfigJSONRequestBody defines body for PatchConfig for application/json ContentType.
+type PatchConfigJSONRequestBody = ConfigPatchRequest
+
+// RepairConfigLayerJSONRequestBody defines body for RepairConfigLayer for application/json ContentType.
+type RepairConfigLayerJSONRequestBody = RepairConfigLayerRequest
+
 // DeleteConfigSettingJSONRequestBody defines body for DeleteConfigSetting for application/json ContentType.
 type DeleteConfigSettingJSONRequestBody = DeleteConfigSettingRequest
 
@@ -731,27 +1996,84 @@ type UpdateFeatureJSONRequestBody = UpdateFeatureRequest
 // CreateHookJSONRequestBody defines body for CreateHook for application/json ContentType.
 type CreateHookJSONRequestBody = CreateHookRequest
 
+// PreviewHookMatcherJSONRequestBody defines body for PreviewHookMatcher for application/json ContentType.
+type PreviewHookMatcherJSONRequestBody = HookMatcherPreviewRequest
+
+// TestHookJSONRequestBody defines body for TestHook for application/json ContentType.
+type TestHookJSONRequestBody = HookTestRequest
+
 // UpdateHookJSONRequestBody defines body for UpdateHook for application/json ContentType.
 type UpdateHookJSONRequestBody = UpdateHookRequest
 
 // UpdateInstructionsJSONRequestBody defines body for UpdateInstructions for application/json ContentType.
 type UpdateInstructionsJSONRequestBody = UpdateInstructionsRequest
 
+// UpdateMcpApprovalsJSONRequestBody defines body for UpdateMcpApprovals for application/json ContentType.
+type UpdateMcpApprovalsJSONRequestBody = UpdateMcpApprovalsRequest
+
+// BulkUpdateMcpApprovalsJSONRequestBody defines body for BulkUpdateMcpApprovals for application/json ContentType.
+type BulkUpdateMcpApprovalsJSONRequestBody = BulkMcpApprovalsRequest
+
+// CreateMcpServerJSONRequestBody defines body for CreateMcpServer for application/json ContentType.
+type CreateMcpServerJSONRequestBody = CreateMcpServerRequest
+
+// UpdateMcpServerJSONRequestBody defines body for UpdateMcpServer for application/json ContentType.
+type UpdateMcpServerJSONRequestBody = UpdateMcpServerRequest
+
+// ProbeMcpServerJSONRequestBody defines body for ProbeMcpServer for application/json ContentType.
+type ProbeMcpServerJSONRequestBody = McpProbeRequest
+
 // CreateMemoryJSONRequestBody defines body for CreateMemory for application/json ContentType.
 type CreateMemoryJSONRequestBody = CreateMemoryRequest
 
 // UpdateMemoryJSONRequestBody defines body for UpdateMemory for application/json ContentType.
 type UpdateMemoryJSONRequestBody = UpdateMemoryRequest
 
+// CreateOutputStyleJSONRequestBody defines body for CreateOutputStyle for application/json ContentType.
+type CreateOutputStyleJSONRequestBody = CreateOutputStyleRequest
+
+// DeleteOutputStyleJSONRequestBody defines body for DeleteOutputStyle for application/json ContentType.
+type DeleteOutputStyleJSONRequestBody = DeleteOutputStyleRequest
+
+// UpdateOutputStyleJSONRequestBody defines body for UpdateOutputStyle for application/json ContentType.
+type UpdateOutputStyleJSONRequestBody = UpdateOutputStyleRequest
+
+// UpdateOutputStyleSettingJSONRequestBody defines body for UpdateOutputStyleSetting for application/json ContentType.
+type UpdateOutputStyleSettingJSONRequestBody = UpdateOutputStyleSettingRequest
+
+// EvaluatePermissionJSONRequestBody defines body for EvaluatePermission for application/json ContentType.
+type EvaluatePermissionJSONRequestBody = PermissionEvaluationRequest
+
+// DeletePermissionRuleJSONRequestBody defines body for DeletePermissionRule for application/json ContentType.
+type DeletePermissionRuleJSONRequestBody = PermissionRuleRequest
+
+// AddPermissionRuleJSONRequestBody defines body for AddPermissionRule for application/json ContentType.
+type AddPermissionRuleJSONRequestBody = PermissionRuleRequest
+
+// UpdatePermissionRuleJSONRequestBody defines body for UpdatePermissionRule for application/json ContentType.
+type UpdatePermissionRuleJSONRequestBody = UpdatePermissionRuleRequest
+
+// UpdatePermissionSettingsJSONRequestBody defines body for UpdatePermissionSettings for application/json ContentType.
+type UpdatePermissionSettingsJSONRequestBody = UpdatePermissionSettingsRequest
+
 // PostProjectsJSONRequestBody defines body for PostProjects for application/json ContentType.
 type PostProjectsJSONRequestBody = AddProjectsRequest
 
+// RevealRedactedValueJSONRequestBody defines body for RevealRedactedValue for application/json ContentType.
+type RevealRedactedValueJSONRequestBody = RevealRequest
+
 // CreateSkillJSONRequestBody defines body for CreateSkill for application/json ContentType.
 type CreateSkillJSONRequestBody = CreateSkillRequest
 
 // UpdateSkillJSONRequestBody defines body for UpdateSkill for application/json ContentType.
 type UpdateSkillJSONRequestBody = UpdateSkillRequest
 
+// UpdateStatusLineJSONRequestBody defines body for UpdateStatusLine for application/json ContentType.
+type UpdateStatusLineJSONRequestBody = UpdateStatusLineRequest
+
+// TestStatusLineJSONRequestBody defines body for TestStatusLine for application/json ContentType.
+type TestStatusLineJSONRequestBody = StatusLineTestRequest
+
 // Getter for additional properties for AddProjectsRequest. Returns the specified
 // element and whether it was found
 func (a AddProjectsRequest) Get(fieldName string) (value interface{}, found bool) {
@@ -1183,6 +2505,14 @@ func (a *BackupFile) UnmarshalJSON(b []byte) error {
 		delete(object, "filePath")
 	}
 
+	if raw, found := object["group"]; found {
+		err = json.Unmarshal(raw, &a.Group)
+		if err != nil {
+			return fmt.Errorf("error reading 'group': %w", err)
+		}
+		delete(object, "group")
+	}
+
 	if raw, found := object["id"]; found {
 		err = json.Unmarshal(raw, &a.Id)
 		if err != nil {
@@ -1236,6 +2566,13 @@ func (a BackupFile) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
+	if a.Group != nil {
+		object["group"], err = json.Marshal(a.Group)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'group': %w", err)
+		}
+	}
+
 	object["id"], err = json.Marshal(a.Id)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'id': %w", err)
@@ -1260,6 +2597,228 @@ func (a BackupFile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
+// Getter for additional properties for BulkMcpApprovalsRequest. Returns the specified
+// element and whether it was found
+func (a BulkMcpApprovalsRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for BulkMcpApprovalsRequest
+func (a *BulkMcpApprovalsRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for BulkMcpApprovalsRequest to handle AdditionalProperties
+func (a *BulkMcpApprovalsRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["projectIds"]; found {
+		err = json.Unmarshal(raw, &a.ProjectIds)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectIds': %w", err)
+		}
+		delete(object, "projectIds")
+	}
+
+	if raw, found := object["servers"]; found {
+		err = json.Unmarshal(raw, &a.Servers)
+		if err != nil {
+			return fmt.Errorf("error reading 'servers': %w", err)
+		}
+		delete(object, "servers")
+	}
+
+	if raw, found := object["state"]; found {
+		err = json.Unmarshal(raw, &a.State)
+		if err != nil {
+			return fmt.Errorf("error reading 'state': %w", err)
+		}
+		delete(object, "state")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for BulkMcpApprovalsRequest to handle AdditionalProperties
+func (a BulkMcpApprovalsRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Layer != nil {
+		object["layer"], err = json.Marshal(a.Layer)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+		}
+	}
+
+	if a.ProjectIds != nil {
+		object["projectIds"], err = json.Marshal(a.ProjectIds)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectIds': %w", err)
+		}
+	}
+
+	if a.Servers != nil {
+		object["servers"], err = json.Marshal(a.Servers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'servers': %w", err)
+		}
+	}
+
+	object["state"], err = json.Marshal(a.State)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'state': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for BulkMcpApprovalsResponse. Returns the specified
+// element and whether it was found
+func (a BulkMcpApprovalsResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for BulkMcpApprovalsResponse
+func (a *BulkMcpApprovalsResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for BulkMcpApprovalsResponse to handle AdditionalProperties
+func (a *BulkMcpApprovalsResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["backupGroup"]; found {
+		err = json.Unmarshal(raw, &a.BackupGroup)
+		if err != nil {
+			return fmt.Errorf("error reading 'backupGroup': %w", err)
+		}
+		delete(object, "backupGroup")
+	}
+
+	if raw, found := object["files"]; found {
+		err = json.Unmarshal(raw, &a.Files)
+		if err != nil {
+			return fmt.Errorf("error reading 'files': %w", err)
+		}
+		delete(object, "files")
+	}
+
+	if raw, found := object["projects"]; found {
+		err = json.Unmarshal(raw, &a.Projects)
+		if err != nil {
+			return fmt.Errorf("error reading 'projects': %w", err)
+		}
+		delete(object, "projects")
+	}
+
+	if raw, found := object["success"]; found {
+		err = json.Unmarshal(raw, &a.Success)
+		if err != nil {
+			return fmt.Errorf("error reading 'success': %w", err)
+		}
+		delete(object, "success")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for BulkMcpApprovalsResponse to handle AdditionalProperties
+func (a BulkMcpApprovalsResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.BackupGroup != nil {
+		object["backupGroup"], err = json.Marshal(a.BackupGroup)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'backupGroup': %w", err)
+		}
+	}
+
+	if a.Files != nil {
+		object["files"], err = json.Marshal(a.Files)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'files': %w", err)
+		}
+	}
+
+	if a.Projects != nil {
+		object["projects"], err = json.Marshal(a.Projects)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projects': %w", err)
+		}
+	}
+
+	object["success"], err = json.Marshal(a.Success)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'success': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
 // Getter for additional properties for CommandDetail. Returns the specified
 // element and whether it was found
 func (a CommandDetail) Get(fieldName string) (value interface{}, found bool) {
@@ -1555,6 +3114,14 @@ func (a *ConfigLayer) UnmarshalJSON(b []byte) error {
 		delete(object, "content")
 	}
 
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
+	}
+
 	if raw, found := object["exists"]; found {
 		err = json.Unmarshal(raw, &a.Exists)
 		if err != nil {
@@ -1571,6 +3138,22 @@ func (a *ConfigLayer) UnmarshalJSON(b []byte) error {
 		delete(object, "filePath")
 	}
 
+	if raw, found := object["parseError"]; found {
+		err = json.Unmarshal(raw, &a.ParseError)
+		if err != nil {
+			return fmt.Errorf("error reading 'parseError': %w", err)
+		}
+		delete(object, "parseError")
+	}
+
+	if raw, found := object["readOnly"]; found {
+		err = json.Unmarshal(raw, &a.ReadOnly)
+		if err != nil {
+			return fmt.Errorf("error reading 'readOnly': %w", err)
+		}
+		delete(object, "readOnly")
+	}
+
 	if raw, found := object["source"]; found {
 		err = json.Unmarshal(raw, &a.Source)
 		if err != nil {
@@ -1605,6 +3188,13 @@ func (a ConfigLayer) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	if a.Etag != nil {
+		object["etag"], err = json.Marshal(a.Etag)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'etag': %w", err)
+		}
+	}
+
 	object["exists"], err = json.Marshal(a.Exists)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
@@ -1615,7 +3205,21 @@ func (a ConfigLayer) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	object["source"], err = json.Marshal(a.Source)
+	if a.ParseError != nil {
+		object["parseError"], err = json.Marshal(a.ParseError)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'parseError': %w", err)
+		}
+	}
+
+	if a.ReadOnly != nil {
+		object["readOnly"], err = json.Marshal(a.ReadOnly)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'readOnly': %w", err)
+		}
+	}
+
+	object["source"], err = json.Marshal(a.Source)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'source': %w", err)
 	}
@@ -1629,45 +3233,77 @@ func (a ConfigLayer) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ConfigResponse. Returns the specified
+// Getter for additional properties for ConfigPatchOperation. Returns the specified
 // element and whether it was found
-func (a ConfigResponse) Get(fieldName string) (value interface{}, found bool) {
+func (a ConfigPatchOperation) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for ConfigResponse
-func (a *ConfigResponse) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ConfigPatchOperation
+func (a *ConfigPatchOperation) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ConfigResponse to handle AdditionalProperties
-func (a *ConfigResponse) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ConfigPatchOperation to handle AdditionalProperties
+func (a *ConfigPatchOperation) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["layers"]; found {
-		err = json.Unmarshal(raw, &a.Layers)
+	if raw, found := object["from"]; found {
+		err = json.Unmarshal(raw, &a.From)
 		if err != nil {
-			return fmt.Errorf("error reading 'layers': %w", err)
+			return fmt.Errorf("error reading 'from': %w", err)
 		}
-		delete(object, "layers")
+		delete(object, "from")
 	}
 
-	if raw, found := object["merged"]; found {
-		err = json.Unmarshal(raw, &a.Merged)
+	if raw, found := object["fromLayer"]; found {
+		err = json.Unmarshal(raw, &a.FromLayer)
 		if err != nil {
-			return fmt.Errorf("error reading 'merged': %w", err)
+			return fmt.Errorf("error reading 'fromLayer': %w", err)
 		}
-		delete(object, "merged")
+		delete(object, "fromLayer")
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["op"]; found {
+		err = json.Unmarshal(raw, &a.Op)
+		if err != nil {
+			return fmt.Errorf("error reading 'op': %w", err)
+		}
+		delete(object, "op")
+	}
+
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
+	}
+
+	if raw, found := object["value"]; found {
+		err = json.Unmarshal(raw, &a.Value)
+		if err != nil {
+			return fmt.Errorf("error reading 'value': %w", err)
+		}
+		delete(object, "value")
 	}
 
 	if len(object) != 0 {
@@ -1684,21 +3320,43 @@ func (a *ConfigResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ConfigResponse to handle AdditionalProperties
-func (a ConfigResponse) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ConfigPatchOperation to handle AdditionalProperties
+func (a ConfigPatchOperation) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Layers != nil {
-		object["layers"], err = json.Marshal(a.Layers)
+	if a.From != nil {
+		object["from"], err = json.Marshal(a.From)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
+			return nil, fmt.Errorf("error marshaling 'from': %w", err)
 		}
 	}
 
-	object["merged"], err = json.Marshal(a.Merged)
+	if a.FromLayer != nil {
+		object["fromLayer"], err = json.Marshal(a.FromLayer)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'fromLayer': %w", err)
+		}
+	}
+
+	object["layer"], err = json.Marshal(a.Layer)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'merged': %w", err)
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
+
+	object["op"], err = json.Marshal(a.Op)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'op': %w", err)
+	}
+
+	object["path"], err = json.Marshal(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	}
+
+	object["value"], err = json.Marshal(a.Value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'value': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -1710,61 +3368,37 @@ func (a ConfigResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateAgentRequest. Returns the specified
+// Getter for additional properties for ConfigPatchRequest. Returns the specified
 // element and whether it was found
-func (a CreateAgentRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a ConfigPatchRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateAgentRequest
-func (a *CreateAgentRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ConfigPatchRequest
+func (a *ConfigPatchRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateAgentRequest to handle AdditionalProperties
-func (a *CreateAgentRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ConfigPatchRequest to handle AdditionalProperties
+func (a *ConfigPatchRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["body"]; found {
-		err = json.Unmarshal(raw, &a.Body)
-		if err != nil {
-			return fmt.Errorf("error reading 'body': %w", err)
-		}
-		delete(object, "body")
-	}
-
-	if raw, found := object["color"]; found {
-		err = json.Unmarshal(raw, &a.Color)
-		if err != nil {
-			return fmt.Errorf("error reading 'color': %w", err)
-		}
-		delete(object, "color")
-	}
-
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
-		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
-		}
-		delete(object, "description")
-	}
-
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["operations"]; found {
+		err = json.Unmarshal(raw, &a.Operations)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'operations': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "operations")
 	}
 
 	if raw, found := object["projectId"]; found {
@@ -1775,22 +3409,6 @@ func (a *CreateAgentRequest) UnmarshalJSON(b []byte) error {
 		delete(object, "projectId")
 	}
 
-	if raw, found := object["scope"]; found {
-		err = json.Unmarshal(raw, &a.Scope)
-		if err != nil {
-			return fmt.Errorf("error reading 'scope': %w", err)
-		}
-		delete(object, "scope")
-	}
-
-	if raw, found := object["tools"]; found {
-		err = json.Unmarshal(raw, &a.Tools)
-		if err != nil {
-			return fmt.Errorf("error reading 'tools': %w", err)
-		}
-		delete(object, "tools")
-	}
-
 	if len(object) != 0 {
 		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
@@ -1805,35 +3423,18 @@ func (a *CreateAgentRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for CreateAgentRequest to handle AdditionalProperties
-func (a CreateAgentRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ConfigPatchRequest to handle AdditionalProperties
+func (a ConfigPatchRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["body"], err = json.Marshal(a.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'body': %w", err)
-	}
-
-	if a.Color != nil {
-		object["color"], err = json.Marshal(a.Color)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'color': %w", err)
-		}
-	}
-
-	if a.Description != nil {
-		object["description"], err = json.Marshal(a.Description)
+	if a.Operations != nil {
+		object["operations"], err = json.Marshal(a.Operations)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+			return nil, fmt.Errorf("error marshaling 'operations': %w", err)
 		}
 	}
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
-	}
-
 	if a.ProjectId != nil {
 		object["projectId"], err = json.Marshal(a.ProjectId)
 		if err != nil {
@@ -1841,18 +3442,6 @@ func (a CreateAgentRequest) MarshalJSON() ([]byte, error) {
 		}
 	}
 
-	object["scope"], err = json.Marshal(a.Scope)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
-	}
-
-	if a.Tools != nil {
-		object["tools"], err = json.Marshal(a.Tools)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'tools': %w", err)
-		}
-	}
-
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -1862,69 +3451,61 @@ func (a CreateAgentRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateCommandRequest. Returns the specified
+// Getter for additional properties for ConfigPatchResponse. Returns the specified
 // element and whether it was found
-func (a CreateCommandRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a ConfigPatchResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateCommandRequest
-func (a *CreateCommandRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ConfigPatchResponse
+func (a *ConfigPatchResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateCommandRequest to handle AdditionalProperties
-func (a *CreateCommandRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ConfigPatchResponse to handle AdditionalProperties
+func (a *ConfigPatchResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["body"]; found {
-		err = json.Unmarshal(raw, &a.Body)
-		if err != nil {
-			return fmt.Errorf("error reading 'body': %w", err)
-		}
-		delete(object, "body")
-	}
-
-	if raw, found := object["folder"]; found {
-		err = json.Unmarshal(raw, &a.Folder)
+	if raw, found := object["backupGroup"]; found {
+		err = json.Unmarshal(raw, &a.BackupGroup)
 		if err != nil {
-			return fmt.Errorf("error reading 'folder': %w", err)
+			return fmt.Errorf("error reading 'backupGroup': %w", err)
 		}
-		delete(object, "folder")
+		delete(object, "backupGroup")
 	}
 
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["files"]; found {
+		err = json.Unmarshal(raw, &a.Files)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'files': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "files")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["success"]; found {
+		err = json.Unmarshal(raw, &a.Success)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'success': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "success")
 	}
 
-	if raw, found := object["scope"]; found {
-		err = json.Unmarshal(raw, &a.Scope)
+	if raw, found := object["warnings"]; found {
+		err = json.Unmarshal(raw, &a.Warnings)
 		if err != nil {
-			return fmt.Errorf("error reading 'scope': %w", err)
+			return fmt.Errorf("error reading 'warnings': %w", err)
 		}
-		delete(object, "scope")
+		delete(object, "warnings")
 	}
 
 	if len(object) != 0 {
@@ -1941,38 +3522,37 @@ func (a *CreateCommandRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for CreateCommandRequest to handle AdditionalProperties
-func (a CreateCommandRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ConfigPatchResponse to handle AdditionalProperties
+func (a ConfigPatchResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["body"], err = json.Marshal(a.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	if a.BackupGroup != nil {
+		object["backupGroup"], err = json.Marshal(a.BackupGroup)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'backupGroup': %w", err)
+		}
 	}
 
-	object["folder"], err = json.Marshal(a.Folder)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'folder': %w", err)
+	if a.Files != nil {
+		object["files"], err = json.Marshal(a.Files)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'files': %w", err)
+		}
 	}
 
-	object["name"], err = json.Marshal(a.Name)
+	object["success"], err = json.Marshal(a.Success)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		return nil, fmt.Errorf("error marshaling 'success': %w", err)
 	}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
+	if a.Warnings != nil {
+		object["warnings"], err = json.Marshal(a.Warnings)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+			return nil, fmt.Errorf("error marshaling 'warnings': %w", err)
 		}
 	}
 
-	object["scope"], err = json.Marshal(a.Scope)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
-	}
-
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -1982,119 +3562,160 @@ func (a CreateCommandRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateHookRequest. Returns the specified
+// Getter for additional properties for ConfigRepairPreview. Returns the specified
 // element and whether it was found
-func (a CreateHookRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a ConfigRepairPreview) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateHookRequest
-func (a *CreateHookRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ConfigRepairPreview
+func (a *ConfigRepairPreview) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateHookRequest to handle AdditionalProperties
-func (a *CreateHookRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ConfigRepairPreview to handle AdditionalProperties
+func (a *ConfigRepairPreview) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["commands"]; found {
-		err = json.Unmarshal(raw, &a.Commands)
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
 		if err != nil {
-			return fmt.Errorf("error reading 'commands': %w", err)
+			return fmt.Errorf("error reading 'etag': %w", err)
 		}
-		delete(object, "commands")
+		delete(object, "etag")
 	}
 
-	if raw, found := object["event"]; found {
-		err = json.Unmarshal(raw, &a.Event)
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
 		if err != nil {
-			return fmt.Errorf("error reading 'event': %w", err)
+			return fmt.Errorf("error reading 'exists': %w", err)
 		}
-		delete(object, "event")
+		delete(object, "exists")
 	}
 
-	if raw, found := object["matcher"]; found {
-		err = json.Unmarshal(raw, &a.Matcher)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
 		if err != nil {
-			return fmt.Errorf("error reading 'matcher': %w", err)
+			return fmt.Errorf("error reading 'filePath': %w", err)
 		}
-		delete(object, "matcher")
+		delete(object, "filePath")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["original"]; found {
+		err = json.Unmarshal(raw, &a.Original)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'original': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "original")
 	}
 
-	if raw, found := object["scope"]; found {
-		err = json.Unmarshal(raw, &a.Scope)
+	if raw, found := object["parseError"]; found {
+		err = json.Unmarshal(raw, &a.ParseError)
 		if err != nil {
-			return fmt.Errorf("error reading 'scope': %w", err)
+			return fmt.Errorf("error reading 'parseError': %w", err)
 		}
-		delete(object, "scope")
+		delete(object, "parseError")
 	}
 
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
-		for fieldName, fieldBuf := range object {
-			var fieldVal interface{}
-			err := json.Unmarshal(fieldBuf, &fieldVal)
-			if err != nil {
-				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
-			}
-			a.AdditionalProperties[fieldName] = fieldVal
+	if raw, found := object["repaired"]; found {
+		err = json.Unmarshal(raw, &a.Repaired)
+		if err != nil {
+			return fmt.Errorf("error reading 'repaired': %w", err)
+		}
+		delete(object, "repaired")
+	}
+
+	if raw, found := object["repairedParseError"]; found {
+		err = json.Unmarshal(raw, &a.RepairedParseError)
+		if err != nil {
+			return fmt.Errorf("error reading 'repairedParseError': %w", err)
+		}
+		delete(object, "repairedParseError")
+	}
+
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
 	return nil
 }
 
-// Override default JSON handling for CreateHookRequest to handle AdditionalProperties
-func (a CreateHookRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ConfigRepairPreview to handle AdditionalProperties
+func (a ConfigRepairPreview) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Commands != nil {
-		object["commands"], err = json.Marshal(a.Commands)
+	if a.Etag != nil {
+		object["etag"], err = json.Marshal(a.Etag)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'commands': %w", err)
+			return nil, fmt.Errorf("error marshaling 'etag': %w", err)
 		}
 	}
 
-	object["event"], err = json.Marshal(a.Event)
+	object["exists"], err = json.Marshal(a.Exists)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'event': %w", err)
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
 	}
 
-	if a.Matcher != nil {
-		object["matcher"], err = json.Marshal(a.Matcher)
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	object["original"], err = json.Marshal(a.Original)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'original': %w", err)
+	}
+
+	if a.ParseError != nil {
+		object["parseError"], err = json.Marshal(a.ParseError)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
+			return nil, fmt.Errorf("error marshaling 'parseError': %w", err)
 		}
 	}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
+	if a.Repaired != nil {
+		object["repaired"], err = json.Marshal(a.Repaired)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+			return nil, fmt.Errorf("error marshaling 'repaired': %w", err)
 		}
 	}
 
-	object["scope"], err = json.Marshal(a.Scope)
+	if a.RepairedParseError != nil {
+		object["repairedParseError"], err = json.Marshal(a.RepairedParseError)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'repairedParseError': %w", err)
+		}
+	}
+
+	object["source"], err = json.Marshal(a.Source)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -2106,53 +3727,53 @@ func (a CreateHookRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateMemoryRequest. Returns the specified
+// Getter for additional properties for ConfigResponse. Returns the specified
 // element and whether it was found
-func (a CreateMemoryRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a ConfigResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateMemoryRequest
-func (a *CreateMemoryRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ConfigResponse
+func (a *ConfigResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateMemoryRequest to handle AdditionalProperties
-func (a *CreateMemoryRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ConfigResponse to handle AdditionalProperties
+func (a *ConfigResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["content"]; found {
-		err = json.Unmarshal(raw, &a.Content)
+	if raw, found := object["layers"]; found {
+		err = json.Unmarshal(raw, &a.Layers)
 		if err != nil {
-			return fmt.Errorf("error reading 'content': %w", err)
+			return fmt.Errorf("error reading 'layers': %w", err)
 		}
-		delete(object, "content")
+		delete(object, "layers")
 	}
 
-	if raw, found := object["filename"]; found {
-		err = json.Unmarshal(raw, &a.Filename)
+	if raw, found := object["merged"]; found {
+		err = json.Unmarshal(raw, &a.Merged)
 		if err != nil {
-			return fmt.Errorf("error reading 'filename': %w", err)
+			return fmt.Errorf("error reading 'merged': %w", err)
 		}
-		delete(object, "filename")
+		delete(object, "merged")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["provenance"]; found {
+		err = json.Unmarshal(raw, &a.Provenance)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'provenance': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "provenance")
 	}
 
 	if len(object) != 0 {
@@ -2169,24 +3790,28 @@ func (a *CreateMemoryRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for CreateMemoryRequest to handle AdditionalProperties
-func (a CreateMemoryRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ConfigResponse to handle AdditionalProperties
+func (a ConfigResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["content"], err = json.Marshal(a.Content)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'content': %w", err)
+	if a.Layers != nil {
+		object["layers"], err = json.Marshal(a.Layers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
+		}
 	}
 
-	object["filename"], err = json.Marshal(a.Filename)
+	object["merged"], err = json.Marshal(a.Merged)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filename': %w", err)
+		return nil, fmt.Errorf("error marshaling 'merged': %w", err)
 	}
 
-	object["projectId"], err = json.Marshal(a.ProjectId)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+	if a.Provenance != nil {
+		object["provenance"], err = json.Marshal(a.Provenance)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'provenance': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -2198,25 +3823,25 @@ func (a CreateMemoryRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for CreateSkillRequest. Returns the specified
+// Getter for additional properties for CreateAgentRequest. Returns the specified
 // element and whether it was found
-func (a CreateSkillRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateAgentRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for CreateSkillRequest
-func (a *CreateSkillRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateAgentRequest
+func (a *CreateAgentRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for CreateSkillRequest to handle AdditionalProperties
-func (a *CreateSkillRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateAgentRequest to handle AdditionalProperties
+func (a *CreateAgentRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
@@ -2231,6 +3856,14 @@ func (a *CreateSkillRequest) UnmarshalJSON(b []byte) error {
 		delete(object, "body")
 	}
 
+	if raw, found := object["color"]; found {
+		err = json.Unmarshal(raw, &a.Color)
+		if err != nil {
+			return fmt.Errorf("error reading 'color': %w", err)
+		}
+		delete(object, "color")
+	}
+
 	if raw, found := object["description"]; found {
 		err = json.Unmarshal(raw, &a.Description)
 		if err != nil {
@@ -2263,6 +3896,14 @@ func (a *CreateSkillRequest) UnmarshalJSON(b []byte) error {
 		delete(object, "scope")
 	}
 
+	if raw, found := object["tools"]; found {
+		err = json.Unmarshal(raw, &a.Tools)
+		if err != nil {
+			return fmt.Errorf("error reading 'tools': %w", err)
+		}
+		delete(object, "tools")
+	}
+
 	if len(object) != 0 {
 		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
@@ -2277,8 +3918,8 @@ func (a *CreateSkillRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for CreateSkillRequest to handle AdditionalProperties
-func (a CreateSkillRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateAgentRequest to handle AdditionalProperties
+func (a CreateAgentRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
@@ -2287,6 +3928,13 @@ func (a CreateSkillRequest) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'body': %w", err)
 	}
 
+	if a.Color != nil {
+		object["color"], err = json.Marshal(a.Color)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'color': %w", err)
+		}
+	}
+
 	if a.Description != nil {
 		object["description"], err = json.Marshal(a.Description)
 		if err != nil {
@@ -2311,6 +3959,13 @@ func (a CreateSkillRequest) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
+	if a.Tools != nil {
+		object["tools"], err = json.Marshal(a.Tools)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tools': %w", err)
+		}
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -2320,31 +3975,55 @@ func (a CreateSkillRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for DeleteAgentRequest. Returns the specified
+// Getter for additional properties for CreateCommandRequest. Returns the specified
 // element and whether it was found
-func (a DeleteAgentRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateCommandRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for DeleteAgentRequest
-func (a *DeleteAgentRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateCommandRequest
+func (a *CreateCommandRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for DeleteAgentRequest to handle AdditionalProperties
-func (a *DeleteAgentRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateCommandRequest to handle AdditionalProperties
+func (a *CreateCommandRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
+
+	if raw, found := object["folder"]; found {
+		err = json.Unmarshal(raw, &a.Folder)
+		if err != nil {
+			return fmt.Errorf("error reading 'folder': %w", err)
+		}
+		delete(object, "folder")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
 	if raw, found := object["projectId"]; found {
 		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
@@ -2375,11 +4054,26 @@ func (a *DeleteAgentRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for DeleteAgentRequest to handle AdditionalProperties
-func (a DeleteAgentRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateCommandRequest to handle AdditionalProperties
+func (a CreateCommandRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	}
+
+	object["folder"], err = json.Marshal(a.Folder)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'folder': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
 	if a.ProjectId != nil {
 		object["projectId"], err = json.Marshal(a.ProjectId)
 		if err != nil {
@@ -2401,37 +4095,61 @@ func (a DeleteAgentRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for DeleteConfigSettingRequest. Returns the specified
+// Getter for additional properties for CreateHookRequest. Returns the specified
 // element and whether it was found
-func (a DeleteConfigSettingRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateHookRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for DeleteConfigSettingRequest
-func (a *DeleteConfigSettingRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateHookRequest
+func (a *CreateHookRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for DeleteConfigSettingRequest to handle AdditionalProperties
-func (a *DeleteConfigSettingRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateHookRequest to handle AdditionalProperties
+func (a *CreateHookRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["keyPath"]; found {
-		err = json.Unmarshal(raw, &a.KeyPath)
+	if raw, found := object["commands"]; found {
+		err = json.Unmarshal(raw, &a.Commands)
 		if err != nil {
-			return fmt.Errorf("error reading 'keyPath': %w", err)
+			return fmt.Errorf("error reading 'commands': %w", err)
 		}
-		delete(object, "keyPath")
+		delete(object, "commands")
+	}
+
+	if raw, found := object["event"]; found {
+		err = json.Unmarshal(raw, &a.Event)
+		if err != nil {
+			return fmt.Errorf("error reading 'event': %w", err)
+		}
+		delete(object, "event")
+	}
+
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &a.Hooks)
+		if err != nil {
+			return fmt.Errorf("error reading 'hooks': %w", err)
+		}
+		delete(object, "hooks")
+	}
+
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
+		if err != nil {
+			return fmt.Errorf("error reading 'matcher': %w", err)
+		}
+		delete(object, "matcher")
 	}
 
 	if raw, found := object["projectId"]; found {
@@ -2442,8 +4160,16 @@ func (a *DeleteConfigSettingRequest) UnmarshalJSON(b []byte) error {
 		delete(object, "projectId")
 	}
 
-	if len(object) != 0 {
-		a.AdditionalProperties = make(map[string]interface{})
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
 			var fieldVal interface{}
 			err := json.Unmarshal(fieldBuf, &fieldVal)
@@ -2456,15 +4182,34 @@ func (a *DeleteConfigSettingRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for DeleteConfigSettingRequest to handle AdditionalProperties
-func (a DeleteConfigSettingRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateHookRequest to handle AdditionalProperties
+func (a CreateHookRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.KeyPath != nil {
-		object["keyPath"], err = json.Marshal(a.KeyPath)
+	if a.Commands != nil {
+		object["commands"], err = json.Marshal(a.Commands)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'keyPath': %w", err)
+			return nil, fmt.Errorf("error marshaling 'commands': %w", err)
+		}
+	}
+
+	object["event"], err = json.Marshal(a.Event)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'event': %w", err)
+	}
+
+	if a.Hooks != nil {
+		object["hooks"], err = json.Marshal(a.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
+	}
+
+	if a.Matcher != nil {
+		object["matcher"], err = json.Marshal(a.Matcher)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
 		}
 	}
 
@@ -2475,6 +4220,11 @@ func (a DeleteConfigSettingRequest) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -2484,37 +4234,61 @@ func (a DeleteConfigSettingRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ErrorResponse. Returns the specified
+// Getter for additional properties for CreateMcpServerRequest. Returns the specified
 // element and whether it was found
-func (a ErrorResponse) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateMcpServerRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for ErrorResponse
-func (a *ErrorResponse) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateMcpServerRequest
+func (a *CreateMcpServerRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ErrorResponse to handle AdditionalProperties
-func (a *ErrorResponse) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateMcpServerRequest to handle AdditionalProperties
+func (a *CreateMcpServerRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["error"]; found {
-		err = json.Unmarshal(raw, &a.Error)
+	if raw, found := object["config"]; found {
+		err = json.Unmarshal(raw, &a.Config)
 		if err != nil {
-			return fmt.Errorf("error reading 'error': %w", err)
+			return fmt.Errorf("error reading 'config': %w", err)
 		}
-		delete(object, "error")
+		delete(object, "config")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
 	}
 
 	if len(object) != 0 {
@@ -2531,14 +4305,31 @@ func (a *ErrorResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ErrorResponse to handle AdditionalProperties
-func (a ErrorResponse) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateMcpServerRequest to handle AdditionalProperties
+func (a CreateMcpServerRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["error"], err = json.Marshal(a.Error)
+	object["config"], err = json.Marshal(a.Config)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		return nil, fmt.Errorf("error marshaling 'config': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -2550,53 +4341,53 @@ func (a ErrorResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for Feature. Returns the specified
+// Getter for additional properties for CreateMemoryRequest. Returns the specified
 // element and whether it was found
-func (a Feature) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateMemoryRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for Feature
-func (a *Feature) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateMemoryRequest
+func (a *CreateMemoryRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for Feature to handle AdditionalProperties
-func (a *Feature) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateMemoryRequest to handle AdditionalProperties
+func (a *CreateMemoryRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["currentValue"]; found {
-		err = json.Unmarshal(raw, &a.CurrentValue)
+	if raw, found := object["content"]; found {
+		err = json.Unmarshal(raw, &a.Content)
 		if err != nil {
-			return fmt.Errorf("error reading 'currentValue': %w", err)
+			return fmt.Errorf("error reading 'content': %w", err)
 		}
-		delete(object, "currentValue")
+		delete(object, "content")
 	}
 
-	if raw, found := object["definition"]; found {
-		err = json.Unmarshal(raw, &a.Definition)
+	if raw, found := object["filename"]; found {
+		err = json.Unmarshal(raw, &a.Filename)
 		if err != nil {
-			return fmt.Errorf("error reading 'definition': %w", err)
+			return fmt.Errorf("error reading 'filename': %w", err)
 		}
-		delete(object, "definition")
+		delete(object, "filename")
 	}
 
-	if raw, found := object["isDocumented"]; found {
-		err = json.Unmarshal(raw, &a.IsDocumented)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'isDocumented': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "isDocumented")
+		delete(object, "projectId")
 	}
 
 	if len(object) != 0 {
@@ -2613,24 +4404,24 @@ func (a *Feature) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for Feature to handle AdditionalProperties
-func (a Feature) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateMemoryRequest to handle AdditionalProperties
+func (a CreateMemoryRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["currentValue"], err = json.Marshal(a.CurrentValue)
+	object["content"], err = json.Marshal(a.Content)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'currentValue': %w", err)
+		return nil, fmt.Errorf("error marshaling 'content': %w", err)
 	}
 
-	object["definition"], err = json.Marshal(a.Definition)
+	object["filename"], err = json.Marshal(a.Filename)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'definition': %w", err)
+		return nil, fmt.Errorf("error marshaling 'filename': %w", err)
 	}
 
-	object["isDocumented"], err = json.Marshal(a.IsDocumented)
+	object["projectId"], err = json.Marshal(a.ProjectId)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'isDocumented': %w", err)
+		return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -2642,37 +4433,37 @@ func (a Feature) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for FeatureDefinition. Returns the specified
+// Getter for additional properties for CreateOutputStyleRequest. Returns the specified
 // element and whether it was found
-func (a FeatureDefinition) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateOutputStyleRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for FeatureDefinition
-func (a *FeatureDefinition) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateOutputStyleRequest
+func (a *CreateOutputStyleRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for FeatureDefinition to handle AdditionalProperties
-func (a *FeatureDefinition) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateOutputStyleRequest to handle AdditionalProperties
+func (a *CreateOutputStyleRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["category"]; found {
-		err = json.Unmarshal(raw, &a.Category)
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
 		if err != nil {
-			return fmt.Errorf("error reading 'category': %w", err)
+			return fmt.Errorf("error reading 'body': %w", err)
 		}
-		delete(object, "category")
+		delete(object, "body")
 	}
 
 	if raw, found := object["description"]; found {
@@ -2683,12 +4474,12 @@ func (a *FeatureDefinition) UnmarshalJSON(b []byte) error {
 		delete(object, "description")
 	}
 
-	if raw, found := object["key"]; found {
-		err = json.Unmarshal(raw, &a.Key)
+	if raw, found := object["keepCodingInstructions"]; found {
+		err = json.Unmarshal(raw, &a.KeepCodingInstructions)
 		if err != nil {
-			return fmt.Errorf("error reading 'key': %w", err)
+			return fmt.Errorf("error reading 'keepCodingInstructions': %w", err)
 		}
-		delete(object, "key")
+		delete(object, "keepCodingInstructions")
 	}
 
 	if raw, found := object["name"]; found {
@@ -2699,20 +4490,20 @@ func (a *FeatureDefinition) UnmarshalJSON(b []byte) error {
 		delete(object, "name")
 	}
 
-	if raw, found := object["type"]; found {
-		err = json.Unmarshal(raw, &a.Type)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'type': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "type")
+		delete(object, "projectId")
 	}
 
-	if raw, found := object["values"]; found {
-		err = json.Unmarshal(raw, &a.Values)
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
 		if err != nil {
-			return fmt.Errorf("error reading 'values': %w", err)
+			return fmt.Errorf("error reading 'scope': %w", err)
 		}
-		delete(object, "values")
+		delete(object, "scope")
 	}
 
 	if len(object) != 0 {
@@ -2729,24 +4520,28 @@ func (a *FeatureDefinition) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for FeatureDefinition to handle AdditionalProperties
-func (a FeatureDefinition) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateOutputStyleRequest to handle AdditionalProperties
+func (a CreateOutputStyleRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["category"], err = json.Marshal(a.Category)
+	object["body"], err = json.Marshal(a.Body)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'category': %w", err)
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
 	}
 
-	object["description"], err = json.Marshal(a.Description)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
 	}
 
-	object["key"], err = json.Marshal(a.Key)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'key': %w", err)
+	if a.KeepCodingInstructions != nil {
+		object["keepCodingInstructions"], err = json.Marshal(a.KeepCodingInstructions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keepCodingInstructions': %w", err)
+		}
 	}
 
 	object["name"], err = json.Marshal(a.Name)
@@ -2754,18 +4549,18 @@ func (a FeatureDefinition) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	object["type"], err = json.Marshal(a.Type)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'type': %w", err)
-	}
-
-	if a.Values != nil {
-		object["values"], err = json.Marshal(a.Values)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'values': %w", err)
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
 		}
 	}
 
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -2775,61 +4570,69 @@ func (a FeatureDefinition) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for FeaturesData. Returns the specified
+// Getter for additional properties for CreateSkillRequest. Returns the specified
 // element and whether it was found
-func (a FeaturesData) Get(fieldName string) (value interface{}, found bool) {
+func (a CreateSkillRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for FeaturesData
-func (a *FeaturesData) Set(fieldName string, value interface{}) {
+// Setter for additional properties for CreateSkillRequest
+func (a *CreateSkillRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for FeaturesData to handle AdditionalProperties
-func (a *FeaturesData) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for CreateSkillRequest to handle AdditionalProperties
+func (a *CreateSkillRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["features"]; found {
-		err = json.Unmarshal(raw, &a.Features)
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
 		if err != nil {
-			return fmt.Errorf("error reading 'features': %w", err)
+			return fmt.Errorf("error reading 'body': %w", err)
 		}
-		delete(object, "features")
+		delete(object, "body")
 	}
 
-	if raw, found := object["totalDiscovered"]; found {
-		err = json.Unmarshal(raw, &a.TotalDiscovered)
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
 		if err != nil {
-			return fmt.Errorf("error reading 'totalDiscovered': %w", err)
+			return fmt.Errorf("error reading 'description': %w", err)
 		}
-		delete(object, "totalDiscovered")
+		delete(object, "description")
 	}
 
-	if raw, found := object["totalDocumented"]; found {
-		err = json.Unmarshal(raw, &a.TotalDocumented)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
 		if err != nil {
-			return fmt.Errorf("error reading 'totalDocumented': %w", err)
+			return fmt.Errorf("error reading 'name': %w", err)
 		}
-		delete(object, "totalDocumented")
+		delete(object, "name")
 	}
 
-	if raw, found := object["version"]; found {
-		err = json.Unmarshal(raw, &a.Version)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'version': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "version")
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
 	}
 
 	if len(object) != 0 {
@@ -2846,35 +4649,40 @@ func (a *FeaturesData) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for FeaturesData to handle AdditionalProperties
-func (a FeaturesData) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for CreateSkillRequest to handle AdditionalProperties
+func (a CreateSkillRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Features != nil {
-		object["features"], err = json.Marshal(a.Features)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'features': %w", err)
-		}
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
 	}
 
-	object["totalDiscovered"], err = json.Marshal(a.TotalDiscovered)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'totalDiscovered': %w", err)
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
 	}
 
-	object["totalDocumented"], err = json.Marshal(a.TotalDocumented)
+	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'totalDocumented': %w", err)
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	if a.Version != nil {
-		object["version"], err = json.Marshal(a.Version)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'version': %w", err)
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
 		}
 	}
 
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -2884,37 +4692,45 @@ func (a FeaturesData) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for HealthResponse. Returns the specified
+// Getter for additional properties for DeleteAgentRequest. Returns the specified
 // element and whether it was found
-func (a HealthResponse) Get(fieldName string) (value interface{}, found bool) {
+func (a DeleteAgentRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for HealthResponse
-func (a *HealthResponse) Set(fieldName string, value interface{}) {
+// Setter for additional properties for DeleteAgentRequest
+func (a *DeleteAgentRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for HealthResponse to handle AdditionalProperties
-func (a *HealthResponse) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for DeleteAgentRequest to handle AdditionalProperties
+func (a *DeleteAgentRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["status"]; found {
-		err = json.Unmarshal(raw, &a.Status)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'status': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "status")
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
 	}
 
 	if len(object) != 0 {
@@ -2931,14 +4747,21 @@ func (a *HealthResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for HealthResponse to handle AdditionalProperties
-func (a HealthResponse) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for DeleteAgentRequest to handle AdditionalProperties
+func (a DeleteAgentRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["status"], err = json.Marshal(a.Status)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'status': %w", err)
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -2950,45 +4773,45 @@ func (a HealthResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for HookCommand. Returns the specified
+// Getter for additional properties for DeleteConfigSettingRequest. Returns the specified
 // element and whether it was found
-func (a HookCommand) Get(fieldName string) (value interface{}, found bool) {
+func (a DeleteConfigSettingRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for HookCommand
-func (a *HookCommand) Set(fieldName string, value interface{}) {
+// Setter for additional properties for DeleteConfigSettingRequest
+func (a *DeleteConfigSettingRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for HookCommand to handle AdditionalProperties
-func (a *HookCommand) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for DeleteConfigSettingRequest to handle AdditionalProperties
+func (a *DeleteConfigSettingRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["command"]; found {
-		err = json.Unmarshal(raw, &a.Command)
+	if raw, found := object["keyPath"]; found {
+		err = json.Unmarshal(raw, &a.KeyPath)
 		if err != nil {
-			return fmt.Errorf("error reading 'command': %w", err)
+			return fmt.Errorf("error reading 'keyPath': %w", err)
 		}
-		delete(object, "command")
+		delete(object, "keyPath")
 	}
 
-	if raw, found := object["type"]; found {
-		err = json.Unmarshal(raw, &a.Type)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'type': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "type")
+		delete(object, "projectId")
 	}
 
 	if len(object) != 0 {
@@ -3005,19 +4828,23 @@ func (a *HookCommand) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for HookCommand to handle AdditionalProperties
-func (a HookCommand) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for DeleteConfigSettingRequest to handle AdditionalProperties
+func (a DeleteConfigSettingRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["command"], err = json.Marshal(a.Command)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'command': %w", err)
+	if a.KeyPath != nil {
+		object["keyPath"], err = json.Marshal(a.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keyPath': %w", err)
+		}
 	}
 
-	object["type"], err = json.Marshal(a.Type)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3029,45 +4856,45 @@ func (a HookCommand) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for HookDefinition. Returns the specified
+// Getter for additional properties for DeleteOutputStyleRequest. Returns the specified
 // element and whether it was found
-func (a HookDefinition) Get(fieldName string) (value interface{}, found bool) {
+func (a DeleteOutputStyleRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for HookDefinition
-func (a *HookDefinition) Set(fieldName string, value interface{}) {
+// Setter for additional properties for DeleteOutputStyleRequest
+func (a *DeleteOutputStyleRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for HookDefinition to handle AdditionalProperties
-func (a *HookDefinition) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for DeleteOutputStyleRequest to handle AdditionalProperties
+func (a *DeleteOutputStyleRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["hooks"]; found {
-		err = json.Unmarshal(raw, &a.Hooks)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'hooks': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "hooks")
+		delete(object, "projectId")
 	}
 
-	if raw, found := object["matcher"]; found {
-		err = json.Unmarshal(raw, &a.Matcher)
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
 		if err != nil {
-			return fmt.Errorf("error reading 'matcher': %w", err)
+			return fmt.Errorf("error reading 'scope': %w", err)
 		}
-		delete(object, "matcher")
+		delete(object, "scope")
 	}
 
 	if len(object) != 0 {
@@ -3084,23 +4911,21 @@ func (a *HookDefinition) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for HookDefinition to handle AdditionalProperties
-func (a HookDefinition) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for DeleteOutputStyleRequest to handle AdditionalProperties
+func (a DeleteOutputStyleRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Hooks != nil {
-		object["hooks"], err = json.Marshal(a.Hooks)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
 		}
 	}
 
-	if a.Matcher != nil {
-		object["matcher"], err = json.Marshal(a.Matcher)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
-		}
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3112,37 +4937,37 @@ func (a HookDefinition) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for HookScope. Returns the specified
+// Getter for additional properties for ErrorResponse. Returns the specified
 // element and whether it was found
-func (a HookScope) Get(fieldName string) (value interface{}, found bool) {
+func (a ErrorResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for HookScope
-func (a *HookScope) Set(fieldName string, value interface{}) {
+// Setter for additional properties for ErrorResponse
+func (a *ErrorResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for HookScope to handle AdditionalProperties
-func (a *HookScope) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for ErrorResponse to handle AdditionalProperties
+func (a *ErrorResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["hooks"]; found {
-		err = json.Unmarshal(raw, &a.Hooks)
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
 		if err != nil {
-			return fmt.Errorf("error reading 'hooks': %w", err)
+			return fmt.Errorf("error reading 'error': %w", err)
 		}
-		delete(object, "hooks")
+		delete(object, "error")
 	}
 
 	if len(object) != 0 {
@@ -3159,16 +4984,14 @@ func (a *HookScope) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for HookScope to handle AdditionalProperties
-func (a HookScope) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for ErrorResponse to handle AdditionalProperties
+func (a ErrorResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Hooks != nil {
-		object["hooks"], err = json.Marshal(a.Hooks)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
-		}
+	object["error"], err = json.Marshal(a.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'error': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3180,85 +5003,53 @@ func (a HookScope) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for HooksByEvent. Returns the specified
+// Getter for additional properties for Feature. Returns the specified
 // element and whether it was found
-func (a HooksByEvent) Get(fieldName string) (value interface{}, found bool) {
+func (a Feature) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for HooksByEvent
-func (a *HooksByEvent) Set(fieldName string, value interface{}) {
+// Setter for additional properties for Feature
+func (a *Feature) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for HooksByEvent to handle AdditionalProperties
-func (a *HooksByEvent) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for Feature to handle AdditionalProperties
+func (a *Feature) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["Notification"]; found {
-		err = json.Unmarshal(raw, &a.Notification)
-		if err != nil {
-			return fmt.Errorf("error reading 'Notification': %w", err)
-		}
-		delete(object, "Notification")
-	}
-
-	if raw, found := object["PostToolUse"]; found {
-		err = json.Unmarshal(raw, &a.PostToolUse)
-		if err != nil {
-			return fmt.Errorf("error reading 'PostToolUse': %w", err)
-		}
-		delete(object, "PostToolUse")
-	}
-
-	if raw, found := object["PreToolUse"]; found {
-		err = json.Unmarshal(raw, &a.PreToolUse)
-		if err != nil {
-			return fmt.Errorf("error reading 'PreToolUse': %w", err)
-		}
-		delete(object, "PreToolUse")
-	}
-
-	if raw, found := object["SessionStart"]; found {
-		err = json.Unmarshal(raw, &a.SessionStart)
-		if err != nil {
-			return fmt.Errorf("error reading 'SessionStart': %w", err)
-		}
-		delete(object, "SessionStart")
-	}
-
-	if raw, found := object["Stop"]; found {
-		err = json.Unmarshal(raw, &a.Stop)
+	if raw, found := object["currentValue"]; found {
+		err = json.Unmarshal(raw, &a.CurrentValue)
 		if err != nil {
-			return fmt.Errorf("error reading 'Stop': %w", err)
+			return fmt.Errorf("error reading 'currentValue': %w", err)
 		}
-		delete(object, "Stop")
+		delete(object, "currentValue")
 	}
 
-	if raw, found := object["SubagentStop"]; found {
-		err = json.Unmarshal(raw, &a.SubagentStop)
+	if raw, found := object["definition"]; found {
+		err = json.Unmarshal(raw, &a.Definition)
 		if err != nil {
-			return fmt.Errorf("error reading 'SubagentStop': %w", err)
+			return fmt.Errorf("error reading 'definition': %w", err)
 		}
-		delete(object, "SubagentStop")
+		delete(object, "definition")
 	}
 
-	if raw, found := object["UserPromptSubmit"]; found {
-		err = json.Unmarshal(raw, &a.UserPromptSubmit)
+	if raw, found := object["isDocumented"]; found {
+		err = json.Unmarshal(raw, &a.IsDocumented)
 		if err != nil {
-			return fmt.Errorf("error reading 'UserPromptSubmit': %w", err)
+			return fmt.Errorf("error reading 'isDocumented': %w", err)
 		}
-		delete(object, "UserPromptSubmit")
+		delete(object, "isDocumented")
 	}
 
 	if len(object) != 0 {
@@ -3275,58 +5066,24 @@ func (a *HooksByEvent) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for HooksByEvent to handle AdditionalProperties
-func (a HooksByEvent) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for Feature to handle AdditionalProperties
+func (a Feature) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Notification != nil {
-		object["Notification"], err = json.Marshal(a.Notification)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'Notification': %w", err)
-		}
+	object["currentValue"], err = json.Marshal(a.CurrentValue)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'currentValue': %w", err)
 	}
 
-	if a.PostToolUse != nil {
-		object["PostToolUse"], err = json.Marshal(a.PostToolUse)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'PostToolUse': %w", err)
-		}
-	}
-
-	if a.PreToolUse != nil {
-		object["PreToolUse"], err = json.Marshal(a.PreToolUse)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'PreToolUse': %w", err)
-		}
-	}
-
-	if a.SessionStart != nil {
-		object["SessionStart"], err = json.Marshal(a.SessionStart)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'SessionStart': %w", err)
-		}
-	}
-
-	if a.Stop != nil {
-		object["Stop"], err = json.Marshal(a.Stop)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'Stop': %w", err)
-		}
-	}
-
-	if a.SubagentStop != nil {
-		object["SubagentStop"], err = json.Marshal(a.SubagentStop)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'SubagentStop': %w", err)
-		}
+	object["definition"], err = json.Marshal(a.Definition)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'definition': %w", err)
 	}
 
-	if a.UserPromptSubmit != nil {
-		object["UserPromptSubmit"], err = json.Marshal(a.UserPromptSubmit)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'UserPromptSubmit': %w", err)
-		}
+	object["isDocumented"], err = json.Marshal(a.IsDocumented)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'isDocumented': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3338,45 +5095,77 @@ func (a HooksByEvent) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for HooksResponse. Returns the specified
+// Getter for additional properties for FeatureDefinition. Returns the specified
 // element and whether it was found
-func (a HooksResponse) Get(fieldName string) (value interface{}, found bool) {
+func (a FeatureDefinition) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for HooksResponse
-func (a *HooksResponse) Set(fieldName string, value interface{}) {
+// Setter for additional properties for FeatureDefinition
+func (a *FeatureDefinition) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for HooksResponse to handle AdditionalProperties
-func (a *HooksResponse) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for FeatureDefinition to handle AdditionalProperties
+func (a *FeatureDefinition) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["global"]; found {
-		err = json.Unmarshal(raw, &a.Global)
+	if raw, found := object["category"]; found {
+		err = json.Unmarshal(raw, &a.Category)
 		if err != nil {
-			return fmt.Errorf("error reading 'global': %w", err)
+			return fmt.Errorf("error reading 'category': %w", err)
 		}
-		delete(object, "global")
+		delete(object, "category")
 	}
 
-	if raw, found := object["project"]; found {
-		err = json.Unmarshal(raw, &a.Project)
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
 		if err != nil {
-			return fmt.Errorf("error reading 'project': %w", err)
+			return fmt.Errorf("error reading 'description': %w", err)
 		}
-		delete(object, "project")
+		delete(object, "description")
+	}
+
+	if raw, found := object["key"]; found {
+		err = json.Unmarshal(raw, &a.Key)
+		if err != nil {
+			return fmt.Errorf("error reading 'key': %w", err)
+		}
+		delete(object, "key")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if raw, found := object["values"]; found {
+		err = json.Unmarshal(raw, &a.Values)
+		if err != nil {
+			return fmt.Errorf("error reading 'values': %w", err)
+		}
+		delete(object, "values")
 	}
 
 	if len(object) != 0 {
@@ -3393,22 +5182,40 @@ func (a *HooksResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for HooksResponse to handle AdditionalProperties
-func (a HooksResponse) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for FeatureDefinition to handle AdditionalProperties
+func (a FeatureDefinition) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Global != nil {
-		object["global"], err = json.Marshal(a.Global)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'global': %w", err)
-		}
+	object["category"], err = json.Marshal(a.Category)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'category': %w", err)
 	}
 
-	if a.Project != nil {
-		object["project"], err = json.Marshal(a.Project)
+	object["description"], err = json.Marshal(a.Description)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	}
+
+	object["key"], err = json.Marshal(a.Key)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'key': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	if a.Values != nil {
+		object["values"], err = json.Marshal(a.Values)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'project': %w", err)
+			return nil, fmt.Errorf("error marshaling 'values': %w", err)
 		}
 	}
 
@@ -3421,53 +5228,69 @@ func (a HooksResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for InstructionsFile. Returns the specified
+// Getter for additional properties for FeaturesData. Returns the specified
 // element and whether it was found
-func (a InstructionsFile) Get(fieldName string) (value interface{}, found bool) {
+func (a FeaturesData) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for InstructionsFile
-func (a *InstructionsFile) Set(fieldName string, value interface{}) {
+// Setter for additional properties for FeaturesData
+func (a *FeaturesData) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for InstructionsFile to handle AdditionalProperties
-func (a *InstructionsFile) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for FeaturesData to handle AdditionalProperties
+func (a *FeaturesData) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["content"]; found {
-		err = json.Unmarshal(raw, &a.Content)
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
 		if err != nil {
-			return fmt.Errorf("error reading 'content': %w", err)
+			return fmt.Errorf("error reading 'etag': %w", err)
 		}
-		delete(object, "content")
+		delete(object, "etag")
 	}
 
-	if raw, found := object["exists"]; found {
-		err = json.Unmarshal(raw, &a.Exists)
+	if raw, found := object["features"]; found {
+		err = json.Unmarshal(raw, &a.Features)
 		if err != nil {
-			return fmt.Errorf("error reading 'exists': %w", err)
+			return fmt.Errorf("error reading 'features': %w", err)
 		}
-		delete(object, "exists")
+		delete(object, "features")
 	}
 
-	if raw, found := object["filePath"]; found {
-		err = json.Unmarshal(raw, &a.FilePath)
+	if raw, found := object["totalDiscovered"]; found {
+		err = json.Unmarshal(raw, &a.TotalDiscovered)
 		if err != nil {
-			return fmt.Errorf("error reading 'filePath': %w", err)
+			return fmt.Errorf("error reading 'totalDiscovered': %w", err)
 		}
-		delete(object, "filePath")
+		delete(object, "totalDiscovered")
+	}
+
+	if raw, found := object["totalDocumented"]; found {
+		err = json.Unmarshal(raw, &a.TotalDocumented)
+		if err != nil {
+			return fmt.Errorf("error reading 'totalDocumented': %w", err)
+		}
+		delete(object, "totalDocumented")
+	}
+
+	if raw, found := object["version"]; found {
+		err = json.Unmarshal(raw, &a.Version)
+		if err != nil {
+			return fmt.Errorf("error reading 'version': %w", err)
+		}
+		delete(object, "version")
 	}
 
 	if len(object) != 0 {
@@ -3484,26 +5307,40 @@ func (a *InstructionsFile) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for InstructionsFile to handle AdditionalProperties
-func (a InstructionsFile) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for FeaturesData to handle AdditionalProperties
+func (a FeaturesData) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Content != nil {
-		object["content"], err = json.Marshal(a.Content)
+	if a.Etag != nil {
+		object["etag"], err = json.Marshal(a.Etag)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'content': %w", err)
+			return nil, fmt.Errorf("error marshaling 'etag': %w", err)
 		}
 	}
 
-	object["exists"], err = json.Marshal(a.Exists)
+	if a.Features != nil {
+		object["features"], err = json.Marshal(a.Features)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'features': %w", err)
+		}
+	}
+
+	object["totalDiscovered"], err = json.Marshal(a.TotalDiscovered)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
+		return nil, fmt.Errorf("error marshaling 'totalDiscovered': %w", err)
 	}
 
-	object["filePath"], err = json.Marshal(a.FilePath)
+	object["totalDocumented"], err = json.Marshal(a.TotalDocumented)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+		return nil, fmt.Errorf("error marshaling 'totalDocumented': %w", err)
+	}
+
+	if a.Version != nil {
+		object["version"], err = json.Marshal(a.Version)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'version': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3515,45 +5352,37 @@ func (a InstructionsFile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for InstructionsResponse. Returns the specified
+// Getter for additional properties for HealthResponse. Returns the specified
 // element and whether it was found
-func (a InstructionsResponse) Get(fieldName string) (value interface{}, found bool) {
+func (a HealthResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for InstructionsResponse
-func (a *InstructionsResponse) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HealthResponse
+func (a *HealthResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for InstructionsResponse to handle AdditionalProperties
-func (a *InstructionsResponse) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HealthResponse to handle AdditionalProperties
+func (a *HealthResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["local"]; found {
-		err = json.Unmarshal(raw, &a.Local)
-		if err != nil {
-			return fmt.Errorf("error reading 'local': %w", err)
-		}
-		delete(object, "local")
-	}
-
-	if raw, found := object["main"]; found {
-		err = json.Unmarshal(raw, &a.Main)
+	if raw, found := object["status"]; found {
+		err = json.Unmarshal(raw, &a.Status)
 		if err != nil {
-			return fmt.Errorf("error reading 'main': %w", err)
+			return fmt.Errorf("error reading 'status': %w", err)
 		}
-		delete(object, "main")
+		delete(object, "status")
 	}
 
 	if len(object) != 0 {
@@ -3570,19 +5399,14 @@ func (a *InstructionsResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for InstructionsResponse to handle AdditionalProperties
-func (a InstructionsResponse) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HealthResponse to handle AdditionalProperties
+func (a HealthResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["local"], err = json.Marshal(a.Local)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'local': %w", err)
-	}
-
-	object["main"], err = json.Marshal(a.Main)
+	object["status"], err = json.Marshal(a.Status)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'main': %w", err)
+		return nil, fmt.Errorf("error marshaling 'status': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3594,37 +5418,61 @@ func (a InstructionsResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for LoginRequest. Returns the specified
+// Getter for additional properties for HookCommand. Returns the specified
 // element and whether it was found
-func (a LoginRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a HookCommand) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for LoginRequest
-func (a *LoginRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookCommand
+func (a *HookCommand) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for LoginRequest to handle AdditionalProperties
-func (a *LoginRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookCommand to handle AdditionalProperties
+func (a *HookCommand) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["password"]; found {
-		err = json.Unmarshal(raw, &a.Password)
+	if raw, found := object["command"]; found {
+		err = json.Unmarshal(raw, &a.Command)
 		if err != nil {
-			return fmt.Errorf("error reading 'password': %w", err)
+			return fmt.Errorf("error reading 'command': %w", err)
 		}
-		delete(object, "password")
+		delete(object, "command")
+	}
+
+	if raw, found := object["prompt"]; found {
+		err = json.Unmarshal(raw, &a.Prompt)
+		if err != nil {
+			return fmt.Errorf("error reading 'prompt': %w", err)
+		}
+		delete(object, "prompt")
+	}
+
+	if raw, found := object["timeout"]; found {
+		err = json.Unmarshal(raw, &a.Timeout)
+		if err != nil {
+			return fmt.Errorf("error reading 'timeout': %w", err)
+		}
+		delete(object, "timeout")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
 	}
 
 	if len(object) != 0 {
@@ -3641,14 +5489,35 @@ func (a *LoginRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for LoginRequest to handle AdditionalProperties
-func (a LoginRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookCommand to handle AdditionalProperties
+func (a HookCommand) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["password"], err = json.Marshal(a.Password)
+	if a.Command != nil {
+		object["command"], err = json.Marshal(a.Command)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'command': %w", err)
+		}
+	}
+
+	if a.Prompt != nil {
+		object["prompt"], err = json.Marshal(a.Prompt)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'prompt': %w", err)
+		}
+	}
+
+	if a.Timeout != nil {
+		object["timeout"], err = json.Marshal(a.Timeout)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timeout': %w", err)
+		}
+	}
+
+	object["type"], err = json.Marshal(a.Type)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'password': %w", err)
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3660,53 +5529,53 @@ func (a LoginRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for MemoryDetail. Returns the specified
+// Getter for additional properties for HookDefinition. Returns the specified
 // element and whether it was found
-func (a MemoryDetail) Get(fieldName string) (value interface{}, found bool) {
+func (a HookDefinition) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for MemoryDetail
-func (a *MemoryDetail) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookDefinition
+func (a *HookDefinition) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for MemoryDetail to handle AdditionalProperties
-func (a *MemoryDetail) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookDefinition to handle AdditionalProperties
+func (a *HookDefinition) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["content"]; found {
-		err = json.Unmarshal(raw, &a.Content)
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &a.Hooks)
 		if err != nil {
-			return fmt.Errorf("error reading 'content': %w", err)
+			return fmt.Errorf("error reading 'hooks': %w", err)
 		}
-		delete(object, "content")
+		delete(object, "hooks")
 	}
 
-	if raw, found := object["filePath"]; found {
-		err = json.Unmarshal(raw, &a.FilePath)
+	if raw, found := object["id"]; found {
+		err = json.Unmarshal(raw, &a.Id)
 		if err != nil {
-			return fmt.Errorf("error reading 'filePath': %w", err)
+			return fmt.Errorf("error reading 'id': %w", err)
 		}
-		delete(object, "filePath")
+		delete(object, "id")
 	}
 
-	if raw, found := object["filename"]; found {
-		err = json.Unmarshal(raw, &a.Filename)
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
 		if err != nil {
-			return fmt.Errorf("error reading 'filename': %w", err)
+			return fmt.Errorf("error reading 'matcher': %w", err)
 		}
-		delete(object, "filename")
+		delete(object, "matcher")
 	}
 
 	if len(object) != 0 {
@@ -3723,24 +5592,28 @@ func (a *MemoryDetail) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for MemoryDetail to handle AdditionalProperties
-func (a MemoryDetail) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookDefinition to handle AdditionalProperties
+func (a HookDefinition) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["content"], err = json.Marshal(a.Content)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'content': %w", err)
+	if a.Hooks != nil {
+		object["hooks"], err = json.Marshal(a.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
 	}
 
-	object["filePath"], err = json.Marshal(a.FilePath)
+	object["id"], err = json.Marshal(a.Id)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+		return nil, fmt.Errorf("error marshaling 'id': %w", err)
 	}
 
-	object["filename"], err = json.Marshal(a.Filename)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filename': %w", err)
+	if a.Matcher != nil {
+		object["matcher"], err = json.Marshal(a.Matcher)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3752,53 +5625,69 @@ func (a MemoryDetail) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for MemoryFile. Returns the specified
+// Getter for additional properties for HookEventInfo. Returns the specified
 // element and whether it was found
-func (a MemoryFile) Get(fieldName string) (value interface{}, found bool) {
+func (a HookEventInfo) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for MemoryFile
-func (a *MemoryFile) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookEventInfo
+func (a *HookEventInfo) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for MemoryFile to handle AdditionalProperties
-func (a *MemoryFile) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookEventInfo to handle AdditionalProperties
+func (a *HookEventInfo) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["filePath"]; found {
-		err = json.Unmarshal(raw, &a.FilePath)
+	if raw, found := object["acceptsMatcher"]; found {
+		err = json.Unmarshal(raw, &a.AcceptsMatcher)
 		if err != nil {
-			return fmt.Errorf("error reading 'filePath': %w", err)
+			return fmt.Errorf("error reading 'acceptsMatcher': %w", err)
 		}
-		delete(object, "filePath")
+		delete(object, "acceptsMatcher")
 	}
 
-	if raw, found := object["filename"]; found {
-		err = json.Unmarshal(raw, &a.Filename)
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
 		if err != nil {
-			return fmt.Errorf("error reading 'filename': %w", err)
+			return fmt.Errorf("error reading 'description': %w", err)
 		}
-		delete(object, "filename")
+		delete(object, "description")
 	}
 
-	if raw, found := object["preview"]; found {
-		err = json.Unmarshal(raw, &a.Preview)
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
 		if err != nil {
-			return fmt.Errorf("error reading 'preview': %w", err)
+			return fmt.Errorf("error reading 'matcher': %w", err)
 		}
-		delete(object, "preview")
+		delete(object, "matcher")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["payload"]; found {
+		err = json.Unmarshal(raw, &a.Payload)
+		if err != nil {
+			return fmt.Errorf("error reading 'payload': %w", err)
+		}
+		delete(object, "payload")
 	}
 
 	if len(object) != 0 {
@@ -3815,24 +5704,36 @@ func (a *MemoryFile) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for MemoryFile to handle AdditionalProperties
-func (a MemoryFile) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookEventInfo to handle AdditionalProperties
+func (a HookEventInfo) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["filePath"], err = json.Marshal(a.FilePath)
+	object["acceptsMatcher"], err = json.Marshal(a.AcceptsMatcher)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+		return nil, fmt.Errorf("error marshaling 'acceptsMatcher': %w", err)
 	}
 
-	object["filename"], err = json.Marshal(a.Filename)
+	object["description"], err = json.Marshal(a.Description)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filename': %w", err)
+		return nil, fmt.Errorf("error marshaling 'description': %w", err)
 	}
 
-	object["preview"], err = json.Marshal(a.Preview)
+	if a.Matcher != nil {
+		object["matcher"], err = json.Marshal(a.Matcher)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
+		}
+	}
+
+	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'preview': %w", err)
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	object["payload"], err = json.Marshal(a.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'payload': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -3844,53 +5745,77 @@ func (a MemoryFile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for MoveConfigSettingRequest. Returns the specified
+// Getter for additional properties for HookMatcherPreview. Returns the specified
 // element and whether it was found
-func (a MoveConfigSettingRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a HookMatcherPreview) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for MoveConfigSettingRequest
-func (a *MoveConfigSettingRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookMatcherPreview
+func (a *HookMatcherPreview) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for MoveConfigSettingRequest to handle AdditionalProperties
-func (a *MoveConfigSettingRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookMatcherPreview to handle AdditionalProperties
+func (a *HookMatcherPreview) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["direction"]; found {
-		err = json.Unmarshal(raw, &a.Direction)
+	if raw, found := object["matched"]; found {
+		err = json.Unmarshal(raw, &a.Matched)
 		if err != nil {
-			return fmt.Errorf("error reading 'direction': %w", err)
+			return fmt.Errorf("error reading 'matched': %w", err)
 		}
-		delete(object, "direction")
+		delete(object, "matched")
 	}
 
-	if raw, found := object["keyPath"]; found {
-		err = json.Unmarshal(raw, &a.KeyPath)
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
 		if err != nil {
-			return fmt.Errorf("error reading 'keyPath': %w", err)
+			return fmt.Errorf("error reading 'matcher': %w", err)
 		}
-		delete(object, "keyPath")
+		delete(object, "matcher")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["matchesAll"]; found {
+		err = json.Unmarshal(raw, &a.MatchesAll)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'matchesAll': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "matchesAll")
+	}
+
+	if raw, found := object["mcpServers"]; found {
+		err = json.Unmarshal(raw, &a.McpServers)
+		if err != nil {
+			return fmt.Errorf("error reading 'mcpServers': %w", err)
+		}
+		delete(object, "mcpServers")
+	}
+
+	if raw, found := object["tools"]; found {
+		err = json.Unmarshal(raw, &a.Tools)
+		if err != nil {
+			return fmt.Errorf("error reading 'tools': %w", err)
+		}
+		delete(object, "tools")
+	}
+
+	if raw, found := object["warnings"]; found {
+		err = json.Unmarshal(raw, &a.Warnings)
+		if err != nil {
+			return fmt.Errorf("error reading 'warnings': %w", err)
+		}
+		delete(object, "warnings")
 	}
 
 	if len(object) != 0 {
@@ -3907,27 +5832,46 @@ func (a *MoveConfigSettingRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for MoveConfigSettingRequest to handle AdditionalProperties
-func (a MoveConfigSettingRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookMatcherPreview to handle AdditionalProperties
+func (a HookMatcherPreview) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["direction"], err = json.Marshal(a.Direction)
+	if a.Matched != nil {
+		object["matched"], err = json.Marshal(a.Matched)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'matched': %w", err)
+		}
+	}
+
+	object["matcher"], err = json.Marshal(a.Matcher)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'direction': %w", err)
+		return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
 	}
 
-	if a.KeyPath != nil {
-		object["keyPath"], err = json.Marshal(a.KeyPath)
+	object["matchesAll"], err = json.Marshal(a.MatchesAll)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'matchesAll': %w", err)
+	}
+
+	if a.McpServers != nil {
+		object["mcpServers"], err = json.Marshal(a.McpServers)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'keyPath': %w", err)
+			return nil, fmt.Errorf("error marshaling 'mcpServers': %w", err)
 		}
 	}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
+	if a.Tools != nil {
+		object["tools"], err = json.Marshal(a.Tools)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+			return nil, fmt.Errorf("error marshaling 'tools': %w", err)
+		}
+	}
+
+	if a.Warnings != nil {
+		object["warnings"], err = json.Marshal(a.Warnings)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'warnings': %w", err)
 		}
 	}
 
@@ -3940,53 +5884,53 @@ func (a MoveConfigSettingRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for PluginFile. Returns the specified
+// Getter for additional properties for HookMatcherPreviewRequest. Returns the specified
 // element and whether it was found
-func (a PluginFile) Get(fieldName string) (value interface{}, found bool) {
+func (a HookMatcherPreviewRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for PluginFile
-func (a *PluginFile) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookMatcherPreviewRequest
+func (a *HookMatcherPreviewRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for PluginFile to handle AdditionalProperties
-func (a *PluginFile) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookMatcherPreviewRequest to handle AdditionalProperties
+func (a *HookMatcherPreviewRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["isUserOwned"]; found {
-		err = json.Unmarshal(raw, &a.IsUserOwned)
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
 		if err != nil {
-			return fmt.Errorf("error reading 'isUserOwned': %w", err)
+			return fmt.Errorf("error reading 'matcher': %w", err)
 		}
-		delete(object, "isUserOwned")
+		delete(object, "matcher")
 	}
 
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["probeMcp"]; found {
+		err = json.Unmarshal(raw, &a.ProbeMcp)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'probeMcp': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "probeMcp")
 	}
 
-	if raw, found := object["path"]; found {
-		err = json.Unmarshal(raw, &a.Path)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'path': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "path")
+		delete(object, "projectId")
 	}
 
 	if len(object) != 0 {
@@ -4003,24 +5947,28 @@ func (a *PluginFile) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for PluginFile to handle AdditionalProperties
-func (a PluginFile) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookMatcherPreviewRequest to handle AdditionalProperties
+func (a HookMatcherPreviewRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["isUserOwned"], err = json.Marshal(a.IsUserOwned)
+	object["matcher"], err = json.Marshal(a.Matcher)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'isUserOwned': %w", err)
+		return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
 	}
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	if a.ProbeMcp != nil {
+		object["probeMcp"], err = json.Marshal(a.ProbeMcp)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'probeMcp': %w", err)
+		}
 	}
 
-	object["path"], err = json.Marshal(a.Path)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4032,31 +5980,39 @@ func (a PluginFile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ProjectFile. Returns the specified
+// Getter for additional properties for HookMatcherServer. Returns the specified
 // element and whether it was found
-func (a ProjectFile) Get(fieldName string) (value interface{}, found bool) {
+func (a HookMatcherServer) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for ProjectFile
-func (a *ProjectFile) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookMatcherServer
+func (a *HookMatcherServer) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ProjectFile to handle AdditionalProperties
-func (a *ProjectFile) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookMatcherServer to handle AdditionalProperties
+func (a *HookMatcherServer) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
+
 	if raw, found := object["name"]; found {
 		err = json.Unmarshal(raw, &a.Name)
 		if err != nil {
@@ -4065,12 +6021,20 @@ func (a *ProjectFile) UnmarshalJSON(b []byte) error {
 		delete(object, "name")
 	}
 
-	if raw, found := object["path"]; found {
-		err = json.Unmarshal(raw, &a.Path)
+	if raw, found := object["probed"]; found {
+		err = json.Unmarshal(raw, &a.Probed)
 		if err != nil {
-			return fmt.Errorf("error reading 'path': %w", err)
+			return fmt.Errorf("error reading 'probed': %w", err)
 		}
-		delete(object, "path")
+		delete(object, "probed")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
 	}
 
 	if len(object) != 0 {
@@ -4087,19 +6051,31 @@ func (a *ProjectFile) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ProjectFile to handle AdditionalProperties
-func (a ProjectFile) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookMatcherServer to handle AdditionalProperties
+func (a HookMatcherServer) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
+	}
+
 	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
 		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	object["path"], err = json.Marshal(a.Path)
+	object["probed"], err = json.Marshal(a.Probed)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+		return nil, fmt.Errorf("error marshaling 'probed': %w", err)
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4111,53 +6087,53 @@ func (a ProjectFile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for ScanProjectResult. Returns the specified
+// Getter for additional properties for HookMatcherTool. Returns the specified
 // element and whether it was found
-func (a ScanProjectResult) Get(fieldName string) (value interface{}, found bool) {
+func (a HookMatcherTool) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for ScanProjectResult
-func (a *ScanProjectResult) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookMatcherTool
+func (a *HookMatcherTool) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for ScanProjectResult to handle AdditionalProperties
-func (a *ScanProjectResult) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookMatcherTool to handle AdditionalProperties
+func (a *HookMatcherTool) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["matched"]; found {
+		err = json.Unmarshal(raw, &a.Matched)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'matched': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "matched")
 	}
 
-	if raw, found := object["path"]; found {
-		err = json.Unmarshal(raw, &a.Path)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
 		if err != nil {
-			return fmt.Errorf("error reading 'path': %w", err)
+			return fmt.Errorf("error reading 'name': %w", err)
 		}
-		delete(object, "path")
+		delete(object, "name")
 	}
 
-	if raw, found := object["registered"]; found {
-		err = json.Unmarshal(raw, &a.Registered)
+	if raw, found := object["server"]; found {
+		err = json.Unmarshal(raw, &a.Server)
 		if err != nil {
-			return fmt.Errorf("error reading 'registered': %w", err)
+			return fmt.Errorf("error reading 'server': %w", err)
 		}
-		delete(object, "registered")
+		delete(object, "server")
 	}
 
 	if len(object) != 0 {
@@ -4174,24 +6150,26 @@ func (a *ScanProjectResult) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for ScanProjectResult to handle AdditionalProperties
-func (a ScanProjectResult) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookMatcherTool to handle AdditionalProperties
+func (a HookMatcherTool) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["name"], err = json.Marshal(a.Name)
+	object["matched"], err = json.Marshal(a.Matched)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		return nil, fmt.Errorf("error marshaling 'matched': %w", err)
 	}
 
-	object["path"], err = json.Marshal(a.Path)
+	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	object["registered"], err = json.Marshal(a.Registered)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'registered': %w", err)
+	if a.Server != nil {
+		object["server"], err = json.Marshal(a.Server)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'server': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4203,69 +6181,53 @@ func (a ScanProjectResult) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for SearchResult. Returns the specified
+// Getter for additional properties for HookScope. Returns the specified
 // element and whether it was found
-func (a SearchResult) Get(fieldName string) (value interface{}, found bool) {
+func (a HookScope) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for SearchResult
-func (a *SearchResult) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookScope
+func (a *HookScope) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for SearchResult to handle AdditionalProperties
-func (a *SearchResult) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookScope to handle AdditionalProperties
+func (a *HookScope) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
-		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
-		}
-		delete(object, "description")
-	}
-
-	if raw, found := object["filePath"]; found {
-		err = json.Unmarshal(raw, &a.FilePath)
-		if err != nil {
-			return fmt.Errorf("error reading 'filePath': %w", err)
-		}
-		delete(object, "filePath")
-	}
-
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'etag': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "etag")
 	}
 
-	if raw, found := object["preview"]; found {
-		err = json.Unmarshal(raw, &a.Preview)
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &a.Hooks)
 		if err != nil {
-			return fmt.Errorf("error reading 'preview': %w", err)
+			return fmt.Errorf("error reading 'hooks': %w", err)
 		}
-		delete(object, "preview")
+		delete(object, "hooks")
 	}
 
-	if raw, found := object["type"]; found {
-		err = json.Unmarshal(raw, &a.Type)
+	if raw, found := object["unknownEvents"]; found {
+		err = json.Unmarshal(raw, &a.UnknownEvents)
 		if err != nil {
-			return fmt.Errorf("error reading 'type': %w", err)
+			return fmt.Errorf("error reading 'unknownEvents': %w", err)
 		}
-		delete(object, "type")
+		delete(object, "unknownEvents")
 	}
 
 	if len(object) != 0 {
@@ -4282,36 +6244,30 @@ func (a *SearchResult) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for SearchResult to handle AdditionalProperties
-func (a SearchResult) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookScope to handle AdditionalProperties
+func (a HookScope) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Description != nil {
-		object["description"], err = json.Marshal(a.Description)
+	if a.Etag != nil {
+		object["etag"], err = json.Marshal(a.Etag)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+			return nil, fmt.Errorf("error marshaling 'etag': %w", err)
 		}
 	}
 
-	object["filePath"], err = json.Marshal(a.FilePath)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
-	}
-
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
-	}
-
-	object["preview"], err = json.Marshal(a.Preview)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'preview': %w", err)
+	if a.Hooks != nil {
+		object["hooks"], err = json.Marshal(a.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
 	}
 
-	object["type"], err = json.Marshal(a.Type)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	if a.UnknownEvents != nil {
+		object["unknownEvents"], err = json.Marshal(a.UnknownEvents)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'unknownEvents': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4323,45 +6279,45 @@ func (a SearchResult) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for SkillDetail. Returns the specified
+// Getter for additional properties for HookSource. Returns the specified
 // element and whether it was found
-func (a SkillDetail) Get(fieldName string) (value interface{}, found bool) {
+func (a HookSource) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for SkillDetail
-func (a *SkillDetail) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookSource
+func (a *HookSource) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for SkillDetail to handle AdditionalProperties
-func (a *SkillDetail) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookSource to handle AdditionalProperties
+func (a *HookSource) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["body"]; found {
-		err = json.Unmarshal(raw, &a.Body)
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
 		if err != nil {
-			return fmt.Errorf("error reading 'body': %w", err)
+			return fmt.Errorf("error reading 'error': %w", err)
 		}
-		delete(object, "body")
+		delete(object, "error")
 	}
 
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
 		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
+			return fmt.Errorf("error reading 'etag': %w", err)
 		}
-		delete(object, "description")
+		delete(object, "etag")
 	}
 
 	if raw, found := object["filePath"]; found {
@@ -4372,28 +6328,44 @@ func (a *SkillDetail) UnmarshalJSON(b []byte) error {
 		delete(object, "filePath")
 	}
 
-	if raw, found := object["folderName"]; found {
-		err = json.Unmarshal(raw, &a.FolderName)
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &a.Hooks)
 		if err != nil {
-			return fmt.Errorf("error reading 'folderName': %w", err)
+			return fmt.Errorf("error reading 'hooks': %w", err)
 		}
-		delete(object, "folderName")
+		delete(object, "hooks")
 	}
 
-	if raw, found := object["isEditable"]; found {
-		err = json.Unmarshal(raw, &a.IsEditable)
+	if raw, found := object["plugin"]; found {
+		err = json.Unmarshal(raw, &a.Plugin)
 		if err != nil {
-			return fmt.Errorf("error reading 'isEditable': %w", err)
+			return fmt.Errorf("error reading 'plugin': %w", err)
 		}
-		delete(object, "isEditable")
+		delete(object, "plugin")
 	}
 
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["readOnly"]; found {
+		err = json.Unmarshal(raw, &a.ReadOnly)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'readOnly': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "readOnly")
+	}
+
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
+
+	if raw, found := object["unknownEvents"]; found {
+		err = json.Unmarshal(raw, &a.UnknownEvents)
+		if err != nil {
+			return fmt.Errorf("error reading 'unknownEvents': %w", err)
+		}
+		delete(object, "unknownEvents")
 	}
 
 	if len(object) != 0 {
@@ -4410,19 +6382,23 @@ func (a *SkillDetail) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for SkillDetail to handle AdditionalProperties
-func (a SkillDetail) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookSource to handle AdditionalProperties
+func (a HookSource) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["body"], err = json.Marshal(a.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
 	}
 
-	object["description"], err = json.Marshal(a.Description)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	if a.Etag != nil {
+		object["etag"], err = json.Marshal(a.Etag)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'etag': %w", err)
+		}
 	}
 
 	object["filePath"], err = json.Marshal(a.FilePath)
@@ -4430,19 +6406,35 @@ func (a SkillDetail) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	object["folderName"], err = json.Marshal(a.FolderName)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'folderName': %w", err)
+	if a.Hooks != nil {
+		object["hooks"], err = json.Marshal(a.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
 	}
 
-	object["isEditable"], err = json.Marshal(a.IsEditable)
+	if a.Plugin != nil {
+		object["plugin"], err = json.Marshal(a.Plugin)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'plugin': %w", err)
+		}
+	}
+
+	object["readOnly"], err = json.Marshal(a.ReadOnly)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'isEditable': %w", err)
+		return nil, fmt.Errorf("error marshaling 'readOnly': %w", err)
 	}
 
-	object["name"], err = json.Marshal(a.Name)
+	object["source"], err = json.Marshal(a.Source)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
+
+	if a.UnknownEvents != nil {
+		object["unknownEvents"], err = json.Marshal(a.UnknownEvents)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'unknownEvents': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4454,77 +6446,93 @@ func (a SkillDetail) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for SkillFile. Returns the specified
+// Getter for additional properties for HookTestRequest. Returns the specified
 // element and whether it was found
-func (a SkillFile) Get(fieldName string) (value interface{}, found bool) {
+func (a HookTestRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for SkillFile
-func (a *SkillFile) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookTestRequest
+func (a *HookTestRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for SkillFile to handle AdditionalProperties
-func (a *SkillFile) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookTestRequest to handle AdditionalProperties
+func (a *HookTestRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["bodyPreview"]; found {
-		err = json.Unmarshal(raw, &a.BodyPreview)
+	if raw, found := object["event"]; found {
+		err = json.Unmarshal(raw, &a.Event)
 		if err != nil {
-			return fmt.Errorf("error reading 'bodyPreview': %w", err)
+			return fmt.Errorf("error reading 'event': %w", err)
 		}
-		delete(object, "bodyPreview")
+		delete(object, "event")
 	}
 
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
+	if raw, found := object["hook"]; found {
+		err = json.Unmarshal(raw, &a.Hook)
 		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
+			return fmt.Errorf("error reading 'hook': %w", err)
 		}
-		delete(object, "description")
+		delete(object, "hook")
 	}
 
-	if raw, found := object["filePath"]; found {
-		err = json.Unmarshal(raw, &a.FilePath)
+	if raw, found := object["hookId"]; found {
+		err = json.Unmarshal(raw, &a.HookId)
 		if err != nil {
-			return fmt.Errorf("error reading 'filePath': %w", err)
+			return fmt.Errorf("error reading 'hookId': %w", err)
 		}
-		delete(object, "filePath")
+		delete(object, "hookId")
 	}
 
-	if raw, found := object["folderName"]; found {
-		err = json.Unmarshal(raw, &a.FolderName)
+	if raw, found := object["hookIndex"]; found {
+		err = json.Unmarshal(raw, &a.HookIndex)
 		if err != nil {
-			return fmt.Errorf("error reading 'folderName': %w", err)
+			return fmt.Errorf("error reading 'hookIndex': %w", err)
 		}
-		delete(object, "folderName")
+		delete(object, "hookIndex")
 	}
 
-	if raw, found := object["isEditable"]; found {
-		err = json.Unmarshal(raw, &a.IsEditable)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return fmt.Errorf("error reading 'isEditable': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
 		}
-		delete(object, "isEditable")
+		delete(object, "projectId")
 	}
 
-	if raw, found := object["name"]; found {
-		err = json.Unmarshal(raw, &a.Name)
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
 		if err != nil {
-			return fmt.Errorf("error reading 'name': %w", err)
+			return fmt.Errorf("error reading 'scope': %w", err)
 		}
-		delete(object, "name")
+		delete(object, "scope")
+	}
+
+	if raw, found := object["toolInput"]; found {
+		err = json.Unmarshal(raw, &a.ToolInput)
+		if err != nil {
+			return fmt.Errorf("error reading 'toolInput': %w", err)
+		}
+		delete(object, "toolInput")
+	}
+
+	if raw, found := object["toolName"]; found {
+		err = json.Unmarshal(raw, &a.ToolName)
+		if err != nil {
+			return fmt.Errorf("error reading 'toolName': %w", err)
+		}
+		delete(object, "toolName")
 	}
 
 	if len(object) != 0 {
@@ -4541,39 +6549,65 @@ func (a *SkillFile) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for SkillFile to handle AdditionalProperties
-func (a SkillFile) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookTestRequest to handle AdditionalProperties
+func (a HookTestRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["bodyPreview"], err = json.Marshal(a.BodyPreview)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'bodyPreview': %w", err)
+	if a.Event != nil {
+		object["event"], err = json.Marshal(a.Event)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'event': %w", err)
+		}
 	}
 
-	object["description"], err = json.Marshal(a.Description)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	if a.Hook != nil {
+		object["hook"], err = json.Marshal(a.Hook)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hook': %w", err)
+		}
 	}
 
-	object["filePath"], err = json.Marshal(a.FilePath)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	if a.HookId != nil {
+		object["hookId"], err = json.Marshal(a.HookId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hookId': %w", err)
+		}
 	}
 
-	object["folderName"], err = json.Marshal(a.FolderName)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'folderName': %w", err)
+	if a.HookIndex != nil {
+		object["hookIndex"], err = json.Marshal(a.HookIndex)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hookIndex': %w", err)
+		}
 	}
 
-	object["isEditable"], err = json.Marshal(a.IsEditable)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'isEditable': %w", err)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
 	}
 
-	object["name"], err = json.Marshal(a.Name)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	if a.Scope != nil {
+		object["scope"], err = json.Marshal(a.Scope)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+		}
+	}
+
+	if a.ToolInput != nil {
+		object["toolInput"], err = json.Marshal(a.ToolInput)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'toolInput': %w", err)
+		}
+	}
+
+	if a.ToolName != nil {
+		object["toolName"], err = json.Marshal(a.ToolName)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'toolName': %w", err)
+		}
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4585,37 +6619,101 @@ func (a SkillFile) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for SuccessResponse. Returns the specified
+// Getter for additional properties for HookTestResult. Returns the specified
 // element and whether it was found
-func (a SuccessResponse) Get(fieldName string) (value interface{}, found bool) {
+func (a HookTestResult) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for SuccessResponse
-func (a *SuccessResponse) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HookTestResult
+func (a *HookTestResult) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for SuccessResponse to handle AdditionalProperties
-func (a *SuccessResponse) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HookTestResult to handle AdditionalProperties
+func (a *HookTestResult) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["success"]; found {
-		err = json.Unmarshal(raw, &a.Success)
+	if raw, found := object["decision"]; found {
+		err = json.Unmarshal(raw, &a.Decision)
 		if err != nil {
-			return fmt.Errorf("error reading 'success': %w", err)
+			return fmt.Errorf("error reading 'decision': %w", err)
 		}
-		delete(object, "success")
+		delete(object, "decision")
+	}
+
+	if raw, found := object["decisionError"]; found {
+		err = json.Unmarshal(raw, &a.DecisionError)
+		if err != nil {
+			return fmt.Errorf("error reading 'decisionError': %w", err)
+		}
+		delete(object, "decisionError")
+	}
+
+	if raw, found := object["durationMs"]; found {
+		err = json.Unmarshal(raw, &a.DurationMs)
+		if err != nil {
+			return fmt.Errorf("error reading 'durationMs': %w", err)
+		}
+		delete(object, "durationMs")
+	}
+
+	if raw, found := object["exitCode"]; found {
+		err = json.Unmarshal(raw, &a.ExitCode)
+		if err != nil {
+			return fmt.Errorf("error reading 'exitCode': %w", err)
+		}
+		delete(object, "exitCode")
+	}
+
+	if raw, found := object["input"]; found {
+		err = json.Unmarshal(raw, &a.Input)
+		if err != nil {
+			return fmt.Errorf("error reading 'input': %w", err)
+		}
+		delete(object, "input")
+	}
+
+	if raw, found := object["outcome"]; found {
+		err = json.Unmarshal(raw, &a.Outcome)
+		if err != nil {
+			return fmt.Errorf("error reading 'outcome': %w", err)
+		}
+		delete(object, "outcome")
+	}
+
+	if raw, found := object["stderr"]; found {
+		err = json.Unmarshal(raw, &a.Stderr)
+		if err != nil {
+			return fmt.Errorf("error reading 'stderr': %w", err)
+		}
+		delete(object, "stderr")
+	}
+
+	if raw, found := object["stdout"]; found {
+		err = json.Unmarshal(raw, &a.Stdout)
+		if err != nil {
+			return fmt.Errorf("error reading 'stdout': %w", err)
+		}
+		delete(object, "stdout")
+	}
+
+	if raw, found := object["timedOut"]; found {
+		err = json.Unmarshal(raw, &a.TimedOut)
+		if err != nil {
+			return fmt.Errorf("error reading 'timedOut': %w", err)
+		}
+		delete(object, "timedOut")
 	}
 
 	if len(object) != 0 {
@@ -4632,14 +6730,58 @@ func (a *SuccessResponse) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for SuccessResponse to handle AdditionalProperties
-func (a SuccessResponse) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HookTestResult to handle AdditionalProperties
+func (a HookTestResult) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["success"], err = json.Marshal(a.Success)
+	if a.Decision != nil {
+		object["decision"], err = json.Marshal(a.Decision)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'decision': %w", err)
+		}
+	}
+
+	if a.DecisionError != nil {
+		object["decisionError"], err = json.Marshal(a.DecisionError)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'decisionError': %w", err)
+		}
+	}
+
+	object["durationMs"], err = json.Marshal(a.DurationMs)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'success': %w", err)
+		return nil, fmt.Errorf("error marshaling 'durationMs': %w", err)
+	}
+
+	object["exitCode"], err = json.Marshal(a.ExitCode)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exitCode': %w", err)
+	}
+
+	object["input"], err = json.Marshal(a.Input)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'input': %w", err)
+	}
+
+	object["outcome"], err = json.Marshal(a.Outcome)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'outcome': %w", err)
+	}
+
+	object["stderr"], err = json.Marshal(a.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'stderr': %w", err)
+	}
+
+	object["stdout"], err = json.Marshal(a.Stdout)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'stdout': %w", err)
+	}
+
+	object["timedOut"], err = json.Marshal(a.TimedOut)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'timedOut': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4651,77 +6793,77 @@ func (a SuccessResponse) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateAgentRequest. Returns the specified
+// Getter for additional properties for HooksResponse. Returns the specified
 // element and whether it was found
-func (a UpdateAgentRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a HooksResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateAgentRequest
-func (a *UpdateAgentRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for HooksResponse
+func (a *HooksResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateAgentRequest to handle AdditionalProperties
-func (a *UpdateAgentRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for HooksResponse to handle AdditionalProperties
+func (a *HooksResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["body"]; found {
-		err = json.Unmarshal(raw, &a.Body)
+	if raw, found := object["disableAllHooks"]; found {
+		err = json.Unmarshal(raw, &a.DisableAllHooks)
 		if err != nil {
-			return fmt.Errorf("error reading 'body': %w", err)
+			return fmt.Errorf("error reading 'disableAllHooks': %w", err)
 		}
-		delete(object, "body")
+		delete(object, "disableAllHooks")
 	}
 
-	if raw, found := object["color"]; found {
-		err = json.Unmarshal(raw, &a.Color)
+	if raw, found := object["global"]; found {
+		err = json.Unmarshal(raw, &a.Global)
 		if err != nil {
-			return fmt.Errorf("error reading 'color': %w", err)
+			return fmt.Errorf("error reading 'global': %w", err)
 		}
-		delete(object, "color")
+		delete(object, "global")
 	}
 
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
+	if raw, found := object["layers"]; found {
+		err = json.Unmarshal(raw, &a.Layers)
 		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
+			return fmt.Errorf("error reading 'layers': %w", err)
 		}
-		delete(object, "description")
+		delete(object, "layers")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["managedHooksOnly"]; found {
+		err = json.Unmarshal(raw, &a.ManagedHooksOnly)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'managedHooksOnly': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "managedHooksOnly")
 	}
 
-	if raw, found := object["scope"]; found {
-		err = json.Unmarshal(raw, &a.Scope)
+	if raw, found := object["merged"]; found {
+		err = json.Unmarshal(raw, &a.Merged)
 		if err != nil {
-			return fmt.Errorf("error reading 'scope': %w", err)
+			return fmt.Errorf("error reading 'merged': %w", err)
 		}
-		delete(object, "scope")
+		delete(object, "merged")
 	}
 
-	if raw, found := object["tools"]; found {
-		err = json.Unmarshal(raw, &a.Tools)
+	if raw, found := object["project"]; found {
+		err = json.Unmarshal(raw, &a.Project)
 		if err != nil {
-			return fmt.Errorf("error reading 'tools': %w", err)
+			return fmt.Errorf("error reading 'project': %w", err)
 		}
-		delete(object, "tools")
+		delete(object, "project")
 	}
 
 	if len(object) != 0 {
@@ -4738,46 +6880,44 @@ func (a *UpdateAgentRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateAgentRequest to handle AdditionalProperties
-func (a UpdateAgentRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for HooksResponse to handle AdditionalProperties
+func (a HooksResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["body"], err = json.Marshal(a.Body)
+	object["disableAllHooks"], err = json.Marshal(a.DisableAllHooks)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+		return nil, fmt.Errorf("error marshaling 'disableAllHooks': %w", err)
 	}
 
-	if a.Color != nil {
-		object["color"], err = json.Marshal(a.Color)
+	if a.Global != nil {
+		object["global"], err = json.Marshal(a.Global)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'color': %w", err)
+			return nil, fmt.Errorf("error marshaling 'global': %w", err)
 		}
 	}
 
-	if a.Description != nil {
-		object["description"], err = json.Marshal(a.Description)
+	if a.Layers != nil {
+		object["layers"], err = json.Marshal(a.Layers)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
 		}
 	}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
-		}
+	object["managedHooksOnly"], err = json.Marshal(a.ManagedHooksOnly)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'managedHooksOnly': %w", err)
 	}
 
-	object["scope"], err = json.Marshal(a.Scope)
+	object["merged"], err = json.Marshal(a.Merged)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+		return nil, fmt.Errorf("error marshaling 'merged': %w", err)
 	}
 
-	if a.Tools != nil {
-		object["tools"], err = json.Marshal(a.Tools)
+	if a.Project != nil {
+		object["project"], err = json.Marshal(a.Project)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'tools': %w", err)
+			return nil, fmt.Errorf("error marshaling 'project': %w", err)
 		}
 	}
 
@@ -4790,45 +6930,61 @@ func (a UpdateAgentRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateCommandRequest. Returns the specified
+// Getter for additional properties for InstructionsFile. Returns the specified
 // element and whether it was found
-func (a UpdateCommandRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a InstructionsFile) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateCommandRequest
-func (a *UpdateCommandRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for InstructionsFile
+func (a *InstructionsFile) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateCommandRequest to handle AdditionalProperties
-func (a *UpdateCommandRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for InstructionsFile to handle AdditionalProperties
+func (a *InstructionsFile) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["body"]; found {
-		err = json.Unmarshal(raw, &a.Body)
+	if raw, found := object["content"]; found {
+		err = json.Unmarshal(raw, &a.Content)
 		if err != nil {
-			return fmt.Errorf("error reading 'body': %w", err)
+			return fmt.Errorf("error reading 'content': %w", err)
 		}
-		delete(object, "body")
+		delete(object, "content")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'etag': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "etag")
+	}
+
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
+		if err != nil {
+			return fmt.Errorf("error reading 'exists': %w", err)
+		}
+		delete(object, "exists")
+	}
+
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
 	}
 
 	if len(object) != 0 {
@@ -4845,23 +7001,35 @@ func (a *UpdateCommandRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateCommandRequest to handle AdditionalProperties
-func (a UpdateCommandRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for InstructionsFile to handle AdditionalProperties
+func (a InstructionsFile) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["body"], err = json.Marshal(a.Body)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	if a.Content != nil {
+		object["content"], err = json.Marshal(a.Content)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'content': %w", err)
+		}
 	}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
+	if a.Etag != nil {
+		object["etag"], err = json.Marshal(a.Etag)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+			return nil, fmt.Errorf("error marshaling 'etag': %w", err)
 		}
 	}
 
+	object["exists"], err = json.Marshal(a.Exists)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
+	}
+
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -4871,53 +7039,45 @@ func (a UpdateCommandRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateConfigSettingRequest. Returns the specified
+// Getter for additional properties for InstructionsResponse. Returns the specified
 // element and whether it was found
-func (a UpdateConfigSettingRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a InstructionsResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateConfigSettingRequest
-func (a *UpdateConfigSettingRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for InstructionsResponse
+func (a *InstructionsResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateConfigSettingRequest to handle AdditionalProperties
-func (a *UpdateConfigSettingRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for InstructionsResponse to handle AdditionalProperties
+func (a *InstructionsResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["keyPath"]; found {
-		err = json.Unmarshal(raw, &a.KeyPath)
-		if err != nil {
-			return fmt.Errorf("error reading 'keyPath': %w", err)
-		}
-		delete(object, "keyPath")
-	}
-
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["local"]; found {
+		err = json.Unmarshal(raw, &a.Local)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'local': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "local")
 	}
 
-	if raw, found := object["value"]; found {
-		err = json.Unmarshal(raw, &a.Value)
+	if raw, found := object["main"]; found {
+		err = json.Unmarshal(raw, &a.Main)
 		if err != nil {
-			return fmt.Errorf("error reading 'value': %w", err)
+			return fmt.Errorf("error reading 'main': %w", err)
 		}
-		delete(object, "value")
+		delete(object, "main")
 	}
 
 	if len(object) != 0 {
@@ -4934,28 +7094,19 @@ func (a *UpdateConfigSettingRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateConfigSettingRequest to handle AdditionalProperties
-func (a UpdateConfigSettingRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for InstructionsResponse to handle AdditionalProperties
+func (a InstructionsResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.KeyPath != nil {
-		object["keyPath"], err = json.Marshal(a.KeyPath)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'keyPath': %w", err)
-		}
-	}
-
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
-		}
+	object["local"], err = json.Marshal(a.Local)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'local': %w", err)
 	}
 
-	object["value"], err = json.Marshal(a.Value)
+	object["main"], err = json.Marshal(a.Main)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'value': %w", err)
+		return nil, fmt.Errorf("error marshaling 'main': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -4967,45 +7118,61 @@ func (a UpdateConfigSettingRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateFeatureRequest. Returns the specified
+// Getter for additional properties for JSONParseError. Returns the specified
 // element and whether it was found
-func (a UpdateFeatureRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a JSONParseError) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateFeatureRequest
-func (a *UpdateFeatureRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for JSONParseError
+func (a *JSONParseError) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateFeatureRequest to handle AdditionalProperties
-func (a *UpdateFeatureRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for JSONParseError to handle AdditionalProperties
+func (a *JSONParseError) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["type"]; found {
-		err = json.Unmarshal(raw, &a.Type)
+	if raw, found := object["column"]; found {
+		err = json.Unmarshal(raw, &a.Column)
 		if err != nil {
-			return fmt.Errorf("error reading 'type': %w", err)
+			return fmt.Errorf("error reading 'column': %w", err)
 		}
-		delete(object, "type")
+		delete(object, "column")
 	}
 
-	if raw, found := object["value"]; found {
-		err = json.Unmarshal(raw, &a.Value)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
 		if err != nil {
-			return fmt.Errorf("error reading 'value': %w", err)
+			return fmt.Errorf("error reading 'filePath': %w", err)
 		}
-		delete(object, "value")
+		delete(object, "filePath")
+	}
+
+	if raw, found := object["line"]; found {
+		err = json.Unmarshal(raw, &a.Line)
+		if err != nil {
+			return fmt.Errorf("error reading 'line': %w", err)
+		}
+		delete(object, "line")
+	}
+
+	if raw, found := object["message"]; found {
+		err = json.Unmarshal(raw, &a.Message)
+		if err != nil {
+			return fmt.Errorf("error reading 'message': %w", err)
+		}
+		delete(object, "message")
 	}
 
 	if len(object) != 0 {
@@ -5022,19 +7189,29 @@ func (a *UpdateFeatureRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateFeatureRequest to handle AdditionalProperties
-func (a UpdateFeatureRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for JSONParseError to handle AdditionalProperties
+func (a JSONParseError) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["type"], err = json.Marshal(a.Type)
+	object["column"], err = json.Marshal(a.Column)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+		return nil, fmt.Errorf("error marshaling 'column': %w", err)
 	}
 
-	object["value"], err = json.Marshal(a.Value)
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'value': %w", err)
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	object["line"], err = json.Marshal(a.Line)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'line': %w", err)
+	}
+
+	object["message"], err = json.Marshal(a.Message)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'message': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -5046,69 +7223,69 @@ func (a UpdateFeatureRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateHookRequest. Returns the specified
+// Getter for additional properties for KeyProvenance. Returns the specified
 // element and whether it was found
-func (a UpdateHookRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a KeyProvenance) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateHookRequest
-func (a *UpdateHookRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for KeyProvenance
+func (a *KeyProvenance) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateHookRequest to handle AdditionalProperties
-func (a *UpdateHookRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for KeyProvenance to handle AdditionalProperties
+func (a *KeyProvenance) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["commands"]; found {
-		err = json.Unmarshal(raw, &a.Commands)
+	if raw, found := object["contributions"]; found {
+		err = json.Unmarshal(raw, &a.Contributions)
 		if err != nil {
-			return fmt.Errorf("error reading 'commands': %w", err)
+			return fmt.Errorf("error reading 'contributions': %w", err)
 		}
-		delete(object, "commands")
+		delete(object, "contributions")
 	}
 
-	if raw, found := object["event"]; found {
-		err = json.Unmarshal(raw, &a.Event)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
 		if err != nil {
-			return fmt.Errorf("error reading 'event': %w", err)
+			return fmt.Errorf("error reading 'filePath': %w", err)
 		}
-		delete(object, "event")
+		delete(object, "filePath")
 	}
 
-	if raw, found := object["matcher"]; found {
-		err = json.Unmarshal(raw, &a.Matcher)
+	if raw, found := object["shadowed"]; found {
+		err = json.Unmarshal(raw, &a.Shadowed)
 		if err != nil {
-			return fmt.Errorf("error reading 'matcher': %w", err)
+			return fmt.Errorf("error reading 'shadowed': %w", err)
 		}
-		delete(object, "matcher")
+		delete(object, "shadowed")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'source': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "source")
 	}
 
-	if raw, found := object["scope"]; found {
-		err = json.Unmarshal(raw, &a.Scope)
+	if raw, found := object["value"]; found {
+		err = json.Unmarshal(raw, &a.Value)
 		if err != nil {
-			return fmt.Errorf("error reading 'scope': %w", err)
+			return fmt.Errorf("error reading 'value': %w", err)
 		}
-		delete(object, "scope")
+		delete(object, "value")
 	}
 
 	if len(object) != 0 {
@@ -5125,40 +7302,38 @@ func (a *UpdateHookRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateHookRequest to handle AdditionalProperties
-func (a UpdateHookRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for KeyProvenance to handle AdditionalProperties
+func (a KeyProvenance) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	if a.Commands != nil {
-		object["commands"], err = json.Marshal(a.Commands)
+	if a.Contributions != nil {
+		object["contributions"], err = json.Marshal(a.Contributions)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'commands': %w", err)
+			return nil, fmt.Errorf("error marshaling 'contributions': %w", err)
 		}
 	}
 
-	object["event"], err = json.Marshal(a.Event)
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'event': %w", err)
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	if a.Matcher != nil {
-		object["matcher"], err = json.Marshal(a.Matcher)
+	if a.Shadowed != nil {
+		object["shadowed"], err = json.Marshal(a.Shadowed)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
+			return nil, fmt.Errorf("error marshaling 'shadowed': %w", err)
 		}
 	}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
-		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
-		}
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
 	}
 
-	object["scope"], err = json.Marshal(a.Scope)
+	object["value"], err = json.Marshal(a.Value)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+		return nil, fmt.Errorf("error marshaling 'value': %w", err)
 	}
 
 	for fieldName, field := range a.AdditionalProperties {
@@ -5170,61 +7345,37 @@ func (a UpdateHookRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateInstructionsRequest. Returns the specified
+// Getter for additional properties for LoginRequest. Returns the specified
 // element and whether it was found
-func (a UpdateInstructionsRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a LoginRequest) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateInstructionsRequest
-func (a *UpdateInstructionsRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for LoginRequest
+func (a *LoginRequest) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateInstructionsRequest to handle AdditionalProperties
-func (a *UpdateInstructionsRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for LoginRequest to handle AdditionalProperties
+func (a *LoginRequest) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["content"]; found {
-		err = json.Unmarshal(raw, &a.Content)
-		if err != nil {
-			return fmt.Errorf("error reading 'content': %w", err)
-		}
-		delete(object, "content")
-	}
-
-	if raw, found := object["file"]; found {
-		err = json.Unmarshal(raw, &a.File)
-		if err != nil {
-			return fmt.Errorf("error reading 'file': %w", err)
-		}
-		delete(object, "file")
-	}
-
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
-		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
-		}
-		delete(object, "projectId")
-	}
-
-	if raw, found := object["scope"]; found {
-		err = json.Unmarshal(raw, &a.Scope)
+	if raw, found := object["password"]; found {
+		err = json.Unmarshal(raw, &a.Password)
 		if err != nil {
-			return fmt.Errorf("error reading 'scope': %w", err)
+			return fmt.Errorf("error reading 'password': %w", err)
 		}
-		delete(object, "scope")
+		delete(object, "password")
 	}
 
 	if len(object) != 0 {
@@ -5241,35 +7392,125 @@ func (a *UpdateInstructionsRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateInstructionsRequest to handle AdditionalProperties
-func (a UpdateInstructionsRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for LoginRequest to handle AdditionalProperties
+func (a LoginRequest) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["content"], err = json.Marshal(a.Content)
+	object["password"], err = json.Marshal(a.Password)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'content': %w", err)
+		return nil, fmt.Errorf("error marshaling 'password': %w", err)
 	}
 
-	object["file"], err = json.Marshal(a.File)
-	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'file': %w", err)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
+	return json.Marshal(object)
+}
 
-	if a.ProjectId != nil {
-		object["projectId"], err = json.Marshal(a.ProjectId)
+// Getter for additional properties for McpApproval. Returns the specified
+// element and whether it was found
+func (a McpApproval) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for McpApproval
+func (a *McpApproval) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for McpApproval to handle AdditionalProperties
+func (a *McpApproval) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+			return fmt.Errorf("error reading 'filePath': %w", err)
 		}
+		delete(object, "filePath")
 	}
 
-	if a.Scope != nil {
-		object["scope"], err = json.Marshal(a.Scope)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
+
+	if raw, found := object["state"]; found {
+		err = json.Unmarshal(raw, &a.State)
+		if err != nil {
+			return fmt.Errorf("error reading 'state': %w", err)
+		}
+		delete(object, "state")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for McpApproval to handle AdditionalProperties
+func (a McpApproval) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.FilePath != nil {
+		object["filePath"], err = json.Marshal(a.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+		}
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	if a.Source != nil {
+		object["source"], err = json.Marshal(a.Source)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'source': %w", err)
 		}
 	}
 
+	object["state"], err = json.Marshal(a.State)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'state': %w", err)
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -5279,37 +7520,53 @@ func (a UpdateInstructionsRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateMemoryRequest. Returns the specified
+// Getter for additional properties for McpApprovalProjectChange. Returns the specified
 // element and whether it was found
-func (a UpdateMemoryRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a McpApprovalProjectChange) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateMemoryRequest
-func (a *UpdateMemoryRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for McpApprovalProjectChange
+func (a *McpApprovalProjectChange) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateMemoryRequest to handle AdditionalProperties
-func (a *UpdateMemoryRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for McpApprovalProjectChange to handle AdditionalProperties
+func (a *McpApprovalProjectChange) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["content"]; found {
-		err = json.Unmarshal(raw, &a.Content)
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
 		if err != nil {
-			return fmt.Errorf("error reading 'content': %w", err)
+			return fmt.Errorf("error reading 'error': %w", err)
 		}
-		delete(object, "content")
+		delete(object, "error")
+	}
+
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
+
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
 	}
 
 	if raw, found := object["projectId"]; found {
@@ -5320,6 +7577,14 @@ func (a *UpdateMemoryRequest) UnmarshalJSON(b []byte) error {
 		delete(object, "projectId")
 	}
 
+	if raw, found := object["servers"]; found {
+		err = json.Unmarshal(raw, &a.Servers)
+		if err != nil {
+			return fmt.Errorf("error reading 'servers': %w", err)
+		}
+		delete(object, "servers")
+	}
+
 	if len(object) != 0 {
 		a.AdditionalProperties = make(map[string]interface{})
 		for fieldName, fieldBuf := range object {
@@ -5334,14 +7599,28 @@ func (a *UpdateMemoryRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateMemoryRequest to handle AdditionalProperties
-func (a UpdateMemoryRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for McpApprovalProjectChange to handle AdditionalProperties
+func (a McpApprovalProjectChange) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["content"], err = json.Marshal(a.Content)
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
+	}
+
+	if a.FilePath != nil {
+		object["filePath"], err = json.Marshal(a.FilePath)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+		}
+	}
+
+	object["path"], err = json.Marshal(a.Path)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'content': %w", err)
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
 	}
 
 	object["projectId"], err = json.Marshal(a.ProjectId)
@@ -5349,6 +7628,13 @@ func (a UpdateMemoryRequest) MarshalJSON() ([]byte, error) {
 		return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
 	}
 
+	if a.Servers != nil {
+		object["servers"], err = json.Marshal(a.Servers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'servers': %w", err)
+		}
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -5358,53 +7644,53 @@ func (a UpdateMemoryRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// Getter for additional properties for UpdateSkillRequest. Returns the specified
+// Getter for additional properties for McpApprovalsResponse. Returns the specified
 // element and whether it was found
-func (a UpdateSkillRequest) Get(fieldName string) (value interface{}, found bool) {
+func (a McpApprovalsResponse) Get(fieldName string) (value interface{}, found bool) {
 	if a.AdditionalProperties != nil {
 		value, found = a.AdditionalProperties[fieldName]
 	}
 	return
 }
 
-// Setter for additional properties for UpdateSkillRequest
-func (a *UpdateSkillRequest) Set(fieldName string, value interface{}) {
+// Setter for additional properties for McpApprovalsResponse
+func (a *McpApprovalsResponse) Set(fieldName string, value interface{}) {
 	if a.AdditionalProperties == nil {
 		a.AdditionalProperties = make(map[string]interface{})
 	}
 	a.AdditionalProperties[fieldName] = value
 }
 
-// Override default JSON handling for UpdateSkillRequest to handle AdditionalProperties
-func (a *UpdateSkillRequest) UnmarshalJSON(b []byte) error {
+// Override default JSON handling for McpApprovalsResponse to handle AdditionalProperties
+func (a *McpApprovalsResponse) UnmarshalJSON(b []byte) error {
 	object := make(map[string]json.RawMessage)
 	err := json.Unmarshal(b, &object)
 	if err != nil {
 		return err
 	}
 
-	if raw, found := object["body"]; found {
-		err = json.Unmarshal(raw, &a.Body)
+	if raw, found := object["enableAll"]; found {
+		err = json.Unmarshal(raw, &a.EnableAll)
 		if err != nil {
-			return fmt.Errorf("error reading 'body': %w", err)
+			return fmt.Errorf("error reading 'enableAll': %w", err)
 		}
-		delete(object, "body")
+		delete(object, "enableAll")
 	}
 
-	if raw, found := object["description"]; found {
-		err = json.Unmarshal(raw, &a.Description)
+	if raw, found := object["layers"]; found {
+		err = json.Unmarshal(raw, &a.Layers)
 		if err != nil {
-			return fmt.Errorf("error reading 'description': %w", err)
+			return fmt.Errorf("error reading 'layers': %w", err)
 		}
-		delete(object, "description")
+		delete(object, "layers")
 	}
 
-	if raw, found := object["projectId"]; found {
-		err = json.Unmarshal(raw, &a.ProjectId)
+	if raw, found := object["servers"]; found {
+		err = json.Unmarshal(raw, &a.Servers)
 		if err != nil {
-			return fmt.Errorf("error reading 'projectId': %w", err)
+			return fmt.Errorf("error reading 'servers': %w", err)
 		}
-		delete(object, "projectId")
+		delete(object, "servers")
 	}
 
 	if len(object) != 0 {
@@ -5421,22 +7707,108 @@ func (a *UpdateSkillRequest) UnmarshalJSON(b []byte) error {
 	return nil
 }
 
-// Override default JSON handling for UpdateSkillRequest to handle AdditionalProperties
-func (a UpdateSkillRequest) MarshalJSON() ([]byte, error) {
+// Override default JSON handling for McpApprovalsResponse to handle AdditionalProperties
+func (a McpApprovalsResponse) MarshalJSON() ([]byte, error) {
 	var err error
 	object := make(map[string]json.RawMessage)
 
-	object["body"], err = json.Marshal(a.Body)
+	if a.EnableAll != nil {
+		object["enableAll"], err = json.Marshal(a.EnableAll)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'enableAll': %w", err)
+		}
+	}
+
+	if a.Layers != nil {
+		object["layers"], err = json.Marshal(a.Layers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
+		}
+	}
+
+	if a.Servers != nil {
+		object["servers"], err = json.Marshal(a.Servers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'servers': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for McpProbeRequest. Returns the specified
+// element and whether it was found
+func (a McpProbeRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for McpProbeRequest
+func (a *McpProbeRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for McpProbeRequest to handle AdditionalProperties
+func (a *McpProbeRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+		return err
 	}
 
-	if a.Description != nil {
-		object["description"], err = json.Marshal(a.Description)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
 		if err != nil {
-			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if raw, found := object["timeoutMs"]; found {
+		err = json.Unmarshal(raw, &a.TimeoutMs)
+		if err != nil {
+			return fmt.Errorf("error reading 'timeoutMs': %w", err)
+		}
+		delete(object, "timeoutMs")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
 		}
 	}
+	return nil
+}
+
+// Override default JSON handling for McpProbeRequest to handle AdditionalProperties
+func (a McpProbeRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
 	if a.ProjectId != nil {
 		object["projectId"], err = json.Marshal(a.ProjectId)
@@ -5445,6 +7817,18 @@ func (a UpdateSkillRequest) MarshalJSON() ([]byte, error) {
 		}
 	}
 
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
+	if a.TimeoutMs != nil {
+		object["timeoutMs"], err = json.Marshal(a.TimeoutMs)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timeoutMs': %w", err)
+		}
+	}
+
 	for fieldName, field := range a.AdditionalProperties {
 		object[fieldName], err = json.Marshal(field)
 		if err != nil {
@@ -5454,2459 +7838,11977 @@ func (a UpdateSkillRequest) MarshalJSON() ([]byte, error) {
 	return json.Marshal(object)
 }
 
-// ServerInterface represents all server handlers.
-type ServerInterface interface {
-	// List agents
-	// (GET /api/agents)
-	GetAgents(w http.ResponseWriter, r *http.Request, params GetAgentsParams)
-	// Create an agent
-	// (POST /api/agents)
-	CreateAgent(w http.ResponseWriter, r *http.Request)
-	// Delete an agent
-	// (DELETE /api/agents/{name})
-	DeleteAgent(w http.ResponseWriter, r *http.Request, name string)
-	// Get agent detail
-	// (GET /api/agents/{name})
-	GetAgent(w http.ResponseWriter, r *http.Request, name string, params GetAgentParams)
-	// Update an agent
-	// (PUT /api/agents/{name})
-	UpdateAgent(w http.ResponseWriter, r *http.Request, name string)
-	// Login with password
-	// (POST /api/auth/login)
-	Login(w http.ResponseWriter, r *http.Request)
-	// Logout
-	// (POST /api/auth/logout)
-	Logout(w http.ResponseWriter, r *http.Request)
-	// Set initial password (only allowed when no credentials exist)
-	// (POST /api/auth/setup)
-	SetupAuth(w http.ResponseWriter, r *http.Request)
-	// Get authentication status
-	// (GET /api/auth/status)
-	GetAuthStatus(w http.ResponseWriter, r *http.Request)
-	// List backups
-	// (GET /api/backups)
-	GetBackups(w http.ResponseWriter, r *http.Request)
-	// Restore a backup
-	// (POST /api/backups/{id}/restore)
-	RestoreBackup(w http.ResponseWriter, r *http.Request, id string)
-	// List commands
-	// (GET /api/commands)
-	GetCommands(w http.ResponseWriter, r *http.Request, params GetCommandsParams)
-	// Create a command
-	// (POST /api/commands)
-	CreateCommand(w http.ResponseWriter, r *http.Request)
-	// Delete a command
-	// (DELETE /api/commands/{scope}/{folder}/{name})
-	DeleteCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params DeleteCommandParams)
-	// Get command detail
-	// (GET /api/commands/{scope}/{folder}/{name})
-	GetCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params GetCommandParams)
-	// Update a command
-	// (PUT /api/commands/{scope}/{folder}/{name})
-	UpdateCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string)
-	// Get merged config with layers
-	// (GET /api/config)
-	GetConfig(w http.ResponseWriter, r *http.Request, params GetConfigParams)
-	// Delete a config setting
-	// (DELETE /api/config/setting)
-	DeleteConfigSetting(w http.ResponseWriter, r *http.Request)
-	// Update a config setting
-	// (POST /api/config/setting)
-	UpdateConfigSetting(w http.ResponseWriter, r *http.Request)
-	// Move a config setting up or down
-	// (POST /api/config/setting/move)
-	MoveConfigSetting(w http.ResponseWriter, r *http.Request)
-	// Get features data
-	// (GET /api/features)
-	GetFeatures(w http.ResponseWriter, r *http.Request)
-	// Delete (reset) a feature value
-	// (DELETE /api/features/{key})
-	DeleteFeature(w http.ResponseWriter, r *http.Request, key string)
-	// Update a feature value
-	// (PUT /api/features/{key})
-	UpdateFeature(w http.ResponseWriter, r *http.Request, key string)
-	// Health check
-	// (GET /api/health)
-	GetHealth(w http.ResponseWriter, r *http.Request)
-	// List hooks
-	// (GET /api/hooks)
-	GetHooks(w http.ResponseWriter, r *http.Request, params GetHooksParams)
-	// Create a hook
-	// (POST /api/hooks)
-	CreateHook(w http.ResponseWriter, r *http.Request)
-	// Delete a hook
-	// (DELETE /api/hooks/{id})
-	DeleteHook(w http.ResponseWriter, r *http.Request, id string, params DeleteHookParams)
-	// Update a hook
-	// (PUT /api/hooks/{id})
-	UpdateHook(w http.ResponseWriter, r *http.Request, id string)
-	// Get CLAUDE.md and CLAUDE.local.md content
-	// (GET /api/instructions)
-	GetInstructions(w http.ResponseWriter, r *http.Request, params GetInstructionsParams)
-	// Write CLAUDE.md or CLAUDE.local.md
-	// (PUT /api/instructions)
-	UpdateInstructions(w http.ResponseWriter, r *http.Request)
-	// List memory files for a project
-	// (GET /api/memory)
-	ListMemory(w http.ResponseWriter, r *http.Request, params ListMemoryParams)
-	// Create a new memory file
-	// (POST /api/memory)
-	CreateMemory(w http.ResponseWriter, r *http.Request)
-	// Delete a memory file
-	// (DELETE /api/memory/{filename})
-	DeleteMemory(w http.ResponseWriter, r *http.Request, filename string, params DeleteMemoryParams)
-	// Get a single memory file
-	// (GET /api/memory/{filename})
-	GetMemory(w http.ResponseWriter, r *http.Request, filename string, params GetMemoryParams)
-	// Update a memory file
-	// (PUT /api/memory/{filename})
-	UpdateMemory(w http.ResponseWriter, r *http.Request, filename string)
-	// List plugins
-	// (GET /api/plugins)
-	GetPlugins(w http.ResponseWriter, r *http.Request)
-	// List projects
-	// (GET /api/projects)
-	GetProjects(w http.ResponseWriter, r *http.Request)
-	// Register one or more projects by filesystem path
-	// (POST /api/projects)
-	PostProjects(w http.ResponseWriter, r *http.Request)
-	// Scan a folder for subdirectories containing .claude/
-	// (GET /api/projects/scan)
-	ScanProjects(w http.ResponseWriter, r *http.Request, params ScanProjectsParams)
-	// Remove a registered project
-	// (DELETE /api/projects/{projectId})
-	DeleteProject(w http.ResponseWriter, r *http.Request, projectId string)
-	// Search across all resources
-	// (GET /api/search)
-	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
-	// List skills
-	// (GET /api/skills)
-	GetSkills(w http.ResponseWriter, r *http.Request, params GetSkillsParams)
-	// Create a skill
-	// (POST /api/skills)
-	CreateSkill(w http.ResponseWriter, r *http.Request)
-	// Delete a skill
-	// (DELETE /api/skills/{scope}/{name})
-	DeleteSkill(w http.ResponseWriter, r *http.Request, scope string, name string, params DeleteSkillParams)
-	// Get skill detail
-	// (GET /api/skills/{scope}/{name})
-	GetSkill(w http.ResponseWriter, r *http.Request, scope string, name string, params GetSkillParams)
-	// Update a skill
-	// (PUT /api/skills/{scope}/{name})
-	UpdateSkill(w http.ResponseWriter, r *http.Request, scope string, name string)
-	// SSE file watcher stream
-	// (GET /api/watch)
-	Watch(w http.ResponseWriter, r *http.Request, params WatchParams)
+// Getter for additional properties for McpProbeResult. Returns the specified
+// element and whether it was found
+func (a McpProbeResult) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// ServerInterfaceWrapper converts contexts to parameters.
-type ServerInterfaceWrapper struct {
-	Handler            ServerInterface
-	HandlerMiddlewares []MiddlewareFunc
-	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+// Setter for additional properties for McpProbeResult
+func (a *McpProbeResult) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type MiddlewareFunc func(http.Handler) http.Handler
-
-// GetAgents operation middleware
-func (siw *ServerInterfaceWrapper) GetAgents(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAgentsParams
-
-	// ------------- Optional query parameter "scope" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+// Override default JSON handling for McpProbeResult to handle AdditionalProperties
+func (a *McpProbeResult) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+		return err
 	}
 
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAgents(w, r, params)
-	}))
+	if raw, found := object["ok"]; found {
+		err = json.Unmarshal(raw, &a.Ok)
+		if err != nil {
+			return fmt.Errorf("error reading 'ok': %w", err)
+		}
+		delete(object, "ok")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["protocolVersion"]; found {
+		err = json.Unmarshal(raw, &a.ProtocolVersion)
+		if err != nil {
+			return fmt.Errorf("error reading 'protocolVersion': %w", err)
+		}
+		delete(object, "protocolVersion")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["serverName"]; found {
+		err = json.Unmarshal(raw, &a.ServerName)
+		if err != nil {
+			return fmt.Errorf("error reading 'serverName': %w", err)
+		}
+		delete(object, "serverName")
+	}
 
-// CreateAgent operation middleware
-func (siw *ServerInterfaceWrapper) CreateAgent(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["serverVersion"]; found {
+		err = json.Unmarshal(raw, &a.ServerVersion)
+		if err != nil {
+			return fmt.Errorf("error reading 'serverVersion': %w", err)
+		}
+		delete(object, "serverVersion")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateAgent(w, r)
-	}))
+	if raw, found := object["stderr"]; found {
+		err = json.Unmarshal(raw, &a.Stderr)
+		if err != nil {
+			return fmt.Errorf("error reading 'stderr': %w", err)
+		}
+		delete(object, "stderr")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["tools"]; found {
+		err = json.Unmarshal(raw, &a.Tools)
+		if err != nil {
+			return fmt.Errorf("error reading 'tools': %w", err)
+		}
+		delete(object, "tools")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// DeleteAgent operation middleware
-func (siw *ServerInterfaceWrapper) DeleteAgent(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for McpProbeResult to handle AdditionalProperties
+func (a McpProbeResult) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["ok"], err = json.Marshal(a.Ok)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'ok': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteAgent(w, r, name)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if a.ProtocolVersion != nil {
+		object["protocolVersion"], err = json.Marshal(a.ProtocolVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'protocolVersion': %w", err)
+		}
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetAgent operation middleware
-func (siw *ServerInterfaceWrapper) GetAgent(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "name" -------------
-	var name string
+	if a.ServerName != nil {
+		object["serverName"], err = json.Marshal(a.ServerName)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'serverName': %w", err)
+		}
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+	if a.ServerVersion != nil {
+		object["serverVersion"], err = json.Marshal(a.ServerVersion)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'serverVersion': %w", err)
+		}
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetAgentParams
+	if a.Stderr != nil {
+		object["stderr"], err = json.Marshal(a.Stderr)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'stderr': %w", err)
+		}
+	}
 
-	// ------------- Optional query parameter "scope" -------------
+	if a.Tools != nil {
+		object["tools"], err = json.Marshal(a.Tools)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tools': %w", err)
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
+	return json.Marshal(object)
+}
 
-	// ------------- Optional query parameter "projectId" -------------
+// Getter for additional properties for McpServer. Returns the specified
+// element and whether it was found
+func (a McpServer) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+// Setter for additional properties for McpServer
+func (a *McpServer) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for McpServer to handle AdditionalProperties
+func (a *McpServer) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAgent(w, r, name, params)
-	}))
+	if raw, found := object["config"]; found {
+		err = json.Unmarshal(raw, &a.Config)
+		if err != nil {
+			return fmt.Errorf("error reading 'config': %w", err)
+		}
+		delete(object, "config")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-// UpdateAgent operation middleware
-func (siw *ServerInterfaceWrapper) UpdateAgent(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
+// Override default JSON handling for McpServer to handle AdditionalProperties
+func (a McpServer) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "name" -------------
-	var name string
+	object["config"], err = json.Marshal(a.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'config': %w", err)
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateAgent(w, r, name)
-	}))
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// Login operation middleware
-func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Login(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Getter for additional properties for McpServerConfig. Returns the specified
+// element and whether it was found
+func (a McpServerConfig) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
-
-	handler.ServeHTTP(w, r)
+	return
 }
 
-// Logout operation middleware
-func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Logout(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Setter for additional properties for McpServerConfig
+func (a *McpServerConfig) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
-
-	handler.ServeHTTP(w, r)
+	a.AdditionalProperties[fieldName] = value
 }
 
-// SetupAuth operation middleware
-func (siw *ServerInterfaceWrapper) SetupAuth(w http.ResponseWriter, r *http.Request) {
+// Override default JSON handling for McpServerConfig to handle AdditionalProperties
+func (a *McpServerConfig) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.SetupAuth(w, r)
-	}))
+	if raw, found := object["args"]; found {
+		err = json.Unmarshal(raw, &a.Args)
+		if err != nil {
+			return fmt.Errorf("error reading 'args': %w", err)
+		}
+		delete(object, "args")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["command"]; found {
+		err = json.Unmarshal(raw, &a.Command)
+		if err != nil {
+			return fmt.Errorf("error reading 'command': %w", err)
+		}
+		delete(object, "command")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["env"]; found {
+		err = json.Unmarshal(raw, &a.Env)
+		if err != nil {
+			return fmt.Errorf("error reading 'env': %w", err)
+		}
+		delete(object, "env")
+	}
 
-// GetAuthStatus operation middleware
-func (siw *ServerInterfaceWrapper) GetAuthStatus(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["headers"]; found {
+		err = json.Unmarshal(raw, &a.Headers)
+		if err != nil {
+			return fmt.Errorf("error reading 'headers': %w", err)
+		}
+		delete(object, "headers")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetAuthStatus(w, r)
-	}))
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["url"]; found {
+		err = json.Unmarshal(raw, &a.Url)
+		if err != nil {
+			return fmt.Errorf("error reading 'url': %w", err)
+		}
+		delete(object, "url")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// GetBackups operation middleware
-func (siw *ServerInterfaceWrapper) GetBackups(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetBackups(w, r)
-	}))
+// Override default JSON handling for McpServerConfig to handle AdditionalProperties
+func (a McpServerConfig) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if a.Args != nil {
+		object["args"], err = json.Marshal(a.Args)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'args': %w", err)
+		}
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// RestoreBackup operation middleware
-func (siw *ServerInterfaceWrapper) RestoreBackup(w http.ResponseWriter, r *http.Request) {
+	if a.Command != nil {
+		object["command"], err = json.Marshal(a.Command)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'command': %w", err)
+		}
+	}
 
-	var err error
+	if a.Env != nil {
+		object["env"], err = json.Marshal(a.Env)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'env': %w", err)
+		}
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	if a.Headers != nil {
+		object["headers"], err = json.Marshal(a.Headers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'headers': %w", err)
+		}
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["type"], err = json.Marshal(a.Type)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.RestoreBackup(w, r, id)
-	}))
+	if a.Url != nil {
+		object["url"], err = json.Marshal(a.Url)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'url': %w", err)
+		}
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
+	return json.Marshal(object)
+}
 
-	handler.ServeHTTP(w, r)
+// Getter for additional properties for McpServerFile. Returns the specified
+// element and whether it was found
+func (a McpServerFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-// GetCommands operation middleware
-func (siw *ServerInterfaceWrapper) GetCommands(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCommandsParams
-
-	// ------------- Optional query parameter "scope" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+// Setter for additional properties for McpServerFile
+func (a *McpServerFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+// Override default JSON handling for McpServerFile to handle AdditionalProperties
+func (a *McpServerFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommands(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// CreateCommand operation middleware
-func (siw *ServerInterfaceWrapper) CreateCommand(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
+		if err != nil {
+			return fmt.Errorf("error reading 'exists': %w", err)
+		}
+		delete(object, "exists")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateCommand(w, r)
-	}))
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// DeleteCommand operation middleware
-func (siw *ServerInterfaceWrapper) DeleteCommand(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for McpServerFile to handle AdditionalProperties
+func (a McpServerFile) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "scope" -------------
-	var scope string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["etag"], err = json.Marshal(a.Etag)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'etag': %w", err)
 	}
 
-	// ------------- Path parameter "folder" -------------
-	var folder string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "folder", r.PathValue("folder"), &folder, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["exists"], err = json.Marshal(a.Exists)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteCommandParams
-
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	object["scope"], err = json.Marshal(a.Scope)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteCommand(w, r, scope, folder, name, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
-
-	handler.ServeHTTP(w, r)
+	return json.Marshal(object)
 }
 
-// GetCommand operation middleware
-func (siw *ServerInterfaceWrapper) GetCommand(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "scope" -------------
-	var scope string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+// Getter for additional properties for McpServersResponse. Returns the specified
+// element and whether it was found
+func (a McpServersResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
+	return
+}
 
-	// ------------- Path parameter "folder" -------------
-	var folder string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "folder", r.PathValue("folder"), &folder, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
-		return
+// Setter for additional properties for McpServersResponse
+func (a *McpServersResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// Override default JSON handling for McpServersResponse to handle AdditionalProperties
+func (a *McpServersResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+		return err
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetCommandParams
-
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if raw, found := object["files"]; found {
+		err = json.Unmarshal(raw, &a.Files)
+		if err != nil {
+			return fmt.Errorf("error reading 'files': %w", err)
+		}
+		delete(object, "files")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetCommand(w, r, scope, folder, name, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["servers"]; found {
+		err = json.Unmarshal(raw, &a.Servers)
+		if err != nil {
+			return fmt.Errorf("error reading 'servers': %w", err)
+		}
+		delete(object, "servers")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// UpdateCommand operation middleware
-func (siw *ServerInterfaceWrapper) UpdateCommand(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for McpServersResponse to handle AdditionalProperties
+func (a McpServersResponse) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "scope" -------------
-	var scope string
+	if a.Files != nil {
+		object["files"], err = json.Marshal(a.Files)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'files': %w", err)
+		}
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+	if a.Servers != nil {
+		object["servers"], err = json.Marshal(a.Servers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'servers': %w", err)
+		}
 	}
 
-	// ------------- Path parameter "folder" -------------
-	var folder string
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "folder", r.PathValue("folder"), &folder, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
-		return
+// Getter for additional properties for McpTool. Returns the specified
+// element and whether it was found
+func (a McpTool) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
+	return
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+// Setter for additional properties for McpTool
+func (a *McpTool) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// Override default JSON handling for McpTool to handle AdditionalProperties
+func (a *McpTool) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+		return err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateCommand(w, r, scope, folder, name)
-	}))
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["inputSchema"]; found {
+		err = json.Unmarshal(raw, &a.InputSchema)
+		if err != nil {
+			return fmt.Errorf("error reading 'inputSchema': %w", err)
+		}
+		delete(object, "inputSchema")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-// GetConfig operation middleware
-func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
+// Override default JSON handling for McpTool to handle AdditionalProperties
+func (a McpTool) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetConfigParams
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
 
-	// ------------- Optional query parameter "projectId" -------------
+	if a.InputSchema != nil {
+		object["inputSchema"], err = json.Marshal(a.InputSchema)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'inputSchema': %w", err)
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	object["name"], err = json.Marshal(a.Name)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetConfig(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
-
-	handler.ServeHTTP(w, r)
+	return json.Marshal(object)
 }
 
-// DeleteConfigSetting operation middleware
-func (siw *ServerInterfaceWrapper) DeleteConfigSetting(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteConfigSetting(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Getter for additional properties for MemoryDetail. Returns the specified
+// element and whether it was found
+func (a MemoryDetail) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
-
-	handler.ServeHTTP(w, r)
+	return
 }
 
-// UpdateConfigSetting operation middleware
-func (siw *ServerInterfaceWrapper) UpdateConfigSetting(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateConfigSetting(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Setter for additional properties for MemoryDetail
+func (a *MemoryDetail) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
-
-	handler.ServeHTTP(w, r)
+	a.AdditionalProperties[fieldName] = value
 }
 
-// MoveConfigSetting operation middleware
-func (siw *ServerInterfaceWrapper) MoveConfigSetting(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.MoveConfigSetting(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Override default JSON handling for MemoryDetail to handle AdditionalProperties
+func (a *MemoryDetail) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// GetFeatures operation middleware
-func (siw *ServerInterfaceWrapper) GetFeatures(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["content"]; found {
+		err = json.Unmarshal(raw, &a.Content)
+		if err != nil {
+			return fmt.Errorf("error reading 'content': %w", err)
+		}
+		delete(object, "content")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetFeatures(w, r)
-	}))
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["filename"]; found {
+		err = json.Unmarshal(raw, &a.Filename)
+		if err != nil {
+			return fmt.Errorf("error reading 'filename': %w", err)
+		}
+		delete(object, "filename")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// DeleteFeature operation middleware
-func (siw *ServerInterfaceWrapper) DeleteFeature(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for MemoryDetail to handle AdditionalProperties
+func (a MemoryDetail) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "key" -------------
-	var key string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["content"], err = json.Marshal(a.Content)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'content': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteFeature(w, r, key)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateFeature operation middleware
-func (siw *ServerInterfaceWrapper) UpdateFeature(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "key" -------------
-	var key string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["filename"], err = json.Marshal(a.Filename)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filename': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateFeature(w, r, key)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
-
-	handler.ServeHTTP(w, r)
+	return json.Marshal(object)
 }
 
-// GetHealth operation middleware
-func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHealth(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Getter for additional properties for MemoryFile. Returns the specified
+// element and whether it was found
+func (a MemoryFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
-
-	handler.ServeHTTP(w, r)
+	return
 }
 
-// GetHooks operation middleware
-func (siw *ServerInterfaceWrapper) GetHooks(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetHooksParams
-
-	// ------------- Optional query parameter "projectId" -------------
+// Setter for additional properties for MemoryFile
+func (a *MemoryFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+// Override default JSON handling for MemoryFile to handle AdditionalProperties
+func (a *MemoryFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetHooks(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["filename"]; found {
+		err = json.Unmarshal(raw, &a.Filename)
+		if err != nil {
+			return fmt.Errorf("error reading 'filename': %w", err)
+		}
+		delete(object, "filename")
+	}
 
-// CreateHook operation middleware
-func (siw *ServerInterfaceWrapper) CreateHook(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateHook(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["preview"]; found {
+		err = json.Unmarshal(raw, &a.Preview)
+		if err != nil {
+			return fmt.Errorf("error reading 'preview': %w", err)
+		}
+		delete(object, "preview")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// DeleteHook operation middleware
-func (siw *ServerInterfaceWrapper) DeleteHook(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for MemoryFile to handle AdditionalProperties
+func (a MemoryFile) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "id" -------------
-	var id string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteHookParams
-
-	// ------------- Optional query parameter "scope" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	object["filename"], err = json.Marshal(a.Filename)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filename': %w", err)
 	}
 
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	object["preview"], err = json.Marshal(a.Preview)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'preview': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteHook(w, r, id, params)
-	}))
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Getter for additional properties for MergedHookEntry. Returns the specified
+// element and whether it was found
+func (a MergedHookEntry) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
+	return
+}
 
-	handler.ServeHTTP(w, r)
+// Setter for additional properties for MergedHookEntry
+func (a *MergedHookEntry) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-// UpdateHook operation middleware
-func (siw *ServerInterfaceWrapper) UpdateHook(w http.ResponseWriter, r *http.Request) {
+// Override default JSON handling for MergedHookEntry to handle AdditionalProperties
+func (a *MergedHookEntry) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	var err error
+	if raw, found := object["definitionId"]; found {
+		err = json.Unmarshal(raw, &a.DefinitionId)
+		if err != nil {
+			return fmt.Errorf("error reading 'definitionId': %w", err)
+		}
+		delete(object, "definitionId")
+	}
 
-	// ------------- Path parameter "id" -------------
-	var id string
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
-		return
+	if raw, found := object["hook"]; found {
+		err = json.Unmarshal(raw, &a.Hook)
+		if err != nil {
+			return fmt.Errorf("error reading 'hook': %w", err)
+		}
+		delete(object, "hook")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateHook(w, r, id)
-	}))
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
+		if err != nil {
+			return fmt.Errorf("error reading 'matcher': %w", err)
+		}
+		delete(object, "matcher")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["plugin"]; found {
+		err = json.Unmarshal(raw, &a.Plugin)
+		if err != nil {
+			return fmt.Errorf("error reading 'plugin': %w", err)
+		}
+		delete(object, "plugin")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
 
-// GetInstructions operation middleware
-func (siw *ServerInterfaceWrapper) GetInstructions(w http.ResponseWriter, r *http.Request) {
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
+// Override default JSON handling for MergedHookEntry to handle AdditionalProperties
+func (a MergedHookEntry) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetInstructionsParams
-
-	// ------------- Optional query parameter "scope" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	object["definitionId"], err = json.Marshal(a.DefinitionId)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'definitionId': %w", err)
 	}
 
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetInstructions(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["hook"], err = json.Marshal(a.Hook)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'hook': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// UpdateInstructions operation middleware
-func (siw *ServerInterfaceWrapper) UpdateInstructions(w http.ResponseWriter, r *http.Request) {
+	if a.Matcher != nil {
+		object["matcher"], err = json.Marshal(a.Matcher)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
+		}
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateInstructions(w, r)
-	}))
+	if a.Plugin != nil {
+		object["plugin"], err = json.Marshal(a.Plugin)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'plugin': %w", err)
+		}
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// ListMemory operation middleware
-func (siw *ServerInterfaceWrapper) ListMemory(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ListMemoryParams
-
-	// ------------- Required query parameter "projectId" -------------
-
-	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+// Getter for additional properties for MoveConfigSettingRequest. Returns the specified
+// element and whether it was found
+func (a MoveConfigSettingRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
-		return
+// Setter for additional properties for MoveConfigSettingRequest
+func (a *MoveConfigSettingRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
+// Override default JSON handling for MoveConfigSettingRequest to handle AdditionalProperties
+func (a *MoveConfigSettingRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return err
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ListMemory(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["copy"]; found {
+		err = json.Unmarshal(raw, &a.Copy)
+		if err != nil {
+			return fmt.Errorf("error reading 'copy': %w", err)
+		}
+		delete(object, "copy")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["direction"]; found {
+		err = json.Unmarshal(raw, &a.Direction)
+		if err != nil {
+			return fmt.Errorf("error reading 'direction': %w", err)
+		}
+		delete(object, "direction")
+	}
 
-// CreateMemory operation middleware
-func (siw *ServerInterfaceWrapper) CreateMemory(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["from"]; found {
+		err = json.Unmarshal(raw, &a.From)
+		if err != nil {
+			return fmt.Errorf("error reading 'from': %w", err)
+		}
+		delete(object, "from")
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateMemory(w, r)
-	}))
+	if raw, found := object["keyPath"]; found {
+		err = json.Unmarshal(raw, &a.KeyPath)
+		if err != nil {
+			return fmt.Errorf("error reading 'keyPath': %w", err)
+		}
+		delete(object, "keyPath")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["to"]; found {
+		err = json.Unmarshal(raw, &a.To)
+		if err != nil {
+			return fmt.Errorf("error reading 'to': %w", err)
+		}
+		delete(object, "to")
+	}
 
-// DeleteMemory operation middleware
-func (siw *ServerInterfaceWrapper) DeleteMemory(w http.ResponseWriter, r *http.Request) {
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
+// Override default JSON handling for MoveConfigSettingRequest to handle AdditionalProperties
+func (a MoveConfigSettingRequest) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "filename" -------------
-	var filename string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
-		return
+	if a.Copy != nil {
+		object["copy"], err = json.Marshal(a.Copy)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'copy': %w", err)
+		}
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteMemoryParams
-
-	// ------------- Required query parameter "projectId" -------------
-
-	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+	if a.Direction != nil {
+		object["direction"], err = json.Marshal(a.Direction)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'direction': %w", err)
+		}
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
-		return
+	if a.From != nil {
+		object["from"], err = json.Marshal(a.From)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'from': %w", err)
+		}
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if a.KeyPath != nil {
+		object["keyPath"], err = json.Marshal(a.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keyPath': %w", err)
+		}
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteMemory(w, r, filename, params)
-	}))
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if a.To != nil {
+		object["to"], err = json.Marshal(a.To)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'to': %w", err)
+		}
 	}
 
-	handler.ServeHTTP(w, r)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// GetMemory operation middleware
-func (siw *ServerInterfaceWrapper) GetMemory(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// Getter for additional properties for OutputStyleDetail. Returns the specified
+// element and whether it was found
+func (a OutputStyleDetail) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// ------------- Path parameter "filename" -------------
-	var filename string
+// Setter for additional properties for OutputStyleDetail
+func (a *OutputStyleDetail) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// Override default JSON handling for OutputStyleDetail to handle AdditionalProperties
+func (a *OutputStyleDetail) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
-		return
+		return err
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetMemoryParams
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
 
-	// ------------- Required query parameter "projectId" -------------
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
 
-	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+	if raw, found := object["fileName"]; found {
+		err = json.Unmarshal(raw, &a.FileName)
+		if err != nil {
+			return fmt.Errorf("error reading 'fileName': %w", err)
+		}
+		delete(object, "fileName")
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
-		return
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if raw, found := object["isEditable"]; found {
+		err = json.Unmarshal(raw, &a.IsEditable)
+		if err != nil {
+			return fmt.Errorf("error reading 'isEditable': %w", err)
+		}
+		delete(object, "isEditable")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetMemory(w, r, filename, params)
-	}))
+	if raw, found := object["keepCodingInstructions"]; found {
+		err = json.Unmarshal(raw, &a.KeepCodingInstructions)
+		if err != nil {
+			return fmt.Errorf("error reading 'keepCodingInstructions': %w", err)
+		}
+		delete(object, "keepCodingInstructions")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// UpdateMemory operation middleware
-func (siw *ServerInterfaceWrapper) UpdateMemory(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for OutputStyleDetail to handle AdditionalProperties
+func (a OutputStyleDetail) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "filename" -------------
-	var filename string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["body"], err = json.Marshal(a.Body)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateMemory(w, r, filename)
-	}))
+	object["description"], err = json.Marshal(a.Description)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["fileName"], err = json.Marshal(a.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'fileName': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-// GetPlugins operation middleware
-func (siw *ServerInterfaceWrapper) GetPlugins(w http.ResponseWriter, r *http.Request) {
+	object["isEditable"], err = json.Marshal(a.IsEditable)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'isEditable': %w", err)
+	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetPlugins(w, r)
-	}))
+	if a.KeepCodingInstructions != nil {
+		object["keepCodingInstructions"], err = json.Marshal(a.KeepCodingInstructions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keepCodingInstructions': %w", err)
+		}
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-// GetProjects operation middleware
-func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetProjects(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Getter for additional properties for OutputStyleFile. Returns the specified
+// element and whether it was found
+func (a OutputStyleFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
-
-	handler.ServeHTTP(w, r)
+	return
 }
 
-// PostProjects operation middleware
-func (siw *ServerInterfaceWrapper) PostProjects(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.PostProjects(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+// Setter for additional properties for OutputStyleFile
+func (a *OutputStyleFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
-
-	handler.ServeHTTP(w, r)
+	a.AdditionalProperties[fieldName] = value
 }
 
-// ScanProjects operation middleware
-func (siw *ServerInterfaceWrapper) ScanProjects(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// Override default JSON handling for OutputStyleFile to handle AdditionalProperties
+func (a *OutputStyleFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params ScanProjectsParams
+	if raw, found := object["bodyPreview"]; found {
+		err = json.Unmarshal(raw, &a.BodyPreview)
+		if err != nil {
+			return fmt.Errorf("error reading 'bodyPreview': %w", err)
+		}
+		delete(object, "bodyPreview")
+	}
 
-	// ------------- Required query parameter "folder" -------------
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
 
-	if paramValue := r.URL.Query().Get("folder"); paramValue != "" {
+	if raw, found := object["fileName"]; found {
+		err = json.Unmarshal(raw, &a.FileName)
+		if err != nil {
+			return fmt.Errorf("error reading 'fileName': %w", err)
+		}
+		delete(object, "fileName")
+	}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "folder"})
-		return
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
 	}
 
-	err = runtime.BindQueryParameter("form", true, true, "folder", r.URL.Query(), &params.Folder)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
-		return
+	if raw, found := object["isEditable"]; found {
+		err = json.Unmarshal(raw, &a.IsEditable)
+		if err != nil {
+			return fmt.Errorf("error reading 'isEditable': %w", err)
+		}
+		delete(object, "isEditable")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.ScanProjects(w, r, params)
-	}))
+	if raw, found := object["keepCodingInstructions"]; found {
+		err = json.Unmarshal(raw, &a.KeepCodingInstructions)
+		if err != nil {
+			return fmt.Errorf("error reading 'keepCodingInstructions': %w", err)
+		}
+		delete(object, "keepCodingInstructions")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// DeleteProject operation middleware
-func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for OutputStyleFile to handle AdditionalProperties
+func (a OutputStyleFile) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "projectId" -------------
-	var projectId string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	object["bodyPreview"], err = json.Marshal(a.BodyPreview)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'bodyPreview': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteProject(w, r, projectId)
-	}))
+	object["description"], err = json.Marshal(a.Description)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["fileName"], err = json.Marshal(a.FileName)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'fileName': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-// Search operation middleware
-func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+	object["isEditable"], err = json.Marshal(a.IsEditable)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'isEditable': %w", err)
+	}
 
-	var err error
+	if a.KeepCodingInstructions != nil {
+		object["keepCodingInstructions"], err = json.Marshal(a.KeepCodingInstructions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keepCodingInstructions': %w", err)
+		}
+	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params SearchParams
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
 
-	// ------------- Required query parameter "q" -------------
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
 
-	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+// Getter for additional properties for OutputStyleLayer. Returns the specified
+// element and whether it was found
+func (a OutputStyleLayer) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	} else {
-		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
-		return
+// Setter for additional properties for OutputStyleLayer
+func (a *OutputStyleLayer) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+// Override default JSON handling for OutputStyleLayer to handle AdditionalProperties
+func (a *OutputStyleLayer) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
-		return
+		return err
 	}
 
-	// ------------- Optional query parameter "projectId" -------------
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
+		if err != nil {
+			return fmt.Errorf("error reading 'exists': %w", err)
+		}
+		delete(object, "exists")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Search(w, r, params)
-	}))
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["outputStyle"]; found {
+		err = json.Unmarshal(raw, &a.OutputStyle)
+		if err != nil {
+			return fmt.Errorf("error reading 'outputStyle': %w", err)
+		}
+		delete(object, "outputStyle")
 	}
 
-	handler.ServeHTTP(w, r)
-}
+	if raw, found := object["readOnly"]; found {
+		err = json.Unmarshal(raw, &a.ReadOnly)
+		if err != nil {
+			return fmt.Errorf("error reading 'readOnly': %w", err)
+		}
+		delete(object, "readOnly")
+	}
 
-// GetSkills operation middleware
-func (siw *ServerInterfaceWrapper) GetSkills(w http.ResponseWriter, r *http.Request) {
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
+// Override default JSON handling for OutputStyleLayer to handle AdditionalProperties
+func (a OutputStyleLayer) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSkillsParams
+	object["etag"], err = json.Marshal(a.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'etag': %w", err)
+	}
 
-	// ------------- Optional query parameter "scope" -------------
+	object["exists"], err = json.Marshal(a.Exists)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	object["filePath"], err = json.Marshal(a.FilePath)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
 	}
 
-	// ------------- Optional query parameter "projectId" -------------
+	if a.OutputStyle != nil {
+		object["outputStyle"], err = json.Marshal(a.OutputStyle)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'outputStyle': %w", err)
+		}
+	}
 
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	object["readOnly"], err = json.Marshal(a.ReadOnly)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'readOnly': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSkills(w, r, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
-}
-
-// CreateSkill operation middleware
-func (siw *ServerInterfaceWrapper) CreateSkill(w http.ResponseWriter, r *http.Request) {
-
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.CreateSkill(w, r)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
-
-	handler.ServeHTTP(w, r)
+	return json.Marshal(object)
 }
 
-// DeleteSkill operation middleware
-func (siw *ServerInterfaceWrapper) DeleteSkill(w http.ResponseWriter, r *http.Request) {
-
-	var err error
-
-	// ------------- Path parameter "scope" -------------
-	var scope string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+// Getter for additional properties for OutputStyleSettingResponse. Returns the specified
+// element and whether it was found
+func (a OutputStyleSettingResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
+	return
+}
 
-	// ------------- Path parameter "name" -------------
-	var name string
+// Setter for additional properties for OutputStyleSettingResponse
+func (a *OutputStyleSettingResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// Override default JSON handling for OutputStyleSettingResponse to handle AdditionalProperties
+func (a *OutputStyleSettingResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+		return err
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params DeleteSkillParams
-
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if raw, found := object["available"]; found {
+		err = json.Unmarshal(raw, &a.Available)
+		if err != nil {
+			return fmt.Errorf("error reading 'available': %w", err)
+		}
+		delete(object, "available")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.DeleteSkill(w, r, scope, name, params)
-	}))
+	if raw, found := object["effective"]; found {
+		err = json.Unmarshal(raw, &a.Effective)
+		if err != nil {
+			return fmt.Errorf("error reading 'effective': %w", err)
+		}
+		delete(object, "effective")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["layers"]; found {
+		err = json.Unmarshal(raw, &a.Layers)
+		if err != nil {
+			return fmt.Errorf("error reading 'layers': %w", err)
+		}
+		delete(object, "layers")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// GetSkill operation middleware
-func (siw *ServerInterfaceWrapper) GetSkill(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for OutputStyleSettingResponse to handle AdditionalProperties
+func (a OutputStyleSettingResponse) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// ------------- Path parameter "scope" -------------
-	var scope string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+	if a.Available != nil {
+		object["available"], err = json.Marshal(a.Available)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'available': %w", err)
+		}
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+	if a.Effective != nil {
+		object["effective"], err = json.Marshal(a.Effective)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'effective': %w", err)
+		}
 	}
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params GetSkillParams
-
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+	if a.Layers != nil {
+		object["layers"], err = json.Marshal(a.Layers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
+		}
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.GetSkill(w, r, scope, name, params)
-	}))
-
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
 	}
-
-	handler.ServeHTTP(w, r)
+	return json.Marshal(object)
 }
 
-// UpdateSkill operation middleware
-func (siw *ServerInterfaceWrapper) UpdateSkill(w http.ResponseWriter, r *http.Request) {
-
-	var err error
+// Getter for additional properties for PatchedConfigFile. Returns the specified
+// element and whether it was found
+func (a PatchedConfigFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
 
-	// ------------- Path parameter "scope" -------------
-	var scope string
+// Setter for additional properties for PatchedConfigFile
+func (a *PatchedConfigFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+// Override default JSON handling for PatchedConfigFile to handle AdditionalProperties
+func (a *PatchedConfigFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
-		return
+		return err
 	}
 
-	// ------------- Path parameter "name" -------------
-	var name string
-
-	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
-	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
-		return
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.UpdateSkill(w, r, scope, name)
-	}))
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
 	}
 
-	handler.ServeHTTP(w, r)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-// Watch operation middleware
-func (siw *ServerInterfaceWrapper) Watch(w http.ResponseWriter, r *http.Request) {
-
+// Override default JSON handling for PatchedConfigFile to handle AdditionalProperties
+func (a PatchedConfigFile) MarshalJSON() ([]byte, error) {
 	var err error
+	object := make(map[string]json.RawMessage)
 
-	// Parameter object where we will unmarshal all parameters from the context
-	var params WatchParams
-
-	// ------------- Optional query parameter "projectId" -------------
-
-	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	object["etag"], err = json.Marshal(a.Etag)
 	if err != nil {
-		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
-		return
+		return nil, fmt.Errorf("error marshaling 'etag': %w", err)
 	}
 
-	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		siw.Handler.Watch(w, r, params)
-	}))
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-	for _, middleware := range siw.HandlerMiddlewares {
-		handler = middleware(handler)
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
 	}
 
-	handler.ServeHTTP(w, r)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type UnescapedCookieParamError struct {
-	ParamName string
-	Err       error
+// Getter for additional properties for PermissionDirectory. Returns the specified
+// element and whether it was found
+func (a PermissionDirectory) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-func (e *UnescapedCookieParamError) Error() string {
-	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+// Setter for additional properties for PermissionDirectory
+func (a *PermissionDirectory) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-func (e *UnescapedCookieParamError) Unwrap() error {
-	return e.Err
-}
+// Override default JSON handling for PermissionDirectory to handle AdditionalProperties
+func (a *PermissionDirectory) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type UnmarshalingParamError struct {
-	ParamName string
-	Err       error
-}
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-func (e *UnmarshalingParamError) Error() string {
-	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
-}
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
+	}
 
-func (e *UnmarshalingParamError) Unwrap() error {
-	return e.Err
-}
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
 
-type RequiredParamError struct {
-	ParamName string
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-func (e *RequiredParamError) Error() string {
-	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
-}
+// Override default JSON handling for PermissionDirectory to handle AdditionalProperties
+func (a PermissionDirectory) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type RequiredHeaderError struct {
-	ParamName string
-	Err       error
-}
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-func (e *RequiredHeaderError) Error() string {
-	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
-}
+	object["path"], err = json.Marshal(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	}
 
-func (e *RequiredHeaderError) Unwrap() error {
-	return e.Err
-}
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
 
-type InvalidParamFormatError struct {
-	ParamName string
-	Err       error
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-func (e *InvalidParamFormatError) Error() string {
-	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+// Getter for additional properties for PermissionEvaluation. Returns the specified
+// element and whether it was found
+func (a PermissionEvaluation) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-func (e *InvalidParamFormatError) Unwrap() error {
-	return e.Err
+// Setter for additional properties for PermissionEvaluation
+func (a *PermissionEvaluation) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type TooManyValuesForParamError struct {
-	ParamName string
-	Count     int
-}
+// Override default JSON handling for PermissionEvaluation to handle AdditionalProperties
+func (a *PermissionEvaluation) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (e *TooManyValuesForParamError) Error() string {
-	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
-}
+	if raw, found := object["decision"]; found {
+		err = json.Unmarshal(raw, &a.Decision)
+		if err != nil {
+			return fmt.Errorf("error reading 'decision': %w", err)
+		}
+		delete(object, "decision")
+	}
 
-// Handler creates http.Handler with routing matching OpenAPI spec.
-func Handler(si ServerInterface) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{})
-}
+	if raw, found := object["reason"]; found {
+		err = json.Unmarshal(raw, &a.Reason)
+		if err != nil {
+			return fmt.Errorf("error reading 'reason': %w", err)
+		}
+		delete(object, "reason")
+	}
 
-// ServeMux is an abstraction of http.ServeMux.
-type ServeMux interface {
-	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
-	ServeHTTP(w http.ResponseWriter, r *http.Request)
-}
+	if raw, found := object["rule"]; found {
+		err = json.Unmarshal(raw, &a.Rule)
+		if err != nil {
+			return fmt.Errorf("error reading 'rule': %w", err)
+		}
+		delete(object, "rule")
+	}
 
-type StdHTTPServerOptions struct {
-	BaseURL          string
-	BaseRouter       ServeMux
-	Middlewares      []MiddlewareFunc
-	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	if raw, found := object["subcommands"]; found {
+		err = json.Unmarshal(raw, &a.Subcommands)
+		if err != nil {
+			return fmt.Errorf("error reading 'subcommands': %w", err)
+		}
+		delete(object, "subcommands")
+	}
 
-// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
-func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseRouter: m,
-	})
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
-	return HandlerWithOptions(si, StdHTTPServerOptions{
-		BaseURL:    baseURL,
-		BaseRouter: m,
-	})
-}
+// Override default JSON handling for PermissionEvaluation to handle AdditionalProperties
+func (a PermissionEvaluation) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-// HandlerWithOptions creates http.Handler with additional options
-func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
-	m := options.BaseRouter
+	object["decision"], err = json.Marshal(a.Decision)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'decision': %w", err)
+	}
 
-	if m == nil {
-		m = http.NewServeMux()
+	object["reason"], err = json.Marshal(a.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'reason': %w", err)
 	}
-	if options.ErrorHandlerFunc == nil {
-		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
+
+	if a.Rule != nil {
+		object["rule"], err = json.Marshal(a.Rule)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'rule': %w", err)
 		}
 	}
 
-	wrapper := ServerInterfaceWrapper{
-		Handler:            si,
-		HandlerMiddlewares: options.Middlewares,
-		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	if a.Subcommands != nil {
+		object["subcommands"], err = json.Marshal(a.Subcommands)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'subcommands': %w", err)
+		}
 	}
 
-	m.HandleFunc("GET "+options.BaseURL+"/api/agents", wrapper.GetAgents)
-	m.HandleFunc("POST "+options.BaseURL+"/api/agents", wrapper.CreateAgent)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/agents/{name}", wrapper.DeleteAgent)
-	m.HandleFunc("GET "+options.BaseURL+"/api/agents/{name}", wrapper.GetAgent)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/agents/{name}", wrapper.UpdateAgent)
-	m.HandleFunc("POST "+options.BaseURL+"/api/auth/login", wrapper.Login)
-	m.HandleFunc("POST "+options.BaseURL+"/api/auth/logout", wrapper.Logout)
-	m.HandleFunc("POST "+options.BaseURL+"/api/auth/setup", wrapper.SetupAuth)
-	m.HandleFunc("GET "+options.BaseURL+"/api/auth/status", wrapper.GetAuthStatus)
-	m.HandleFunc("GET "+options.BaseURL+"/api/backups", wrapper.GetBackups)
-	m.HandleFunc("POST "+options.BaseURL+"/api/backups/{id}/restore", wrapper.RestoreBackup)
-	m.HandleFunc("GET "+options.BaseURL+"/api/commands", wrapper.GetCommands)
-	m.HandleFunc("POST "+options.BaseURL+"/api/commands", wrapper.CreateCommand)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/commands/{scope}/{folder}/{name}", wrapper.DeleteCommand)
-	m.HandleFunc("GET "+options.BaseURL+"/api/commands/{scope}/{folder}/{name}", wrapper.GetCommand)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/commands/{scope}/{folder}/{name}", wrapper.UpdateCommand)
-	m.HandleFunc("GET "+options.BaseURL+"/api/config", wrapper.GetConfig)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/config/setting", wrapper.DeleteConfigSetting)
-	m.HandleFunc("POST "+options.BaseURL+"/api/config/setting", wrapper.UpdateConfigSetting)
-	m.HandleFunc("POST "+options.BaseURL+"/api/config/setting/move", wrapper.MoveConfigSetting)
-	m.HandleFunc("GET "+options.BaseURL+"/api/features", wrapper.GetFeatures)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/features/{key}", wrapper.DeleteFeature)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/features/{key}", wrapper.UpdateFeature)
-	m.HandleFunc("GET "+options.BaseURL+"/api/health", wrapper.GetHealth)
-	m.HandleFunc("GET "+options.BaseURL+"/api/hooks", wrapper.GetHooks)
-	m.HandleFunc("POST "+options.BaseURL+"/api/hooks", wrapper.CreateHook)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/hooks/{id}", wrapper.DeleteHook)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/hooks/{id}", wrapper.UpdateHook)
-	m.HandleFunc("GET "+options.BaseURL+"/api/instructions", wrapper.GetInstructions)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/instructions", wrapper.UpdateInstructions)
-	m.HandleFunc("GET "+options.BaseURL+"/api/memory", wrapper.ListMemory)
-	m.HandleFunc("POST "+options.BaseURL+"/api/memory", wrapper.CreateMemory)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/memory/{filename}", wrapper.DeleteMemory)
-	m.HandleFunc("GET "+options.BaseURL+"/api/memory/{filename}", wrapper.GetMemory)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/memory/{filename}", wrapper.UpdateMemory)
-	m.HandleFunc("GET "+options.BaseURL+"/api/plugins", wrapper.GetPlugins)
-	m.HandleFunc("GET "+options.BaseURL+"/api/projects", wrapper.GetProjects)
-	m.HandleFunc("POST "+options.BaseURL+"/api/projects", wrapper.PostProjects)
-	m.HandleFunc("GET "+options.BaseURL+"/api/projects/scan", wrapper.ScanProjects)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/projects/{projectId}", wrapper.DeleteProject)
-	m.HandleFunc("GET "+options.BaseURL+"/api/search", wrapper.Search)
-	m.HandleFunc("GET "+options.BaseURL+"/api/skills", wrapper.GetSkills)
-	m.HandleFunc("POST "+options.BaseURL+"/api/skills", wrapper.CreateSkill)
-	m.HandleFunc("DELETE "+options.BaseURL+"/api/skills/{scope}/{name}", wrapper.DeleteSkill)
-	m.HandleFunc("GET "+options.BaseURL+"/api/skills/{scope}/{name}", wrapper.GetSkill)
-	m.HandleFunc("PUT "+options.BaseURL+"/api/skills/{scope}/{name}", wrapper.UpdateSkill)
-	m.HandleFunc("GET "+options.BaseURL+"/api/watch", wrapper.Watch)
-
-	return m
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetAgentsRequestObject struct {
-	Params GetAgentsParams
+// Getter for additional properties for PermissionEvaluationRequest. Returns the specified
+// element and whether it was found
+func (a PermissionEvaluationRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type GetAgentsResponseObject interface {
-	VisitGetAgentsResponse(w http.ResponseWriter) error
+// Setter for additional properties for PermissionEvaluationRequest
+func (a *PermissionEvaluationRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type GetAgents200JSONResponse []AgentFile
+// Override default JSON handling for PermissionEvaluationRequest to handle AdditionalProperties
+func (a *PermissionEvaluationRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response GetAgents200JSONResponse) VisitGetAgentsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["input"]; found {
+		err = json.Unmarshal(raw, &a.Input)
+		if err != nil {
+			return fmt.Errorf("error reading 'input': %w", err)
+		}
+		delete(object, "input")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
-
-type CreateAgentRequestObject struct {
-	Body *CreateAgentJSONRequestBody
-}
-
-type CreateAgentResponseObject interface {
-	VisitCreateAgentResponse(w http.ResponseWriter) error
-}
-
-type CreateAgent200JSONResponse AgentFile
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
 
-func (response CreateAgent200JSONResponse) VisitCreateAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["tool"]; found {
+		err = json.Unmarshal(raw, &a.Tool)
+		if err != nil {
+			return fmt.Errorf("error reading 'tool': %w", err)
+		}
+		delete(object, "tool")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type DeleteAgentRequestObject struct {
-	Name string `json:"name"`
-	Body *DeleteAgentJSONRequestBody
-}
+// Override default JSON handling for PermissionEvaluationRequest to handle AdditionalProperties
+func (a PermissionEvaluationRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type DeleteAgentResponseObject interface {
-	VisitDeleteAgentResponse(w http.ResponseWriter) error
-}
+	if a.Input != nil {
+		object["input"], err = json.Marshal(a.Input)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'input': %w", err)
+		}
+	}
 
-type DeleteAgent200JSONResponse SuccessResponse
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
 
-func (response DeleteAgent200JSONResponse) VisitDeleteAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["tool"], err = json.Marshal(a.Tool)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'tool': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetAgentRequestObject struct {
-	Name   string `json:"name"`
-	Params GetAgentParams
+// Getter for additional properties for PermissionRule. Returns the specified
+// element and whether it was found
+func (a PermissionRule) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type GetAgentResponseObject interface {
-	VisitGetAgentResponse(w http.ResponseWriter) error
+// Setter for additional properties for PermissionRule
+func (a *PermissionRule) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type GetAgent200JSONResponse AgentDetail
+// Override default JSON handling for PermissionRule to handle AdditionalProperties
+func (a *PermissionRule) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response GetAgent200JSONResponse) VisitGetAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["behavior"]; found {
+		err = json.Unmarshal(raw, &a.Behavior)
+		if err != nil {
+			return fmt.Errorf("error reading 'behavior': %w", err)
+		}
+		delete(object, "behavior")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
 
-type UpdateAgentRequestObject struct {
-	Name string `json:"name"`
-	Body *UpdateAgentJSONRequestBody
-}
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-type UpdateAgentResponseObject interface {
-	VisitUpdateAgentResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["index"]; found {
+		err = json.Unmarshal(raw, &a.Index)
+		if err != nil {
+			return fmt.Errorf("error reading 'index': %w", err)
+		}
+		delete(object, "index")
+	}
 
-type UpdateAgent200JSONResponse AgentDetail
+	if raw, found := object["kind"]; found {
+		err = json.Unmarshal(raw, &a.Kind)
+		if err != nil {
+			return fmt.Errorf("error reading 'kind': %w", err)
+		}
+		delete(object, "kind")
+	}
 
-func (response UpdateAgent200JSONResponse) VisitUpdateAgentResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["mcpServer"]; found {
+		err = json.Unmarshal(raw, &a.McpServer)
+		if err != nil {
+			return fmt.Errorf("error reading 'mcpServer': %w", err)
+		}
+		delete(object, "mcpServer")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["pattern"]; found {
+		err = json.Unmarshal(raw, &a.Pattern)
+		if err != nil {
+			return fmt.Errorf("error reading 'pattern': %w", err)
+		}
+		delete(object, "pattern")
+	}
 
-type LoginRequestObject struct {
-	Body *LoginJSONRequestBody
-}
+	if raw, found := object["rule"]; found {
+		err = json.Unmarshal(raw, &a.Rule)
+		if err != nil {
+			return fmt.Errorf("error reading 'rule': %w", err)
+		}
+		delete(object, "rule")
+	}
 
-type LoginResponseObject interface {
-	VisitLoginResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
 
-type Login200JSONResponse SuccessResponse
+	if raw, found := object["specifier"]; found {
+		err = json.Unmarshal(raw, &a.Specifier)
+		if err != nil {
+			return fmt.Errorf("error reading 'specifier': %w", err)
+		}
+		delete(object, "specifier")
+	}
 
-func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["tool"]; found {
+		err = json.Unmarshal(raw, &a.Tool)
+		if err != nil {
+			return fmt.Errorf("error reading 'tool': %w", err)
+		}
+		delete(object, "tool")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type LogoutRequestObject struct {
-}
+// Override default JSON handling for PermissionRule to handle AdditionalProperties
+func (a PermissionRule) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type LogoutResponseObject interface {
-	VisitLogoutResponse(w http.ResponseWriter) error
-}
+	object["behavior"], err = json.Marshal(a.Behavior)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'behavior': %w", err)
+	}
 
-type Logout200JSONResponse SuccessResponse
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
+	}
 
-func (response Logout200JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	object["index"], err = json.Marshal(a.Index)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'index': %w", err)
+	}
 
-type SetupAuthRequestObject struct {
-	Body *SetupAuthJSONRequestBody
-}
+	if a.Kind != nil {
+		object["kind"], err = json.Marshal(a.Kind)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'kind': %w", err)
+		}
+	}
 
-type SetupAuthResponseObject interface {
-	VisitSetupAuthResponse(w http.ResponseWriter) error
-}
+	if a.McpServer != nil {
+		object["mcpServer"], err = json.Marshal(a.McpServer)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'mcpServer': %w", err)
+		}
+	}
 
-type SetupAuth200JSONResponse SuccessResponse
+	if a.Pattern != nil {
+		object["pattern"], err = json.Marshal(a.Pattern)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'pattern': %w", err)
+		}
+	}
 
-func (response SetupAuth200JSONResponse) VisitSetupAuthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["rule"], err = json.Marshal(a.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'rule': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
 
-type SetupAuth403Response struct {
-}
+	if a.Specifier != nil {
+		object["specifier"], err = json.Marshal(a.Specifier)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'specifier': %w", err)
+		}
+	}
 
-func (response SetupAuth403Response) VisitSetupAuthResponse(w http.ResponseWriter) error {
-	w.WriteHeader(403)
-	return nil
-}
+	if a.Tool != nil {
+		object["tool"], err = json.Marshal(a.Tool)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tool': %w", err)
+		}
+	}
 
-type GetAuthStatusRequestObject struct {
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetAuthStatusResponseObject interface {
-	VisitGetAuthStatusResponse(w http.ResponseWriter) error
+// Getter for additional properties for PermissionRuleRequest. Returns the specified
+// element and whether it was found
+func (a PermissionRuleRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type GetAuthStatus200JSONResponse AuthStatusResponse
+// Setter for additional properties for PermissionRuleRequest
+func (a *PermissionRuleRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
 
-func (response GetAuthStatus200JSONResponse) VisitGetAuthStatusResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// Override default JSON handling for PermissionRuleRequest to handle AdditionalProperties
+func (a *PermissionRuleRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type GetBackupsRequestObject struct {
-}
+	if raw, found := object["behavior"]; found {
+		err = json.Unmarshal(raw, &a.Behavior)
+		if err != nil {
+			return fmt.Errorf("error reading 'behavior': %w", err)
+		}
+		delete(object, "behavior")
+	}
 
-type GetBackupsResponseObject interface {
-	VisitGetBackupsResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
 
-type GetBackups200JSONResponse []BackupFile
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
 
-func (response GetBackups200JSONResponse) VisitGetBackupsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["rule"]; found {
+		err = json.Unmarshal(raw, &a.Rule)
+		if err != nil {
+			return fmt.Errorf("error reading 'rule': %w", err)
+		}
+		delete(object, "rule")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type RestoreBackupRequestObject struct {
-	Id string `json:"id"`
-}
+// Override default JSON handling for PermissionRuleRequest to handle AdditionalProperties
+func (a PermissionRuleRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type RestoreBackupResponseObject interface {
-	VisitRestoreBackupResponse(w http.ResponseWriter) error
-}
+	object["behavior"], err = json.Marshal(a.Behavior)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'behavior': %w", err)
+	}
 
-type RestoreBackup200JSONResponse SuccessResponse
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
 
-func (response RestoreBackup200JSONResponse) VisitRestoreBackupResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	object["rule"], err = json.Marshal(a.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'rule': %w", err)
+	}
 
-type GetCommandsRequestObject struct {
-	Params GetCommandsParams
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetCommandsResponseObject interface {
-	VisitGetCommandsResponse(w http.ResponseWriter) error
+// Getter for additional properties for PermissionSubcommandDecision. Returns the specified
+// element and whether it was found
+func (a PermissionSubcommandDecision) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type GetCommands200JSONResponse []CommandFile
-
-func (response GetCommands200JSONResponse) VisitGetCommandsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Setter for additional properties for PermissionSubcommandDecision
+func (a *PermissionSubcommandDecision) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type CreateCommandRequestObject struct {
-	Body *CreateCommandJSONRequestBody
-}
+// Override default JSON handling for PermissionSubcommandDecision to handle AdditionalProperties
+func (a *PermissionSubcommandDecision) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type CreateCommandResponseObject interface {
-	VisitCreateCommandResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["command"]; found {
+		err = json.Unmarshal(raw, &a.Command)
+		if err != nil {
+			return fmt.Errorf("error reading 'command': %w", err)
+		}
+		delete(object, "command")
+	}
 
-type CreateCommand200JSONResponse CommandFile
+	if raw, found := object["decision"]; found {
+		err = json.Unmarshal(raw, &a.Decision)
+		if err != nil {
+			return fmt.Errorf("error reading 'decision': %w", err)
+		}
+		delete(object, "decision")
+	}
 
-func (response CreateCommand200JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["reason"]; found {
+		err = json.Unmarshal(raw, &a.Reason)
+		if err != nil {
+			return fmt.Errorf("error reading 'reason': %w", err)
+		}
+		delete(object, "reason")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["rule"]; found {
+		err = json.Unmarshal(raw, &a.Rule)
+		if err != nil {
+			return fmt.Errorf("error reading 'rule': %w", err)
+		}
+		delete(object, "rule")
+	}
 
-type DeleteCommandRequestObject struct {
-	Scope  string `json:"scope"`
-	Folder string `json:"folder"`
-	Name   string `json:"name"`
-	Params DeleteCommandParams
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type DeleteCommandResponseObject interface {
-	VisitDeleteCommandResponse(w http.ResponseWriter) error
-}
+// Override default JSON handling for PermissionSubcommandDecision to handle AdditionalProperties
+func (a PermissionSubcommandDecision) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type DeleteCommand200JSONResponse SuccessResponse
+	object["command"], err = json.Marshal(a.Command)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'command': %w", err)
+	}
 
-func (response DeleteCommand200JSONResponse) VisitDeleteCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["decision"], err = json.Marshal(a.Decision)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'decision': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	object["reason"], err = json.Marshal(a.Reason)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'reason': %w", err)
+	}
 
-type GetCommandRequestObject struct {
-	Scope  string `json:"scope"`
-	Folder string `json:"folder"`
-	Name   string `json:"name"`
-	Params GetCommandParams
-}
+	if a.Rule != nil {
+		object["rule"], err = json.Marshal(a.Rule)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'rule': %w", err)
+		}
+	}
 
-type GetCommandResponseObject interface {
-	VisitGetCommandResponse(w http.ResponseWriter) error
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetCommand200JSONResponse CommandDetail
-
-func (response GetCommand200JSONResponse) VisitGetCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Getter for additional properties for PermissionsLayer. Returns the specified
+// element and whether it was found
+func (a PermissionsLayer) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type UpdateCommandRequestObject struct {
-	Scope  string `json:"scope"`
-	Folder string `json:"folder"`
-	Name   string `json:"name"`
-	Body   *UpdateCommandJSONRequestBody
+// Setter for additional properties for PermissionsLayer
+func (a *PermissionsLayer) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type UpdateCommandResponseObject interface {
-	VisitUpdateCommandResponse(w http.ResponseWriter) error
-}
+// Override default JSON handling for PermissionsLayer to handle AdditionalProperties
+func (a *PermissionsLayer) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type UpdateCommand200JSONResponse CommandDetail
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
+	}
 
-func (response UpdateCommand200JSONResponse) VisitUpdateCommandResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
+		if err != nil {
+			return fmt.Errorf("error reading 'exists': %w", err)
+		}
+		delete(object, "exists")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-type GetConfigRequestObject struct {
-	Params GetConfigParams
-}
+	if raw, found := object["readOnly"]; found {
+		err = json.Unmarshal(raw, &a.ReadOnly)
+		if err != nil {
+			return fmt.Errorf("error reading 'readOnly': %w", err)
+		}
+		delete(object, "readOnly")
+	}
 
-type GetConfigResponseObject interface {
-	VisitGetConfigResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
 
-type GetConfig200JSONResponse ConfigResponse
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
 
-func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Override default JSON handling for PermissionsLayer to handle AdditionalProperties
+func (a PermissionsLayer) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-	return json.NewEncoder(w).Encode(response)
-}
+	object["etag"], err = json.Marshal(a.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'etag': %w", err)
+	}
 
-type DeleteConfigSettingRequestObject struct {
-	Body *DeleteConfigSettingJSONRequestBody
-}
+	object["exists"], err = json.Marshal(a.Exists)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
+	}
 
-type DeleteConfigSettingResponseObject interface {
-	VisitDeleteConfigSettingResponse(w http.ResponseWriter) error
-}
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-type DeleteConfigSetting200JSONResponse SuccessResponse
+	object["readOnly"], err = json.Marshal(a.ReadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'readOnly': %w", err)
+	}
 
-func (response DeleteConfigSetting200JSONResponse) VisitDeleteConfigSettingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type UpdateConfigSettingRequestObject struct {
-	Body *UpdateConfigSettingJSONRequestBody
+// Getter for additional properties for PermissionsResponse. Returns the specified
+// element and whether it was found
+func (a PermissionsResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type UpdateConfigSettingResponseObject interface {
-	VisitUpdateConfigSettingResponse(w http.ResponseWriter) error
+// Setter for additional properties for PermissionsResponse
+func (a *PermissionsResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type UpdateConfigSetting200JSONResponse SuccessResponse
-
-func (response UpdateConfigSetting200JSONResponse) VisitUpdateConfigSettingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
-}
+// Override default JSON handling for PermissionsResponse to handle AdditionalProperties
+func (a *PermissionsResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type MoveConfigSettingRequestObject struct {
-	Body *MoveConfigSettingJSONRequestBody
-}
+	if raw, found := object["additionalDirectories"]; found {
+		err = json.Unmarshal(raw, &a.AdditionalDirectories)
+		if err != nil {
+			return fmt.Errorf("error reading 'additionalDirectories': %w", err)
+		}
+		delete(object, "additionalDirectories")
+	}
 
-type MoveConfigSettingResponseObject interface {
-	VisitMoveConfigSettingResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["defaultMode"]; found {
+		err = json.Unmarshal(raw, &a.DefaultMode)
+		if err != nil {
+			return fmt.Errorf("error reading 'defaultMode': %w", err)
+		}
+		delete(object, "defaultMode")
+	}
 
-type MoveConfigSetting200JSONResponse SuccessResponse
+	if raw, found := object["layers"]; found {
+		err = json.Unmarshal(raw, &a.Layers)
+		if err != nil {
+			return fmt.Errorf("error reading 'layers': %w", err)
+		}
+		delete(object, "layers")
+	}
 
-func (response MoveConfigSetting200JSONResponse) VisitMoveConfigSettingResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["rules"]; found {
+		err = json.Unmarshal(raw, &a.Rules)
+		if err != nil {
+			return fmt.Errorf("error reading 'rules': %w", err)
+		}
+		delete(object, "rules")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type GetFeaturesRequestObject struct {
-}
+// Override default JSON handling for PermissionsResponse to handle AdditionalProperties
+func (a PermissionsResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type GetFeaturesResponseObject interface {
-	VisitGetFeaturesResponse(w http.ResponseWriter) error
-}
+	if a.AdditionalDirectories != nil {
+		object["additionalDirectories"], err = json.Marshal(a.AdditionalDirectories)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'additionalDirectories': %w", err)
+		}
+	}
 
-type GetFeatures200JSONResponse FeaturesData
+	if a.DefaultMode != nil {
+		object["defaultMode"], err = json.Marshal(a.DefaultMode)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'defaultMode': %w", err)
+		}
+	}
 
-func (response GetFeatures200JSONResponse) VisitGetFeaturesResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if a.Layers != nil {
+		object["layers"], err = json.Marshal(a.Layers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
+		}
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if a.Rules != nil {
+		object["rules"], err = json.Marshal(a.Rules)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'rules': %w", err)
+		}
+	}
 
-type DeleteFeatureRequestObject struct {
-	Key string `json:"key"`
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type DeleteFeatureResponseObject interface {
-	VisitDeleteFeatureResponse(w http.ResponseWriter) error
+// Getter for additional properties for PluginFile. Returns the specified
+// element and whether it was found
+func (a PluginFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type DeleteFeature200JSONResponse SuccessResponse
-
-func (response DeleteFeature200JSONResponse) VisitDeleteFeatureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Setter for additional properties for PluginFile
+func (a *PluginFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type UpdateFeatureRequestObject struct {
-	Key  string `json:"key"`
-	Body *UpdateFeatureJSONRequestBody
-}
+// Override default JSON handling for PluginFile to handle AdditionalProperties
+func (a *PluginFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type UpdateFeatureResponseObject interface {
-	VisitUpdateFeatureResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["isUserOwned"]; found {
+		err = json.Unmarshal(raw, &a.IsUserOwned)
+		if err != nil {
+			return fmt.Errorf("error reading 'isUserOwned': %w", err)
+		}
+		delete(object, "isUserOwned")
+	}
 
-type UpdateFeature200JSONResponse SuccessResponse
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-func (response UpdateFeature200JSONResponse) VisitUpdateFeatureResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type GetHealthRequestObject struct {
-}
+// Override default JSON handling for PluginFile to handle AdditionalProperties
+func (a PluginFile) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type GetHealthResponseObject interface {
-	VisitGetHealthResponse(w http.ResponseWriter) error
-}
+	object["isUserOwned"], err = json.Marshal(a.IsUserOwned)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'isUserOwned': %w", err)
+	}
 
-type GetHealth200JSONResponse HealthResponse
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
 
-func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["path"], err = json.Marshal(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetHooksRequestObject struct {
-	Params GetHooksParams
+// Getter for additional properties for PreconditionFailedResponse. Returns the specified
+// element and whether it was found
+func (a PreconditionFailedResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type GetHooksResponseObject interface {
-	VisitGetHooksResponse(w http.ResponseWriter) error
+// Setter for additional properties for PreconditionFailedResponse
+func (a *PreconditionFailedResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type GetHooks200JSONResponse HooksResponse
-
-func (response GetHooks200JSONResponse) VisitGetHooksResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Override default JSON handling for PreconditionFailedResponse to handle AdditionalProperties
+func (a *PreconditionFailedResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["content"]; found {
+		err = json.Unmarshal(raw, &a.Content)
+		if err != nil {
+			return fmt.Errorf("error reading 'content': %w", err)
+		}
+		delete(object, "content")
+	}
 
-type CreateHookRequestObject struct {
-	Body *CreateHookJSONRequestBody
-}
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
 
-type CreateHookResponseObject interface {
-	VisitCreateHookResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
+	}
 
-type CreateHook200JSONResponse SuccessResponse
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
+		if err != nil {
+			return fmt.Errorf("error reading 'exists': %w", err)
+		}
+		delete(object, "exists")
+	}
 
-func (response CreateHook200JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type DeleteHookRequestObject struct {
-	Id     string `json:"id"`
-	Params DeleteHookParams
-}
+// Override default JSON handling for PreconditionFailedResponse to handle AdditionalProperties
+func (a PreconditionFailedResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type DeleteHookResponseObject interface {
-	VisitDeleteHookResponse(w http.ResponseWriter) error
-}
+	if a.Content != nil {
+		object["content"], err = json.Marshal(a.Content)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'content': %w", err)
+		}
+	}
 
-type DeleteHook200JSONResponse SuccessResponse
+	object["error"], err = json.Marshal(a.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'error': %w", err)
+	}
 
-func (response DeleteHook200JSONResponse) VisitDeleteHookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["etag"], err = json.Marshal(a.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'etag': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	object["exists"], err = json.Marshal(a.Exists)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
+	}
+
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type UpdateHookRequestObject struct {
-	Id   string `json:"id"`
-	Body *UpdateHookJSONRequestBody
+// Getter for additional properties for ProjectFile. Returns the specified
+// element and whether it was found
+func (a ProjectFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type UpdateHookResponseObject interface {
-	VisitUpdateHookResponse(w http.ResponseWriter) error
+// Setter for additional properties for ProjectFile
+func (a *ProjectFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type UpdateHook200JSONResponse SuccessResponse
+// Override default JSON handling for ProjectFile to handle AdditionalProperties
+func (a *ProjectFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response UpdateHook200JSONResponse) VisitUpdateHookResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
+	}
 
-type GetInstructionsRequestObject struct {
-	Params GetInstructionsParams
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type GetInstructionsResponseObject interface {
-	VisitGetInstructionsResponse(w http.ResponseWriter) error
-}
+// Override default JSON handling for ProjectFile to handle AdditionalProperties
+func (a ProjectFile) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type GetInstructions200JSONResponse InstructionsResponse
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
 
-func (response GetInstructions200JSONResponse) VisitGetInstructionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["path"], err = json.Marshal(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type UpdateInstructionsRequestObject struct {
-	Body *UpdateInstructionsJSONRequestBody
+// Getter for additional properties for RepairConfigLayerRequest. Returns the specified
+// element and whether it was found
+func (a RepairConfigLayerRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type UpdateInstructionsResponseObject interface {
-	VisitUpdateInstructionsResponse(w http.ResponseWriter) error
+// Setter for additional properties for RepairConfigLayerRequest
+func (a *RepairConfigLayerRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type UpdateInstructions200JSONResponse SuccessResponse
+// Override default JSON handling for RepairConfigLayerRequest to handle AdditionalProperties
+func (a *RepairConfigLayerRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response UpdateInstructions200JSONResponse) VisitUpdateInstructionsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
 
-type ListMemoryRequestObject struct {
-	Params ListMemoryParams
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type ListMemoryResponseObject interface {
-	VisitListMemoryResponse(w http.ResponseWriter) error
-}
+// Override default JSON handling for RepairConfigLayerRequest to handle AdditionalProperties
+func (a RepairConfigLayerRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type ListMemory200JSONResponse []MemoryFile
-
-func (response ListMemory200JSONResponse) VisitListMemoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
 
-type CreateMemoryRequestObject struct {
-	Body *CreateMemoryJSONRequestBody
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type CreateMemoryResponseObject interface {
-	VisitCreateMemoryResponse(w http.ResponseWriter) error
+// Getter for additional properties for ScanProjectResult. Returns the specified
+// element and whether it was found
+func (a ScanProjectResult) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type CreateMemory200JSONResponse MemoryFile
-
-func (response CreateMemory200JSONResponse) VisitCreateMemoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
-
-	return json.NewEncoder(w).Encode(response)
+// Setter for additional properties for ScanProjectResult
+func (a *ScanProjectResult) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type DeleteMemoryRequestObject struct {
-	Filename string `json:"filename"`
-	Params   DeleteMemoryParams
-}
+// Override default JSON handling for ScanProjectResult to handle AdditionalProperties
+func (a *ScanProjectResult) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type DeleteMemoryResponseObject interface {
-	VisitDeleteMemoryResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-type DeleteMemory200JSONResponse SuccessResponse
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
+	}
 
-func (response DeleteMemory200JSONResponse) VisitDeleteMemoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["registered"]; found {
+		err = json.Unmarshal(raw, &a.Registered)
+		if err != nil {
+			return fmt.Errorf("error reading 'registered': %w", err)
+		}
+		delete(object, "registered")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type GetMemoryRequestObject struct {
-	Filename string `json:"filename"`
-	Params   GetMemoryParams
-}
+// Override default JSON handling for ScanProjectResult to handle AdditionalProperties
+func (a ScanProjectResult) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type GetMemoryResponseObject interface {
-	VisitGetMemoryResponse(w http.ResponseWriter) error
-}
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
 
-type GetMemory200JSONResponse MemoryDetail
+	object["path"], err = json.Marshal(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	}
 
-func (response GetMemory200JSONResponse) VisitGetMemoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["registered"], err = json.Marshal(a.Registered)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'registered': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type UpdateMemoryRequestObject struct {
-	Filename string `json:"filename"`
-	Body     *UpdateMemoryJSONRequestBody
+// Getter for additional properties for SearchResult. Returns the specified
+// element and whether it was found
+func (a SearchResult) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type UpdateMemoryResponseObject interface {
-	VisitUpdateMemoryResponse(w http.ResponseWriter) error
+// Setter for additional properties for SearchResult
+func (a *SearchResult) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type UpdateMemory200JSONResponse SuccessResponse
-
-func (response UpdateMemory200JSONResponse) VisitUpdateMemoryResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+// Override default JSON handling for SearchResult to handle AdditionalProperties
+func (a *SearchResult) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
 
-type GetPluginsRequestObject struct {
-}
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-type GetPluginsResponseObject interface {
-	VisitGetPluginsResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-type GetPlugins200JSONResponse []PluginFile
+	if raw, found := object["preview"]; found {
+		err = json.Unmarshal(raw, &a.Preview)
+		if err != nil {
+			return fmt.Errorf("error reading 'preview': %w", err)
+		}
+		delete(object, "preview")
+	}
 
-func (response GetPlugins200JSONResponse) VisitGetPluginsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type GetProjectsRequestObject struct {
-}
+// Override default JSON handling for SearchResult to handle AdditionalProperties
+func (a SearchResult) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type GetProjectsResponseObject interface {
-	VisitGetProjectsResponse(w http.ResponseWriter) error
-}
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
 
-type GetProjects200JSONResponse []ProjectFile
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-func (response GetProjects200JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	object["preview"], err = json.Marshal(a.Preview)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'preview': %w", err)
+	}
 
-type PostProjectsRequestObject struct {
-	Body *PostProjectsJSONRequestBody
-}
-
-type PostProjectsResponseObject interface {
-	VisitPostProjectsResponse(w http.ResponseWriter) error
-}
-
-type PostProjects200JSONResponse []ProjectFile
-
-func (response PostProjects200JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type PostProjects400JSONResponse ErrorResponse
-
-func (response PostProjects400JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
-
-	return json.NewEncoder(w).Encode(response)
+// Getter for additional properties for SettingLayerRef. Returns the specified
+// element and whether it was found
+func (a SettingLayerRef) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type ScanProjectsRequestObject struct {
-	Params ScanProjectsParams
+// Setter for additional properties for SettingLayerRef
+func (a *SettingLayerRef) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type ScanProjectsResponseObject interface {
-	VisitScanProjectsResponse(w http.ResponseWriter) error
-}
+// Override default JSON handling for SettingLayerRef to handle AdditionalProperties
+func (a *SettingLayerRef) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type ScanProjects200JSONResponse []ScanProjectResult
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
 
-func (response ScanProjects200JSONResponse) VisitScanProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type ScanProjects400JSONResponse ErrorResponse
+// Override default JSON handling for SettingLayerRef to handle AdditionalProperties
+func (a SettingLayerRef) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-func (response ScanProjects400JSONResponse) VisitScanProjectsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
 
-type DeleteProjectRequestObject struct {
-	ProjectId string `json:"projectId"`
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type DeleteProjectResponseObject interface {
-	VisitDeleteProjectResponse(w http.ResponseWriter) error
+// Getter for additional properties for SettingWriteResponse. Returns the specified
+// element and whether it was found
+func (a SettingWriteResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type DeleteProject204Response struct {
+// Setter for additional properties for SettingWriteResponse
+func (a *SettingWriteResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.WriteHeader(204)
-	return nil
-}
+// Override default JSON handling for SettingWriteResponse to handle AdditionalProperties
+func (a *SettingWriteResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-type DeleteProject400JSONResponse ErrorResponse
+	if raw, found := object["success"]; found {
+		err = json.Unmarshal(raw, &a.Success)
+		if err != nil {
+			return fmt.Errorf("error reading 'success': %w", err)
+		}
+		delete(object, "success")
+	}
 
-func (response DeleteProject400JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(400)
+	if raw, found := object["warnings"]; found {
+		err = json.Unmarshal(raw, &a.Warnings)
+		if err != nil {
+			return fmt.Errorf("error reading 'warnings': %w", err)
+		}
+		delete(object, "warnings")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type DeleteProject404JSONResponse ErrorResponse
+// Override default JSON handling for SettingWriteResponse to handle AdditionalProperties
+func (a SettingWriteResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(404)
+	object["success"], err = json.Marshal(a.Success)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'success': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if a.Warnings != nil {
+		object["warnings"], err = json.Marshal(a.Warnings)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'warnings': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type SearchRequestObject struct {
-	Params SearchParams
+// Getter for additional properties for SettingsParseErrorResponse. Returns the specified
+// element and whether it was found
+func (a SettingsParseErrorResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type SearchResponseObject interface {
-	VisitSearchResponse(w http.ResponseWriter) error
+// Setter for additional properties for SettingsParseErrorResponse
+func (a *SettingsParseErrorResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type Search200JSONResponse []SearchResult
+// Override default JSON handling for SettingsParseErrorResponse to handle AdditionalProperties
+func (a *SettingsParseErrorResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["parseError"]; found {
+		err = json.Unmarshal(raw, &a.ParseError)
+		if err != nil {
+			return fmt.Errorf("error reading 'parseError': %w", err)
+		}
+		delete(object, "parseError")
+	}
 
-type GetSkillsRequestObject struct {
-	Params GetSkillsParams
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type GetSkillsResponseObject interface {
-	VisitGetSkillsResponse(w http.ResponseWriter) error
-}
+// Override default JSON handling for SettingsParseErrorResponse to handle AdditionalProperties
+func (a SettingsParseErrorResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type GetSkills200JSONResponse []SkillFile
+	object["error"], err = json.Marshal(a.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'error': %w", err)
+	}
 
-func (response GetSkills200JSONResponse) VisitGetSkillsResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["parseError"], err = json.Marshal(a.ParseError)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'parseError': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type CreateSkillRequestObject struct {
-	Body *CreateSkillJSONRequestBody
+// Getter for additional properties for ShadowedValue. Returns the specified
+// element and whether it was found
+func (a ShadowedValue) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type CreateSkillResponseObject interface {
-	VisitCreateSkillResponse(w http.ResponseWriter) error
+// Setter for additional properties for ShadowedValue
+func (a *ShadowedValue) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type CreateSkill200JSONResponse SkillFile
+// Override default JSON handling for ShadowedValue to handle AdditionalProperties
+func (a *ShadowedValue) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response CreateSkill200JSONResponse) VisitCreateSkillResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
 
-type DeleteSkillRequestObject struct {
-	Scope  string `json:"scope"`
-	Name   string `json:"name"`
-	Params DeleteSkillParams
-}
+	if raw, found := object["value"]; found {
+		err = json.Unmarshal(raw, &a.Value)
+		if err != nil {
+			return fmt.Errorf("error reading 'value': %w", err)
+		}
+		delete(object, "value")
+	}
 
-type DeleteSkillResponseObject interface {
-	VisitDeleteSkillResponse(w http.ResponseWriter) error
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type DeleteSkill200JSONResponse SuccessResponse
+// Override default JSON handling for ShadowedValue to handle AdditionalProperties
+func (a ShadowedValue) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-func (response DeleteSkill200JSONResponse) VisitDeleteSkillResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
+
+	object["value"], err = json.Marshal(a.Value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'value': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-type GetSkillRequestObject struct {
-	Scope  string `json:"scope"`
-	Name   string `json:"name"`
-	Params GetSkillParams
+// Getter for additional properties for SkillDetail. Returns the specified
+// element and whether it was found
+func (a SkillDetail) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
 }
 
-type GetSkillResponseObject interface {
-	VisitGetSkillResponse(w http.ResponseWriter) error
+// Setter for additional properties for SkillDetail
+func (a *SkillDetail) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
 }
 
-type GetSkill200JSONResponse SkillDetail
+// Override default JSON handling for SkillDetail to handle AdditionalProperties
+func (a *SkillDetail) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
 
-func (response GetSkill200JSONResponse) VisitGetSkillResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
 
-	return json.NewEncoder(w).Encode(response)
-}
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
 
-type UpdateSkillRequestObject struct {
-	Scope string `json:"scope"`
-	Name  string `json:"name"`
-	Body  *UpdateSkillJSONRequestBody
-}
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
 
-type UpdateSkillResponseObject interface {
-	VisitUpdateSkillResponse(w http.ResponseWriter) error
-}
+	if raw, found := object["folderName"]; found {
+		err = json.Unmarshal(raw, &a.FolderName)
+		if err != nil {
+			return fmt.Errorf("error reading 'folderName': %w", err)
+		}
+		delete(object, "folderName")
+	}
 
-type UpdateSkill200JSONResponse SkillDetail
+	if raw, found := object["isEditable"]; found {
+		err = json.Unmarshal(raw, &a.IsEditable)
+		if err != nil {
+			return fmt.Errorf("error reading 'isEditable': %w", err)
+		}
+		delete(object, "isEditable")
+	}
 
-func (response UpdateSkill200JSONResponse) VisitUpdateSkillResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "application/json")
-	w.WriteHeader(200)
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
 
-	return json.NewEncoder(w).Encode(response)
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
 }
 
-type WatchRequestObject struct {
-	Params WatchParams
-}
+// Override default JSON handling for SkillDetail to handle AdditionalProperties
+func (a SkillDetail) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
 
-type WatchResponseObject interface {
-	VisitWatchResponse(w http.ResponseWriter) error
-}
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	}
 
-type Watch200TexteventStreamResponse struct {
-	Body          io.Reader
-	ContentLength int64
+	object["description"], err = json.Marshal(a.Description)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	}
+
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	object["folderName"], err = json.Marshal(a.FolderName)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'folderName': %w", err)
+	}
+
+	object["isEditable"], err = json.Marshal(a.IsEditable)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'isEditable': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
 }
 
-func (response Watch200TexteventStreamResponse) VisitWatchResponse(w http.ResponseWriter) error {
-	w.Header().Set("Content-Type", "text/event-stream")
-	if response.ContentLength != 0 {
-		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+// Getter for additional properties for SkillFile. Returns the specified
+// element and whether it was found
+func (a SkillFile) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
 	}
-	w.WriteHeader(200)
+	return
+}
 
-	if closer, ok := response.Body.(io.ReadCloser); ok {
-		defer closer.Close()
+// Setter for additional properties for SkillFile
+func (a *SkillFile) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
 	}
-	_, err := io.Copy(w, response.Body)
-	return err
+	a.AdditionalProperties[fieldName] = value
 }
 
-// StrictServerInterface represents all server handlers.
-type StrictServerInterface interface {
-	// List agents
-	// (GET /api/agents)
-	GetAgents(ctx context.Context, request GetAgentsRequestObject) (GetAgentsResponseObject, error)
-	// Create an agent
-	// (POST /api/agents)
-	CreateAgent(ctx context.Context, request CreateAgentRequestObject) (CreateAgentResponseObject, error)
-	// Delete an agent
-	// (DELETE /api/agents/{name})
-	DeleteAgent(ctx context.Context, request DeleteAgentRequestObject) (DeleteAgentResponseObject, error)
-	// Get agent detail
-	// (GET /api/agents/{name})
+// Override default JSON handling for SkillFile to handle AdditionalProperties
+func (a *SkillFile) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["bodyPreview"]; found {
+		err = json.Unmarshal(raw, &a.BodyPreview)
+		if err != nil {
+			return fmt.Errorf("error reading 'bodyPreview': %w", err)
+		}
+		delete(object, "bodyPreview")
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
+
+	if raw, found := object["folderName"]; found {
+		err = json.Unmarshal(raw, &a.FolderName)
+		if err != nil {
+			return fmt.Errorf("error reading 'folderName': %w", err)
+		}
+		delete(object, "folderName")
+	}
+
+	if raw, found := object["isEditable"]; found {
+		err = json.Unmarshal(raw, &a.IsEditable)
+		if err != nil {
+			return fmt.Errorf("error reading 'isEditable': %w", err)
+		}
+		delete(object, "isEditable")
+	}
+
+	if raw, found := object["name"]; found {
+		err = json.Unmarshal(raw, &a.Name)
+		if err != nil {
+			return fmt.Errorf("error reading 'name': %w", err)
+		}
+		delete(object, "name")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SkillFile to handle AdditionalProperties
+func (a SkillFile) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["bodyPreview"], err = json.Marshal(a.BodyPreview)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'bodyPreview': %w", err)
+	}
+
+	object["description"], err = json.Marshal(a.Description)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'description': %w", err)
+	}
+
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	object["folderName"], err = json.Marshal(a.FolderName)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'folderName': %w", err)
+	}
+
+	object["isEditable"], err = json.Marshal(a.IsEditable)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'isEditable': %w", err)
+	}
+
+	object["name"], err = json.Marshal(a.Name)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'name': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StatusLineConfig. Returns the specified
+// element and whether it was found
+func (a StatusLineConfig) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusLineConfig
+func (a *StatusLineConfig) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusLineConfig to handle AdditionalProperties
+func (a *StatusLineConfig) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["command"]; found {
+		err = json.Unmarshal(raw, &a.Command)
+		if err != nil {
+			return fmt.Errorf("error reading 'command': %w", err)
+		}
+		delete(object, "command")
+	}
+
+	if raw, found := object["padding"]; found {
+		err = json.Unmarshal(raw, &a.Padding)
+		if err != nil {
+			return fmt.Errorf("error reading 'padding': %w", err)
+		}
+		delete(object, "padding")
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusLineConfig to handle AdditionalProperties
+func (a StatusLineConfig) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["command"], err = json.Marshal(a.Command)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'command': %w", err)
+	}
+
+	if a.Padding != nil {
+		object["padding"], err = json.Marshal(a.Padding)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'padding': %w", err)
+		}
+	}
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StatusLineEffective. Returns the specified
+// element and whether it was found
+func (a StatusLineEffective) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusLineEffective
+func (a *StatusLineEffective) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusLineEffective to handle AdditionalProperties
+func (a *StatusLineEffective) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
+
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
+
+	if raw, found := object["statusLine"]; found {
+		err = json.Unmarshal(raw, &a.StatusLine)
+		if err != nil {
+			return fmt.Errorf("error reading 'statusLine': %w", err)
+		}
+		delete(object, "statusLine")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusLineEffective to handle AdditionalProperties
+func (a StatusLineEffective) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
+
+	object["statusLine"], err = json.Marshal(a.StatusLine)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'statusLine': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StatusLineLayer. Returns the specified
+// element and whether it was found
+func (a StatusLineLayer) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusLineLayer
+func (a *StatusLineLayer) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusLineLayer to handle AdditionalProperties
+func (a *StatusLineLayer) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
+
+	if raw, found := object["etag"]; found {
+		err = json.Unmarshal(raw, &a.Etag)
+		if err != nil {
+			return fmt.Errorf("error reading 'etag': %w", err)
+		}
+		delete(object, "etag")
+	}
+
+	if raw, found := object["exists"]; found {
+		err = json.Unmarshal(raw, &a.Exists)
+		if err != nil {
+			return fmt.Errorf("error reading 'exists': %w", err)
+		}
+		delete(object, "exists")
+	}
+
+	if raw, found := object["filePath"]; found {
+		err = json.Unmarshal(raw, &a.FilePath)
+		if err != nil {
+			return fmt.Errorf("error reading 'filePath': %w", err)
+		}
+		delete(object, "filePath")
+	}
+
+	if raw, found := object["readOnly"]; found {
+		err = json.Unmarshal(raw, &a.ReadOnly)
+		if err != nil {
+			return fmt.Errorf("error reading 'readOnly': %w", err)
+		}
+		delete(object, "readOnly")
+	}
+
+	if raw, found := object["source"]; found {
+		err = json.Unmarshal(raw, &a.Source)
+		if err != nil {
+			return fmt.Errorf("error reading 'source': %w", err)
+		}
+		delete(object, "source")
+	}
+
+	if raw, found := object["statusLine"]; found {
+		err = json.Unmarshal(raw, &a.StatusLine)
+		if err != nil {
+			return fmt.Errorf("error reading 'statusLine': %w", err)
+		}
+		delete(object, "statusLine")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusLineLayer to handle AdditionalProperties
+func (a StatusLineLayer) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Error != nil {
+		object["error"], err = json.Marshal(a.Error)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'error': %w", err)
+		}
+	}
+
+	object["etag"], err = json.Marshal(a.Etag)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'etag': %w", err)
+	}
+
+	object["exists"], err = json.Marshal(a.Exists)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exists': %w", err)
+	}
+
+	object["filePath"], err = json.Marshal(a.FilePath)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'filePath': %w", err)
+	}
+
+	object["readOnly"], err = json.Marshal(a.ReadOnly)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'readOnly': %w", err)
+	}
+
+	object["source"], err = json.Marshal(a.Source)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'source': %w", err)
+	}
+
+	if a.StatusLine != nil {
+		object["statusLine"], err = json.Marshal(a.StatusLine)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'statusLine': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StatusLineResponse. Returns the specified
+// element and whether it was found
+func (a StatusLineResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusLineResponse
+func (a *StatusLineResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusLineResponse to handle AdditionalProperties
+func (a *StatusLineResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["effective"]; found {
+		err = json.Unmarshal(raw, &a.Effective)
+		if err != nil {
+			return fmt.Errorf("error reading 'effective': %w", err)
+		}
+		delete(object, "effective")
+	}
+
+	if raw, found := object["layers"]; found {
+		err = json.Unmarshal(raw, &a.Layers)
+		if err != nil {
+			return fmt.Errorf("error reading 'layers': %w", err)
+		}
+		delete(object, "layers")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusLineResponse to handle AdditionalProperties
+func (a StatusLineResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Effective != nil {
+		object["effective"], err = json.Marshal(a.Effective)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'effective': %w", err)
+		}
+	}
+
+	if a.Layers != nil {
+		object["layers"], err = json.Marshal(a.Layers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layers': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StatusLineTestRequest. Returns the specified
+// element and whether it was found
+func (a StatusLineTestRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusLineTestRequest
+func (a *StatusLineTestRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusLineTestRequest to handle AdditionalProperties
+func (a *StatusLineTestRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["command"]; found {
+		err = json.Unmarshal(raw, &a.Command)
+		if err != nil {
+			return fmt.Errorf("error reading 'command': %w", err)
+		}
+		delete(object, "command")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["session"]; found {
+		err = json.Unmarshal(raw, &a.Session)
+		if err != nil {
+			return fmt.Errorf("error reading 'session': %w", err)
+		}
+		delete(object, "session")
+	}
+
+	if raw, found := object["timeoutMs"]; found {
+		err = json.Unmarshal(raw, &a.TimeoutMs)
+		if err != nil {
+			return fmt.Errorf("error reading 'timeoutMs': %w", err)
+		}
+		delete(object, "timeoutMs")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusLineTestRequest to handle AdditionalProperties
+func (a StatusLineTestRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Command != nil {
+		object["command"], err = json.Marshal(a.Command)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'command': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	if a.Session != nil {
+		object["session"], err = json.Marshal(a.Session)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'session': %w", err)
+		}
+	}
+
+	if a.TimeoutMs != nil {
+		object["timeoutMs"], err = json.Marshal(a.TimeoutMs)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'timeoutMs': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for StatusLineTestResult. Returns the specified
+// element and whether it was found
+func (a StatusLineTestResult) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for StatusLineTestResult
+func (a *StatusLineTestResult) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for StatusLineTestResult to handle AdditionalProperties
+func (a *StatusLineTestResult) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["durationMs"]; found {
+		err = json.Unmarshal(raw, &a.DurationMs)
+		if err != nil {
+			return fmt.Errorf("error reading 'durationMs': %w", err)
+		}
+		delete(object, "durationMs")
+	}
+
+	if raw, found := object["exitCode"]; found {
+		err = json.Unmarshal(raw, &a.ExitCode)
+		if err != nil {
+			return fmt.Errorf("error reading 'exitCode': %w", err)
+		}
+		delete(object, "exitCode")
+	}
+
+	if raw, found := object["input"]; found {
+		err = json.Unmarshal(raw, &a.Input)
+		if err != nil {
+			return fmt.Errorf("error reading 'input': %w", err)
+		}
+		delete(object, "input")
+	}
+
+	if raw, found := object["output"]; found {
+		err = json.Unmarshal(raw, &a.Output)
+		if err != nil {
+			return fmt.Errorf("error reading 'output': %w", err)
+		}
+		delete(object, "output")
+	}
+
+	if raw, found := object["stderr"]; found {
+		err = json.Unmarshal(raw, &a.Stderr)
+		if err != nil {
+			return fmt.Errorf("error reading 'stderr': %w", err)
+		}
+		delete(object, "stderr")
+	}
+
+	if raw, found := object["timedOut"]; found {
+		err = json.Unmarshal(raw, &a.TimedOut)
+		if err != nil {
+			return fmt.Errorf("error reading 'timedOut': %w", err)
+		}
+		delete(object, "timedOut")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for StatusLineTestResult to handle AdditionalProperties
+func (a StatusLineTestResult) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["durationMs"], err = json.Marshal(a.DurationMs)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'durationMs': %w", err)
+	}
+
+	object["exitCode"], err = json.Marshal(a.ExitCode)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'exitCode': %w", err)
+	}
+
+	object["input"], err = json.Marshal(a.Input)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'input': %w", err)
+	}
+
+	object["output"], err = json.Marshal(a.Output)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'output': %w", err)
+	}
+
+	object["stderr"], err = json.Marshal(a.Stderr)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'stderr': %w", err)
+	}
+
+	object["timedOut"], err = json.Marshal(a.TimedOut)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'timedOut': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for SuccessResponse. Returns the specified
+// element and whether it was found
+func (a SuccessResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for SuccessResponse
+func (a *SuccessResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for SuccessResponse to handle AdditionalProperties
+func (a *SuccessResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["success"]; found {
+		err = json.Unmarshal(raw, &a.Success)
+		if err != nil {
+			return fmt.Errorf("error reading 'success': %w", err)
+		}
+		delete(object, "success")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for SuccessResponse to handle AdditionalProperties
+func (a SuccessResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["success"], err = json.Marshal(a.Success)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'success': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateAgentRequest. Returns the specified
+// element and whether it was found
+func (a UpdateAgentRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateAgentRequest
+func (a *UpdateAgentRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateAgentRequest to handle AdditionalProperties
+func (a *UpdateAgentRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
+
+	if raw, found := object["color"]; found {
+		err = json.Unmarshal(raw, &a.Color)
+		if err != nil {
+			return fmt.Errorf("error reading 'color': %w", err)
+		}
+		delete(object, "color")
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if raw, found := object["tools"]; found {
+		err = json.Unmarshal(raw, &a.Tools)
+		if err != nil {
+			return fmt.Errorf("error reading 'tools': %w", err)
+		}
+		delete(object, "tools")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateAgentRequest to handle AdditionalProperties
+func (a UpdateAgentRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	}
+
+	if a.Color != nil {
+		object["color"], err = json.Marshal(a.Color)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'color': %w", err)
+		}
+	}
+
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
+	if a.Tools != nil {
+		object["tools"], err = json.Marshal(a.Tools)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'tools': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateCommandRequest. Returns the specified
+// element and whether it was found
+func (a UpdateCommandRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateCommandRequest
+func (a *UpdateCommandRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateCommandRequest to handle AdditionalProperties
+func (a *UpdateCommandRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateCommandRequest to handle AdditionalProperties
+func (a UpdateCommandRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateConfigSettingRequest. Returns the specified
+// element and whether it was found
+func (a UpdateConfigSettingRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateConfigSettingRequest
+func (a *UpdateConfigSettingRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateConfigSettingRequest to handle AdditionalProperties
+func (a *UpdateConfigSettingRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["insert"]; found {
+		err = json.Unmarshal(raw, &a.Insert)
+		if err != nil {
+			return fmt.Errorf("error reading 'insert': %w", err)
+		}
+		delete(object, "insert")
+	}
+
+	if raw, found := object["keyPath"]; found {
+		err = json.Unmarshal(raw, &a.KeyPath)
+		if err != nil {
+			return fmt.Errorf("error reading 'keyPath': %w", err)
+		}
+		delete(object, "keyPath")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["value"]; found {
+		err = json.Unmarshal(raw, &a.Value)
+		if err != nil {
+			return fmt.Errorf("error reading 'value': %w", err)
+		}
+		delete(object, "value")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateConfigSettingRequest to handle AdditionalProperties
+func (a UpdateConfigSettingRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Insert != nil {
+		object["insert"], err = json.Marshal(a.Insert)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'insert': %w", err)
+		}
+	}
+
+	if a.KeyPath != nil {
+		object["keyPath"], err = json.Marshal(a.KeyPath)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keyPath': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["value"], err = json.Marshal(a.Value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'value': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateFeatureRequest. Returns the specified
+// element and whether it was found
+func (a UpdateFeatureRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateFeatureRequest
+func (a *UpdateFeatureRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateFeatureRequest to handle AdditionalProperties
+func (a *UpdateFeatureRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["type"]; found {
+		err = json.Unmarshal(raw, &a.Type)
+		if err != nil {
+			return fmt.Errorf("error reading 'type': %w", err)
+		}
+		delete(object, "type")
+	}
+
+	if raw, found := object["value"]; found {
+		err = json.Unmarshal(raw, &a.Value)
+		if err != nil {
+			return fmt.Errorf("error reading 'value': %w", err)
+		}
+		delete(object, "value")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateFeatureRequest to handle AdditionalProperties
+func (a UpdateFeatureRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["type"], err = json.Marshal(a.Type)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'type': %w", err)
+	}
+
+	object["value"], err = json.Marshal(a.Value)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'value': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateHookRequest. Returns the specified
+// element and whether it was found
+func (a UpdateHookRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateHookRequest
+func (a *UpdateHookRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateHookRequest to handle AdditionalProperties
+func (a *UpdateHookRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["commands"]; found {
+		err = json.Unmarshal(raw, &a.Commands)
+		if err != nil {
+			return fmt.Errorf("error reading 'commands': %w", err)
+		}
+		delete(object, "commands")
+	}
+
+	if raw, found := object["event"]; found {
+		err = json.Unmarshal(raw, &a.Event)
+		if err != nil {
+			return fmt.Errorf("error reading 'event': %w", err)
+		}
+		delete(object, "event")
+	}
+
+	if raw, found := object["hooks"]; found {
+		err = json.Unmarshal(raw, &a.Hooks)
+		if err != nil {
+			return fmt.Errorf("error reading 'hooks': %w", err)
+		}
+		delete(object, "hooks")
+	}
+
+	if raw, found := object["matcher"]; found {
+		err = json.Unmarshal(raw, &a.Matcher)
+		if err != nil {
+			return fmt.Errorf("error reading 'matcher': %w", err)
+		}
+		delete(object, "matcher")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateHookRequest to handle AdditionalProperties
+func (a UpdateHookRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Commands != nil {
+		object["commands"], err = json.Marshal(a.Commands)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'commands': %w", err)
+		}
+	}
+
+	object["event"], err = json.Marshal(a.Event)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'event': %w", err)
+	}
+
+	if a.Hooks != nil {
+		object["hooks"], err = json.Marshal(a.Hooks)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'hooks': %w", err)
+		}
+	}
+
+	if a.Matcher != nil {
+		object["matcher"], err = json.Marshal(a.Matcher)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'matcher': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateInstructionsRequest. Returns the specified
+// element and whether it was found
+func (a UpdateInstructionsRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateInstructionsRequest
+func (a *UpdateInstructionsRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateInstructionsRequest to handle AdditionalProperties
+func (a *UpdateInstructionsRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["content"]; found {
+		err = json.Unmarshal(raw, &a.Content)
+		if err != nil {
+			return fmt.Errorf("error reading 'content': %w", err)
+		}
+		delete(object, "content")
+	}
+
+	if raw, found := object["file"]; found {
+		err = json.Unmarshal(raw, &a.File)
+		if err != nil {
+			return fmt.Errorf("error reading 'file': %w", err)
+		}
+		delete(object, "file")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateInstructionsRequest to handle AdditionalProperties
+func (a UpdateInstructionsRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["content"], err = json.Marshal(a.Content)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'content': %w", err)
+	}
+
+	object["file"], err = json.Marshal(a.File)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'file': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	if a.Scope != nil {
+		object["scope"], err = json.Marshal(a.Scope)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateMcpApprovalsRequest. Returns the specified
+// element and whether it was found
+func (a UpdateMcpApprovalsRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateMcpApprovalsRequest
+func (a *UpdateMcpApprovalsRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateMcpApprovalsRequest to handle AdditionalProperties
+func (a *UpdateMcpApprovalsRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["servers"]; found {
+		err = json.Unmarshal(raw, &a.Servers)
+		if err != nil {
+			return fmt.Errorf("error reading 'servers': %w", err)
+		}
+		delete(object, "servers")
+	}
+
+	if raw, found := object["state"]; found {
+		err = json.Unmarshal(raw, &a.State)
+		if err != nil {
+			return fmt.Errorf("error reading 'state': %w", err)
+		}
+		delete(object, "state")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateMcpApprovalsRequest to handle AdditionalProperties
+func (a UpdateMcpApprovalsRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.Layer != nil {
+		object["layer"], err = json.Marshal(a.Layer)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+		}
+	}
+
+	object["projectId"], err = json.Marshal(a.ProjectId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+	}
+
+	if a.Servers != nil {
+		object["servers"], err = json.Marshal(a.Servers)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'servers': %w", err)
+		}
+	}
+
+	object["state"], err = json.Marshal(a.State)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'state': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateMcpServerRequest. Returns the specified
+// element and whether it was found
+func (a UpdateMcpServerRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateMcpServerRequest
+func (a *UpdateMcpServerRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateMcpServerRequest to handle AdditionalProperties
+func (a *UpdateMcpServerRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["config"]; found {
+		err = json.Unmarshal(raw, &a.Config)
+		if err != nil {
+			return fmt.Errorf("error reading 'config': %w", err)
+		}
+		delete(object, "config")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateMcpServerRequest to handle AdditionalProperties
+func (a UpdateMcpServerRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["config"], err = json.Marshal(a.Config)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'config': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateMemoryRequest. Returns the specified
+// element and whether it was found
+func (a UpdateMemoryRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateMemoryRequest
+func (a *UpdateMemoryRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateMemoryRequest to handle AdditionalProperties
+func (a *UpdateMemoryRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["content"]; found {
+		err = json.Unmarshal(raw, &a.Content)
+		if err != nil {
+			return fmt.Errorf("error reading 'content': %w", err)
+		}
+		delete(object, "content")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateMemoryRequest to handle AdditionalProperties
+func (a UpdateMemoryRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["content"], err = json.Marshal(a.Content)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'content': %w", err)
+	}
+
+	object["projectId"], err = json.Marshal(a.ProjectId)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateOutputStyleRequest. Returns the specified
+// element and whether it was found
+func (a UpdateOutputStyleRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateOutputStyleRequest
+func (a *UpdateOutputStyleRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateOutputStyleRequest to handle AdditionalProperties
+func (a *UpdateOutputStyleRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["keepCodingInstructions"]; found {
+		err = json.Unmarshal(raw, &a.KeepCodingInstructions)
+		if err != nil {
+			return fmt.Errorf("error reading 'keepCodingInstructions': %w", err)
+		}
+		delete(object, "keepCodingInstructions")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["scope"]; found {
+		err = json.Unmarshal(raw, &a.Scope)
+		if err != nil {
+			return fmt.Errorf("error reading 'scope': %w", err)
+		}
+		delete(object, "scope")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateOutputStyleRequest to handle AdditionalProperties
+func (a UpdateOutputStyleRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	}
+
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
+
+	if a.KeepCodingInstructions != nil {
+		object["keepCodingInstructions"], err = json.Marshal(a.KeepCodingInstructions)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'keepCodingInstructions': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["scope"], err = json.Marshal(a.Scope)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'scope': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateOutputStyleSettingRequest. Returns the specified
+// element and whether it was found
+func (a UpdateOutputStyleSettingRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateOutputStyleSettingRequest
+func (a *UpdateOutputStyleSettingRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateOutputStyleSettingRequest to handle AdditionalProperties
+func (a *UpdateOutputStyleSettingRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["outputStyle"]; found {
+		err = json.Unmarshal(raw, &a.OutputStyle)
+		if err != nil {
+			return fmt.Errorf("error reading 'outputStyle': %w", err)
+		}
+		delete(object, "outputStyle")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateOutputStyleSettingRequest to handle AdditionalProperties
+func (a UpdateOutputStyleSettingRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
+
+	if a.OutputStyle != nil {
+		object["outputStyle"], err = json.Marshal(a.OutputStyle)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'outputStyle': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdatePermissionRuleRequest. Returns the specified
+// element and whether it was found
+func (a UpdatePermissionRuleRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdatePermissionRuleRequest
+func (a *UpdatePermissionRuleRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdatePermissionRuleRequest to handle AdditionalProperties
+func (a *UpdatePermissionRuleRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["behavior"]; found {
+		err = json.Unmarshal(raw, &a.Behavior)
+		if err != nil {
+			return fmt.Errorf("error reading 'behavior': %w", err)
+		}
+		delete(object, "behavior")
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["newBehavior"]; found {
+		err = json.Unmarshal(raw, &a.NewBehavior)
+		if err != nil {
+			return fmt.Errorf("error reading 'newBehavior': %w", err)
+		}
+		delete(object, "newBehavior")
+	}
+
+	if raw, found := object["newRule"]; found {
+		err = json.Unmarshal(raw, &a.NewRule)
+		if err != nil {
+			return fmt.Errorf("error reading 'newRule': %w", err)
+		}
+		delete(object, "newRule")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["rule"]; found {
+		err = json.Unmarshal(raw, &a.Rule)
+		if err != nil {
+			return fmt.Errorf("error reading 'rule': %w", err)
+		}
+		delete(object, "rule")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdatePermissionRuleRequest to handle AdditionalProperties
+func (a UpdatePermissionRuleRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["behavior"], err = json.Marshal(a.Behavior)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'behavior': %w", err)
+	}
+
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
+
+	if a.NewBehavior != nil {
+		object["newBehavior"], err = json.Marshal(a.NewBehavior)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'newBehavior': %w", err)
+		}
+	}
+
+	object["newRule"], err = json.Marshal(a.NewRule)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'newRule': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	object["rule"], err = json.Marshal(a.Rule)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'rule': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdatePermissionSettingsRequest. Returns the specified
+// element and whether it was found
+func (a UpdatePermissionSettingsRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdatePermissionSettingsRequest
+func (a *UpdatePermissionSettingsRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdatePermissionSettingsRequest to handle AdditionalProperties
+func (a *UpdatePermissionSettingsRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["additionalDirectories"]; found {
+		err = json.Unmarshal(raw, &a.AdditionalDirectories)
+		if err != nil {
+			return fmt.Errorf("error reading 'additionalDirectories': %w", err)
+		}
+		delete(object, "additionalDirectories")
+	}
+
+	if raw, found := object["defaultMode"]; found {
+		err = json.Unmarshal(raw, &a.DefaultMode)
+		if err != nil {
+			return fmt.Errorf("error reading 'defaultMode': %w", err)
+		}
+		delete(object, "defaultMode")
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdatePermissionSettingsRequest to handle AdditionalProperties
+func (a UpdatePermissionSettingsRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	if a.AdditionalDirectories != nil {
+		object["additionalDirectories"], err = json.Marshal(a.AdditionalDirectories)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'additionalDirectories': %w", err)
+		}
+	}
+
+	if a.DefaultMode != nil {
+		object["defaultMode"], err = json.Marshal(a.DefaultMode)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'defaultMode': %w", err)
+		}
+	}
+
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateSkillRequest. Returns the specified
+// element and whether it was found
+func (a UpdateSkillRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateSkillRequest
+func (a *UpdateSkillRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateSkillRequest to handle AdditionalProperties
+func (a *UpdateSkillRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["body"]; found {
+		err = json.Unmarshal(raw, &a.Body)
+		if err != nil {
+			return fmt.Errorf("error reading 'body': %w", err)
+		}
+		delete(object, "body")
+	}
+
+	if raw, found := object["description"]; found {
+		err = json.Unmarshal(raw, &a.Description)
+		if err != nil {
+			return fmt.Errorf("error reading 'description': %w", err)
+		}
+		delete(object, "description")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateSkillRequest to handle AdditionalProperties
+func (a UpdateSkillRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["body"], err = json.Marshal(a.Body)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'body': %w", err)
+	}
+
+	if a.Description != nil {
+		object["description"], err = json.Marshal(a.Description)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'description': %w", err)
+		}
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for UpdateStatusLineRequest. Returns the specified
+// element and whether it was found
+func (a UpdateStatusLineRequest) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for UpdateStatusLineRequest
+func (a *UpdateStatusLineRequest) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for UpdateStatusLineRequest to handle AdditionalProperties
+func (a *UpdateStatusLineRequest) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["layer"]; found {
+		err = json.Unmarshal(raw, &a.Layer)
+		if err != nil {
+			return fmt.Errorf("error reading 'layer': %w", err)
+		}
+		delete(object, "layer")
+	}
+
+	if raw, found := object["projectId"]; found {
+		err = json.Unmarshal(raw, &a.ProjectId)
+		if err != nil {
+			return fmt.Errorf("error reading 'projectId': %w", err)
+		}
+		delete(object, "projectId")
+	}
+
+	if raw, found := object["statusLine"]; found {
+		err = json.Unmarshal(raw, &a.StatusLine)
+		if err != nil {
+			return fmt.Errorf("error reading 'statusLine': %w", err)
+		}
+		delete(object, "statusLine")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for UpdateStatusLineRequest to handle AdditionalProperties
+func (a UpdateStatusLineRequest) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["layer"], err = json.Marshal(a.Layer)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'layer': %w", err)
+	}
+
+	if a.ProjectId != nil {
+		object["projectId"], err = json.Marshal(a.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'projectId': %w", err)
+		}
+	}
+
+	if a.StatusLine != nil {
+		object["statusLine"], err = json.Marshal(a.StatusLine)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'statusLine': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ValidationErrorResponse. Returns the specified
+// element and whether it was found
+func (a ValidationErrorResponse) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ValidationErrorResponse
+func (a *ValidationErrorResponse) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ValidationErrorResponse to handle AdditionalProperties
+func (a *ValidationErrorResponse) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["error"]; found {
+		err = json.Unmarshal(raw, &a.Error)
+		if err != nil {
+			return fmt.Errorf("error reading 'error': %w", err)
+		}
+		delete(object, "error")
+	}
+
+	if raw, found := object["issues"]; found {
+		err = json.Unmarshal(raw, &a.Issues)
+		if err != nil {
+			return fmt.Errorf("error reading 'issues': %w", err)
+		}
+		delete(object, "issues")
+	}
+
+	if raw, found := object["warnings"]; found {
+		err = json.Unmarshal(raw, &a.Warnings)
+		if err != nil {
+			return fmt.Errorf("error reading 'warnings': %w", err)
+		}
+		delete(object, "warnings")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ValidationErrorResponse to handle AdditionalProperties
+func (a ValidationErrorResponse) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["error"], err = json.Marshal(a.Error)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'error': %w", err)
+	}
+
+	if a.Issues != nil {
+		object["issues"], err = json.Marshal(a.Issues)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'issues': %w", err)
+		}
+	}
+
+	if a.Warnings != nil {
+		object["warnings"], err = json.Marshal(a.Warnings)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling 'warnings': %w", err)
+		}
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// Getter for additional properties for ValidationIssue. Returns the specified
+// element and whether it was found
+func (a ValidationIssue) Get(fieldName string) (value interface{}, found bool) {
+	if a.AdditionalProperties != nil {
+		value, found = a.AdditionalProperties[fieldName]
+	}
+	return
+}
+
+// Setter for additional properties for ValidationIssue
+func (a *ValidationIssue) Set(fieldName string, value interface{}) {
+	if a.AdditionalProperties == nil {
+		a.AdditionalProperties = make(map[string]interface{})
+	}
+	a.AdditionalProperties[fieldName] = value
+}
+
+// Override default JSON handling for ValidationIssue to handle AdditionalProperties
+func (a *ValidationIssue) UnmarshalJSON(b []byte) error {
+	object := make(map[string]json.RawMessage)
+	err := json.Unmarshal(b, &object)
+	if err != nil {
+		return err
+	}
+
+	if raw, found := object["message"]; found {
+		err = json.Unmarshal(raw, &a.Message)
+		if err != nil {
+			return fmt.Errorf("error reading 'message': %w", err)
+		}
+		delete(object, "message")
+	}
+
+	if raw, found := object["path"]; found {
+		err = json.Unmarshal(raw, &a.Path)
+		if err != nil {
+			return fmt.Errorf("error reading 'path': %w", err)
+		}
+		delete(object, "path")
+	}
+
+	if len(object) != 0 {
+		a.AdditionalProperties = make(map[string]interface{})
+		for fieldName, fieldBuf := range object {
+			var fieldVal interface{}
+			err := json.Unmarshal(fieldBuf, &fieldVal)
+			if err != nil {
+				return fmt.Errorf("error unmarshaling field %s: %w", fieldName, err)
+			}
+			a.AdditionalProperties[fieldName] = fieldVal
+		}
+	}
+	return nil
+}
+
+// Override default JSON handling for ValidationIssue to handle AdditionalProperties
+func (a ValidationIssue) MarshalJSON() ([]byte, error) {
+	var err error
+	object := make(map[string]json.RawMessage)
+
+	object["message"], err = json.Marshal(a.Message)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'message': %w", err)
+	}
+
+	object["path"], err = json.Marshal(a.Path)
+	if err != nil {
+		return nil, fmt.Errorf("error marshaling 'path': %w", err)
+	}
+
+	for fieldName, field := range a.AdditionalProperties {
+		object[fieldName], err = json.Marshal(field)
+		if err != nil {
+			return nil, fmt.Errorf("error marshaling '%s': %w", fieldName, err)
+		}
+	}
+	return json.Marshal(object)
+}
+
+// ServerInterface represents all server handlers.
+type ServerInterface interface {
+	// List agents
+	// (GET /api/agents)
+	GetAgents(w http.ResponseWriter, r *http.Request, params GetAgentsParams)
+	// Create an agent
+	// (POST /api/agents)
+	CreateAgent(w http.ResponseWriter, r *http.Request)
+	// Delete an agent
+	// (DELETE /api/agents/{name})
+	DeleteAgent(w http.ResponseWriter, r *http.Request, name string, params DeleteAgentParams)
+	// Get agent detail
+	// (GET /api/agents/{name})
+	GetAgent(w http.ResponseWriter, r *http.Request, name string, params GetAgentParams)
+	// Update an agent
+	// (PUT /api/agents/{name})
+	UpdateAgent(w http.ResponseWriter, r *http.Request, name string, params UpdateAgentParams)
+	// Login with password
+	// (POST /api/auth/login)
+	Login(w http.ResponseWriter, r *http.Request)
+	// Logout
+	// (POST /api/auth/logout)
+	Logout(w http.ResponseWriter, r *http.Request)
+	// Set initial password (only allowed when no credentials exist)
+	// (POST /api/auth/setup)
+	SetupAuth(w http.ResponseWriter, r *http.Request)
+	// Get authentication status
+	// (GET /api/auth/status)
+	GetAuthStatus(w http.ResponseWriter, r *http.Request)
+	// List backups
+	// (GET /api/backups)
+	GetBackups(w http.ResponseWriter, r *http.Request)
+	// Restore a backup
+	// (POST /api/backups/{id}/restore)
+	RestoreBackup(w http.ResponseWriter, r *http.Request, id string)
+	// List commands
+	// (GET /api/commands)
+	GetCommands(w http.ResponseWriter, r *http.Request, params GetCommandsParams)
+	// Create a command
+	// (POST /api/commands)
+	CreateCommand(w http.ResponseWriter, r *http.Request)
+	// Delete a command
+	// (DELETE /api/commands/{scope}/{folder}/{name})
+	DeleteCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params DeleteCommandParams)
+	// Get command detail
+	// (GET /api/commands/{scope}/{folder}/{name})
+	GetCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params GetCommandParams)
+	// Update a command
+	// (PUT /api/commands/{scope}/{folder}/{name})
+	UpdateCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params UpdateCommandParams)
+	// Get merged config with layers
+	// (GET /api/config)
+	GetConfig(w http.ResponseWriter, r *http.Request, params GetConfigParams)
+	// Apply a JSON Patch across one or more settings layers atomically
+	// (POST /api/config/patch)
+	PatchConfig(w http.ResponseWriter, r *http.Request, params PatchConfigParams)
+	// Preview a best-effort repair of a settings file that fails to parse
+	// (GET /api/config/repair)
+	GetConfigRepair(w http.ResponseWriter, r *http.Request, params GetConfigRepairParams)
+	// Replace a broken settings file with its repaired version
+	// (POST /api/config/repair)
+	RepairConfigLayer(w http.ResponseWriter, r *http.Request, params RepairConfigLayerParams)
+	// Delete a config setting
+	// (DELETE /api/config/setting)
+	DeleteConfigSetting(w http.ResponseWriter, r *http.Request, params DeleteConfigSettingParams)
+	// Update a config setting
+	// (POST /api/config/setting)
+	UpdateConfigSetting(w http.ResponseWriter, r *http.Request, params UpdateConfigSettingParams)
+	// Move or copy a config setting between settings layers
+	// (POST /api/config/setting/move)
+	MoveConfigSetting(w http.ResponseWriter, r *http.Request, params MoveConfigSettingParams)
+	// Get features data
+	// (GET /api/features)
+	GetFeatures(w http.ResponseWriter, r *http.Request)
+	// Delete (reset) a feature value
+	// (DELETE /api/features/{key})
+	DeleteFeature(w http.ResponseWriter, r *http.Request, key string, params DeleteFeatureParams)
+	// Update a feature value
+	// (PUT /api/features/{key})
+	UpdateFeature(w http.ResponseWriter, r *http.Request, key string, params UpdateFeatureParams)
+	// Health check
+	// (GET /api/health)
+	GetHealth(w http.ResponseWriter, r *http.Request)
+	// List hooks
+	// (GET /api/hooks)
+	GetHooks(w http.ResponseWriter, r *http.Request, params GetHooksParams)
+	// Create a hook
+	// (POST /api/hooks)
+	CreateHook(w http.ResponseWriter, r *http.Request, params CreateHookParams)
+	// List the hook events Claude Code runs hooks for
+	// (GET /api/hooks/events)
+	GetHookEvents(w http.ResponseWriter, r *http.Request)
+	// List the known tools a hook matcher matches
+	// (POST /api/hooks/matcher-preview)
+	PreviewHookMatcher(w http.ResponseWriter, r *http.Request)
+	// Dry-run a command hook with a synthetic event on stdin
+	// (POST /api/hooks/test)
+	TestHook(w http.ResponseWriter, r *http.Request)
+	// Delete a hook
+	// (DELETE /api/hooks/{id})
+	DeleteHook(w http.ResponseWriter, r *http.Request, id string, params DeleteHookParams)
+	// Update a hook
+	// (PUT /api/hooks/{id})
+	UpdateHook(w http.ResponseWriter, r *http.Request, id string, params UpdateHookParams)
+	// Get CLAUDE.md and CLAUDE.local.md content
+	// (GET /api/instructions)
+	GetInstructions(w http.ResponseWriter, r *http.Request, params GetInstructionsParams)
+	// Write CLAUDE.md or CLAUDE.local.md
+	// (PUT /api/instructions)
+	UpdateInstructions(w http.ResponseWriter, r *http.Request, params UpdateInstructionsParams)
+	// List a project's .mcp.json servers with their approval state
+	// (GET /api/mcp/approvals)
+	GetMcpApprovals(w http.ResponseWriter, r *http.Request, params GetMcpApprovalsParams)
+	// Approve, reject or reset project .mcp.json servers
+	// (PUT /api/mcp/approvals)
+	UpdateMcpApprovals(w http.ResponseWriter, r *http.Request, params UpdateMcpApprovalsParams)
+	// Approve, reject or reset .mcp.json servers across registered projects
+	// (POST /api/mcp/approvals/bulk)
+	BulkUpdateMcpApprovals(w http.ResponseWriter, r *http.Request)
+	// List MCP servers of the user scope and, with a project, its local and project scopes
+	// (GET /api/mcp/servers)
+	GetMcpServers(w http.ResponseWriter, r *http.Request, params GetMcpServersParams)
+	// Add an MCP server to a scope
+	// (POST /api/mcp/servers)
+	CreateMcpServer(w http.ResponseWriter, r *http.Request, params CreateMcpServerParams)
+	// Remove an MCP server from a scope
+	// (DELETE /api/mcp/servers/{name})
+	DeleteMcpServer(w http.ResponseWriter, r *http.Request, name string, params DeleteMcpServerParams)
+	// Replace an MCP server definition
+	// (PUT /api/mcp/servers/{name})
+	UpdateMcpServer(w http.ResponseWriter, r *http.Request, name string, params UpdateMcpServerParams)
+	// Start a stdio MCP server, perform the initialize handshake and list its tools
+	// (POST /api/mcp/servers/{name}/probe)
+	ProbeMcpServer(w http.ResponseWriter, r *http.Request, name string)
+	// List memory files for a project
+	// (GET /api/memory)
+	ListMemory(w http.ResponseWriter, r *http.Request, params ListMemoryParams)
+	// Create a new memory file
+	// (POST /api/memory)
+	CreateMemory(w http.ResponseWriter, r *http.Request)
+	// Delete a memory file
+	// (DELETE /api/memory/{filename})
+	DeleteMemory(w http.ResponseWriter, r *http.Request, filename string, params DeleteMemoryParams)
+	// Get a single memory file
+	// (GET /api/memory/{filename})
+	GetMemory(w http.ResponseWriter, r *http.Request, filename string, params GetMemoryParams)
+	// Update a memory file
+	// (PUT /api/memory/{filename})
+	UpdateMemory(w http.ResponseWriter, r *http.Request, filename string, params UpdateMemoryParams)
+	// List custom output styles
+	// (GET /api/output-styles)
+	GetOutputStyles(w http.ResponseWriter, r *http.Request, params GetOutputStylesParams)
+	// Create an output style
+	// (POST /api/output-styles)
+	CreateOutputStyle(w http.ResponseWriter, r *http.Request)
+	// Delete an output style
+	// (DELETE /api/output-styles/{name})
+	DeleteOutputStyle(w http.ResponseWriter, r *http.Request, name string, params DeleteOutputStyleParams)
+	// Get output style detail
+	// (GET /api/output-styles/{name})
+	GetOutputStyle(w http.ResponseWriter, r *http.Request, name string, params GetOutputStyleParams)
+	// Update an output style
+	// (PUT /api/output-styles/{name})
+	UpdateOutputStyle(w http.ResponseWriter, r *http.Request, name string, params UpdateOutputStyleParams)
+	// Show the outputStyle setting of every layer, the one in effect and the styles available
+	// (GET /api/outputstyle)
+	GetOutputStyleSetting(w http.ResponseWriter, r *http.Request, params GetOutputStyleSettingParams)
+	// Set or remove the outputStyle setting of a layer
+	// (PUT /api/outputstyle)
+	UpdateOutputStyleSetting(w http.ResponseWriter, r *http.Request, params UpdateOutputStyleSettingParams)
+	// List permission rules of every layer, parsed, with their source
+	// (GET /api/permissions)
+	GetPermissions(w http.ResponseWriter, r *http.Request, params GetPermissionsParams)
+	// Decide a hypothetical tool call against the rules of every layer
+	// (POST /api/permissions/evaluate)
+	EvaluatePermission(w http.ResponseWriter, r *http.Request)
+	// Remove a permission rule from a layer
+	// (DELETE /api/permissions/rules)
+	DeletePermissionRule(w http.ResponseWriter, r *http.Request, params DeletePermissionRuleParams)
+	// Append a permission rule to a layer
+	// (POST /api/permissions/rules)
+	AddPermissionRule(w http.ResponseWriter, r *http.Request, params AddPermissionRuleParams)
+	// Replace a permission rule, optionally moving it to another list
+	// (PUT /api/permissions/rules)
+	UpdatePermissionRule(w http.ResponseWriter, r *http.Request, params UpdatePermissionRuleParams)
+	// Set or clear defaultMode and additionalDirectories in a layer
+	// (PUT /api/permissions/settings)
+	UpdatePermissionSettings(w http.ResponseWriter, r *http.Request, params UpdatePermissionSettingsParams)
+	// List plugins
+	// (GET /api/plugins)
+	GetPlugins(w http.ResponseWriter, r *http.Request)
+	// List projects
+	// (GET /api/projects)
+	GetProjects(w http.ResponseWriter, r *http.Request)
+	// Register one or more projects by filesystem path
+	// (POST /api/projects)
+	PostProjects(w http.ResponseWriter, r *http.Request)
+	// Scan a folder for subdirectories containing .claude/
+	// (GET /api/projects/scan)
+	ScanProjects(w http.ResponseWriter, r *http.Request, params ScanProjectsParams)
+	// Remove a registered project
+	// (DELETE /api/projects/{projectId})
+	DeleteProject(w http.ResponseWriter, r *http.Request, projectId string)
+	// Reveal one redacted settings or MCP server value
+	// (POST /api/redaction/reveal)
+	RevealRedactedValue(w http.ResponseWriter, r *http.Request)
+	// Search across all resources
+	// (GET /api/search)
+	Search(w http.ResponseWriter, r *http.Request, params SearchParams)
+	// Scan managed Claude files for secrets
+	// (GET /api/secrets/scan)
+	ScanSecrets(w http.ResponseWriter, r *http.Request)
+	// List skills
+	// (GET /api/skills)
+	GetSkills(w http.ResponseWriter, r *http.Request, params GetSkillsParams)
+	// Create a skill
+	// (POST /api/skills)
+	CreateSkill(w http.ResponseWriter, r *http.Request)
+	// Delete a skill
+	// (DELETE /api/skills/{scope}/{name})
+	DeleteSkill(w http.ResponseWriter, r *http.Request, scope string, name string, params DeleteSkillParams)
+	// Get skill detail
+	// (GET /api/skills/{scope}/{name})
+	GetSkill(w http.ResponseWriter, r *http.Request, scope string, name string, params GetSkillParams)
+	// Update a skill
+	// (PUT /api/skills/{scope}/{name})
+	UpdateSkill(w http.ResponseWriter, r *http.Request, scope string, name string, params UpdateSkillParams)
+	// Show the statusLine setting of every layer and the one in effect
+	// (GET /api/statusline)
+	GetStatusLine(w http.ResponseWriter, r *http.Request, params GetStatusLineParams)
+	// Set or remove the statusLine setting of a layer
+	// (PUT /api/statusline)
+	UpdateStatusLine(w http.ResponseWriter, r *http.Request, params UpdateStatusLineParams)
+	// Run a status line command with a synthetic session on stdin
+	// (POST /api/statusline/test)
+	TestStatusLine(w http.ResponseWriter, r *http.Request)
+	// SSE file watcher stream
+	// (GET /api/watch)
+	Watch(w http.ResponseWriter, r *http.Request, params WatchParams)
+}
+
+// ServerInterfaceWrapper converts contexts to parameters.
+type ServerInterfaceWrapper struct {
+	Handler            ServerInterface
+	HandlerMiddlewares []MiddlewareFunc
+	ErrorHandlerFunc   func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+type MiddlewareFunc func(http.Handler) http.Handler
+
+// GetAgents operation middleware
+func (siw *ServerInterfaceWrapper) GetAgents(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgentsParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgents(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateAgent operation middleware
+func (siw *ServerInterfaceWrapper) CreateAgent(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateAgent(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteAgent operation middleware
+func (siw *ServerInterfaceWrapper) DeleteAgent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteAgentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteAgent(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAgent operation middleware
+func (siw *ServerInterfaceWrapper) GetAgent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetAgentParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAgent(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateAgent operation middleware
+func (siw *ServerInterfaceWrapper) UpdateAgent(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateAgentParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateAgent(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Login operation middleware
+func (siw *ServerInterfaceWrapper) Login(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Login(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Logout operation middleware
+func (siw *ServerInterfaceWrapper) Logout(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Logout(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// SetupAuth operation middleware
+func (siw *ServerInterfaceWrapper) SetupAuth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.SetupAuth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetAuthStatus operation middleware
+func (siw *ServerInterfaceWrapper) GetAuthStatus(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetAuthStatus(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetBackups operation middleware
+func (siw *ServerInterfaceWrapper) GetBackups(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetBackups(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RestoreBackup operation middleware
+func (siw *ServerInterfaceWrapper) RestoreBackup(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RestoreBackup(w, r, id)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCommands operation middleware
+func (siw *ServerInterfaceWrapper) GetCommands(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCommandsParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCommands(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateCommand operation middleware
+func (siw *ServerInterfaceWrapper) CreateCommand(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateCommand(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteCommand operation middleware
+func (siw *ServerInterfaceWrapper) DeleteCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "folder" -------------
+	var folder string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folder", r.PathValue("folder"), &folder, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteCommandParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteCommand(w, r, scope, folder, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetCommand operation middleware
+func (siw *ServerInterfaceWrapper) GetCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "folder" -------------
+	var folder string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folder", r.PathValue("folder"), &folder, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetCommandParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetCommand(w, r, scope, folder, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateCommand operation middleware
+func (siw *ServerInterfaceWrapper) UpdateCommand(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "folder" -------------
+	var folder string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "folder", r.PathValue("folder"), &folder, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateCommandParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateCommand(w, r, scope, folder, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConfig operation middleware
+func (siw *ServerInterfaceWrapper) GetConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConfigParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfig(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PatchConfig operation middleware
+func (siw *ServerInterfaceWrapper) PatchConfig(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PatchConfigParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PatchConfig(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetConfigRepair operation middleware
+func (siw *ServerInterfaceWrapper) GetConfigRepair(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetConfigRepairParams
+
+	// ------------- Required query parameter "layer" -------------
+
+	if paramValue := r.URL.Query().Get("layer"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "layer"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "layer", r.URL.Query(), &params.Layer)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "layer", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetConfigRepair(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RepairConfigLayer operation middleware
+func (siw *ServerInterfaceWrapper) RepairConfigLayer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params RepairConfigLayerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RepairConfigLayer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteConfigSetting operation middleware
+func (siw *ServerInterfaceWrapper) DeleteConfigSetting(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteConfigSettingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteConfigSetting(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateConfigSetting operation middleware
+func (siw *ServerInterfaceWrapper) UpdateConfigSetting(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateConfigSettingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateConfigSetting(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// MoveConfigSetting operation middleware
+func (siw *ServerInterfaceWrapper) MoveConfigSetting(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params MoveConfigSettingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.MoveConfigSetting(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetFeatures operation middleware
+func (siw *ServerInterfaceWrapper) GetFeatures(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetFeatures(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteFeature operation middleware
+func (siw *ServerInterfaceWrapper) DeleteFeature(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteFeatureParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteFeature(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateFeature operation middleware
+func (siw *ServerInterfaceWrapper) UpdateFeature(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "key" -------------
+	var key string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "key", r.PathValue("key"), &key, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "key", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateFeatureParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateFeature(w, r, key, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHealth operation middleware
+func (siw *ServerInterfaceWrapper) GetHealth(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHealth(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHooks operation middleware
+func (siw *ServerInterfaceWrapper) GetHooks(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetHooksParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHooks(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateHook operation middleware
+func (siw *ServerInterfaceWrapper) CreateHook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateHookParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateHook(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetHookEvents operation middleware
+func (siw *ServerInterfaceWrapper) GetHookEvents(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetHookEvents(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PreviewHookMatcher operation middleware
+func (siw *ServerInterfaceWrapper) PreviewHookMatcher(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PreviewHookMatcher(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestHook operation middleware
+func (siw *ServerInterfaceWrapper) TestHook(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestHook(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteHook operation middleware
+func (siw *ServerInterfaceWrapper) DeleteHook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteHookParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteHook(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateHook operation middleware
+func (siw *ServerInterfaceWrapper) UpdateHook(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "id" -------------
+	var id string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "id", r.PathValue("id"), &id, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "id", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateHookParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateHook(w, r, id, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetInstructions operation middleware
+func (siw *ServerInterfaceWrapper) GetInstructions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetInstructionsParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetInstructions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateInstructions operation middleware
+func (siw *ServerInterfaceWrapper) UpdateInstructions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateInstructionsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateInstructions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMcpApprovals operation middleware
+func (siw *ServerInterfaceWrapper) GetMcpApprovals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMcpApprovalsParams
+
+	// ------------- Required query parameter "projectId" -------------
+
+	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMcpApprovals(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMcpApprovals operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMcpApprovals(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMcpApprovalsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMcpApprovals(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// BulkUpdateMcpApprovals operation middleware
+func (siw *ServerInterfaceWrapper) BulkUpdateMcpApprovals(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.BulkUpdateMcpApprovals(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMcpServers operation middleware
+func (siw *ServerInterfaceWrapper) GetMcpServers(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMcpServersParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMcpServers(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMcpServer operation middleware
+func (siw *ServerInterfaceWrapper) CreateMcpServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params CreateMcpServerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMcpServer(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMcpServer operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMcpServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMcpServerParams
+
+	// ------------- Required query parameter "scope" -------------
+
+	if paramValue := r.URL.Query().Get("scope"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "scope"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMcpServer(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMcpServer operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMcpServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMcpServerParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMcpServer(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ProbeMcpServer operation middleware
+func (siw *ServerInterfaceWrapper) ProbeMcpServer(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ProbeMcpServer(w, r, name)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ListMemory operation middleware
+func (siw *ServerInterfaceWrapper) ListMemory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ListMemoryParams
+
+	// ------------- Required query parameter "projectId" -------------
+
+	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ListMemory(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateMemory operation middleware
+func (siw *ServerInterfaceWrapper) CreateMemory(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateMemory(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteMemory operation middleware
+func (siw *ServerInterfaceWrapper) DeleteMemory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "filename" -------------
+	var filename string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteMemoryParams
+
+	// ------------- Required query parameter "projectId" -------------
+
+	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteMemory(w, r, filename, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetMemory operation middleware
+func (siw *ServerInterfaceWrapper) GetMemory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "filename" -------------
+	var filename string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetMemoryParams
+
+	// ------------- Required query parameter "projectId" -------------
+
+	if paramValue := r.URL.Query().Get("projectId"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "projectId"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetMemory(w, r, filename, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateMemory operation middleware
+func (siw *ServerInterfaceWrapper) UpdateMemory(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "filename" -------------
+	var filename string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "filename", r.PathValue("filename"), &filename, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "filename", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateMemoryParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateMemory(w, r, filename, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOutputStyles operation middleware
+func (siw *ServerInterfaceWrapper) GetOutputStyles(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOutputStylesParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOutputStyles(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateOutputStyle operation middleware
+func (siw *ServerInterfaceWrapper) CreateOutputStyle(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateOutputStyle(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteOutputStyle operation middleware
+func (siw *ServerInterfaceWrapper) DeleteOutputStyle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteOutputStyleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteOutputStyle(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOutputStyle operation middleware
+func (siw *ServerInterfaceWrapper) GetOutputStyle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOutputStyleParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOutputStyle(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOutputStyle operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOutputStyle(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateOutputStyleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOutputStyle(w, r, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetOutputStyleSetting operation middleware
+func (siw *ServerInterfaceWrapper) GetOutputStyleSetting(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetOutputStyleSettingParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetOutputStyleSetting(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateOutputStyleSetting operation middleware
+func (siw *ServerInterfaceWrapper) UpdateOutputStyleSetting(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateOutputStyleSettingParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateOutputStyleSetting(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPermissions operation middleware
+func (siw *ServerInterfaceWrapper) GetPermissions(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetPermissionsParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPermissions(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// EvaluatePermission operation middleware
+func (siw *ServerInterfaceWrapper) EvaluatePermission(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.EvaluatePermission(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeletePermissionRule operation middleware
+func (siw *ServerInterfaceWrapper) DeletePermissionRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeletePermissionRuleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeletePermissionRule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// AddPermissionRule operation middleware
+func (siw *ServerInterfaceWrapper) AddPermissionRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params AddPermissionRuleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.AddPermissionRule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePermissionRule operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePermissionRule(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePermissionRuleParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePermissionRule(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdatePermissionSettings operation middleware
+func (siw *ServerInterfaceWrapper) UpdatePermissionSettings(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdatePermissionSettingsParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdatePermissionSettings(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetPlugins operation middleware
+func (siw *ServerInterfaceWrapper) GetPlugins(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetPlugins(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetProjects operation middleware
+func (siw *ServerInterfaceWrapper) GetProjects(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetProjects(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// PostProjects operation middleware
+func (siw *ServerInterfaceWrapper) PostProjects(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.PostProjects(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScanProjects operation middleware
+func (siw *ServerInterfaceWrapper) ScanProjects(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params ScanProjectsParams
+
+	// ------------- Required query parameter "folder" -------------
+
+	if paramValue := r.URL.Query().Get("folder"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "folder"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "folder", r.URL.Query(), &params.Folder)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "folder", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScanProjects(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteProject operation middleware
+func (siw *ServerInterfaceWrapper) DeleteProject(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "projectId" -------------
+	var projectId string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "projectId", r.PathValue("projectId"), &projectId, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteProject(w, r, projectId)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// RevealRedactedValue operation middleware
+func (siw *ServerInterfaceWrapper) RevealRedactedValue(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.RevealRedactedValue(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Search operation middleware
+func (siw *ServerInterfaceWrapper) Search(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params SearchParams
+
+	// ------------- Required query parameter "q" -------------
+
+	if paramValue := r.URL.Query().Get("q"); paramValue != "" {
+
+	} else {
+		siw.ErrorHandlerFunc(w, r, &RequiredParamError{ParamName: "q"})
+		return
+	}
+
+	err = runtime.BindQueryParameter("form", true, true, "q", r.URL.Query(), &params.Q)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "q", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Search(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// ScanSecrets operation middleware
+func (siw *ServerInterfaceWrapper) ScanSecrets(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.ScanSecrets(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSkills operation middleware
+func (siw *ServerInterfaceWrapper) GetSkills(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSkillsParams
+
+	// ------------- Optional query parameter "scope" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "scope", r.URL.Query(), &params.Scope)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSkills(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// CreateSkill operation middleware
+func (siw *ServerInterfaceWrapper) CreateSkill(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.CreateSkill(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// DeleteSkill operation middleware
+func (siw *ServerInterfaceWrapper) DeleteSkill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params DeleteSkillParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.DeleteSkill(w, r, scope, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetSkill operation middleware
+func (siw *ServerInterfaceWrapper) GetSkill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetSkillParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetSkill(w, r, scope, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateSkill operation middleware
+func (siw *ServerInterfaceWrapper) UpdateSkill(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// ------------- Path parameter "scope" -------------
+	var scope string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "scope", r.PathValue("scope"), &scope, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "scope", Err: err})
+		return
+	}
+
+	// ------------- Path parameter "name" -------------
+	var name string
+
+	err = runtime.BindStyledParameterWithOptions("simple", "name", r.PathValue("name"), &name, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationPath, Explode: false, Required: true})
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "name", Err: err})
+		return
+	}
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateSkillParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateSkill(w, r, scope, name, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// GetStatusLine operation middleware
+func (siw *ServerInterfaceWrapper) GetStatusLine(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params GetStatusLineParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.GetStatusLine(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// UpdateStatusLine operation middleware
+func (siw *ServerInterfaceWrapper) UpdateStatusLine(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params UpdateStatusLineParams
+
+	headers := r.Header
+
+	// ------------- Optional header parameter "If-Match" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("If-Match")]; found {
+		var IfMatch IfMatch
+		n := len(valueList)
+		if n != 1 {
+			siw.ErrorHandlerFunc(w, r, &TooManyValuesForParamError{ParamName: "If-Match", Count: n})
+			return
+		}
+
+		err = runtime.BindStyledParameterWithOptions("simple", "If-Match", valueList[0], &IfMatch, runtime.BindStyledParameterOptions{ParamLocation: runtime.ParamLocationHeader, Explode: false, Required: false})
+		if err != nil {
+			siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "If-Match", Err: err})
+			return
+		}
+
+		params.IfMatch = &IfMatch
+
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.UpdateStatusLine(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// TestStatusLine operation middleware
+func (siw *ServerInterfaceWrapper) TestStatusLine(w http.ResponseWriter, r *http.Request) {
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.TestStatusLine(w, r)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+// Watch operation middleware
+func (siw *ServerInterfaceWrapper) Watch(w http.ResponseWriter, r *http.Request) {
+
+	var err error
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params WatchParams
+
+	// ------------- Optional query parameter "projectId" -------------
+
+	err = runtime.BindQueryParameter("form", true, false, "projectId", r.URL.Query(), &params.ProjectId)
+	if err != nil {
+		siw.ErrorHandlerFunc(w, r, &InvalidParamFormatError{ParamName: "projectId", Err: err})
+		return
+	}
+
+	handler := http.Handler(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		siw.Handler.Watch(w, r, params)
+	}))
+
+	for _, middleware := range siw.HandlerMiddlewares {
+		handler = middleware(handler)
+	}
+
+	handler.ServeHTTP(w, r)
+}
+
+type UnescapedCookieParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnescapedCookieParamError) Error() string {
+	return fmt.Sprintf("error unescaping cookie parameter '%s'", e.ParamName)
+}
+
+func (e *UnescapedCookieParamError) Unwrap() error {
+	return e.Err
+}
+
+type UnmarshalingParamError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *UnmarshalingParamError) Error() string {
+	return fmt.Sprintf("Error unmarshaling parameter %s as JSON: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *UnmarshalingParamError) Unwrap() error {
+	return e.Err
+}
+
+type RequiredParamError struct {
+	ParamName string
+}
+
+func (e *RequiredParamError) Error() string {
+	return fmt.Sprintf("Query argument %s is required, but not found", e.ParamName)
+}
+
+type RequiredHeaderError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *RequiredHeaderError) Error() string {
+	return fmt.Sprintf("Header parameter %s is required, but not found", e.ParamName)
+}
+
+func (e *RequiredHeaderError) Unwrap() error {
+	return e.Err
+}
+
+type InvalidParamFormatError struct {
+	ParamName string
+	Err       error
+}
+
+func (e *InvalidParamFormatError) Error() string {
+	return fmt.Sprintf("Invalid format for parameter %s: %s", e.ParamName, e.Err.Error())
+}
+
+func (e *InvalidParamFormatError) Unwrap() error {
+	return e.Err
+}
+
+type TooManyValuesForParamError struct {
+	ParamName string
+	Count     int
+}
+
+func (e *TooManyValuesForParamError) Error() string {
+	return fmt.Sprintf("Expected one value for %s, got %d", e.ParamName, e.Count)
+}
+
+// Handler creates http.Handler with routing matching OpenAPI spec.
+func Handler(si ServerInterface) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{})
+}
+
+// ServeMux is an abstraction of http.ServeMux.
+type ServeMux interface {
+	HandleFunc(pattern string, handler func(http.ResponseWriter, *http.Request))
+	ServeHTTP(w http.ResponseWriter, r *http.Request)
+}
+
+type StdHTTPServerOptions struct {
+	BaseURL          string
+	BaseRouter       ServeMux
+	Middlewares      []MiddlewareFunc
+	ErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+// HandlerFromMux creates http.Handler with routing matching OpenAPI spec based on the provided mux.
+func HandlerFromMux(si ServerInterface, m ServeMux) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseRouter: m,
+	})
+}
+
+func HandlerFromMuxWithBaseURL(si ServerInterface, m ServeMux, baseURL string) http.Handler {
+	return HandlerWithOptions(si, StdHTTPServerOptions{
+		BaseURL:    baseURL,
+		BaseRouter: m,
+	})
+}
+
+// HandlerWithOptions creates http.Handler with additional options
+func HandlerWithOptions(si ServerInterface, options StdHTTPServerOptions) http.Handler {
+	m := options.BaseRouter
+
+	if m == nil {
+		m = http.NewServeMux()
+	}
+	if options.ErrorHandlerFunc == nil {
+		options.ErrorHandlerFunc = func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		}
+	}
+
+	wrapper := ServerInterfaceWrapper{
+		Handler:            si,
+		HandlerMiddlewares: options.Middlewares,
+		ErrorHandlerFunc:   options.ErrorHandlerFunc,
+	}
+
+	m.HandleFunc("GET "+options.BaseURL+"/api/agents", wrapper.GetAgents)
+	m.HandleFunc("POST "+options.BaseURL+"/api/agents", wrapper.CreateAgent)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/agents/{name}", wrapper.DeleteAgent)
+	m.HandleFunc("GET "+options.BaseURL+"/api/agents/{name}", wrapper.GetAgent)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/agents/{name}", wrapper.UpdateAgent)
+	m.HandleFunc("POST "+options.BaseURL+"/api/auth/login", wrapper.Login)
+	m.HandleFunc("POST "+options.BaseURL+"/api/auth/logout", wrapper.Logout)
+	m.HandleFunc("POST "+options.BaseURL+"/api/auth/setup", wrapper.SetupAuth)
+	m.HandleFunc("GET "+options.BaseURL+"/api/auth/status", wrapper.GetAuthStatus)
+	m.HandleFunc("GET "+options.BaseURL+"/api/backups", wrapper.GetBackups)
+	m.HandleFunc("POST "+options.BaseURL+"/api/backups/{id}/restore", wrapper.RestoreBackup)
+	m.HandleFunc("GET "+options.BaseURL+"/api/commands", wrapper.GetCommands)
+	m.HandleFunc("POST "+options.BaseURL+"/api/commands", wrapper.CreateCommand)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/commands/{scope}/{folder}/{name}", wrapper.DeleteCommand)
+	m.HandleFunc("GET "+options.BaseURL+"/api/commands/{scope}/{folder}/{name}", wrapper.GetCommand)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/commands/{scope}/{folder}/{name}", wrapper.UpdateCommand)
+	m.HandleFunc("GET "+options.BaseURL+"/api/config", wrapper.GetConfig)
+	m.HandleFunc("POST "+options.BaseURL+"/api/config/patch", wrapper.PatchConfig)
+	m.HandleFunc("GET "+options.BaseURL+"/api/config/repair", wrapper.GetConfigRepair)
+	m.HandleFunc("POST "+options.BaseURL+"/api/config/repair", wrapper.RepairConfigLayer)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/config/setting", wrapper.DeleteConfigSetting)
+	m.HandleFunc("POST "+options.BaseURL+"/api/config/setting", wrapper.UpdateConfigSetting)
+	m.HandleFunc("POST "+options.BaseURL+"/api/config/setting/move", wrapper.MoveConfigSetting)
+	m.HandleFunc("GET "+options.BaseURL+"/api/features", wrapper.GetFeatures)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/features/{key}", wrapper.DeleteFeature)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/features/{key}", wrapper.UpdateFeature)
+	m.HandleFunc("GET "+options.BaseURL+"/api/health", wrapper.GetHealth)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hooks", wrapper.GetHooks)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hooks", wrapper.CreateHook)
+	m.HandleFunc("GET "+options.BaseURL+"/api/hooks/events", wrapper.GetHookEvents)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hooks/matcher-preview", wrapper.PreviewHookMatcher)
+	m.HandleFunc("POST "+options.BaseURL+"/api/hooks/test", wrapper.TestHook)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/hooks/{id}", wrapper.DeleteHook)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/hooks/{id}", wrapper.UpdateHook)
+	m.HandleFunc("GET "+options.BaseURL+"/api/instructions", wrapper.GetInstructions)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/instructions", wrapper.UpdateInstructions)
+	m.HandleFunc("GET "+options.BaseURL+"/api/mcp/approvals", wrapper.GetMcpApprovals)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/mcp/approvals", wrapper.UpdateMcpApprovals)
+	m.HandleFunc("POST "+options.BaseURL+"/api/mcp/approvals/bulk", wrapper.BulkUpdateMcpApprovals)
+	m.HandleFunc("GET "+options.BaseURL+"/api/mcp/servers", wrapper.GetMcpServers)
+	m.HandleFunc("POST "+options.BaseURL+"/api/mcp/servers", wrapper.CreateMcpServer)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/mcp/servers/{name}", wrapper.DeleteMcpServer)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/mcp/servers/{name}", wrapper.UpdateMcpServer)
+	m.HandleFunc("POST "+options.BaseURL+"/api/mcp/servers/{name}/probe", wrapper.ProbeMcpServer)
+	m.HandleFunc("GET "+options.BaseURL+"/api/memory", wrapper.ListMemory)
+	m.HandleFunc("POST "+options.BaseURL+"/api/memory", wrapper.CreateMemory)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/memory/{filename}", wrapper.DeleteMemory)
+	m.HandleFunc("GET "+options.BaseURL+"/api/memory/{filename}", wrapper.GetMemory)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/memory/{filename}", wrapper.UpdateMemory)
+	m.HandleFunc("GET "+options.BaseURL+"/api/output-styles", wrapper.GetOutputStyles)
+	m.HandleFunc("POST "+options.BaseURL+"/api/output-styles", wrapper.CreateOutputStyle)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/output-styles/{name}", wrapper.DeleteOutputStyle)
+	m.HandleFunc("GET "+options.BaseURL+"/api/output-styles/{name}", wrapper.GetOutputStyle)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/output-styles/{name}", wrapper.UpdateOutputStyle)
+	m.HandleFunc("GET "+options.BaseURL+"/api/outputstyle", wrapper.GetOutputStyleSetting)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/outputstyle", wrapper.UpdateOutputStyleSetting)
+	m.HandleFunc("GET "+options.BaseURL+"/api/permissions", wrapper.GetPermissions)
+	m.HandleFunc("POST "+options.BaseURL+"/api/permissions/evaluate", wrapper.EvaluatePermission)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/permissions/rules", wrapper.DeletePermissionRule)
+	m.HandleFunc("POST "+options.BaseURL+"/api/permissions/rules", wrapper.AddPermissionRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/permissions/rules", wrapper.UpdatePermissionRule)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/permissions/settings", wrapper.UpdatePermissionSettings)
+	m.HandleFunc("GET "+options.BaseURL+"/api/plugins", wrapper.GetPlugins)
+	m.HandleFunc("GET "+options.BaseURL+"/api/projects", wrapper.GetProjects)
+	m.HandleFunc("POST "+options.BaseURL+"/api/projects", wrapper.PostProjects)
+	m.HandleFunc("GET "+options.BaseURL+"/api/projects/scan", wrapper.ScanProjects)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/projects/{projectId}", wrapper.DeleteProject)
+	m.HandleFunc("POST "+options.BaseURL+"/api/redaction/reveal", wrapper.RevealRedactedValue)
+	m.HandleFunc("GET "+options.BaseURL+"/api/search", wrapper.Search)
+	m.HandleFunc("GET "+options.BaseURL+"/api/secrets/scan", wrapper.ScanSecrets)
+	m.HandleFunc("GET "+options.BaseURL+"/api/skills", wrapper.GetSkills)
+	m.HandleFunc("POST "+options.BaseURL+"/api/skills", wrapper.CreateSkill)
+	m.HandleFunc("DELETE "+options.BaseURL+"/api/skills/{scope}/{name}", wrapper.DeleteSkill)
+	m.HandleFunc("GET "+options.BaseURL+"/api/skills/{scope}/{name}", wrapper.GetSkill)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/skills/{scope}/{name}", wrapper.UpdateSkill)
+	m.HandleFunc("GET "+options.BaseURL+"/api/statusline", wrapper.GetStatusLine)
+	m.HandleFunc("PUT "+options.BaseURL+"/api/statusline", wrapper.UpdateStatusLine)
+	m.HandleFunc("POST "+options.BaseURL+"/api/statusline/test", wrapper.TestStatusLine)
+	m.HandleFunc("GET "+options.BaseURL+"/api/watch", wrapper.Watch)
+
+	return m
+}
+
+type GetAgentsRequestObject struct {
+	Params GetAgentsParams
+}
+
+type GetAgentsResponseObject interface {
+	VisitGetAgentsResponse(w http.ResponseWriter) error
+}
+
+type GetAgents200JSONResponse []AgentFile
+
+func (response GetAgents200JSONResponse) VisitGetAgentsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateAgentRequestObject struct {
+	Body *CreateAgentJSONRequestBody
+}
+
+type CreateAgentResponseObject interface {
+	VisitCreateAgentResponse(w http.ResponseWriter) error
+}
+
+type CreateAgent200JSONResponse AgentFile
+
+func (response CreateAgent200JSONResponse) VisitCreateAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAgentRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteAgentParams
+	Body   *DeleteAgentJSONRequestBody
+}
+
+type DeleteAgentResponseObject interface {
+	VisitDeleteAgentResponse(w http.ResponseWriter) error
+}
+
+type DeleteAgent200JSONResponse SuccessResponse
+
+func (response DeleteAgent200JSONResponse) VisitDeleteAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteAgent412JSONResponse PreconditionFailedResponse
+
+func (response DeleteAgent412JSONResponse) VisitDeleteAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetAgentRequestObject struct {
+	Name   string `json:"name"`
+	Params GetAgentParams
+}
+
+type GetAgentResponseObject interface {
+	VisitGetAgentResponse(w http.ResponseWriter) error
+}
+
+type GetAgent200ResponseHeaders struct {
+	ETag string
+}
+
+type GetAgent200JSONResponse struct {
+	Body    AgentDetail
+	Headers GetAgent200ResponseHeaders
+}
+
+func (response GetAgent200JSONResponse) VisitGetAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateAgentRequestObject struct {
+	Name   string `json:"name"`
+	Params UpdateAgentParams
+	Body   *UpdateAgentJSONRequestBody
+}
+
+type UpdateAgentResponseObject interface {
+	VisitUpdateAgentResponse(w http.ResponseWriter) error
+}
+
+type UpdateAgent200JSONResponse AgentDetail
+
+func (response UpdateAgent200JSONResponse) VisitUpdateAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAgent409JSONResponse ErrorResponse
+
+func (response UpdateAgent409JSONResponse) VisitUpdateAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateAgent412JSONResponse PreconditionFailedResponse
+
+func (response UpdateAgent412JSONResponse) VisitUpdateAgentResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LoginRequestObject struct {
+	Body *LoginJSONRequestBody
+}
+
+type LoginResponseObject interface {
+	VisitLoginResponse(w http.ResponseWriter) error
+}
+
+type Login200JSONResponse SuccessResponse
+
+func (response Login200JSONResponse) VisitLoginResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type LogoutRequestObject struct {
+}
+
+type LogoutResponseObject interface {
+	VisitLogoutResponse(w http.ResponseWriter) error
+}
+
+type Logout200JSONResponse SuccessResponse
+
+func (response Logout200JSONResponse) VisitLogoutResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetupAuthRequestObject struct {
+	Body *SetupAuthJSONRequestBody
+}
+
+type SetupAuthResponseObject interface {
+	VisitSetupAuthResponse(w http.ResponseWriter) error
+}
+
+type SetupAuth200JSONResponse SuccessResponse
+
+func (response SetupAuth200JSONResponse) VisitSetupAuthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SetupAuth403Response struct {
+}
+
+func (response SetupAuth403Response) VisitSetupAuthResponse(w http.ResponseWriter) error {
+	w.WriteHeader(403)
+	return nil
+}
+
+type GetAuthStatusRequestObject struct {
+}
+
+type GetAuthStatusResponseObject interface {
+	VisitGetAuthStatusResponse(w http.ResponseWriter) error
+}
+
+type GetAuthStatus200JSONResponse AuthStatusResponse
+
+func (response GetAuthStatus200JSONResponse) VisitGetAuthStatusResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetBackupsRequestObject struct {
+}
+
+type GetBackupsResponseObject interface {
+	VisitGetBackupsResponse(w http.ResponseWriter) error
+}
+
+type GetBackups200JSONResponse []BackupFile
+
+func (response GetBackups200JSONResponse) VisitGetBackupsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RestoreBackupRequestObject struct {
+	Id string `json:"id"`
+}
+
+type RestoreBackupResponseObject interface {
+	VisitRestoreBackupResponse(w http.ResponseWriter) error
+}
+
+type RestoreBackup200JSONResponse SuccessResponse
+
+func (response RestoreBackup200JSONResponse) VisitRestoreBackupResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandsRequestObject struct {
+	Params GetCommandsParams
+}
+
+type GetCommandsResponseObject interface {
+	VisitGetCommandsResponse(w http.ResponseWriter) error
+}
+
+type GetCommands200JSONResponse []CommandFile
+
+func (response GetCommands200JSONResponse) VisitGetCommandsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateCommandRequestObject struct {
+	Body *CreateCommandJSONRequestBody
+}
+
+type CreateCommandResponseObject interface {
+	VisitCreateCommandResponse(w http.ResponseWriter) error
+}
+
+type CreateCommand200JSONResponse CommandFile
+
+func (response CreateCommand200JSONResponse) VisitCreateCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommandRequestObject struct {
+	Scope  string `json:"scope"`
+	Folder string `json:"folder"`
+	Name   string `json:"name"`
+	Params DeleteCommandParams
+}
+
+type DeleteCommandResponseObject interface {
+	VisitDeleteCommandResponse(w http.ResponseWriter) error
+}
+
+type DeleteCommand200JSONResponse SuccessResponse
+
+func (response DeleteCommand200JSONResponse) VisitDeleteCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteCommand412JSONResponse PreconditionFailedResponse
+
+func (response DeleteCommand412JSONResponse) VisitDeleteCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetCommandRequestObject struct {
+	Scope  string `json:"scope"`
+	Folder string `json:"folder"`
+	Name   string `json:"name"`
+	Params GetCommandParams
+}
+
+type GetCommandResponseObject interface {
+	VisitGetCommandResponse(w http.ResponseWriter) error
+}
+
+type GetCommand200ResponseHeaders struct {
+	ETag string
+}
+
+type GetCommand200JSONResponse struct {
+	Body    CommandDetail
+	Headers GetCommand200ResponseHeaders
+}
+
+func (response GetCommand200JSONResponse) VisitGetCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateCommandRequestObject struct {
+	Scope  string `json:"scope"`
+	Folder string `json:"folder"`
+	Name   string `json:"name"`
+	Params UpdateCommandParams
+	Body   *UpdateCommandJSONRequestBody
+}
+
+type UpdateCommandResponseObject interface {
+	VisitUpdateCommandResponse(w http.ResponseWriter) error
+}
+
+type UpdateCommand200JSONResponse CommandDetail
+
+func (response UpdateCommand200JSONResponse) VisitUpdateCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommand409JSONResponse ErrorResponse
+
+func (response UpdateCommand409JSONResponse) VisitUpdateCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateCommand412JSONResponse PreconditionFailedResponse
+
+func (response UpdateCommand412JSONResponse) VisitUpdateCommandResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfigRequestObject struct {
+	Params GetConfigParams
+}
+
+type GetConfigResponseObject interface {
+	VisitGetConfigResponse(w http.ResponseWriter) error
+}
+
+type GetConfig200JSONResponse ConfigResponse
+
+func (response GetConfig200JSONResponse) VisitGetConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchConfigRequestObject struct {
+	Params PatchConfigParams
+	Body   *PatchConfigJSONRequestBody
+}
+
+type PatchConfigResponseObject interface {
+	VisitPatchConfigResponse(w http.ResponseWriter) error
+}
+
+type PatchConfig200JSONResponse ConfigPatchResponse
+
+func (response PatchConfig200JSONResponse) VisitPatchConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchConfig400JSONResponse ValidationErrorResponse
+
+func (response PatchConfig400JSONResponse) VisitPatchConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchConfig409JSONResponse ErrorResponse
+
+func (response PatchConfig409JSONResponse) VisitPatchConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchConfig412JSONResponse PreconditionFailedResponse
+
+func (response PatchConfig412JSONResponse) VisitPatchConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PatchConfig422JSONResponse SettingsParseErrorResponse
+
+func (response PatchConfig422JSONResponse) VisitPatchConfigResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetConfigRepairRequestObject struct {
+	Params GetConfigRepairParams
+}
+
+type GetConfigRepairResponseObject interface {
+	VisitGetConfigRepairResponse(w http.ResponseWriter) error
+}
+
+type GetConfigRepair200JSONResponse ConfigRepairPreview
+
+func (response GetConfigRepair200JSONResponse) VisitGetConfigRepairResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RepairConfigLayerRequestObject struct {
+	Params RepairConfigLayerParams
+	Body   *RepairConfigLayerJSONRequestBody
+}
+
+type RepairConfigLayerResponseObject interface {
+	VisitRepairConfigLayerResponse(w http.ResponseWriter) error
+}
+
+type RepairConfigLayer200JSONResponse SuccessResponse
+
+func (response RepairConfigLayer200JSONResponse) VisitRepairConfigLayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RepairConfigLayer409JSONResponse ErrorResponse
+
+func (response RepairConfigLayer409JSONResponse) VisitRepairConfigLayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RepairConfigLayer412JSONResponse PreconditionFailedResponse
+
+func (response RepairConfigLayer412JSONResponse) VisitRepairConfigLayerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConfigSettingRequestObject struct {
+	Params DeleteConfigSettingParams
+	Body   *DeleteConfigSettingJSONRequestBody
+}
+
+type DeleteConfigSettingResponseObject interface {
+	VisitDeleteConfigSettingResponse(w http.ResponseWriter) error
+}
+
+type DeleteConfigSetting200JSONResponse SuccessResponse
+
+func (response DeleteConfigSetting200JSONResponse) VisitDeleteConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConfigSetting412JSONResponse PreconditionFailedResponse
+
+func (response DeleteConfigSetting412JSONResponse) VisitDeleteConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteConfigSetting422JSONResponse SettingsParseErrorResponse
+
+func (response DeleteConfigSetting422JSONResponse) VisitDeleteConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfigSettingRequestObject struct {
+	Params UpdateConfigSettingParams
+	Body   *UpdateConfigSettingJSONRequestBody
+}
+
+type UpdateConfigSettingResponseObject interface {
+	VisitUpdateConfigSettingResponse(w http.ResponseWriter) error
+}
+
+type UpdateConfigSetting200JSONResponse SettingWriteResponse
+
+func (response UpdateConfigSetting200JSONResponse) VisitUpdateConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfigSetting400JSONResponse ValidationErrorResponse
+
+func (response UpdateConfigSetting400JSONResponse) VisitUpdateConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfigSetting409JSONResponse ErrorResponse
+
+func (response UpdateConfigSetting409JSONResponse) VisitUpdateConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfigSetting412JSONResponse PreconditionFailedResponse
+
+func (response UpdateConfigSetting412JSONResponse) VisitUpdateConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateConfigSetting422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateConfigSetting422JSONResponse) VisitUpdateConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveConfigSettingRequestObject struct {
+	Params MoveConfigSettingParams
+	Body   *MoveConfigSettingJSONRequestBody
+}
+
+type MoveConfigSettingResponseObject interface {
+	VisitMoveConfigSettingResponse(w http.ResponseWriter) error
+}
+
+type MoveConfigSetting200JSONResponse ConfigPatchResponse
+
+func (response MoveConfigSetting200JSONResponse) VisitMoveConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveConfigSetting400JSONResponse ValidationErrorResponse
+
+func (response MoveConfigSetting400JSONResponse) VisitMoveConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveConfigSetting409JSONResponse ErrorResponse
+
+func (response MoveConfigSetting409JSONResponse) VisitMoveConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveConfigSetting412JSONResponse PreconditionFailedResponse
+
+func (response MoveConfigSetting412JSONResponse) VisitMoveConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type MoveConfigSetting422JSONResponse SettingsParseErrorResponse
+
+func (response MoveConfigSetting422JSONResponse) VisitMoveConfigSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetFeaturesRequestObject struct {
+}
+
+type GetFeaturesResponseObject interface {
+	VisitGetFeaturesResponse(w http.ResponseWriter) error
+}
+
+type GetFeatures200JSONResponse FeaturesData
+
+func (response GetFeatures200JSONResponse) VisitGetFeaturesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFeatureRequestObject struct {
+	Key    string `json:"key"`
+	Params DeleteFeatureParams
+}
+
+type DeleteFeatureResponseObject interface {
+	VisitDeleteFeatureResponse(w http.ResponseWriter) error
+}
+
+type DeleteFeature200JSONResponse SuccessResponse
+
+func (response DeleteFeature200JSONResponse) VisitDeleteFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFeature412JSONResponse PreconditionFailedResponse
+
+func (response DeleteFeature412JSONResponse) VisitDeleteFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteFeature422JSONResponse SettingsParseErrorResponse
+
+func (response DeleteFeature422JSONResponse) VisitDeleteFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFeatureRequestObject struct {
+	Key    string `json:"key"`
+	Params UpdateFeatureParams
+	Body   *UpdateFeatureJSONRequestBody
+}
+
+type UpdateFeatureResponseObject interface {
+	VisitUpdateFeatureResponse(w http.ResponseWriter) error
+}
+
+type UpdateFeature200JSONResponse SettingWriteResponse
+
+func (response UpdateFeature200JSONResponse) VisitUpdateFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFeature400JSONResponse ValidationErrorResponse
+
+func (response UpdateFeature400JSONResponse) VisitUpdateFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFeature409JSONResponse ErrorResponse
+
+func (response UpdateFeature409JSONResponse) VisitUpdateFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFeature412JSONResponse PreconditionFailedResponse
+
+func (response UpdateFeature412JSONResponse) VisitUpdateFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateFeature422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateFeature422JSONResponse) VisitUpdateFeatureResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHealthRequestObject struct {
+}
+
+type GetHealthResponseObject interface {
+	VisitGetHealthResponse(w http.ResponseWriter) error
+}
+
+type GetHealth200JSONResponse HealthResponse
+
+func (response GetHealth200JSONResponse) VisitGetHealthResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHooksRequestObject struct {
+	Params GetHooksParams
+}
+
+type GetHooksResponseObject interface {
+	VisitGetHooksResponse(w http.ResponseWriter) error
+}
+
+type GetHooks200JSONResponse HooksResponse
+
+func (response GetHooks200JSONResponse) VisitGetHooksResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHookRequestObject struct {
+	Params CreateHookParams
+	Body   *CreateHookJSONRequestBody
+}
+
+type CreateHookResponseObject interface {
+	VisitCreateHookResponse(w http.ResponseWriter) error
+}
+
+type CreateHook200JSONResponse SuccessResponse
+
+func (response CreateHook200JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHook400JSONResponse ErrorResponse
+
+func (response CreateHook400JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHook409JSONResponse ErrorResponse
+
+func (response CreateHook409JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHook412JSONResponse PreconditionFailedResponse
+
+func (response CreateHook412JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateHook422JSONResponse SettingsParseErrorResponse
+
+func (response CreateHook422JSONResponse) VisitCreateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetHookEventsRequestObject struct {
+}
+
+type GetHookEventsResponseObject interface {
+	VisitGetHookEventsResponse(w http.ResponseWriter) error
+}
+
+type GetHookEvents200JSONResponse []HookEventInfo
+
+func (response GetHookEvents200JSONResponse) VisitGetHookEventsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewHookMatcherRequestObject struct {
+	Body *PreviewHookMatcherJSONRequestBody
+}
+
+type PreviewHookMatcherResponseObject interface {
+	VisitPreviewHookMatcherResponse(w http.ResponseWriter) error
+}
+
+type PreviewHookMatcher200JSONResponse HookMatcherPreview
+
+func (response PreviewHookMatcher200JSONResponse) VisitPreviewHookMatcherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PreviewHookMatcher400JSONResponse ErrorResponse
+
+func (response PreviewHookMatcher400JSONResponse) VisitPreviewHookMatcherResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestHookRequestObject struct {
+	Body *TestHookJSONRequestBody
+}
+
+type TestHookResponseObject interface {
+	VisitTestHookResponse(w http.ResponseWriter) error
+}
+
+type TestHook200JSONResponse HookTestResult
+
+func (response TestHook200JSONResponse) VisitTestHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestHook400JSONResponse ErrorResponse
+
+func (response TestHook400JSONResponse) VisitTestHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestHook404JSONResponse ErrorResponse
+
+func (response TestHook404JSONResponse) VisitTestHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHookRequestObject struct {
+	Id     string `json:"id"`
+	Params DeleteHookParams
+}
+
+type DeleteHookResponseObject interface {
+	VisitDeleteHookResponse(w http.ResponseWriter) error
+}
+
+type DeleteHook200JSONResponse SuccessResponse
+
+func (response DeleteHook200JSONResponse) VisitDeleteHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHook409JSONResponse ErrorResponse
+
+func (response DeleteHook409JSONResponse) VisitDeleteHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHook412JSONResponse PreconditionFailedResponse
+
+func (response DeleteHook412JSONResponse) VisitDeleteHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteHook422JSONResponse SettingsParseErrorResponse
+
+func (response DeleteHook422JSONResponse) VisitDeleteHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHookRequestObject struct {
+	Id     string `json:"id"`
+	Params UpdateHookParams
+	Body   *UpdateHookJSONRequestBody
+}
+
+type UpdateHookResponseObject interface {
+	VisitUpdateHookResponse(w http.ResponseWriter) error
+}
+
+type UpdateHook200JSONResponse SuccessResponse
+
+func (response UpdateHook200JSONResponse) VisitUpdateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHook400JSONResponse ErrorResponse
+
+func (response UpdateHook400JSONResponse) VisitUpdateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHook409JSONResponse ErrorResponse
+
+func (response UpdateHook409JSONResponse) VisitUpdateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHook412JSONResponse PreconditionFailedResponse
+
+func (response UpdateHook412JSONResponse) VisitUpdateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateHook422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateHook422JSONResponse) VisitUpdateHookResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetInstructionsRequestObject struct {
+	Params GetInstructionsParams
+}
+
+type GetInstructionsResponseObject interface {
+	VisitGetInstructionsResponse(w http.ResponseWriter) error
+}
+
+type GetInstructions200JSONResponse InstructionsResponse
+
+func (response GetInstructions200JSONResponse) VisitGetInstructionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInstructionsRequestObject struct {
+	Params UpdateInstructionsParams
+	Body   *UpdateInstructionsJSONRequestBody
+}
+
+type UpdateInstructionsResponseObject interface {
+	VisitUpdateInstructionsResponse(w http.ResponseWriter) error
+}
+
+type UpdateInstructions200JSONResponse SuccessResponse
+
+func (response UpdateInstructions200JSONResponse) VisitUpdateInstructionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInstructions409JSONResponse ErrorResponse
+
+func (response UpdateInstructions409JSONResponse) VisitUpdateInstructionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateInstructions412JSONResponse PreconditionFailedResponse
+
+func (response UpdateInstructions412JSONResponse) VisitUpdateInstructionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpApprovalsRequestObject struct {
+	Params GetMcpApprovalsParams
+}
+
+type GetMcpApprovalsResponseObject interface {
+	VisitGetMcpApprovalsResponse(w http.ResponseWriter) error
+}
+
+type GetMcpApprovals200JSONResponse McpApprovalsResponse
+
+func (response GetMcpApprovals200JSONResponse) VisitGetMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpApprovalsRequestObject struct {
+	Params UpdateMcpApprovalsParams
+	Body   *UpdateMcpApprovalsJSONRequestBody
+}
+
+type UpdateMcpApprovalsResponseObject interface {
+	VisitUpdateMcpApprovalsResponse(w http.ResponseWriter) error
+}
+
+type UpdateMcpApprovals200JSONResponse ConfigPatchResponse
+
+func (response UpdateMcpApprovals200JSONResponse) VisitUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpApprovals400JSONResponse ErrorResponse
+
+func (response UpdateMcpApprovals400JSONResponse) VisitUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpApprovals409JSONResponse ErrorResponse
+
+func (response UpdateMcpApprovals409JSONResponse) VisitUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpApprovals412JSONResponse PreconditionFailedResponse
+
+func (response UpdateMcpApprovals412JSONResponse) VisitUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpApprovals422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateMcpApprovals422JSONResponse) VisitUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkUpdateMcpApprovalsRequestObject struct {
+	Body *BulkUpdateMcpApprovalsJSONRequestBody
+}
+
+type BulkUpdateMcpApprovalsResponseObject interface {
+	VisitBulkUpdateMcpApprovalsResponse(w http.ResponseWriter) error
+}
+
+type BulkUpdateMcpApprovals200JSONResponse BulkMcpApprovalsResponse
+
+func (response BulkUpdateMcpApprovals200JSONResponse) VisitBulkUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkUpdateMcpApprovals400JSONResponse ErrorResponse
+
+func (response BulkUpdateMcpApprovals400JSONResponse) VisitBulkUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type BulkUpdateMcpApprovals409JSONResponse ErrorResponse
+
+func (response BulkUpdateMcpApprovals409JSONResponse) VisitBulkUpdateMcpApprovalsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMcpServersRequestObject struct {
+	Params GetMcpServersParams
+}
+
+type GetMcpServersResponseObject interface {
+	VisitGetMcpServersResponse(w http.ResponseWriter) error
+}
+
+type GetMcpServers200JSONResponse McpServersResponse
+
+func (response GetMcpServers200JSONResponse) VisitGetMcpServersResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMcpServerRequestObject struct {
+	Params CreateMcpServerParams
+	Body   *CreateMcpServerJSONRequestBody
+}
+
+type CreateMcpServerResponseObject interface {
+	VisitCreateMcpServerResponse(w http.ResponseWriter) error
+}
+
+type CreateMcpServer200JSONResponse SuccessResponse
+
+func (response CreateMcpServer200JSONResponse) VisitCreateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMcpServer400JSONResponse ValidationErrorResponse
+
+func (response CreateMcpServer400JSONResponse) VisitCreateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMcpServer409JSONResponse ErrorResponse
+
+func (response CreateMcpServer409JSONResponse) VisitCreateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMcpServer412JSONResponse PreconditionFailedResponse
+
+func (response CreateMcpServer412JSONResponse) VisitCreateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMcpServer422JSONResponse SettingsParseErrorResponse
+
+func (response CreateMcpServer422JSONResponse) VisitCreateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServerRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteMcpServerParams
+}
+
+type DeleteMcpServerResponseObject interface {
+	VisitDeleteMcpServerResponse(w http.ResponseWriter) error
+}
+
+type DeleteMcpServer200JSONResponse SuccessResponse
+
+func (response DeleteMcpServer200JSONResponse) VisitDeleteMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServer404JSONResponse ErrorResponse
+
+func (response DeleteMcpServer404JSONResponse) VisitDeleteMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServer412JSONResponse PreconditionFailedResponse
+
+func (response DeleteMcpServer412JSONResponse) VisitDeleteMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMcpServer422JSONResponse SettingsParseErrorResponse
+
+func (response DeleteMcpServer422JSONResponse) VisitDeleteMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpServerRequestObject struct {
+	Name   string `json:"name"`
+	Params UpdateMcpServerParams
+	Body   *UpdateMcpServerJSONRequestBody
+}
+
+type UpdateMcpServerResponseObject interface {
+	VisitUpdateMcpServerResponse(w http.ResponseWriter) error
+}
+
+type UpdateMcpServer200JSONResponse SuccessResponse
+
+func (response UpdateMcpServer200JSONResponse) VisitUpdateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpServer400JSONResponse ValidationErrorResponse
+
+func (response UpdateMcpServer400JSONResponse) VisitUpdateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpServer404JSONResponse ErrorResponse
+
+func (response UpdateMcpServer404JSONResponse) VisitUpdateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpServer409JSONResponse ErrorResponse
+
+func (response UpdateMcpServer409JSONResponse) VisitUpdateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpServer412JSONResponse PreconditionFailedResponse
+
+func (response UpdateMcpServer412JSONResponse) VisitUpdateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMcpServer422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateMcpServer422JSONResponse) VisitUpdateMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProbeMcpServerRequestObject struct {
+	Name string `json:"name"`
+	Body *ProbeMcpServerJSONRequestBody
+}
+
+type ProbeMcpServerResponseObject interface {
+	VisitProbeMcpServerResponse(w http.ResponseWriter) error
+}
+
+type ProbeMcpServer200JSONResponse McpProbeResult
+
+func (response ProbeMcpServer200JSONResponse) VisitProbeMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProbeMcpServer400JSONResponse ErrorResponse
+
+func (response ProbeMcpServer400JSONResponse) VisitProbeMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProbeMcpServer404JSONResponse ErrorResponse
+
+func (response ProbeMcpServer404JSONResponse) VisitProbeMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ProbeMcpServer422JSONResponse SettingsParseErrorResponse
+
+func (response ProbeMcpServer422JSONResponse) VisitProbeMcpServerResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ListMemoryRequestObject struct {
+	Params ListMemoryParams
+}
+
+type ListMemoryResponseObject interface {
+	VisitListMemoryResponse(w http.ResponseWriter) error
+}
+
+type ListMemory200JSONResponse []MemoryFile
+
+func (response ListMemory200JSONResponse) VisitListMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateMemoryRequestObject struct {
+	Body *CreateMemoryJSONRequestBody
+}
+
+type CreateMemoryResponseObject interface {
+	VisitCreateMemoryResponse(w http.ResponseWriter) error
+}
+
+type CreateMemory200JSONResponse MemoryFile
+
+func (response CreateMemory200JSONResponse) VisitCreateMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMemoryRequestObject struct {
+	Filename string `json:"filename"`
+	Params   DeleteMemoryParams
+}
+
+type DeleteMemoryResponseObject interface {
+	VisitDeleteMemoryResponse(w http.ResponseWriter) error
+}
+
+type DeleteMemory200JSONResponse SuccessResponse
+
+func (response DeleteMemory200JSONResponse) VisitDeleteMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteMemory412JSONResponse PreconditionFailedResponse
+
+func (response DeleteMemory412JSONResponse) VisitDeleteMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetMemoryRequestObject struct {
+	Filename string `json:"filename"`
+	Params   GetMemoryParams
+}
+
+type GetMemoryResponseObject interface {
+	VisitGetMemoryResponse(w http.ResponseWriter) error
+}
+
+type GetMemory200ResponseHeaders struct {
+	ETag string
+}
+
+type GetMemory200JSONResponse struct {
+	Body    MemoryDetail
+	Headers GetMemory200ResponseHeaders
+}
+
+func (response GetMemory200JSONResponse) VisitGetMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateMemoryRequestObject struct {
+	Filename string `json:"filename"`
+	Params   UpdateMemoryParams
+	Body     *UpdateMemoryJSONRequestBody
+}
+
+type UpdateMemoryResponseObject interface {
+	VisitUpdateMemoryResponse(w http.ResponseWriter) error
+}
+
+type UpdateMemory200JSONResponse SuccessResponse
+
+func (response UpdateMemory200JSONResponse) VisitUpdateMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMemory409JSONResponse ErrorResponse
+
+func (response UpdateMemory409JSONResponse) VisitUpdateMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateMemory412JSONResponse PreconditionFailedResponse
+
+func (response UpdateMemory412JSONResponse) VisitUpdateMemoryResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutputStylesRequestObject struct {
+	Params GetOutputStylesParams
+}
+
+type GetOutputStylesResponseObject interface {
+	VisitGetOutputStylesResponse(w http.ResponseWriter) error
+}
+
+type GetOutputStyles200JSONResponse []OutputStyleFile
+
+func (response GetOutputStyles200JSONResponse) VisitGetOutputStylesResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateOutputStyleRequestObject struct {
+	Body *CreateOutputStyleJSONRequestBody
+}
+
+type CreateOutputStyleResponseObject interface {
+	VisitCreateOutputStyleResponse(w http.ResponseWriter) error
+}
+
+type CreateOutputStyle200JSONResponse OutputStyleFile
+
+func (response CreateOutputStyle200JSONResponse) VisitCreateOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOutputStyleRequestObject struct {
+	Name   string `json:"name"`
+	Params DeleteOutputStyleParams
+	Body   *DeleteOutputStyleJSONRequestBody
+}
+
+type DeleteOutputStyleResponseObject interface {
+	VisitDeleteOutputStyleResponse(w http.ResponseWriter) error
+}
+
+type DeleteOutputStyle200JSONResponse SuccessResponse
+
+func (response DeleteOutputStyle200JSONResponse) VisitDeleteOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteOutputStyle412JSONResponse PreconditionFailedResponse
+
+func (response DeleteOutputStyle412JSONResponse) VisitDeleteOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutputStyleRequestObject struct {
+	Name   string `json:"name"`
+	Params GetOutputStyleParams
+}
+
+type GetOutputStyleResponseObject interface {
+	VisitGetOutputStyleResponse(w http.ResponseWriter) error
+}
+
+type GetOutputStyle200ResponseHeaders struct {
+	ETag string
+}
+
+type GetOutputStyle200JSONResponse struct {
+	Body    OutputStyleDetail
+	Headers GetOutputStyle200ResponseHeaders
+}
+
+func (response GetOutputStyle200JSONResponse) VisitGetOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateOutputStyleRequestObject struct {
+	Name   string `json:"name"`
+	Params UpdateOutputStyleParams
+	Body   *UpdateOutputStyleJSONRequestBody
+}
+
+type UpdateOutputStyleResponseObject interface {
+	VisitUpdateOutputStyleResponse(w http.ResponseWriter) error
+}
+
+type UpdateOutputStyle200JSONResponse OutputStyleDetail
+
+func (response UpdateOutputStyle200JSONResponse) VisitUpdateOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyle409JSONResponse ErrorResponse
+
+func (response UpdateOutputStyle409JSONResponse) VisitUpdateOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyle412JSONResponse PreconditionFailedResponse
+
+func (response UpdateOutputStyle412JSONResponse) VisitUpdateOutputStyleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetOutputStyleSettingRequestObject struct {
+	Params GetOutputStyleSettingParams
+}
+
+type GetOutputStyleSettingResponseObject interface {
+	VisitGetOutputStyleSettingResponse(w http.ResponseWriter) error
+}
+
+type GetOutputStyleSetting200JSONResponse OutputStyleSettingResponse
+
+func (response GetOutputStyleSetting200JSONResponse) VisitGetOutputStyleSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyleSettingRequestObject struct {
+	Params UpdateOutputStyleSettingParams
+	Body   *UpdateOutputStyleSettingJSONRequestBody
+}
+
+type UpdateOutputStyleSettingResponseObject interface {
+	VisitUpdateOutputStyleSettingResponse(w http.ResponseWriter) error
+}
+
+type UpdateOutputStyleSetting200JSONResponse SuccessResponse
+
+func (response UpdateOutputStyleSetting200JSONResponse) VisitUpdateOutputStyleSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyleSetting400JSONResponse ValidationErrorResponse
+
+func (response UpdateOutputStyleSetting400JSONResponse) VisitUpdateOutputStyleSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyleSetting409JSONResponse ErrorResponse
+
+func (response UpdateOutputStyleSetting409JSONResponse) VisitUpdateOutputStyleSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyleSetting412JSONResponse PreconditionFailedResponse
+
+func (response UpdateOutputStyleSetting412JSONResponse) VisitUpdateOutputStyleSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateOutputStyleSetting422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateOutputStyleSetting422JSONResponse) VisitUpdateOutputStyleSettingResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPermissionsRequestObject struct {
+	Params GetPermissionsParams
+}
+
+type GetPermissionsResponseObject interface {
+	VisitGetPermissionsResponse(w http.ResponseWriter) error
+}
+
+type GetPermissions200JSONResponse PermissionsResponse
+
+func (response GetPermissions200JSONResponse) VisitGetPermissionsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePermissionRequestObject struct {
+	Body *EvaluatePermissionJSONRequestBody
+}
+
+type EvaluatePermissionResponseObject interface {
+	VisitEvaluatePermissionResponse(w http.ResponseWriter) error
+}
+
+type EvaluatePermission200JSONResponse PermissionEvaluation
+
+func (response EvaluatePermission200JSONResponse) VisitEvaluatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type EvaluatePermission400JSONResponse ErrorResponse
+
+func (response EvaluatePermission400JSONResponse) VisitEvaluatePermissionResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRuleRequestObject struct {
+	Params DeletePermissionRuleParams
+	Body   *DeletePermissionRuleJSONRequestBody
+}
+
+type DeletePermissionRuleResponseObject interface {
+	VisitDeletePermissionRuleResponse(w http.ResponseWriter) error
+}
+
+type DeletePermissionRule200JSONResponse SuccessResponse
+
+func (response DeletePermissionRule200JSONResponse) VisitDeletePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRule400JSONResponse ValidationErrorResponse
+
+func (response DeletePermissionRule400JSONResponse) VisitDeletePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRule404JSONResponse ErrorResponse
+
+func (response DeletePermissionRule404JSONResponse) VisitDeletePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRule409JSONResponse ErrorResponse
+
+func (response DeletePermissionRule409JSONResponse) VisitDeletePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRule412JSONResponse PreconditionFailedResponse
+
+func (response DeletePermissionRule412JSONResponse) VisitDeletePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeletePermissionRule422JSONResponse SettingsParseErrorResponse
+
+func (response DeletePermissionRule422JSONResponse) VisitDeletePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPermissionRuleRequestObject struct {
+	Params AddPermissionRuleParams
+	Body   *AddPermissionRuleJSONRequestBody
+}
+
+type AddPermissionRuleResponseObject interface {
+	VisitAddPermissionRuleResponse(w http.ResponseWriter) error
+}
+
+type AddPermissionRule200JSONResponse SuccessResponse
+
+func (response AddPermissionRule200JSONResponse) VisitAddPermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPermissionRule400JSONResponse ValidationErrorResponse
+
+func (response AddPermissionRule400JSONResponse) VisitAddPermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPermissionRule409JSONResponse ErrorResponse
+
+func (response AddPermissionRule409JSONResponse) VisitAddPermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPermissionRule412JSONResponse PreconditionFailedResponse
+
+func (response AddPermissionRule412JSONResponse) VisitAddPermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type AddPermissionRule422JSONResponse SettingsParseErrorResponse
+
+func (response AddPermissionRule422JSONResponse) VisitAddPermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRuleRequestObject struct {
+	Params UpdatePermissionRuleParams
+	Body   *UpdatePermissionRuleJSONRequestBody
+}
+
+type UpdatePermissionRuleResponseObject interface {
+	VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error
+}
+
+type UpdatePermissionRule200JSONResponse SuccessResponse
+
+func (response UpdatePermissionRule200JSONResponse) VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRule400JSONResponse ValidationErrorResponse
+
+func (response UpdatePermissionRule400JSONResponse) VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRule404JSONResponse ErrorResponse
+
+func (response UpdatePermissionRule404JSONResponse) VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRule409JSONResponse ErrorResponse
+
+func (response UpdatePermissionRule409JSONResponse) VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRule412JSONResponse PreconditionFailedResponse
+
+func (response UpdatePermissionRule412JSONResponse) VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionRule422JSONResponse SettingsParseErrorResponse
+
+func (response UpdatePermissionRule422JSONResponse) VisitUpdatePermissionRuleResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionSettingsRequestObject struct {
+	Params UpdatePermissionSettingsParams
+	Body   *UpdatePermissionSettingsJSONRequestBody
+}
+
+type UpdatePermissionSettingsResponseObject interface {
+	VisitUpdatePermissionSettingsResponse(w http.ResponseWriter) error
+}
+
+type UpdatePermissionSettings200JSONResponse SuccessResponse
+
+func (response UpdatePermissionSettings200JSONResponse) VisitUpdatePermissionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionSettings400JSONResponse ValidationErrorResponse
+
+func (response UpdatePermissionSettings400JSONResponse) VisitUpdatePermissionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionSettings409JSONResponse ErrorResponse
+
+func (response UpdatePermissionSettings409JSONResponse) VisitUpdatePermissionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionSettings412JSONResponse PreconditionFailedResponse
+
+func (response UpdatePermissionSettings412JSONResponse) VisitUpdatePermissionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdatePermissionSettings422JSONResponse SettingsParseErrorResponse
+
+func (response UpdatePermissionSettings422JSONResponse) VisitUpdatePermissionSettingsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetPluginsRequestObject struct {
+}
+
+type GetPluginsResponseObject interface {
+	VisitGetPluginsResponse(w http.ResponseWriter) error
+}
+
+type GetPlugins200JSONResponse []PluginFile
+
+func (response GetPlugins200JSONResponse) VisitGetPluginsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetProjectsRequestObject struct {
+}
+
+type GetProjectsResponseObject interface {
+	VisitGetProjectsResponse(w http.ResponseWriter) error
+}
+
+type GetProjects200JSONResponse []ProjectFile
+
+func (response GetProjects200JSONResponse) VisitGetProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjectsRequestObject struct {
+	Body *PostProjectsJSONRequestBody
+}
+
+type PostProjectsResponseObject interface {
+	VisitPostProjectsResponse(w http.ResponseWriter) error
+}
+
+type PostProjects200JSONResponse []ProjectFile
+
+func (response PostProjects200JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type PostProjects400JSONResponse ErrorResponse
+
+func (response PostProjects400JSONResponse) VisitPostProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScanProjectsRequestObject struct {
+	Params ScanProjectsParams
+}
+
+type ScanProjectsResponseObject interface {
+	VisitScanProjectsResponse(w http.ResponseWriter) error
+}
+
+type ScanProjects200JSONResponse []ScanProjectResult
+
+func (response ScanProjects200JSONResponse) VisitScanProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScanProjects400JSONResponse ErrorResponse
+
+func (response ScanProjects400JSONResponse) VisitScanProjectsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProjectRequestObject struct {
+	ProjectId string `json:"projectId"`
+}
+
+type DeleteProjectResponseObject interface {
+	VisitDeleteProjectResponse(w http.ResponseWriter) error
+}
+
+type DeleteProject204Response struct {
+}
+
+func (response DeleteProject204Response) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.WriteHeader(204)
+	return nil
+}
+
+type DeleteProject400JSONResponse ErrorResponse
+
+func (response DeleteProject400JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteProject404JSONResponse ErrorResponse
+
+func (response DeleteProject404JSONResponse) VisitDeleteProjectResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealRedactedValueRequestObject struct {
+	Body *RevealRedactedValueJSONRequestBody
+}
+
+type RevealRedactedValueResponseObject interface {
+	VisitRevealRedactedValueResponse(w http.ResponseWriter) error
+}
+
+type RevealRedactedValue200JSONResponse RevealResponse
+
+func (response RevealRedactedValue200JSONResponse) VisitRevealRedactedValueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealRedactedValue400JSONResponse ErrorResponse
+
+func (response RevealRedactedValue400JSONResponse) VisitRevealRedactedValueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealRedactedValue401JSONResponse ErrorResponse
+
+func (response RevealRedactedValue401JSONResponse) VisitRevealRedactedValueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(401)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type RevealRedactedValue404JSONResponse ErrorResponse
+
+func (response RevealRedactedValue404JSONResponse) VisitRevealRedactedValueResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(404)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type SearchRequestObject struct {
+	Params SearchParams
+}
+
+type SearchResponseObject interface {
+	VisitSearchResponse(w http.ResponseWriter) error
+}
+
+type Search200JSONResponse []SearchResult
+
+func (response Search200JSONResponse) VisitSearchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type ScanSecretsRequestObject struct {
+}
+
+type ScanSecretsResponseObject interface {
+	VisitScanSecretsResponse(w http.ResponseWriter) error
+}
+
+type ScanSecrets200JSONResponse SecretScanResponse
+
+func (response ScanSecrets200JSONResponse) VisitScanSecretsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSkillsRequestObject struct {
+	Params GetSkillsParams
+}
+
+type GetSkillsResponseObject interface {
+	VisitGetSkillsResponse(w http.ResponseWriter) error
+}
+
+type GetSkills200JSONResponse []SkillFile
+
+func (response GetSkills200JSONResponse) VisitGetSkillsResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type CreateSkillRequestObject struct {
+	Body *CreateSkillJSONRequestBody
+}
+
+type CreateSkillResponseObject interface {
+	VisitCreateSkillResponse(w http.ResponseWriter) error
+}
+
+type CreateSkill200JSONResponse SkillFile
+
+func (response CreateSkill200JSONResponse) VisitCreateSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSkillRequestObject struct {
+	Scope  string `json:"scope"`
+	Name   string `json:"name"`
+	Params DeleteSkillParams
+}
+
+type DeleteSkillResponseObject interface {
+	VisitDeleteSkillResponse(w http.ResponseWriter) error
+}
+
+type DeleteSkill200JSONResponse SuccessResponse
+
+func (response DeleteSkill200JSONResponse) VisitDeleteSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type DeleteSkill412JSONResponse PreconditionFailedResponse
+
+func (response DeleteSkill412JSONResponse) VisitDeleteSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetSkillRequestObject struct {
+	Scope  string `json:"scope"`
+	Name   string `json:"name"`
+	Params GetSkillParams
+}
+
+type GetSkillResponseObject interface {
+	VisitGetSkillResponse(w http.ResponseWriter) error
+}
+
+type GetSkill200ResponseHeaders struct {
+	ETag string
+}
+
+type GetSkill200JSONResponse struct {
+	Body    SkillDetail
+	Headers GetSkill200ResponseHeaders
+}
+
+func (response GetSkill200JSONResponse) VisitGetSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("ETag", fmt.Sprint(response.Headers.ETag))
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response.Body)
+}
+
+type UpdateSkillRequestObject struct {
+	Scope  string `json:"scope"`
+	Name   string `json:"name"`
+	Params UpdateSkillParams
+	Body   *UpdateSkillJSONRequestBody
+}
+
+type UpdateSkillResponseObject interface {
+	VisitUpdateSkillResponse(w http.ResponseWriter) error
+}
+
+type UpdateSkill200JSONResponse SkillDetail
+
+func (response UpdateSkill200JSONResponse) VisitUpdateSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSkill409JSONResponse ErrorResponse
+
+func (response UpdateSkill409JSONResponse) VisitUpdateSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateSkill412JSONResponse PreconditionFailedResponse
+
+func (response UpdateSkill412JSONResponse) VisitUpdateSkillResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type GetStatusLineRequestObject struct {
+	Params GetStatusLineParams
+}
+
+type GetStatusLineResponseObject interface {
+	VisitGetStatusLineResponse(w http.ResponseWriter) error
+}
+
+type GetStatusLine200JSONResponse StatusLineResponse
+
+func (response GetStatusLine200JSONResponse) VisitGetStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatusLineRequestObject struct {
+	Params UpdateStatusLineParams
+	Body   *UpdateStatusLineJSONRequestBody
+}
+
+type UpdateStatusLineResponseObject interface {
+	VisitUpdateStatusLineResponse(w http.ResponseWriter) error
+}
+
+type UpdateStatusLine200JSONResponse SuccessResponse
+
+func (response UpdateStatusLine200JSONResponse) VisitUpdateStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatusLine400JSONResponse ValidationErrorResponse
+
+func (response UpdateStatusLine400JSONResponse) VisitUpdateStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatusLine409JSONResponse ErrorResponse
+
+func (response UpdateStatusLine409JSONResponse) VisitUpdateStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(409)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatusLine412JSONResponse PreconditionFailedResponse
+
+func (response UpdateStatusLine412JSONResponse) VisitUpdateStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(412)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type UpdateStatusLine422JSONResponse SettingsParseErrorResponse
+
+func (response UpdateStatusLine422JSONResponse) VisitUpdateStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(422)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestStatusLineRequestObject struct {
+	Body *TestStatusLineJSONRequestBody
+}
+
+type TestStatusLineResponseObject interface {
+	VisitTestStatusLineResponse(w http.ResponseWriter) error
+}
+
+type TestStatusLine200JSONResponse StatusLineTestResult
+
+func (response TestStatusLine200JSONResponse) VisitTestStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(200)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type TestStatusLine400JSONResponse ErrorResponse
+
+func (response TestStatusLine400JSONResponse) VisitTestStatusLineResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(400)
+
+	return json.NewEncoder(w).Encode(response)
+}
+
+type WatchRequestObject struct {
+	Params WatchParams
+}
+
+type WatchResponseObject interface {
+	VisitWatchResponse(w http.ResponseWriter) error
+}
+
+type Watch200TexteventStreamResponse struct {
+	Body          io.Reader
+	ContentLength int64
+}
+
+func (response Watch200TexteventStreamResponse) VisitWatchResponse(w http.ResponseWriter) error {
+	w.Header().Set("Content-Type", "text/event-stream")
+	if response.ContentLength != 0 {
+		w.Header().Set("Content-Length", fmt.Sprint(response.ContentLength))
+	}
+	w.WriteHeader(200)
+
+	if closer, ok := response.Body.(io.ReadCloser); ok {
+		defer closer.Close()
+	}
+	_, err := io.Copy(w, response.Body)
+	return err
+}
+
+// StrictServerInterface represents all server handlers.
+type StrictServerInterface interface {
+	// List agents
+	// (GET /api/agents)
+	GetAgents(ctx context.Context, request GetAgentsRequestObject) (GetAgentsResponseObject, error)
+	// Create an agent
+	// (POST /api/agents)
+	CreateAgent(ctx context.Context, request CreateAgentRequestObject) (CreateAgentResponseObject, error)
+	// Delete an agent
+	// (DELETE /api/agents/{name})
+	DeleteAgent(ctx context.Context, request DeleteAgentRequestObject) (DeleteAgentResponseObject, error)
+	// Get agent detail
+	// (GET /api/agents/{name})
 	GetAgent(ctx context.Context, request GetAgentRequestObject) (GetAgentResponseObject, error)
 	// Update an agent
 	// (PUT /api/agents/{name})
@@ -7947,13 +19849,22 @@ type StrictServerInterface interface {
 	// Get merged config with layers
 	// (GET /api/config)
 	GetConfig(ctx context.Context, request GetConfigRequestObject) (GetConfigResponseObject, error)
+	// Apply a JSON Patch across one or more settings layers atomically
+	// (POST /api/config/patch)
+	PatchConfig(ctx context.Context, request PatchConfigRequestObject) (PatchConfigResponseObject, error)
+	// Preview a best-effort repair of a settings file that fails to parse
+	// (GET /api/config/repair)
+	GetConfigRepair(ctx context.Context, request GetConfigRepairRequestObject) (GetConfigRepairResponseObject, error)
+	// Replace a broken settings file with its repaired version
+	// (POST /api/config/repair)
+	RepairConfigLayer(ctx context.Context, request RepairConfigLayerRequestObject) (RepairConfigLayerResponseObject, error)
 	// Delete a config setting
 	// (DELETE /api/config/setting)
 	DeleteConfigSetting(ctx context.Context, request DeleteConfigSettingRequestObject) (DeleteConfigSettingResponseObject, error)
 	// Update a config setting
 	// (POST /api/config/setting)
 	UpdateConfigSetting(ctx context.Context, request UpdateConfigSettingRequestObject) (UpdateConfigSettingResponseObject, error)
-	// Move a config setting up or down
+	// Move or copy a config setting between settings layers
 	// (POST /api/config/setting/move)
 	MoveConfigSetting(ctx context.Context, request MoveConfigSettingRequestObject) (MoveConfigSettingResponseObject, error)
 	// Get features data
@@ -7974,6 +19885,15 @@ type StrictServerInterface interface {
 	// Create a hook
 	// (POST /api/hooks)
 	CreateHook(ctx context.Context, request CreateHookRequestObject) (CreateHookResponseObject, error)
+	// List the hook events Claude Code runs hooks for
+	// (GET /api/hooks/events)
+	GetHookEvents(ctx context.Context, request GetHookEventsRequestObject) (GetHookEventsResponseObject, error)
+	// List the known tools a hook matcher matches
+	// (POST /api/hooks/matcher-preview)
+	PreviewHookMatcher(ctx context.Context, request PreviewHookMatcherRequestObject) (PreviewHookMatcherResponseObject, error)
+	// Dry-run a command hook with a synthetic event on stdin
+	// (POST /api/hooks/test)
+	TestHook(ctx context.Context, request TestHookRequestObject) (TestHookResponseObject, error)
 	// Delete a hook
 	// (DELETE /api/hooks/{id})
 	DeleteHook(ctx context.Context, request DeleteHookRequestObject) (DeleteHookResponseObject, error)
@@ -7986,6 +19906,30 @@ type StrictServerInterface interface {
 	// Write CLAUDE.md or CLAUDE.local.md
 	// (PUT /api/instructions)
 	UpdateInstructions(ctx context.Context, request UpdateInstructionsRequestObject) (UpdateInstructionsResponseObject, error)
+	// List a project's .mcp.json servers with their approval state
+	// (GET /api/mcp/approvals)
+	GetMcpApprovals(ctx context.Context, request GetMcpApprovalsRequestObject) (GetMcpApprovalsResponseObject, error)
+	// Approve, reject or reset project .mcp.json servers
+	// (PUT /api/mcp/approvals)
+	UpdateMcpApprovals(ctx context.Context, request UpdateMcpApprovalsRequestObject) (UpdateMcpApprovalsResponseObject, error)
+	// Approve, reject or reset .mcp.json servers across registered projects
+	// (POST /api/mcp/approvals/bulk)
+	BulkUpdateMcpApprovals(ctx context.Context, request BulkUpdateMcpApprovalsRequestObject) (BulkUpdateMcpApprovalsResponseObject, error)
+	// List MCP servers of the user scope and, with a project, its local and project scopes
+	// (GET /api/mcp/servers)
+	GetMcpServers(ctx context.Context, request GetMcpServersRequestObject) (GetMcpServersResponseObject, error)
+	// Add an MCP server to a scope
+	// (POST /api/mcp/servers)
+	CreateMcpServer(ctx context.Context, request CreateMcpServerRequestObject) (CreateMcpServerResponseObject, error)
+	// Remove an MCP server from a scope
+	// (DELETE /api/mcp/servers/{name})
+	DeleteMcpServer(ctx context.Context, request DeleteMcpServerRequestObject) (DeleteMcpServerResponseObject, error)
+	// Replace an MCP server definition
+	// (PUT /api/mcp/servers/{name})
+	UpdateMcpServer(ctx context.Context, request UpdateMcpServerRequestObject) (UpdateMcpServerResponseObject, error)
+	// Start a stdio MCP server, perform the initialize handshake and list its tools
+	// (POST /api/mcp/servers/{name}/probe)
+	ProbeMcpServer(ctx context.Context, request ProbeMcpServerRequestObject) (ProbeMcpServerResponseObject, error)
 	// List memory files for a project
 	// (GET /api/memory)
 	ListMemory(ctx context.Context, request ListMemoryRequestObject) (ListMemoryResponseObject, error)
@@ -8001,6 +19945,45 @@ type StrictServerInterface interface {
 	// Update a memory file
 	// (PUT /api/memory/{filename})
 	UpdateMemory(ctx context.Context, request UpdateMemoryRequestObject) (UpdateMemoryResponseObject, error)
+	// List custom output styles
+	// (GET /api/output-styles)
+	GetOutputStyles(ctx context.Context, request GetOutputStylesRequestObject) (GetOutputStylesResponseObject, error)
+	// Create an output style
+	// (POST /api/output-styles)
+	CreateOutputStyle(ctx context.Context, request CreateOutputStyleRequestObject) (CreateOutputStyleResponseObject, error)
+	// Delete an output style
+	// (DELETE /api/output-styles/{name})
+	DeleteOutputStyle(ctx context.Context, request DeleteOutputStyleRequestObject) (DeleteOutputStyleResponseObject, error)
+	// Get output style detail
+	// (GET /api/output-styles/{name})
+	GetOutputStyle(ctx context.Context, request GetOutputStyleRequestObject) (GetOutputStyleResponseObject, error)
+	// Update an output style
+	// (PUT /api/output-styles/{name})
+	UpdateOutputStyle(ctx context.Context, request UpdateOutputStyleRequestObject) (UpdateOutputStyleResponseObject, error)
+	// Show the outputStyle setting of every layer, the one in effect and the styles available
+	// (GET /api/outputstyle)
+	GetOutputStyleSetting(ctx context.Context, request GetOutputStyleSettingRequestObject) (GetOutputStyleSettingResponseObject, error)
+	// Set or remove the outputStyle setting of a layer
+	// (PUT /api/outputstyle)
+	UpdateOutputStyleSetting(ctx context.Context, request UpdateOutputStyleSettingRequestObject) (UpdateOutputStyleSettingResponseObject, error)
+	// List permission rules of every layer, parsed, with their source
+	// (GET /api/permissions)
+	GetPermissions(ctx context.Context, request GetPermissionsRequestObject) (GetPermissionsResponseObject, error)
+	// Decide a hypothetical tool call against the rules of every layer
+	// (POST /api/permissions/evaluate)
+	EvaluatePermission(ctx context.Context, request EvaluatePermissionRequestObject) (EvaluatePermissionResponseObject, error)
+	// Remove a permission rule from a layer
+	// (DELETE /api/permissions/rules)
+	DeletePermissionRule(ctx context.Context, request DeletePermissionRuleRequestObject) (DeletePermissionRuleResponseObject, error)
+	// Append a permission rule to a layer
+	// (POST /api/permissions/rules)
+	AddPermissionRule(ctx context.Context, request AddPermissionRuleRequestObject) (AddPermissionRuleResponseObject, error)
+	// Replace a permission rule, optionally moving it to another list
+	// (PUT /api/permissions/rules)
+	UpdatePermissionRule(ctx context.Context, request UpdatePermissionRuleRequestObject) (UpdatePermissionRuleResponseObject, error)
+	// Set or clear defaultMode and additionalDirectories in a layer
+	// (PUT /api/permissions/settings)
+	UpdatePermissionSettings(ctx context.Context, request UpdatePermissionSettingsRequestObject) (UpdatePermissionSettingsResponseObject, error)
 	// List plugins
 	// (GET /api/plugins)
 	GetPlugins(ctx context.Context, request GetPluginsRequestObject) (GetPluginsResponseObject, error)
@@ -8016,9 +19999,15 @@ type StrictServerInterface interface {
 	// Remove a registered project
 	// (DELETE /api/projects/{projectId})
 	DeleteProject(ctx context.Context, request DeleteProjectRequestObject) (DeleteProjectResponseObject, error)
+	// Reveal one redacted settings or MCP server value
+	// (POST /api/redaction/reveal)
+	RevealRedactedValue(ctx context.Context, request RevealRedactedValueRequestObject) (RevealRedactedValueResponseObject, error)
 	// Search across all resources
 	// (GET /api/search)
 	Search(ctx context.Context, request SearchRequestObject) (SearchResponseObject, error)
+	// Scan managed Claude files for secrets
+	// (GET /api/secrets/scan)
+	ScanSecrets(ctx context.Context, request ScanSecretsRequestObject) (ScanSecretsResponseObject, error)
 	// List skills
 	// (GET /api/skills)
 	GetSkills(ctx context.Context, request GetSkillsRequestObject) (GetSkillsResponseObject, error)
@@ -8034,59 +20023,773 @@ type StrictServerInterface interface {
 	// Update a skill
 	// (PUT /api/skills/{scope}/{name})
 	UpdateSkill(ctx context.Context, request UpdateSkillRequestObject) (UpdateSkillResponseObject, error)
+	// Show the statusLine setting of every layer and the one in effect
+	// (GET /api/statusline)
+	GetStatusLine(ctx context.Context, request GetStatusLineRequestObject) (GetStatusLineResponseObject, error)
+	// Set or remove the statusLine setting of a layer
+	// (PUT /api/statusline)
+	UpdateStatusLine(ctx context.Context, request UpdateStatusLineRequestObject) (UpdateStatusLineResponseObject, error)
+	// Run a status line command with a synthetic session on stdin
+	// (POST /api/statusline/test)
+	TestStatusLine(ctx context.Context, request TestStatusLineRequestObject) (TestStatusLineResponseObject, error)
 	// SSE file watcher stream
 	// (GET /api/watch)
 	Watch(ctx context.Context, request WatchRequestObject) (WatchResponseObject, error)
 }
 
-type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
-type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+type StrictHandlerFunc = strictnethttp.StrictHTTPHandlerFunc
+type StrictMiddlewareFunc = strictnethttp.StrictHTTPMiddlewareFunc
+
+type StrictHTTPServerOptions struct {
+	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
+	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
+}
+
+func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
+		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+		},
+		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+		},
+	}}
+}
+
+func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
+	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+}
+
+type strictHandler struct {
+	ssi         StrictServerInterface
+	middlewares []StrictMiddlewareFunc
+	options     StrictHTTPServerOptions
+}
+
+// GetAgents operation middleware
+func (sh *strictHandler) GetAgents(w http.ResponseWriter, r *http.Request, params GetAgentsParams) {
+	var request GetAgentsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgents(ctx, request.(GetAgentsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAgentsResponseObject); ok {
+		if err := validResponse.VisitGetAgentsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateAgent operation middleware
+func (sh *strictHandler) CreateAgent(w http.ResponseWriter, r *http.Request) {
+	var request CreateAgentRequestObject
+
+	var body CreateAgentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateAgent(ctx, request.(CreateAgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateAgent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateAgentResponseObject); ok {
+		if err := validResponse.VisitCreateAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteAgent operation middleware
+func (sh *strictHandler) DeleteAgent(w http.ResponseWriter, r *http.Request, name string, params DeleteAgentParams) {
+	var request DeleteAgentRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	var body DeleteAgentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteAgent(ctx, request.(DeleteAgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteAgent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteAgentResponseObject); ok {
+		if err := validResponse.VisitDeleteAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAgent operation middleware
+func (sh *strictHandler) GetAgent(w http.ResponseWriter, r *http.Request, name string, params GetAgentParams) {
+	var request GetAgentRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAgent(ctx, request.(GetAgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAgent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAgentResponseObject); ok {
+		if err := validResponse.VisitGetAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateAgent operation middleware
+func (sh *strictHandler) UpdateAgent(w http.ResponseWriter, r *http.Request, name string, params UpdateAgentParams) {
+	var request UpdateAgentRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	var body UpdateAgentJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateAgent(ctx, request.(UpdateAgentRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateAgent")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateAgentResponseObject); ok {
+		if err := validResponse.VisitUpdateAgentResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Login operation middleware
+func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
+	var request LoginRequestObject
+
+	var body LoginJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Login")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LoginResponseObject); ok {
+		if err := validResponse.VisitLoginResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// Logout operation middleware
+func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
+	var request LogoutRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "Logout")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(LogoutResponseObject); ok {
+		if err := validResponse.VisitLogoutResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// SetupAuth operation middleware
+func (sh *strictHandler) SetupAuth(w http.ResponseWriter, r *http.Request) {
+	var request SetupAuthRequestObject
+
+	var body SetupAuthJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.SetupAuth(ctx, request.(SetupAuthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "SetupAuth")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(SetupAuthResponseObject); ok {
+		if err := validResponse.VisitSetupAuthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetAuthStatus operation middleware
+func (sh *strictHandler) GetAuthStatus(w http.ResponseWriter, r *http.Request) {
+	var request GetAuthStatusRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetAuthStatus(ctx, request.(GetAuthStatusRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetAuthStatus")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetAuthStatusResponseObject); ok {
+		if err := validResponse.VisitGetAuthStatusResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetBackups operation middleware
+func (sh *strictHandler) GetBackups(w http.ResponseWriter, r *http.Request) {
+	var request GetBackupsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetBackups(ctx, request.(GetBackupsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetBackups")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetBackupsResponseObject); ok {
+		if err := validResponse.VisitGetBackupsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RestoreBackup operation middleware
+func (sh *strictHandler) RestoreBackup(w http.ResponseWriter, r *http.Request, id string) {
+	var request RestoreBackupRequestObject
+
+	request.Id = id
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RestoreBackup(ctx, request.(RestoreBackupRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RestoreBackup")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RestoreBackupResponseObject); ok {
+		if err := validResponse.VisitRestoreBackupResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommands operation middleware
+func (sh *strictHandler) GetCommands(w http.ResponseWriter, r *http.Request, params GetCommandsParams) {
+	var request GetCommandsRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommands(ctx, request.(GetCommandsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommands")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandsResponseObject); ok {
+		if err := validResponse.VisitGetCommandsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateCommand operation middleware
+func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
+	var request CreateCommandRequestObject
+
+	var body CreateCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.CreateCommand(ctx, request.(CreateCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "CreateCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(CreateCommandResponseObject); ok {
+		if err := validResponse.VisitCreateCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteCommand operation middleware
+func (sh *strictHandler) DeleteCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params DeleteCommandParams) {
+	var request DeleteCommandRequestObject
+
+	request.Scope = scope
+	request.Folder = folder
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteCommand(ctx, request.(DeleteCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteCommandResponseObject); ok {
+		if err := validResponse.VisitDeleteCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetCommand operation middleware
+func (sh *strictHandler) GetCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params GetCommandParams) {
+	var request GetCommandRequestObject
+
+	request.Scope = scope
+	request.Folder = folder
+	request.Name = name
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetCommand(ctx, request.(GetCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetCommandResponseObject); ok {
+		if err := validResponse.VisitGetCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateCommand operation middleware
+func (sh *strictHandler) UpdateCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params UpdateCommandParams) {
+	var request UpdateCommandRequestObject
+
+	request.Scope = scope
+	request.Folder = folder
+	request.Name = name
+	request.Params = params
+
+	var body UpdateCommandJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateCommand(ctx, request.(UpdateCommandRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateCommand")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateCommandResponseObject); ok {
+		if err := validResponse.VisitUpdateCommandResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConfig operation middleware
+func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request, params GetConfigParams) {
+	var request GetConfigRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
+		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PatchConfig operation middleware
+func (sh *strictHandler) PatchConfig(w http.ResponseWriter, r *http.Request, params PatchConfigParams) {
+	var request PatchConfigRequestObject
+
+	request.Params = params
+
+	var body PatchConfigJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.PatchConfig(ctx, request.(PatchConfigRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "PatchConfig")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(PatchConfigResponseObject); ok {
+		if err := validResponse.VisitPatchConfigResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetConfigRepair operation middleware
+func (sh *strictHandler) GetConfigRepair(w http.ResponseWriter, r *http.Request, params GetConfigRepairParams) {
+	var request GetConfigRepairRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetConfigRepair(ctx, request.(GetConfigRepairRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetConfigRepair")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetConfigRepairResponseObject); ok {
+		if err := validResponse.VisitGetConfigRepairResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// RepairConfigLayer operation middleware
+func (sh *strictHandler) RepairConfigLayer(w http.ResponseWriter, r *http.Request, params RepairConfigLayerParams) {
+	var request RepairConfigLayerRequestObject
+
+	request.Params = params
+
+	var body RepairConfigLayerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RepairConfigLayer(ctx, request.(RepairConfigLayerRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RepairConfigLayer")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RepairConfigLayerResponseObject); ok {
+		if err := validResponse.VisitRepairConfigLayerResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// DeleteConfigSetting operation middleware
+func (sh *strictHandler) DeleteConfigSetting(w http.ResponseWriter, r *http.Request, params DeleteConfigSettingParams) {
+	var request DeleteConfigSettingRequestObject
+
+	request.Params = params
+
+	var body DeleteConfigSettingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.DeleteConfigSetting(ctx, request.(DeleteConfigSettingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "DeleteConfigSetting")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(DeleteConfigSettingResponseObject); ok {
+		if err := validResponse.VisitDeleteConfigSettingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateConfigSetting operation middleware
+func (sh *strictHandler) UpdateConfigSetting(w http.ResponseWriter, r *http.Request, params UpdateConfigSettingParams) {
+	var request UpdateConfigSettingRequestObject
 
-type StrictHTTPServerOptions struct {
-	RequestErrorHandlerFunc  func(w http.ResponseWriter, r *http.Request, err error)
-	ResponseErrorHandlerFunc func(w http.ResponseWriter, r *http.Request, err error)
-}
+	request.Params = params
 
-func NewStrictHandler(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: StrictHTTPServerOptions{
-		RequestErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusBadRequest)
-		},
-		ResponseErrorHandlerFunc: func(w http.ResponseWriter, r *http.Request, err error) {
-			http.Error(w, err.Error(), http.StatusInternalServerError)
-		},
-	}}
+	var body UpdateConfigSettingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateConfigSetting(ctx, request.(UpdateConfigSettingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateConfigSetting")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateConfigSettingResponseObject); ok {
+		if err := validResponse.VisitUpdateConfigSettingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-func NewStrictHandlerWithOptions(ssi StrictServerInterface, middlewares []StrictMiddlewareFunc, options StrictHTTPServerOptions) ServerInterface {
-	return &strictHandler{ssi: ssi, middlewares: middlewares, options: options}
+// MoveConfigSetting operation middleware
+func (sh *strictHandler) MoveConfigSetting(w http.ResponseWriter, r *http.Request, params MoveConfigSettingParams) {
+	var request MoveConfigSettingRequestObject
+
+	request.Params = params
+
+	var body MoveConfigSettingJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.MoveConfigSetting(ctx, request.(MoveConfigSettingRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "MoveConfigSetting")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(MoveConfigSettingResponseObject); ok {
+		if err := validResponse.VisitMoveConfigSettingResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-type strictHandler struct {
-	ssi         StrictServerInterface
-	middlewares []StrictMiddlewareFunc
-	options     StrictHTTPServerOptions
+// GetFeatures operation middleware
+func (sh *strictHandler) GetFeatures(w http.ResponseWriter, r *http.Request) {
+	var request GetFeaturesRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetFeatures(ctx, request.(GetFeaturesRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetFeatures")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetFeaturesResponseObject); ok {
+		if err := validResponse.VisitGetFeaturesResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
 }
 
-// GetAgents operation middleware
-func (sh *strictHandler) GetAgents(w http.ResponseWriter, r *http.Request, params GetAgentsParams) {
-	var request GetAgentsRequestObject
+// DeleteFeature operation middleware
+func (sh *strictHandler) DeleteFeature(w http.ResponseWriter, r *http.Request, key string, params DeleteFeatureParams) {
+	var request DeleteFeatureRequestObject
 
+	request.Key = key
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAgents(ctx, request.(GetAgentsRequestObject))
+		return sh.ssi.DeleteFeature(ctx, request.(DeleteFeatureRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAgents")
+		handler = middleware(handler, "DeleteFeature")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAgentsResponseObject); ok {
-		if err := validResponse.VisitGetAgentsResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteFeatureResponseObject); ok {
+		if err := validResponse.VisitDeleteFeatureResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8094,11 +20797,14 @@ func (sh *strictHandler) GetAgents(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// CreateAgent operation middleware
-func (sh *strictHandler) CreateAgent(w http.ResponseWriter, r *http.Request) {
-	var request CreateAgentRequestObject
+// UpdateFeature operation middleware
+func (sh *strictHandler) UpdateFeature(w http.ResponseWriter, r *http.Request, key string, params UpdateFeatureParams) {
+	var request UpdateFeatureRequestObject
 
-	var body CreateAgentJSONRequestBody
+	request.Key = key
+	request.Params = params
+
+	var body UpdateFeatureJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8106,18 +20812,18 @@ func (sh *strictHandler) CreateAgent(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateAgent(ctx, request.(CreateAgentRequestObject))
+		return sh.ssi.UpdateFeature(ctx, request.(UpdateFeatureRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateAgent")
+		handler = middleware(handler, "UpdateFeature")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateAgentResponseObject); ok {
-		if err := validResponse.VisitCreateAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateFeatureResponseObject); ok {
+		if err := validResponse.VisitUpdateFeatureResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8125,13 +20831,63 @@ func (sh *strictHandler) CreateAgent(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteAgent operation middleware
-func (sh *strictHandler) DeleteAgent(w http.ResponseWriter, r *http.Request, name string) {
-	var request DeleteAgentRequestObject
+// GetHealth operation middleware
+func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
+	var request GetHealthRequestObject
 
-	request.Name = name
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHealth")
+	}
 
-	var body DeleteAgentJSONRequestBody
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
+		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// GetHooks operation middleware
+func (sh *strictHandler) GetHooks(w http.ResponseWriter, r *http.Request, params GetHooksParams) {
+	var request GetHooksRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHooks(ctx, request.(GetHooksRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHooks")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHooksResponseObject); ok {
+		if err := validResponse.VisitGetHooksResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// CreateHook operation middleware
+func (sh *strictHandler) CreateHook(w http.ResponseWriter, r *http.Request, params CreateHookParams) {
+	var request CreateHookRequestObject
+
+	request.Params = params
+
+	var body CreateHookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8139,18 +20895,18 @@ func (sh *strictHandler) DeleteAgent(w http.ResponseWriter, r *http.Request, nam
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteAgent(ctx, request.(DeleteAgentRequestObject))
+		return sh.ssi.CreateHook(ctx, request.(CreateHookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteAgent")
+		handler = middleware(handler, "CreateHook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteAgentResponseObject); ok {
-		if err := validResponse.VisitDeleteAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateHookResponseObject); ok {
+		if err := validResponse.VisitCreateHookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8158,26 +20914,54 @@ func (sh *strictHandler) DeleteAgent(w http.ResponseWriter, r *http.Request, nam
 	}
 }
 
-// GetAgent operation middleware
-func (sh *strictHandler) GetAgent(w http.ResponseWriter, r *http.Request, name string, params GetAgentParams) {
-	var request GetAgentRequestObject
+// GetHookEvents operation middleware
+func (sh *strictHandler) GetHookEvents(w http.ResponseWriter, r *http.Request) {
+	var request GetHookEventsRequestObject
 
-	request.Name = name
-	request.Params = params
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetHookEvents(ctx, request.(GetHookEventsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetHookEvents")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetHookEventsResponseObject); ok {
+		if err := validResponse.VisitGetHookEventsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// PreviewHookMatcher operation middleware
+func (sh *strictHandler) PreviewHookMatcher(w http.ResponseWriter, r *http.Request) {
+	var request PreviewHookMatcherRequestObject
+
+	var body PreviewHookMatcherJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAgent(ctx, request.(GetAgentRequestObject))
+		return sh.ssi.PreviewHookMatcher(ctx, request.(PreviewHookMatcherRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAgent")
+		handler = middleware(handler, "PreviewHookMatcher")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAgentResponseObject); ok {
-		if err := validResponse.VisitGetAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(PreviewHookMatcherResponseObject); ok {
+		if err := validResponse.VisitPreviewHookMatcherResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8185,32 +20969,57 @@ func (sh *strictHandler) GetAgent(w http.ResponseWriter, r *http.Request, name s
 	}
 }
 
-// UpdateAgent operation middleware
-func (sh *strictHandler) UpdateAgent(w http.ResponseWriter, r *http.Request, name string) {
-	var request UpdateAgentRequestObject
+// TestHook operation middleware
+func (sh *strictHandler) TestHook(w http.ResponseWriter, r *http.Request) {
+	var request TestHookRequestObject
+
+	var body TestHookJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestHook(ctx, request.(TestHookRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestHook")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TestHookResponseObject); ok {
+		if err := validResponse.VisitTestHookResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
 
-	request.Name = name
+// DeleteHook operation middleware
+func (sh *strictHandler) DeleteHook(w http.ResponseWriter, r *http.Request, id string, params DeleteHookParams) {
+	var request DeleteHookRequestObject
 
-	var body UpdateAgentJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Id = id
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateAgent(ctx, request.(UpdateAgentRequestObject))
+		return sh.ssi.DeleteHook(ctx, request.(DeleteHookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateAgent")
+		handler = middleware(handler, "DeleteHook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateAgentResponseObject); ok {
-		if err := validResponse.VisitUpdateAgentResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteHookResponseObject); ok {
+		if err := validResponse.VisitDeleteHookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8218,11 +21027,14 @@ func (sh *strictHandler) UpdateAgent(w http.ResponseWriter, r *http.Request, nam
 	}
 }
 
-// Login operation middleware
-func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
-	var request LoginRequestObject
+// UpdateHook operation middleware
+func (sh *strictHandler) UpdateHook(w http.ResponseWriter, r *http.Request, id string, params UpdateHookParams) {
+	var request UpdateHookRequestObject
 
-	var body LoginJSONRequestBody
+	request.Id = id
+	request.Params = params
+
+	var body UpdateHookJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8230,18 +21042,18 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Login(ctx, request.(LoginRequestObject))
+		return sh.ssi.UpdateHook(ctx, request.(UpdateHookRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Login")
+		handler = middleware(handler, "UpdateHook")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LoginResponseObject); ok {
-		if err := validResponse.VisitLoginResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateHookResponseObject); ok {
+		if err := validResponse.VisitUpdateHookResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8249,23 +21061,25 @@ func (sh *strictHandler) Login(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// Logout operation middleware
-func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
-	var request LogoutRequestObject
+// GetInstructions operation middleware
+func (sh *strictHandler) GetInstructions(w http.ResponseWriter, r *http.Request, params GetInstructionsParams) {
+	var request GetInstructionsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.Logout(ctx, request.(LogoutRequestObject))
+		return sh.ssi.GetInstructions(ctx, request.(GetInstructionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "Logout")
+		handler = middleware(handler, "GetInstructions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(LogoutResponseObject); ok {
-		if err := validResponse.VisitLogoutResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetInstructionsResponseObject); ok {
+		if err := validResponse.VisitGetInstructionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8273,11 +21087,13 @@ func (sh *strictHandler) Logout(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// SetupAuth operation middleware
-func (sh *strictHandler) SetupAuth(w http.ResponseWriter, r *http.Request) {
-	var request SetupAuthRequestObject
+// UpdateInstructions operation middleware
+func (sh *strictHandler) UpdateInstructions(w http.ResponseWriter, r *http.Request, params UpdateInstructionsParams) {
+	var request UpdateInstructionsRequestObject
 
-	var body SetupAuthJSONRequestBody
+	request.Params = params
+
+	var body UpdateInstructionsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8285,18 +21101,18 @@ func (sh *strictHandler) SetupAuth(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.SetupAuth(ctx, request.(SetupAuthRequestObject))
+		return sh.ssi.UpdateInstructions(ctx, request.(UpdateInstructionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "SetupAuth")
+		handler = middleware(handler, "UpdateInstructions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(SetupAuthResponseObject); ok {
-		if err := validResponse.VisitSetupAuthResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateInstructionsResponseObject); ok {
+		if err := validResponse.VisitUpdateInstructionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8304,23 +21120,25 @@ func (sh *strictHandler) SetupAuth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetAuthStatus operation middleware
-func (sh *strictHandler) GetAuthStatus(w http.ResponseWriter, r *http.Request) {
-	var request GetAuthStatusRequestObject
+// GetMcpApprovals operation middleware
+func (sh *strictHandler) GetMcpApprovals(w http.ResponseWriter, r *http.Request, params GetMcpApprovalsParams) {
+	var request GetMcpApprovalsRequestObject
+
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetAuthStatus(ctx, request.(GetAuthStatusRequestObject))
+		return sh.ssi.GetMcpApprovals(ctx, request.(GetMcpApprovalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetAuthStatus")
+		handler = middleware(handler, "GetMcpApprovals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetAuthStatusResponseObject); ok {
-		if err := validResponse.VisitGetAuthStatusResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMcpApprovalsResponseObject); ok {
+		if err := validResponse.VisitGetMcpApprovalsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8328,23 +21146,32 @@ func (sh *strictHandler) GetAuthStatus(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetBackups operation middleware
-func (sh *strictHandler) GetBackups(w http.ResponseWriter, r *http.Request) {
-	var request GetBackupsRequestObject
+// UpdateMcpApprovals operation middleware
+func (sh *strictHandler) UpdateMcpApprovals(w http.ResponseWriter, r *http.Request, params UpdateMcpApprovalsParams) {
+	var request UpdateMcpApprovalsRequestObject
+
+	request.Params = params
+
+	var body UpdateMcpApprovalsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetBackups(ctx, request.(GetBackupsRequestObject))
+		return sh.ssi.UpdateMcpApprovals(ctx, request.(UpdateMcpApprovalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetBackups")
+		handler = middleware(handler, "UpdateMcpApprovals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetBackupsResponseObject); ok {
-		if err := validResponse.VisitGetBackupsResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateMcpApprovalsResponseObject); ok {
+		if err := validResponse.VisitUpdateMcpApprovalsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8352,25 +21179,30 @@ func (sh *strictHandler) GetBackups(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// RestoreBackup operation middleware
-func (sh *strictHandler) RestoreBackup(w http.ResponseWriter, r *http.Request, id string) {
-	var request RestoreBackupRequestObject
+// BulkUpdateMcpApprovals operation middleware
+func (sh *strictHandler) BulkUpdateMcpApprovals(w http.ResponseWriter, r *http.Request) {
+	var request BulkUpdateMcpApprovalsRequestObject
 
-	request.Id = id
+	var body BulkUpdateMcpApprovalsJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.RestoreBackup(ctx, request.(RestoreBackupRequestObject))
+		return sh.ssi.BulkUpdateMcpApprovals(ctx, request.(BulkUpdateMcpApprovalsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "RestoreBackup")
+		handler = middleware(handler, "BulkUpdateMcpApprovals")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(RestoreBackupResponseObject); ok {
-		if err := validResponse.VisitRestoreBackupResponse(w); err != nil {
+	} else if validResponse, ok := response.(BulkUpdateMcpApprovalsResponseObject); ok {
+		if err := validResponse.VisitBulkUpdateMcpApprovalsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8378,25 +21210,25 @@ func (sh *strictHandler) RestoreBackup(w http.ResponseWriter, r *http.Request, i
 	}
 }
 
-// GetCommands operation middleware
-func (sh *strictHandler) GetCommands(w http.ResponseWriter, r *http.Request, params GetCommandsParams) {
-	var request GetCommandsRequestObject
+// GetMcpServers operation middleware
+func (sh *strictHandler) GetMcpServers(w http.ResponseWriter, r *http.Request, params GetMcpServersParams) {
+	var request GetMcpServersRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommands(ctx, request.(GetCommandsRequestObject))
+		return sh.ssi.GetMcpServers(ctx, request.(GetMcpServersRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommands")
+		handler = middleware(handler, "GetMcpServers")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommandsResponseObject); ok {
-		if err := validResponse.VisitGetCommandsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMcpServersResponseObject); ok {
+		if err := validResponse.VisitGetMcpServersResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8404,11 +21236,13 @@ func (sh *strictHandler) GetCommands(w http.ResponseWriter, r *http.Request, par
 	}
 }
 
-// CreateCommand operation middleware
-func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
-	var request CreateCommandRequestObject
+// CreateMcpServer operation middleware
+func (sh *strictHandler) CreateMcpServer(w http.ResponseWriter, r *http.Request, params CreateMcpServerParams) {
+	var request CreateMcpServerRequestObject
 
-	var body CreateCommandJSONRequestBody
+	request.Params = params
+
+	var body CreateMcpServerJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8416,18 +21250,18 @@ func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateCommand(ctx, request.(CreateCommandRequestObject))
+		return sh.ssi.CreateMcpServer(ctx, request.(CreateMcpServerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateCommand")
+		handler = middleware(handler, "CreateMcpServer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateCommandResponseObject); ok {
-		if err := validResponse.VisitCreateCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateMcpServerResponseObject); ok {
+		if err := validResponse.VisitCreateMcpServerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8435,28 +21269,26 @@ func (sh *strictHandler) CreateCommand(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteCommand operation middleware
-func (sh *strictHandler) DeleteCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params DeleteCommandParams) {
-	var request DeleteCommandRequestObject
+// DeleteMcpServer operation middleware
+func (sh *strictHandler) DeleteMcpServer(w http.ResponseWriter, r *http.Request, name string, params DeleteMcpServerParams) {
+	var request DeleteMcpServerRequestObject
 
-	request.Scope = scope
-	request.Folder = folder
 	request.Name = name
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteCommand(ctx, request.(DeleteCommandRequestObject))
+		return sh.ssi.DeleteMcpServer(ctx, request.(DeleteMcpServerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteCommand")
+		handler = middleware(handler, "DeleteMcpServer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteCommandResponseObject); ok {
-		if err := validResponse.VisitDeleteCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteMcpServerResponseObject); ok {
+		if err := validResponse.VisitDeleteMcpServerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8464,28 +21296,33 @@ func (sh *strictHandler) DeleteCommand(w http.ResponseWriter, r *http.Request, s
 	}
 }
 
-// GetCommand operation middleware
-func (sh *strictHandler) GetCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string, params GetCommandParams) {
-	var request GetCommandRequestObject
+// UpdateMcpServer operation middleware
+func (sh *strictHandler) UpdateMcpServer(w http.ResponseWriter, r *http.Request, name string, params UpdateMcpServerParams) {
+	var request UpdateMcpServerRequestObject
 
-	request.Scope = scope
-	request.Folder = folder
 	request.Name = name
 	request.Params = params
 
+	var body UpdateMcpServerJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetCommand(ctx, request.(GetCommandRequestObject))
+		return sh.ssi.UpdateMcpServer(ctx, request.(UpdateMcpServerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetCommand")
+		handler = middleware(handler, "UpdateMcpServer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetCommandResponseObject); ok {
-		if err := validResponse.VisitGetCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateMcpServerResponseObject); ok {
+		if err := validResponse.VisitUpdateMcpServerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8493,15 +21330,13 @@ func (sh *strictHandler) GetCommand(w http.ResponseWriter, r *http.Request, scop
 	}
 }
 
-// UpdateCommand operation middleware
-func (sh *strictHandler) UpdateCommand(w http.ResponseWriter, r *http.Request, scope string, folder string, name string) {
-	var request UpdateCommandRequestObject
+// ProbeMcpServer operation middleware
+func (sh *strictHandler) ProbeMcpServer(w http.ResponseWriter, r *http.Request, name string) {
+	var request ProbeMcpServerRequestObject
 
-	request.Scope = scope
-	request.Folder = folder
 	request.Name = name
 
-	var body UpdateCommandJSONRequestBody
+	var body ProbeMcpServerJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8509,18 +21344,18 @@ func (sh *strictHandler) UpdateCommand(w http.ResponseWriter, r *http.Request, s
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateCommand(ctx, request.(UpdateCommandRequestObject))
+		return sh.ssi.ProbeMcpServer(ctx, request.(ProbeMcpServerRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateCommand")
+		handler = middleware(handler, "ProbeMcpServer")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateCommandResponseObject); ok {
-		if err := validResponse.VisitUpdateCommandResponse(w); err != nil {
+	} else if validResponse, ok := response.(ProbeMcpServerResponseObject); ok {
+		if err := validResponse.VisitProbeMcpServerResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8528,25 +21363,25 @@ func (sh *strictHandler) UpdateCommand(w http.ResponseWriter, r *http.Request, s
 	}
 }
 
-// GetConfig operation middleware
-func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request, params GetConfigParams) {
-	var request GetConfigRequestObject
+// ListMemory operation middleware
+func (sh *strictHandler) ListMemory(w http.ResponseWriter, r *http.Request, params ListMemoryParams) {
+	var request ListMemoryRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetConfig(ctx, request.(GetConfigRequestObject))
+		return sh.ssi.ListMemory(ctx, request.(ListMemoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetConfig")
+		handler = middleware(handler, "ListMemory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetConfigResponseObject); ok {
-		if err := validResponse.VisitGetConfigResponse(w); err != nil {
+	} else if validResponse, ok := response.(ListMemoryResponseObject); ok {
+		if err := validResponse.VisitListMemoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8554,11 +21389,11 @@ func (sh *strictHandler) GetConfig(w http.ResponseWriter, r *http.Request, param
 	}
 }
 
-// DeleteConfigSetting operation middleware
-func (sh *strictHandler) DeleteConfigSetting(w http.ResponseWriter, r *http.Request) {
-	var request DeleteConfigSettingRequestObject
+// CreateMemory operation middleware
+func (sh *strictHandler) CreateMemory(w http.ResponseWriter, r *http.Request) {
+	var request CreateMemoryRequestObject
 
-	var body DeleteConfigSettingJSONRequestBody
+	var body CreateMemoryJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8566,18 +21401,18 @@ func (sh *strictHandler) DeleteConfigSetting(w http.ResponseWriter, r *http.Requ
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteConfigSetting(ctx, request.(DeleteConfigSettingRequestObject))
+		return sh.ssi.CreateMemory(ctx, request.(CreateMemoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteConfigSetting")
+		handler = middleware(handler, "CreateMemory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteConfigSettingResponseObject); ok {
-		if err := validResponse.VisitDeleteConfigSettingResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateMemoryResponseObject); ok {
+		if err := validResponse.VisitCreateMemoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8585,30 +21420,26 @@ func (sh *strictHandler) DeleteConfigSetting(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// UpdateConfigSetting operation middleware
-func (sh *strictHandler) UpdateConfigSetting(w http.ResponseWriter, r *http.Request) {
-	var request UpdateConfigSettingRequestObject
+// DeleteMemory operation middleware
+func (sh *strictHandler) DeleteMemory(w http.ResponseWriter, r *http.Request, filename string, params DeleteMemoryParams) {
+	var request DeleteMemoryRequestObject
 
-	var body UpdateConfigSettingJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Filename = filename
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateConfigSetting(ctx, request.(UpdateConfigSettingRequestObject))
+		return sh.ssi.DeleteMemory(ctx, request.(DeleteMemoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateConfigSetting")
+		handler = middleware(handler, "DeleteMemory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateConfigSettingResponseObject); ok {
-		if err := validResponse.VisitUpdateConfigSettingResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteMemoryResponseObject); ok {
+		if err := validResponse.VisitDeleteMemoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8616,30 +21447,26 @@ func (sh *strictHandler) UpdateConfigSetting(w http.ResponseWriter, r *http.Requ
 	}
 }
 
-// MoveConfigSetting operation middleware
-func (sh *strictHandler) MoveConfigSetting(w http.ResponseWriter, r *http.Request) {
-	var request MoveConfigSettingRequestObject
+// GetMemory operation middleware
+func (sh *strictHandler) GetMemory(w http.ResponseWriter, r *http.Request, filename string, params GetMemoryParams) {
+	var request GetMemoryRequestObject
 
-	var body MoveConfigSettingJSONRequestBody
-	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
-		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
-		return
-	}
-	request.Body = &body
+	request.Filename = filename
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.MoveConfigSetting(ctx, request.(MoveConfigSettingRequestObject))
+		return sh.ssi.GetMemory(ctx, request.(GetMemoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "MoveConfigSetting")
+		handler = middleware(handler, "GetMemory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(MoveConfigSettingResponseObject); ok {
-		if err := validResponse.VisitMoveConfigSettingResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetMemoryResponseObject); ok {
+		if err := validResponse.VisitGetMemoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8647,23 +21474,33 @@ func (sh *strictHandler) MoveConfigSetting(w http.ResponseWriter, r *http.Reques
 	}
 }
 
-// GetFeatures operation middleware
-func (sh *strictHandler) GetFeatures(w http.ResponseWriter, r *http.Request) {
-	var request GetFeaturesRequestObject
+// UpdateMemory operation middleware
+func (sh *strictHandler) UpdateMemory(w http.ResponseWriter, r *http.Request, filename string, params UpdateMemoryParams) {
+	var request UpdateMemoryRequestObject
+
+	request.Filename = filename
+	request.Params = params
+
+	var body UpdateMemoryJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetFeatures(ctx, request.(GetFeaturesRequestObject))
+		return sh.ssi.UpdateMemory(ctx, request.(UpdateMemoryRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetFeatures")
+		handler = middleware(handler, "UpdateMemory")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetFeaturesResponseObject); ok {
-		if err := validResponse.VisitGetFeaturesResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateMemoryResponseObject); ok {
+		if err := validResponse.VisitUpdateMemoryResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8671,25 +21508,25 @@ func (sh *strictHandler) GetFeatures(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteFeature operation middleware
-func (sh *strictHandler) DeleteFeature(w http.ResponseWriter, r *http.Request, key string) {
-	var request DeleteFeatureRequestObject
+// GetOutputStyles operation middleware
+func (sh *strictHandler) GetOutputStyles(w http.ResponseWriter, r *http.Request, params GetOutputStylesParams) {
+	var request GetOutputStylesRequestObject
 
-	request.Key = key
+	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteFeature(ctx, request.(DeleteFeatureRequestObject))
+		return sh.ssi.GetOutputStyles(ctx, request.(GetOutputStylesRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteFeature")
+		handler = middleware(handler, "GetOutputStyles")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteFeatureResponseObject); ok {
-		if err := validResponse.VisitDeleteFeatureResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOutputStylesResponseObject); ok {
+		if err := validResponse.VisitGetOutputStylesResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8697,13 +21534,11 @@ func (sh *strictHandler) DeleteFeature(w http.ResponseWriter, r *http.Request, k
 	}
 }
 
-// UpdateFeature operation middleware
-func (sh *strictHandler) UpdateFeature(w http.ResponseWriter, r *http.Request, key string) {
-	var request UpdateFeatureRequestObject
-
-	request.Key = key
+// CreateOutputStyle operation middleware
+func (sh *strictHandler) CreateOutputStyle(w http.ResponseWriter, r *http.Request) {
+	var request CreateOutputStyleRequestObject
 
-	var body UpdateFeatureJSONRequestBody
+	var body CreateOutputStyleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8711,18 +21546,18 @@ func (sh *strictHandler) UpdateFeature(w http.ResponseWriter, r *http.Request, k
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateFeature(ctx, request.(UpdateFeatureRequestObject))
+		return sh.ssi.CreateOutputStyle(ctx, request.(CreateOutputStyleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateFeature")
+		handler = middleware(handler, "CreateOutputStyle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateFeatureResponseObject); ok {
-		if err := validResponse.VisitUpdateFeatureResponse(w); err != nil {
+	} else if validResponse, ok := response.(CreateOutputStyleResponseObject); ok {
+		if err := validResponse.VisitCreateOutputStyleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8730,23 +21565,33 @@ func (sh *strictHandler) UpdateFeature(w http.ResponseWriter, r *http.Request, k
 	}
 }
 
-// GetHealth operation middleware
-func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
-	var request GetHealthRequestObject
+// DeleteOutputStyle operation middleware
+func (sh *strictHandler) DeleteOutputStyle(w http.ResponseWriter, r *http.Request, name string, params DeleteOutputStyleParams) {
+	var request DeleteOutputStyleRequestObject
+
+	request.Name = name
+	request.Params = params
+
+	var body DeleteOutputStyleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHealth(ctx, request.(GetHealthRequestObject))
+		return sh.ssi.DeleteOutputStyle(ctx, request.(DeleteOutputStyleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHealth")
+		handler = middleware(handler, "DeleteOutputStyle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHealthResponseObject); ok {
-		if err := validResponse.VisitGetHealthResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeleteOutputStyleResponseObject); ok {
+		if err := validResponse.VisitDeleteOutputStyleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8754,25 +21599,26 @@ func (sh *strictHandler) GetHealth(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// GetHooks operation middleware
-func (sh *strictHandler) GetHooks(w http.ResponseWriter, r *http.Request, params GetHooksParams) {
-	var request GetHooksRequestObject
+// GetOutputStyle operation middleware
+func (sh *strictHandler) GetOutputStyle(w http.ResponseWriter, r *http.Request, name string, params GetOutputStyleParams) {
+	var request GetOutputStyleRequestObject
 
+	request.Name = name
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetHooks(ctx, request.(GetHooksRequestObject))
+		return sh.ssi.GetOutputStyle(ctx, request.(GetOutputStyleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetHooks")
+		handler = middleware(handler, "GetOutputStyle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetHooksResponseObject); ok {
-		if err := validResponse.VisitGetHooksResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOutputStyleResponseObject); ok {
+		if err := validResponse.VisitGetOutputStyleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8780,11 +21626,14 @@ func (sh *strictHandler) GetHooks(w http.ResponseWriter, r *http.Request, params
 	}
 }
 
-// CreateHook operation middleware
-func (sh *strictHandler) CreateHook(w http.ResponseWriter, r *http.Request) {
-	var request CreateHookRequestObject
+// UpdateOutputStyle operation middleware
+func (sh *strictHandler) UpdateOutputStyle(w http.ResponseWriter, r *http.Request, name string, params UpdateOutputStyleParams) {
+	var request UpdateOutputStyleRequestObject
 
-	var body CreateHookJSONRequestBody
+	request.Name = name
+	request.Params = params
+
+	var body UpdateOutputStyleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8792,18 +21641,18 @@ func (sh *strictHandler) CreateHook(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateHook(ctx, request.(CreateHookRequestObject))
+		return sh.ssi.UpdateOutputStyle(ctx, request.(UpdateOutputStyleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateHook")
+		handler = middleware(handler, "UpdateOutputStyle")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateHookResponseObject); ok {
-		if err := validResponse.VisitCreateHookResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateOutputStyleResponseObject); ok {
+		if err := validResponse.VisitUpdateOutputStyleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8811,26 +21660,25 @@ func (sh *strictHandler) CreateHook(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteHook operation middleware
-func (sh *strictHandler) DeleteHook(w http.ResponseWriter, r *http.Request, id string, params DeleteHookParams) {
-	var request DeleteHookRequestObject
+// GetOutputStyleSetting operation middleware
+func (sh *strictHandler) GetOutputStyleSetting(w http.ResponseWriter, r *http.Request, params GetOutputStyleSettingParams) {
+	var request GetOutputStyleSettingRequestObject
 
-	request.Id = id
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteHook(ctx, request.(DeleteHookRequestObject))
+		return sh.ssi.GetOutputStyleSetting(ctx, request.(GetOutputStyleSettingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteHook")
+		handler = middleware(handler, "GetOutputStyleSetting")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteHookResponseObject); ok {
-		if err := validResponse.VisitDeleteHookResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetOutputStyleSettingResponseObject); ok {
+		if err := validResponse.VisitGetOutputStyleSettingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8838,13 +21686,13 @@ func (sh *strictHandler) DeleteHook(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// UpdateHook operation middleware
-func (sh *strictHandler) UpdateHook(w http.ResponseWriter, r *http.Request, id string) {
-	var request UpdateHookRequestObject
+// UpdateOutputStyleSetting operation middleware
+func (sh *strictHandler) UpdateOutputStyleSetting(w http.ResponseWriter, r *http.Request, params UpdateOutputStyleSettingParams) {
+	var request UpdateOutputStyleSettingRequestObject
 
-	request.Id = id
+	request.Params = params
 
-	var body UpdateHookJSONRequestBody
+	var body UpdateOutputStyleSettingJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8852,18 +21700,18 @@ func (sh *strictHandler) UpdateHook(w http.ResponseWriter, r *http.Request, id s
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateHook(ctx, request.(UpdateHookRequestObject))
+		return sh.ssi.UpdateOutputStyleSetting(ctx, request.(UpdateOutputStyleSettingRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateHook")
+		handler = middleware(handler, "UpdateOutputStyleSetting")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateHookResponseObject); ok {
-		if err := validResponse.VisitUpdateHookResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdateOutputStyleSettingResponseObject); ok {
+		if err := validResponse.VisitUpdateOutputStyleSettingResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8871,25 +21719,25 @@ func (sh *strictHandler) UpdateHook(w http.ResponseWriter, r *http.Request, id s
 	}
 }
 
-// GetInstructions operation middleware
-func (sh *strictHandler) GetInstructions(w http.ResponseWriter, r *http.Request, params GetInstructionsParams) {
-	var request GetInstructionsRequestObject
+// GetPermissions operation middleware
+func (sh *strictHandler) GetPermissions(w http.ResponseWriter, r *http.Request, params GetPermissionsParams) {
+	var request GetPermissionsRequestObject
 
 	request.Params = params
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetInstructions(ctx, request.(GetInstructionsRequestObject))
+		return sh.ssi.GetPermissions(ctx, request.(GetPermissionsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetInstructions")
+		handler = middleware(handler, "GetPermissions")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetInstructionsResponseObject); ok {
-		if err := validResponse.VisitGetInstructionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(GetPermissionsResponseObject); ok {
+		if err := validResponse.VisitGetPermissionsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8897,11 +21745,11 @@ func (sh *strictHandler) GetInstructions(w http.ResponseWriter, r *http.Request,
 	}
 }
 
-// UpdateInstructions operation middleware
-func (sh *strictHandler) UpdateInstructions(w http.ResponseWriter, r *http.Request) {
-	var request UpdateInstructionsRequestObject
+// EvaluatePermission operation middleware
+func (sh *strictHandler) EvaluatePermission(w http.ResponseWriter, r *http.Request) {
+	var request EvaluatePermissionRequestObject
 
-	var body UpdateInstructionsJSONRequestBody
+	var body EvaluatePermissionJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8909,18 +21757,18 @@ func (sh *strictHandler) UpdateInstructions(w http.ResponseWriter, r *http.Reque
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateInstructions(ctx, request.(UpdateInstructionsRequestObject))
+		return sh.ssi.EvaluatePermission(ctx, request.(EvaluatePermissionRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateInstructions")
+		handler = middleware(handler, "EvaluatePermission")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateInstructionsResponseObject); ok {
-		if err := validResponse.VisitUpdateInstructionsResponse(w); err != nil {
+	} else if validResponse, ok := response.(EvaluatePermissionResponseObject); ok {
+		if err := validResponse.VisitEvaluatePermissionResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8928,37 +21776,13 @@ func (sh *strictHandler) UpdateInstructions(w http.ResponseWriter, r *http.Reque
 	}
 }
 
-// ListMemory operation middleware
-func (sh *strictHandler) ListMemory(w http.ResponseWriter, r *http.Request, params ListMemoryParams) {
-	var request ListMemoryRequestObject
+// DeletePermissionRule operation middleware
+func (sh *strictHandler) DeletePermissionRule(w http.ResponseWriter, r *http.Request, params DeletePermissionRuleParams) {
+	var request DeletePermissionRuleRequestObject
 
 	request.Params = params
 
-	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.ListMemory(ctx, request.(ListMemoryRequestObject))
-	}
-	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "ListMemory")
-	}
-
-	response, err := handler(r.Context(), w, r, request)
-
-	if err != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(ListMemoryResponseObject); ok {
-		if err := validResponse.VisitListMemoryResponse(w); err != nil {
-			sh.options.ResponseErrorHandlerFunc(w, r, err)
-		}
-	} else if response != nil {
-		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
-	}
-}
-
-// CreateMemory operation middleware
-func (sh *strictHandler) CreateMemory(w http.ResponseWriter, r *http.Request) {
-	var request CreateMemoryRequestObject
-
-	var body CreateMemoryJSONRequestBody
+	var body DeletePermissionRuleJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -8966,18 +21790,18 @@ func (sh *strictHandler) CreateMemory(w http.ResponseWriter, r *http.Request) {
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.CreateMemory(ctx, request.(CreateMemoryRequestObject))
+		return sh.ssi.DeletePermissionRule(ctx, request.(DeletePermissionRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "CreateMemory")
+		handler = middleware(handler, "DeletePermissionRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(CreateMemoryResponseObject); ok {
-		if err := validResponse.VisitCreateMemoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(DeletePermissionRuleResponseObject); ok {
+		if err := validResponse.VisitDeletePermissionRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -8985,26 +21809,32 @@ func (sh *strictHandler) CreateMemory(w http.ResponseWriter, r *http.Request) {
 	}
 }
 
-// DeleteMemory operation middleware
-func (sh *strictHandler) DeleteMemory(w http.ResponseWriter, r *http.Request, filename string, params DeleteMemoryParams) {
-	var request DeleteMemoryRequestObject
+// AddPermissionRule operation middleware
+func (sh *strictHandler) AddPermissionRule(w http.ResponseWriter, r *http.Request, params AddPermissionRuleParams) {
+	var request AddPermissionRuleRequestObject
 
-	request.Filename = filename
 	request.Params = params
 
+	var body AddPermissionRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.DeleteMemory(ctx, request.(DeleteMemoryRequestObject))
+		return sh.ssi.AddPermissionRule(ctx, request.(AddPermissionRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "DeleteMemory")
+		handler = middleware(handler, "AddPermissionRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(DeleteMemoryResponseObject); ok {
-		if err := validResponse.VisitDeleteMemoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(AddPermissionRuleResponseObject); ok {
+		if err := validResponse.VisitAddPermissionRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9012,26 +21842,32 @@ func (sh *strictHandler) DeleteMemory(w http.ResponseWriter, r *http.Request, fi
 	}
 }
 
-// GetMemory operation middleware
-func (sh *strictHandler) GetMemory(w http.ResponseWriter, r *http.Request, filename string, params GetMemoryParams) {
-	var request GetMemoryRequestObject
+// UpdatePermissionRule operation middleware
+func (sh *strictHandler) UpdatePermissionRule(w http.ResponseWriter, r *http.Request, params UpdatePermissionRuleParams) {
+	var request UpdatePermissionRuleRequestObject
 
-	request.Filename = filename
 	request.Params = params
 
+	var body UpdatePermissionRuleJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.GetMemory(ctx, request.(GetMemoryRequestObject))
+		return sh.ssi.UpdatePermissionRule(ctx, request.(UpdatePermissionRuleRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "GetMemory")
+		handler = middleware(handler, "UpdatePermissionRule")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(GetMemoryResponseObject); ok {
-		if err := validResponse.VisitGetMemoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePermissionRuleResponseObject); ok {
+		if err := validResponse.VisitUpdatePermissionRuleResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9039,13 +21875,13 @@ func (sh *strictHandler) GetMemory(w http.ResponseWriter, r *http.Request, filen
 	}
 }
 
-// UpdateMemory operation middleware
-func (sh *strictHandler) UpdateMemory(w http.ResponseWriter, r *http.Request, filename string) {
-	var request UpdateMemoryRequestObject
+// UpdatePermissionSettings operation middleware
+func (sh *strictHandler) UpdatePermissionSettings(w http.ResponseWriter, r *http.Request, params UpdatePermissionSettingsParams) {
+	var request UpdatePermissionSettingsRequestObject
 
-	request.Filename = filename
+	request.Params = params
 
-	var body UpdateMemoryJSONRequestBody
+	var body UpdatePermissionSettingsJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
 		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
 		return
@@ -9053,18 +21889,18 @@ func (sh *strictHandler) UpdateMemory(w http.ResponseWriter, r *http.Request, fi
 	request.Body = &body
 
 	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
-		return sh.ssi.UpdateMemory(ctx, request.(UpdateMemoryRequestObject))
+		return sh.ssi.UpdatePermissionSettings(ctx, request.(UpdatePermissionSettingsRequestObject))
 	}
 	for _, middleware := range sh.middlewares {
-		handler = middleware(handler, "UpdateMemory")
+		handler = middleware(handler, "UpdatePermissionSettings")
 	}
 
 	response, err := handler(r.Context(), w, r, request)
 
 	if err != nil {
 		sh.options.ResponseErrorHandlerFunc(w, r, err)
-	} else if validResponse, ok := response.(UpdateMemoryResponseObject); ok {
-		if err := validResponse.VisitUpdateMemoryResponse(w); err != nil {
+	} else if validResponse, ok := response.(UpdatePermissionSettingsResponseObject); ok {
+		if err := validResponse.VisitUpdatePermissionSettingsResponse(w); err != nil {
 			sh.options.ResponseErrorHandlerFunc(w, r, err)
 		}
 	} else if response != nil {
@@ -9203,6 +22039,37 @@ func (sh *strictHandler) DeleteProject(w http.ResponseWriter, r *http.Request, p
 	}
 }
 
+// RevealRedactedValue operation middleware
+func (sh *strictHandler) RevealRedactedValue(w http.ResponseWriter, r *http.Request) {
+	var request RevealRedactedValueRequestObject
+
+	var body RevealRedactedValueJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.RevealRedactedValue(ctx, request.(RevealRedactedValueRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "RevealRedactedValue")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(RevealRedactedValueResponseObject); ok {
+		if err := validResponse.VisitRevealRedactedValueResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Search operation middleware
 func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request, params SearchParams) {
 	var request SearchRequestObject
@@ -9229,6 +22096,30 @@ func (sh *strictHandler) Search(w http.ResponseWriter, r *http.Request, params S
 	}
 }
 
+// ScanSecrets operation middleware
+func (sh *strictHandler) ScanSecrets(w http.ResponseWriter, r *http.Request) {
+	var request ScanSecretsRequestObject
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.ScanSecrets(ctx, request.(ScanSecretsRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "ScanSecrets")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(ScanSecretsResponseObject); ok {
+		if err := validResponse.VisitScanSecretsResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // GetSkills operation middleware
 func (sh *strictHandler) GetSkills(w http.ResponseWriter, r *http.Request, params GetSkillsParams) {
 	var request GetSkillsRequestObject
@@ -9343,11 +22234,12 @@ func (sh *strictHandler) GetSkill(w http.ResponseWriter, r *http.Request, scope
 }
 
 // UpdateSkill operation middleware
-func (sh *strictHandler) UpdateSkill(w http.ResponseWriter, r *http.Request, scope string, name string) {
+func (sh *strictHandler) UpdateSkill(w http.ResponseWriter, r *http.Request, scope string, name string, params UpdateSkillParams) {
 	var request UpdateSkillRequestObject
 
 	request.Scope = scope
 	request.Name = name
+	request.Params = params
 
 	var body UpdateSkillJSONRequestBody
 	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
@@ -9376,6 +22268,96 @@ func (sh *strictHandler) UpdateSkill(w http.ResponseWriter, r *http.Request, sco
 	}
 }
 
+// GetStatusLine operation middleware
+func (sh *strictHandler) GetStatusLine(w http.ResponseWriter, r *http.Request, params GetStatusLineParams) {
+	var request GetStatusLineRequestObject
+
+	request.Params = params
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.GetStatusLine(ctx, request.(GetStatusLineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "GetStatusLine")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(GetStatusLineResponseObject); ok {
+		if err := validResponse.VisitGetStatusLineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// UpdateStatusLine operation middleware
+func (sh *strictHandler) UpdateStatusLine(w http.ResponseWriter, r *http.Request, params UpdateStatusLineParams) {
+	var request UpdateStatusLineRequestObject
+
+	request.Params = params
+
+	var body UpdateStatusLineJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.UpdateStatusLine(ctx, request.(UpdateStatusLineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "UpdateStatusLine")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(UpdateStatusLineResponseObject); ok {
+		if err := validResponse.VisitUpdateStatusLineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
+// TestStatusLine operation middleware
+func (sh *strictHandler) TestStatusLine(w http.ResponseWriter, r *http.Request) {
+	var request TestStatusLineRequestObject
+
+	var body TestStatusLineJSONRequestBody
+	if err := json.NewDecoder(r.Body).Decode(&body); err != nil {
+		sh.options.RequestErrorHandlerFunc(w, r, fmt.Errorf("can't decode JSON body: %w", err))
+		return
+	}
+	request.Body = &body
+
+	handler := func(ctx context.Context, w http.ResponseWriter, r *http.Request, request interface{}) (interface{}, error) {
+		return sh.ssi.TestStatusLine(ctx, request.(TestStatusLineRequestObject))
+	}
+	for _, middleware := range sh.middlewares {
+		handler = middleware(handler, "TestStatusLine")
+	}
+
+	response, err := handler(r.Context(), w, r, request)
+
+	if err != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, err)
+	} else if validResponse, ok := response.(TestStatusLineResponseObject); ok {
+		if err := validResponse.VisitTestStatusLineResponse(w); err != nil {
+			sh.options.ResponseErrorHandlerFunc(w, r, err)
+		}
+	} else if response != nil {
+		sh.options.ResponseErrorHandlerFunc(w, r, fmt.Errorf("unexpected response type: %T", response))
+	}
+}
+
 // Watch operation middleware
 func (sh *strictHandler) Watch(w http.ResponseWriter, r *http.Request, params WatchParams) {
 	var request WatchRequestObject
@@ -0,0 +1,166 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+
+	"fieldstation/lib"
+)
+
+// mcpScopePrecedence orders MCP scopes from the one whose server Claude Code
+// uses when several define the same name.
+var mcpScopePrecedence = []lib.MCPScope{lib.MCPScopeLocal, lib.MCPScopeProject, lib.MCPScopeUser}
+
+// effectiveMCPServers returns the servers of servers Claude Code starts: one
+// per name, from the scope with the highest precedence, in the order given.
+func effectiveMCPServers(servers []lib.MCPServer) []lib.MCPServer {
+	best := map[string]lib.MCPServer{}
+	for _, s := range servers {
+		if cur, ok := best[s.Name]; !ok || mcpScopeRank(s.Scope) < mcpScopeRank(cur.Scope) {
+			best[s.Name] = s
+		}
+	}
+	out := make([]lib.MCPServer, 0, len(best))
+	for _, s := range servers {
+		if best[s.Name].Scope == s.Scope {
+			out = append(out, s)
+		}
+	}
+	return out
+}
+
+func mcpScopeRank(scope lib.MCPScope) int {
+	for i, s := range mcpScopePrecedence {
+		if s == scope {
+			return i
+		}
+	}
+	return len(mcpScopePrecedence)
+}
+
+// probeMCPTools asks each stdio server of servers for its tools, concurrently,
+// and returns their names per server, or why they could not be listed.
+func probeMCPTools(ctx context.Context, servers []lib.MCPServer, projectPath, dir string) ([][]string, []string) {
+	tools := make([][]string, len(servers))
+	errs := make([]string, len(servers))
+	var wg sync.WaitGroup
+	for i, s := range servers {
+		if s.Type != lib.MCPServerStdio {
+			errs[i] = fmt.Sprintf("only stdio servers can be probed; %q is %s", s.Name, s.Type)
+			continue
+		}
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			// ListMCPServers redacts; probe the server as stored.
+			server, err := lib.LoadMCPServer(s.Scope, projectPath, s.Name)
+			if err != nil {
+				errs[i] = err.Error()
+				return
+			}
+			result, err := lib.ProbeMCPServer(ctx, server, dir, lib.MCPProbeTimeout)
+			if err != nil {
+				errs[i] = err.Error()
+				return
+			}
+			for _, tool := range result.Tools {
+				tools[i] = append(tools[i], lib.MCPToolName(s.Name, tool.Name))
+			}
+		}()
+	}
+	wg.Wait()
+	return tools, errs
+}
+
+// PreviewHookMatcher implements StrictServerInterface.
+func (h *FieldStationHandler) PreviewHookMatcher(ctx context.Context, request PreviewHookMatcherRequestObject) (PreviewHookMatcherResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	matcher, err := lib.CompileHookMatcher(body.Matcher)
+	if err != nil {
+		return PreviewHookMatcher400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	projectPath := ""
+	if body.ProjectId != nil && *body.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *body.ProjectId)
+		if err != nil {
+			return nil, err
+		}
+		projectPath = pp
+	}
+
+	resp := PreviewHookMatcher200JSONResponse{
+		Matcher:    body.Matcher,
+		MatchesAll: matcher.MatchesAll(),
+		Tools:      []HookMatcherTool{},
+		Matched:    []string{},
+		McpServers: []HookMatcherServer{},
+		Warnings:   []string{},
+	}
+	addTool := func(name string, server *string) {
+		matched := matcher.Match(name)
+		resp.Tools = append(resp.Tools, HookMatcherTool{Name: name, Server: server, Matched: matched})
+		if matched {
+			resp.Matched = append(resp.Matched, name)
+		}
+	}
+	for _, name := range lib.BuiltinTools {
+		addTool(name, nil)
+	}
+
+	allServers, _ := lib.ListMCPServers(projectPath)
+	servers := effectiveMCPServers(allServers)
+	probe := body.ProbeMcp != nil && *body.ProbeMcp
+	var tools [][]string
+	var errs []string
+	if probe && len(servers) > 0 {
+		dir := projectPath
+		if dir == "" {
+			if dir, err = os.UserHomeDir(); err != nil {
+				return nil, fmt.Errorf("hooks: %w", err)
+			}
+		}
+		tools, errs = probeMCPTools(ctx, servers, projectPath, dir)
+	}
+	unprobed := 0
+	for i, s := range servers {
+		entry := HookMatcherServer{Name: s.Name, Scope: McpScope(s.Scope)}
+		if probe {
+			if errs[i] != "" {
+				e := errs[i]
+				entry.Error = &e
+			} else {
+				entry.Probed = true
+				name := s.Name
+				for _, tool := range tools[i] {
+					addTool(tool, &name)
+				}
+			}
+		}
+		if !entry.Probed {
+			unprobed++
+		}
+		resp.McpServers = append(resp.McpServers, entry)
+	}
+
+	switch {
+	case matcher.MatchesAll():
+		resp.Warnings = append(resp.Warnings, "the matcher matches every tool call")
+	case len(resp.Matched) == 0:
+		msg := "the matcher matches no known tool"
+		if unprobed > 0 && strings.Contains(body.Matcher, "mcp__") {
+			msg += "; tools of MCP servers that were not probed are not known, set probeMcp to include them"
+		}
+		resp.Warnings = append(resp.Warnings, msg)
+	case len(resp.Matched) == len(resp.Tools):
+		resp.Warnings = append(resp.Warnings, `the matcher matches every known tool; use "*" if that is intended`)
+	}
+	return resp, nil
+}
@@ -2,93 +2,261 @@ package api //nolint:revive // "api" is a meaningful package name for this HTTP
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"maps"
+	"math"
+	"os"
 	"path/filepath"
+	"slices"
 	"strconv"
 	"strings"
+	"time"
 
 	"fieldstation/lib"
 )
 
-// settingsHookDefinition mirrors the JSON structure of a hook definition
-// in settings.json:  { hooks: [{type: "command", command: "..."}], matcher?: "..." }
+// settingsHookDefinition is a hook definition in settings.json:
+// { hooks: [{type: "command", command: "..."}], matcher?: "..." }. Extra holds
+// the keys it does not model, which are written back unchanged.
 type settingsHookDefinition struct {
-	Hooks   []settingsHookCommand `json:"hooks"`
-	Matcher string                `json:"matcher,omitempty"`
+	Hooks   []settingsHookCommand
+	Matcher string
+	Extra   lib.JsonObject
 }
 
+// settingsHookCommand is one hook of a definition. Command is set for
+// "command" hooks and Prompt for "prompt" hooks; Timeout is in seconds. Extra
+// holds the keys it does not model, which are written back unchanged.
 type settingsHookCommand struct {
-	Type    string `json:"type"`
-	Command string `json:"command"`
+	Type    string
+	Command string
+	Prompt  string
+	Timeout *int
+	Extra   lib.JsonObject
 }
 
-// hookEvents is the ordered list of recognised hook event names.
-var hookEvents = []string{
-	"SessionStart",
-	"UserPromptSubmit",
-	"PreToolUse",
-	"PostToolUse",
-	"Notification",
-	"Stop",
-	"SubagentStop",
+// parseHookDefinition reads a hook definition as decoded from a settings file.
+// A key of an unexpected type is kept in Extra rather than dropped.
+func parseHookDefinition(value any) settingsHookDefinition {
+	var def settingsHookDefinition
+	obj, _ := value.(lib.JsonObject)
+	for k, v := range obj {
+		switch k {
+		case "matcher":
+			if m, ok := v.(string); ok {
+				def.Matcher = m
+				continue
+			}
+		case "hooks":
+			if items, ok := v.([]any); ok {
+				def.Hooks = make([]settingsHookCommand, 0, len(items))
+				for _, item := range items {
+					def.Hooks = append(def.Hooks, parseHookCommand(item))
+				}
+				continue
+			}
+		}
+		if def.Extra == nil {
+			def.Extra = lib.JsonObject{}
+		}
+		def.Extra[k] = v
+	}
+	return def
+}
+
+// parseHookCommand reads one hook as decoded from a settings file. A key of an
+// unexpected type, such as a fractional timeout, is kept in Extra.
+func parseHookCommand(value any) settingsHookCommand {
+	var cmd settingsHookCommand
+	obj, _ := value.(lib.JsonObject)
+	for k, v := range obj {
+		switch k {
+		case "type":
+			if t, ok := v.(string); ok {
+				cmd.Type = t
+				continue
+			}
+		case "command":
+			if c, ok := v.(string); ok {
+				cmd.Command = c
+				continue
+			}
+		case "prompt":
+			if p, ok := v.(string); ok {
+				cmd.Prompt = p
+				continue
+			}
+		case "timeout":
+			if n, ok := v.(float64); ok && n == math.Trunc(n) {
+				timeout := int(n)
+				cmd.Timeout = &timeout
+				continue
+			}
+		}
+		if cmd.Extra == nil {
+			cmd.Extra = lib.JsonObject{}
+		}
+		cmd.Extra[k] = v
+	}
+	return cmd
+}
+
+// toJSON returns the definition as written to a settings file.
+func (d settingsHookDefinition) toJSON() lib.JsonObject {
+	obj := maps.Clone(d.Extra)
+	if obj == nil {
+		obj = lib.JsonObject{}
+	}
+	hooks := make([]any, 0, len(d.Hooks))
+	for _, c := range d.Hooks {
+		hooks = append(hooks, c.toJSON())
+	}
+	obj["hooks"] = hooks
+	if d.Matcher != "" {
+		obj["matcher"] = d.Matcher
+	}
+	return obj
+}
+
+// toJSON returns the hook as written to a settings file.
+func (c settingsHookCommand) toJSON() lib.JsonObject {
+	obj := maps.Clone(c.Extra)
+	if obj == nil {
+		obj = lib.JsonObject{}
+	}
+	obj["type"] = c.Type
+	if c.Type == hookTypeCommand || c.Command != "" {
+		obj["command"] = c.Command
+	}
+	if c.Prompt != "" {
+		obj["prompt"] = c.Prompt
+	}
+	if c.Timeout != nil {
+		obj["timeout"] = *c.Timeout
+	}
+	return obj
 }
 
-// validHookEvent reports whether event is one of the recognised hook event names.
+// Hook types Claude Code runs.
+const (
+	hookTypeCommand = string(HookCommandTypeCommand)
+	hookTypePrompt  = string(HookCommandTypePrompt)
+)
+
+// validHookEvent reports whether event is one Claude Code runs hooks for.
 func validHookEvent(event string) bool {
-	for _, e := range hookEvents {
-		if e == event {
-			return true
+	_, ok := lib.GetHookEvent(event)
+	return ok
+}
+
+// checkHookEventAndMatcher returns why a hook definition for event with
+// matcher cannot be written, or "" if it can: the event must be one Claude
+// Code knows, only events that filter by matcher may have one, and the matcher
+// must compile.
+func checkHookEventAndMatcher(event string, matcher *string) string {
+	def, ok := lib.GetHookEvent(event)
+	if !ok {
+		return fmt.Sprintf("unknown hook event %q", event)
+	}
+	if matcher != nil && *matcher != "" && !def.AcceptsMatcher() {
+		return fmt.Sprintf("%s hooks do not use a matcher", event)
+	}
+	if matcher != nil {
+		if _, err := lib.CompileHookMatcher(*matcher); err != nil {
+			return err.Error()
+		}
+	}
+	return ""
+}
+
+// unknownHookEvents returns the events of hooksMap that Claude Code does not
+// know, sorted, or nil if there are none.
+func unknownHookEvents(hooksMap map[string][]settingsHookDefinition) *[]string {
+	var unknown []string
+	for event := range hooksMap {
+		if !validHookEvent(event) {
+			unknown = append(unknown, event)
 		}
 	}
-	return false
+	if unknown == nil {
+		return nil
+	}
+	slices.Sort(unknown)
+	return &unknown
 }
 
-// settingsHooksPath returns the path to the settings.json used for hooks.
-// If scope is "project" and projectPath is non-empty, returns the project
-// settings path; otherwise returns the global settings path.
+// settingsHooksPath returns the settings file of the layer scope names:
+// global, global-local, project or project-local, as resolved by hookScope.
 func (h *FieldStationHandler) settingsHooksPath(scope string, projectPath string) string {
-	if scope == "project" && projectPath != "" {
+	switch hookScope(scope, projectPath) {
+	case "global-local":
+		return filepath.Join(h.claudeHome, "settings.local.json")
+	case "project":
 		return filepath.Join(projectPath, ".claude", "settings.json")
+	case "project-local":
+		return filepath.Join(projectPath, ".claude", "settings.local.json")
+	default:
+		return filepath.Join(h.claudeHome, "settings.json")
 	}
-	return filepath.Join(h.claudeHome, "settings.json")
 }
 
 // readHooksByEvent reads the hooks map from a settings.json file.
-// Returns nil if the file does not exist or has no hooks key.
+// Returns nil if the file does not exist, cannot be parsed or has no hooks key.
 func readHooksByEvent(settingsPath string) map[string][]settingsHookDefinition {
-	settings := lib.ReadJSONFileSafe(settingsPath)
-	hooksRaw, ok := settings["hooks"]
-	if !ok || hooksRaw == nil {
-		return nil
-	}
-	// Re-marshal and unmarshal to get typed hook structs.
-	data, err := json.Marshal(hooksRaw)
+	return hooksFromSettings(lib.ReadJSONFileSafe(settingsPath))
+}
+
+// readHooksForWrite reads the hooks map from a settings.json file that is about
+// to be rewritten. Unlike readHooksByEvent it returns a *lib.JSONParseError
+// when the file exists but cannot be parsed.
+func readHooksForWrite(settingsPath string) (map[string][]settingsHookDefinition, error) {
+	settings, err := lib.ReadJSONFileStrict(settingsPath)
 	if err != nil {
-		return nil
+		return nil, err
 	}
-	var result map[string][]settingsHookDefinition
-	if err := json.Unmarshal(data, &result); err != nil {
+	return hooksFromSettings(settings), nil
+}
+
+// hooksFromSettings extracts the hooks map from a parsed settings object.
+// Every element of an event's array becomes a definition, so that indexes
+// line up with the file. Returns nil if there is no hooks key.
+func hooksFromSettings(settings lib.JsonObject) map[string][]settingsHookDefinition {
+	hooksRaw, ok := settings["hooks"].(lib.JsonObject)
+	if !ok {
 		return nil
 	}
+	result := make(map[string][]settingsHookDefinition, len(hooksRaw))
+	for event, raw := range hooksRaw {
+		items, ok := raw.([]any)
+		if !ok {
+			continue
+		}
+		defs := make([]settingsHookDefinition, 0, len(items))
+		for _, item := range items {
+			defs = append(defs, parseHookDefinition(item))
+		}
+		result[event] = defs
+	}
 	return result
 }
 
-// hooksByEventToAPIType converts the raw hooks map to the API HooksByEvent type.
-func hooksByEventToAPIType(hooksMap map[string][]settingsHookDefinition) *HooksByEvent {
+// hooksByEventToAPIType converts the raw hooks map read from scope to the API
+// HooksByEvent type, redacting secrets from the commands.
+func hooksByEventToAPIType(hooksMap map[string][]settingsHookDefinition, scope string, redactor *lib.Redactor) *HooksByEvent {
 	if len(hooksMap) == 0 {
 		return nil
 	}
-	out := &HooksByEvent{}
+	out := HooksByEvent{}
 	for event, defs := range hooksMap {
 		apiDefs := make([]HookDefinition, 0, len(defs))
-		for _, d := range defs {
+		ids := hookDefinitionIDs(scope, event, defs)
+		for i, d := range defs {
 			cmds := make([]HookCommand, 0, len(d.Hooks))
 			for _, c := range d.Hooks {
-				cmds = append(cmds, HookCommand{
-					Type:    HookCommandType(c.Type),
-					Command: c.Command,
-				})
+				cmds = append(cmds, hookCommandToAPI(c, redactor))
 			}
 			var matcher *string
 			if d.Matcher != "" {
@@ -96,93 +264,255 @@ func hooksByEventToAPIType(hooksMap map[string][]settingsHookDefinition) *HooksB
 				matcher = &m
 			}
 			apiDefs = append(apiDefs, HookDefinition{
-				Hooks:   cmds,
-				Matcher: matcher,
+				Id:                   ids[i],
+				Hooks:                cmds,
+				Matcher:              matcher,
+				AdditionalProperties: redactedExtra(d.Extra, redactor),
 			})
 		}
-		switch event {
-		case "SessionStart":
-			out.SessionStart = &apiDefs
-		case "UserPromptSubmit":
-			out.UserPromptSubmit = &apiDefs
-		case "PreToolUse":
-			out.PreToolUse = &apiDefs
-		case "PostToolUse":
-			out.PostToolUse = &apiDefs
-		case "Notification":
-			out.Notification = &apiDefs
-		case "Stop":
-			out.Stop = &apiDefs
-		case "SubagentStop":
-			out.SubagentStop = &apiDefs
-		}
-	}
-	return out
+		out[event] = apiDefs
+	}
+	return &out
+}
+
+// hookCommandToAPI converts one hook to its API type, redacting secrets.
+func hookCommandToAPI(c settingsHookCommand, redactor *lib.Redactor) HookCommand {
+	cmd := HookCommand{
+		Type:                 HookCommandType(c.Type),
+		Timeout:              c.Timeout,
+		AdditionalProperties: redactedExtra(c.Extra, redactor),
+	}
+	if c.Command != "" || c.Type == hookTypeCommand {
+		command := redactor.Text(c.Command)
+		cmd.Command = &command
+	}
+	if c.Prompt != "" {
+		prompt := redactor.Text(c.Prompt)
+		cmd.Prompt = &prompt
+	}
+	return cmd
+}
+
+// redactedExtra returns the unmodelled keys of a hook or definition for the
+// API, redacted, or nil if there are none.
+func redactedExtra(extra lib.JsonObject, redactor *lib.Redactor) map[string]interface{} {
+	if len(extra) == 0 {
+		return nil
+	}
+	return redactor.Object(extra)
 }
 
-// GetHooks reads hooks from global (and optionally project) settings.
+// GetHooks reads hooks from global (and optionally project) settings, lists
+// every source of hooks and merges them into the lists Claude Code runs.
 func (h *FieldStationHandler) GetHooks(_ context.Context, request GetHooksRequestObject) (GetHooksResponseObject, error) {
 	globalSettingsPath := filepath.Join(h.claudeHome, "settings.json")
 	globalHooks := readHooksByEvent(globalSettingsPath)
-	globalByEvent := hooksByEventToAPIType(globalHooks)
+	redactor := h.redactor()
+	globalByEvent := hooksByEventToAPIType(globalHooks, "global", redactor)
 
+	globalETag := lib.FileETag(globalSettingsPath)
 	resp := HooksResponse{
 		Global: &HookScope{
-			Hooks: globalByEvent,
+			Hooks:         globalByEvent,
+			UnknownEvents: unknownHookEvents(globalHooks),
+			Etag:          &globalETag,
 		},
 	}
 
+	projectPath := ""
 	if request.Params.ProjectId != nil && *request.Params.ProjectId != "" {
 		pp, err := resolveProjectPath(h.claudeHome, *request.Params.ProjectId)
 		if err != nil {
 			return nil, fmt.Errorf("invalid project id: %w", err)
 		}
+		projectPath = pp
 		projectSettingsPath := filepath.Join(pp, ".claude", "settings.json")
 		projectHooks := readHooksByEvent(projectSettingsPath)
-		projectByEvent := hooksByEventToAPIType(projectHooks)
+		projectByEvent := hooksByEventToAPIType(projectHooks, "project", redactor)
+		projectETag := lib.FileETag(projectSettingsPath)
 		resp.Project = &HookScope{
-			Hooks: projectByEvent,
+			Hooks:         projectByEvent,
+			UnknownEvents: unknownHookEvents(projectHooks),
+			Etag:          &projectETag,
 		}
 	}
 
+	config := lib.MergeConfigLayers(projectPath)
+	sources := h.hookSources(config)
+	resp.Layers = hookSourcesToAPI(sources, redactor)
+	resp.DisableAllHooks, _ = config.Merged["disableAllHooks"].(bool)
+	for _, layer := range config.Layers {
+		if layer.Source == lib.ConfigLayerManaged {
+			resp.ManagedHooksOnly, _ = layer.Content["allowManagedHooksOnly"].(bool)
+		}
+	}
+	resp.Merged = map[string][]MergedHookEntry{}
+	if !resp.DisableAllHooks {
+		resp.Merged = mergedHooks(sources, resp.ManagedHooksOnly, redactor)
+	}
+
 	return GetHooks200JSONResponse(resp), nil
 }
 
-// parseHookID parses a hook ID of the format "event:index" (e.g., "PreToolUse:0").
-func parseHookID(id string) (event string, index int, err error) {
-	parts := strings.SplitN(id, ":", 2)
-	if len(parts) != 2 {
-		return "", 0, fmt.Errorf("invalid hook ID %q: expected format event:index", id)
+// hookDefinitionIDs returns the stable IDs of the definitions of event in
+// scope, in order. An ID is a hash of the scope, event, matcher and hooks of
+// the definition, so it keeps addressing the same definition when others are
+// added or removed around it and stops matching once the definition is
+// changed. Identical definitions are told apart by a "-2", "-3"... suffix.
+func hookDefinitionIDs(scope, event string, defs []settingsHookDefinition) []string {
+	type hookIDPart struct {
+		Type    string `json:"type"`
+		Command string `json:"command,omitempty"`
+		Prompt  string `json:"prompt,omitempty"`
+	}
+	ids := make([]string, len(defs))
+	seen := map[string]int{}
+	for i, d := range defs {
+		parts := make([]hookIDPart, 0, len(d.Hooks))
+		for _, c := range d.Hooks {
+			parts = append(parts, hookIDPart{Type: c.Type, Command: c.Command, Prompt: c.Prompt})
+		}
+		data, _ := json.Marshal([]any{scope, event, d.Matcher, parts}) //nolint:errcheck // marshalling strings cannot fail
+		sum := sha256.Sum256(data)
+		id := hex.EncodeToString(sum[:8])
+		seen[id]++
+		if n := seen[id]; n > 1 {
+			id = fmt.Sprintf("%s-%d", id, n)
+		}
+		ids[i] = id
 	}
-	event = parts[0]
-	idx, convErr := strconv.Atoi(parts[1])
-	if convErr != nil {
-		return "", 0, fmt.Errorf("invalid hook ID %q: index is not an integer", id)
+	return ids
+}
+
+// findHookDefinition returns the event and index of the definition with the
+// given ID in hooksMap, read from scope.
+func findHookDefinition(hooksMap map[string][]settingsHookDefinition, scope, id string) (event string, index int, ok bool) {
+	for event, defs := range hooksMap {
+		for i, defID := range hookDefinitionIDs(scope, event, defs) {
+			if defID == id {
+				return event, i, true
+			}
+		}
 	}
-	return event, idx, nil
+	return "", 0, false
 }
 
-// writeHooksToSettings reads settings, replaces the hooks key, and writes back atomically.
-func writeHooksToSettings(settingsPath string, claudeHome string, hooksMap map[string][]settingsHookDefinition) error {
-	settings := lib.ReadJSONFileSafe(settingsPath)
+// hookScope returns the settings layer scope names. A project layer without
+// projectPath falls back to its global counterpart, and anything unknown to
+// global.
+func hookScope(scope, projectPath string) string {
+	switch scope {
+	case "global-local":
+		return scope
+	case "project", "project-local":
+		if projectPath != "" {
+			return scope
+		}
+		return "global" + strings.TrimPrefix(scope, "project")
+	default:
+		return "global"
+	}
+}
 
-	if len(hooksMap) == 0 {
-		delete(settings, "hooks")
-	} else {
-		// Encode hooksMap as a JsonObject value.
-		data, err := json.Marshal(hooksMap)
-		if err != nil {
-			return fmt.Errorf("hooks: cannot marshal hooks: %w", err)
+// hookGoneResponse is the error for a hook ID that matches no definition,
+// because the definition was changed or removed since the ID was read.
+func hookGoneResponse(id string) ErrorResponse {
+	return ErrorResponse{Error: fmt.Sprintf("hook %q no longer exists; it was changed or removed since it was read", id)}
+}
+
+// writeHookDefinition writes def over the definition at index of event in a
+// settings file in place, or appends it when index is -1, leaving every other
+// hook, key and the file's formatting untouched. Redaction placeholders in def
+// are restored from the definition it replaces.
+// Returns a *lib.JSONParseError, without writing, if the settings file cannot be parsed.
+func writeHookDefinition(settingsPath, claudeHome, event string, index int, def settingsHookDefinition) error {
+	key := "-"
+	if index >= 0 {
+		key = strconv.Itoa(index)
+	}
+	return lib.ApplyUpdateSetting(settingsPath, lib.JoinPath("hooks", event, key), def.toJSON(), claudeHome)
+}
+
+// deleteHookDefinition removes the definition at index of event from a
+// settings file in place, leaving every other hook untouched. An event with no
+// definitions left is removed, and so is the hooks key once it holds nothing
+// else, including values hooksMap skips because they are not arrays.
+// Returns a *lib.JSONParseError, without writing, if the settings file cannot be parsed.
+func deleteHookDefinition(settingsPath, claudeHome string, hooksMap map[string][]settingsHookDefinition, event string, index int) error {
+	if len(hooksMap[event]) > 1 {
+		return lib.ApplyDeleteSetting(settingsPath, lib.JoinPath("hooks", event, strconv.Itoa(index)), claudeHome)
+	}
+	content, err := lib.ReadJSONFileStrict(settingsPath)
+	if err != nil {
+		return err
+	}
+	if raw, _ := content["hooks"].(lib.JsonObject); len(raw) == 1 {
+		return lib.ApplyDeleteSetting(settingsPath, "hooks", claudeHome)
+	}
+	return lib.ApplyDeleteSetting(settingsPath, lib.JoinPath("hooks", event), claudeHome)
+}
+
+// hookCommandsFromRequest builds the hooks of a create or update request: from
+// hooks when set, otherwise one command hook per entry of commands. stored are
+// the hooks being replaced, if any. A hook keeps the unmodelled keys of the
+// stored hook of the same type at its position, and a hook given only as a
+// command also keeps that hook's timeout.
+// Returns an error describing the first invalid hook.
+func hookCommandsFromRequest(hooks *[]HookCommand, commands *[]string, stored []settingsHookCommand) ([]settingsHookCommand, error) {
+	var cmds []settingsHookCommand
+	switch {
+	case hooks != nil:
+		for _, hc := range *hooks {
+			cmd := settingsHookCommand{Type: string(hc.Type), Timeout: hc.Timeout, Extra: hc.AdditionalProperties}
+			if hc.Command != nil {
+				cmd.Command = *hc.Command
+			}
+			if hc.Prompt != nil {
+				cmd.Prompt = *hc.Prompt
+			}
+			cmds = append(cmds, cmd)
 		}
-		var hooksObj interface{}
-		if err := json.Unmarshal(data, &hooksObj); err != nil {
-			return fmt.Errorf("hooks: cannot unmarshal hooks: %w", err)
+	case commands != nil:
+		for _, c := range *commands {
+			cmds = append(cmds, settingsHookCommand{Type: hookTypeCommand, Command: c})
 		}
-		settings["hooks"] = hooksObj
+	}
+	if len(cmds) == 0 {
+		return nil, fmt.Errorf("at least one hook is required")
 	}
 
-	lib.BackupFile(settingsPath, lib.BackupOpUpdate, claudeHome)
-	return lib.WriteJSONFileSafe(settingsPath, settings)
+	for i := range cmds {
+		cmd := &cmds[i]
+		switch cmd.Type {
+		case hookTypeCommand:
+			if strings.TrimSpace(cmd.Command) == "" {
+				return nil, fmt.Errorf("hook %d: a command hook needs a command", i)
+			}
+		case hookTypePrompt:
+			if strings.TrimSpace(cmd.Prompt) == "" {
+				return nil, fmt.Errorf("hook %d: a prompt hook needs a prompt", i)
+			}
+		default:
+			return nil, fmt.Errorf("hook %d: unknown hook type %q", i, cmd.Type)
+		}
+		if cmd.Timeout != nil && *cmd.Timeout < 1 {
+			return nil, fmt.Errorf("hook %d: timeout must be at least 1 second", i)
+		}
+
+		if i >= len(stored) || stored[i].Type != cmd.Type {
+			continue
+		}
+		extra := maps.Clone(stored[i].Extra)
+		if extra == nil {
+			extra = lib.JsonObject{}
+		}
+		maps.Copy(extra, cmd.Extra)
+		cmd.Extra = extra
+		if hooks == nil {
+			cmd.Timeout = stored[i].Timeout
+		}
+	}
+	return cmds, nil
 }
 
 // CreateHook appends a new HookDefinition to the specified event in settings.
@@ -202,44 +532,43 @@ func (h *FieldStationHandler) CreateHook(_ context.Context, request CreateHookRe
 	}
 	settingsPath := h.settingsHooksPath(string(body.Scope), projectPath)
 
-	if !validHookEvent(body.Event) {
-		return nil, fmt.Errorf("invalid hook event: %q", body.Event)
+	if msg := checkHookEventAndMatcher(body.Event, body.Matcher); msg != "" {
+		return CreateHook400JSONResponse(ErrorResponse{Error: msg}), nil
 	}
 
-	hooksMap := readHooksByEvent(settingsPath)
-	if hooksMap == nil {
-		hooksMap = make(map[string][]settingsHookDefinition)
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, settingsPath); failed != nil {
+		return CreateHook412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
 	}
 
-	cmds := make([]settingsHookCommand, 0, len(body.Commands))
-	for _, cmd := range body.Commands {
-		cmds = append(cmds, settingsHookCommand{Type: "command", Command: cmd})
+	cmds, err := hookCommandsFromRequest(body.Hooks, body.Commands, nil)
+	if err != nil {
+		return CreateHook400JSONResponse(ErrorResponse{Error: err.Error()}), nil
 	}
 
 	newDef := settingsHookDefinition{Hooks: cmds}
 	if body.Matcher != nil && *body.Matcher != "" {
 		newDef.Matcher = *body.Matcher
 	}
-	hooksMap[body.Event] = append(hooksMap[body.Event], newDef)
 
-	if err := writeHooksToSettings(settingsPath, h.claudeHome, hooksMap); err != nil {
+	if err := writeHookDefinition(settingsPath, h.claudeHome, body.Event, -1, newDef); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return CreateHook422JSONResponse(resp), nil
+		}
+		if resp, ok := redactedWriteConflict(err); ok {
+			return CreateHook409JSONResponse(resp), nil
+		}
 		return nil, fmt.Errorf("hooks: failed to write settings: %w", err)
 	}
 	return CreateHook200JSONResponse(SuccessResponse{Success: true}), nil
 }
 
-// UpdateHook replaces the hook at the given event:index position.
+// UpdateHook replaces the hook definition with the given ID.
 func (h *FieldStationHandler) UpdateHook(_ context.Context, request UpdateHookRequestObject) (UpdateHookResponseObject, error) {
 	if request.Body == nil {
 		return nil, fmt.Errorf("request body is required")
 	}
 	body := request.Body
 
-	event, index, err := parseHookID(request.Id)
-	if err != nil {
-		return nil, err
-	}
-
 	projectPath := ""
 	if body.ProjectId != nil && *body.ProjectId != "" {
 		pp, err := resolveProjectPath(h.claudeHome, *body.ProjectId)
@@ -250,43 +579,52 @@ func (h *FieldStationHandler) UpdateHook(_ context.Context, request UpdateHookRe
 	}
 	settingsPath := h.settingsHooksPath(string(body.Scope), projectPath)
 
-	if !validHookEvent(body.Event) {
-		return nil, fmt.Errorf("invalid hook event: %q", body.Event)
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, settingsPath); failed != nil {
+		return UpdateHook412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
 	}
 
-	hooksMap := readHooksByEvent(settingsPath)
-	if hooksMap == nil {
-		return nil, fmt.Errorf("hooks: no hooks found for event %q", event)
+	hooksMap, err := readHooksForWrite(settingsPath)
+	if err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateHook422JSONResponse(resp), nil
+		}
+		return nil, err
 	}
-
-	defs, ok := hooksMap[event]
-	if !ok || index < 0 || index >= len(defs) {
-		return nil, fmt.Errorf("hooks: hook %q not found (event=%s, index=%d)", request.Id, event, index)
+	event, index, ok := findHookDefinition(hooksMap, hookScope(string(body.Scope), projectPath), request.Id)
+	if !ok {
+		return UpdateHook409JSONResponse(hookGoneResponse(request.Id)), nil
+	}
+	if body.Event != event {
+		return UpdateHook400JSONResponse(ErrorResponse{Error: fmt.Sprintf("hook %q is a %s hook, not %s; a hook cannot be moved to another event", request.Id, event, body.Event)}), nil
+	}
+	if msg := checkHookEventAndMatcher(event, body.Matcher); msg != "" {
+		return UpdateHook400JSONResponse(ErrorResponse{Error: msg}), nil
 	}
 
-	cmds := make([]settingsHookCommand, 0, len(body.Commands))
-	for _, cmd := range body.Commands {
-		cmds = append(cmds, settingsHookCommand{Type: "command", Command: cmd})
+	stored := hooksMap[event][index]
+	cmds, err := hookCommandsFromRequest(body.Hooks, body.Commands, stored.Hooks)
+	if err != nil {
+		return UpdateHook400JSONResponse(ErrorResponse{Error: err.Error()}), nil
 	}
-	updatedDef := settingsHookDefinition{Hooks: cmds}
+	updatedDef := settingsHookDefinition{Hooks: cmds, Extra: stored.Extra}
 	if body.Matcher != nil && *body.Matcher != "" {
 		updatedDef.Matcher = *body.Matcher
 	}
-	hooksMap[event][index] = updatedDef
 
-	if err := writeHooksToSettings(settingsPath, h.claudeHome, hooksMap); err != nil {
+	if err := writeHookDefinition(settingsPath, h.claudeHome, event, index, updatedDef); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateHook422JSONResponse(resp), nil
+		}
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateHook409JSONResponse(resp), nil
+		}
 		return nil, fmt.Errorf("hooks: failed to write settings: %w", err)
 	}
 	return UpdateHook200JSONResponse(SuccessResponse{Success: true}), nil
 }
 
-// DeleteHook removes the hook at the given event:index position.
+// DeleteHook removes the hook definition with the given ID.
 func (h *FieldStationHandler) DeleteHook(_ context.Context, request DeleteHookRequestObject) (DeleteHookResponseObject, error) {
-	event, index, err := parseHookID(request.Id)
-	if err != nil {
-		return nil, err
-	}
-
 	scope := "global"
 	if request.Params.Scope != nil && *request.Params.Scope != "" {
 		scope = *request.Params.Scope
@@ -302,25 +640,168 @@ func (h *FieldStationHandler) DeleteHook(_ context.Context, request DeleteHookRe
 	}
 	settingsPath := h.settingsHooksPath(scope, projectPath)
 
-	hooksMap := readHooksByEvent(settingsPath)
-	if hooksMap == nil {
-		return nil, fmt.Errorf("hooks: no hooks found for event %q", event)
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, settingsPath); failed != nil {
+		return DeleteHook412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
 	}
 
-	defs, ok := hooksMap[event]
-	if !ok || index < 0 || index >= len(defs) {
-		return nil, fmt.Errorf("hooks: hook %q not found (event=%s, index=%d)", request.Id, event, index)
+	hooksMap, err := readHooksForWrite(settingsPath)
+	if err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return DeleteHook422JSONResponse(resp), nil
+		}
+		return nil, err
 	}
-
-	// Remove the hook at the given index.
-	hooksMap[event] = append(defs[:index], defs[index+1:]...)
-	// Clean up empty event slices.
-	if len(hooksMap[event]) == 0 {
-		delete(hooksMap, event)
+	event, index, ok := findHookDefinition(hooksMap, hookScope(scope, projectPath), request.Id)
+	if !ok {
+		return DeleteHook409JSONResponse(hookGoneResponse(request.Id)), nil
 	}
 
-	if err := writeHooksToSettings(settingsPath, h.claudeHome, hooksMap); err != nil {
+	if err := deleteHookDefinition(settingsPath, h.claudeHome, hooksMap, event, index); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return DeleteHook422JSONResponse(resp), nil
+		}
 		return nil, fmt.Errorf("hooks: failed to write settings: %w", err)
 	}
 	return DeleteHook200JSONResponse(SuccessResponse{Success: true}), nil
 }
+
+// GetHookEvents lists the hook events Claude Code runs hooks for.
+func (h *FieldStationHandler) GetHookEvents(_ context.Context, _ GetHookEventsRequestObject) (GetHookEventsResponseObject, error) {
+	events := lib.AllHookEvents()
+	result := make([]HookEventInfo, 0, len(events))
+	for _, e := range events {
+		info := HookEventInfo{
+			Name:           e.Name,
+			Description:    e.Description,
+			AcceptsMatcher: e.AcceptsMatcher(),
+			Payload:        e.Payload,
+		}
+		if e.Matcher != "" {
+			matcher := e.Matcher
+			info.Matcher = &matcher
+		}
+		result = append(result, info)
+	}
+	return GetHookEvents200JSONResponse(result), nil
+}
+
+// maxHookTestTimeoutSeconds caps the timeout a hook may run under when it is
+// dry-run, as the request is held open until it finishes.
+const maxHookTestTimeoutSeconds = 120
+
+// TestHook dry-runs a saved or draft command hook with a synthetic event.
+func (h *FieldStationHandler) TestHook(ctx context.Context, request TestHookRequestObject) (TestHookResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	projectPath := ""
+	if body.ProjectId != nil && *body.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *body.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("hooks: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	event := ""
+	var hook *settingsHookCommand
+	if body.HookId != nil && *body.HookId != "" {
+		scope := "global"
+		if body.Scope != nil {
+			scope = string(*body.Scope)
+		}
+		hooksMap := readHooksByEvent(h.settingsHooksPath(scope, projectPath))
+		hookEvent, index, ok := findHookDefinition(hooksMap, hookScope(scope, projectPath), *body.HookId)
+		if !ok {
+			return TestHook404JSONResponse(hookGoneResponse(*body.HookId)), nil
+		}
+		hooks := hooksMap[hookEvent][index].Hooks
+		hookIndex := 0
+		if body.HookIndex != nil {
+			hookIndex = *body.HookIndex
+		}
+		if hookIndex < 0 || hookIndex >= len(hooks) {
+			return TestHook404JSONResponse(ErrorResponse{Error: fmt.Sprintf("hook %q has no hook %d", *body.HookId, hookIndex)}), nil
+		}
+		event = hookEvent
+		hook = &hooks[hookIndex]
+	}
+	if body.Event != nil && *body.Event != "" {
+		event = *body.Event
+	}
+
+	if body.Hook != nil {
+		draft, err := hookCommandsFromRequest(&[]HookCommand{*body.Hook}, nil, nil)
+		if err != nil {
+			return TestHook400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+		}
+		if hook != nil {
+			if draft[0].Command, err = h.redactor().RestoreText(draft[0].Command, hook.Command); err != nil {
+				return TestHook400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+			}
+		}
+		hook = &draft[0]
+	}
+	if hook == nil {
+		return TestHook400JSONResponse(ErrorResponse{Error: "pass a hookId or a draft hook to test"}), nil
+	}
+	if hook.Type != hookTypeCommand {
+		return TestHook400JSONResponse(ErrorResponse{Error: fmt.Sprintf("%s hooks are evaluated by the model and cannot be dry-run", hook.Type)}), nil
+	}
+
+	if event == "" {
+		return TestHook400JSONResponse(ErrorResponse{Error: "pass the event to simulate"}), nil
+	}
+
+	dir := projectPath
+	if dir == "" {
+		var err error
+		if dir, err = os.UserHomeDir(); err != nil {
+			return nil, fmt.Errorf("hooks: %w", err)
+		}
+	}
+	toolName := ""
+	if body.ToolName != nil {
+		toolName = *body.ToolName
+	}
+	var toolInput lib.JsonObject
+	if body.ToolInput != nil {
+		toolInput = *body.ToolInput
+	}
+	payload, err := lib.SyntheticHookEvent(event, dir, toolName, toolInput)
+	if err != nil {
+		return TestHook400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	timeout := lib.HookTimeout
+	if hook.Timeout != nil {
+		if *hook.Timeout < 1 || *hook.Timeout > maxHookTestTimeoutSeconds {
+			return TestHook400JSONResponse(ErrorResponse{Error: fmt.Sprintf("a hook can only be dry-run with a timeout between 1 and %d seconds", maxHookTestTimeoutSeconds)}), nil
+		}
+		timeout = time.Duration(*hook.Timeout) * time.Second
+	}
+	run, err := lib.RunHook(ctx, hook.Command, dir, payload, timeout)
+	if err != nil {
+		return nil, err
+	}
+	redactor := h.redactor()
+	result := HookTestResult{
+		Stdout:     redactor.Text(run.Stdout),
+		Stderr:     redactor.Text(run.Stderr),
+		ExitCode:   run.ExitCode,
+		TimedOut:   run.TimedOut,
+		DurationMs: int(run.Duration.Milliseconds()),
+		Outcome:    HookTestResultOutcome(run.Outcome),
+		Input:      payload,
+	}
+	if run.Decision != nil {
+		decision := redactor.Object(run.Decision)
+		result.Decision = &decision
+	}
+	if run.DecisionError != "" {
+		result.DecisionError = &run.DecisionError
+	}
+	return TestHook200JSONResponse(result), nil
+}
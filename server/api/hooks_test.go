@@ -2,6 +2,11 @@ package api_test
 
 import (
 	"context"
+	"encoding/json"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -9,6 +14,18 @@ import (
 	"fieldstation/api"
 )
 
+// globalHookID returns the id GetHooks reports for the definition at index of
+// event in the global settings.
+func globalHookID(t *testing.T, h *api.FieldStationHandler, event string, index int) string {
+	t.Helper()
+	resp, err := h.GetHooks(context.Background(), api.GetHooksRequestObject{})
+	require.NoError(t, err)
+	hooks := resp.(api.GetHooks200JSONResponse).Global.Hooks
+	require.NotNil(t, hooks)
+	require.Greater(t, len((*hooks)[event]), index)
+	return (*hooks)[event][index].Id
+}
+
 // Unregistered project ID must be rejected — resolveProjectPath validates registration.
 
 func TestGetHooks_RejectsUnregisteredProjectPath(t *testing.T) {
@@ -32,7 +49,7 @@ func TestCreateHook_RejectsUnregisteredProjectPath(t *testing.T) {
 			Scope:     scope,
 			ProjectId: &fakeID,
 			Event:     "PreToolUse",
-			Commands:  []string{"echo injected"},
+			Commands:  &[]string{"echo injected"},
 		},
 	})
 	require.Error(t, err, "CreateHook must reject unregistered project ids")
@@ -50,7 +67,7 @@ func TestUpdateHook_RejectsUnregisteredProjectPath(t *testing.T) {
 			Scope:     scope,
 			ProjectId: &fakeID,
 			Event:     "PreToolUse",
-			Commands:  []string{"echo injected"},
+			Commands:  &[]string{"echo injected"},
 		},
 	})
 	require.Error(t, err, "UpdateHook must reject unregistered project ids")
@@ -72,3 +89,569 @@ func TestDeleteHook_RejectsUnregisteredProjectPath(t *testing.T) {
 	require.Error(t, err, "DeleteHook must reject unregistered project ids")
 	assert.Contains(t, err.Error(), "unregistered")
 }
+
+func TestCreateHook_RefusesUnparseableSettings(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	original := []byte(`{"model":"opus",}`)
+	require.NoError(t, os.WriteFile(settingsPath, original, 0o600))
+
+	resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+		Body: &api.CreateHookJSONRequestBody{
+			Scope:    api.CreateHookRequestScopeGlobal,
+			Event:    "Stop",
+			Commands: &[]string{"echo done"},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.CreateHook422JSONResponse)
+	require.True(t, ok, "expected 422, got %T", resp)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, original, data)
+}
+
+func TestDeleteHook_LeavesOtherEventsUntouched(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	original := "{\n  \"hooks\": {\n    \"PreToolUse\": [\n      {\"matcher\": \"Bash\", \"hooks\": [{\"type\": \"command\", \"command\": \"a\"}]}\n    ],\n" +
+		"    \"Stop\": [\n      {\"hooks\": [{\"type\": \"command\", \"command\": \"b\"}]}\n    ]\n  }\n}\n"
+	require.NoError(t, os.WriteFile(settingsPath, []byte(original), 0o600))
+
+	_, err := h.DeleteHook(context.Background(), api.DeleteHookRequestObject{Id: globalHookID(t, h, "Stop", 0)})
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"hooks\": {\n    \"PreToolUse\": [\n      {\"matcher\": \"Bash\", \"hooks\": [{\"type\": \"command\", \"command\": \"a\"}]}\n    ]\n  }\n}\n", string(data))
+}
+
+func TestDeleteHook_KeepsNonArraySiblingEvents(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"hooks":{"PreToolUse":[{"matcher":"Bash","hooks":[{"type":"command","command":"a"}]}],"FutureEvent":{"weird":true}}}`), 0o600))
+
+	resp, err := h.DeleteHook(context.Background(), api.DeleteHookRequestObject{Id: globalHookID(t, h, "PreToolUse", 0)})
+	require.NoError(t, err)
+	_, ok := resp.(api.DeleteHook200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"hooks":{"FutureEvent":{"weird":true}}}`, string(data))
+
+	// With the last hook gone, the empty hooks key goes too.
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"model":"opus","hooks":{"Stop":[{"hooks":[{"type":"command","command":"b"}]}]}}`), 0o600))
+	_, err = h.DeleteHook(context.Background(), api.DeleteHookRequestObject{Id: globalHookID(t, h, "Stop", 0)})
+	require.NoError(t, err)
+	data, err = os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus"}`, string(data))
+}
+
+func TestUpdateHook_LeavesOtherHooksByteIdentical(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	untouchedPre := `{"matcher": "Bash", "hooks": [{"type": "command", "command": "a", "timeout": 30, "statusMessage": "Checking"}]}`
+	untouchedStop := `{"hooks": [{"type": "prompt", "prompt": "Is the task done?", "timeout": 15}], "x-note": "keep"}`
+	original := "{\n  \"hooks\": {\n    \"PreToolUse\": [\n      " + untouchedPre + ",\n" +
+		"      {\"matcher\": \"Edit\", \"hooks\": [{\"type\": \"command\", \"command\": \"b\", \"timeout\": 60, \"async\": true}], \"x-note\": \"mine\"}\n    ],\n" +
+		"    \"Stop\": [\n      " + untouchedStop + "\n    ]\n  }\n}\n"
+	require.NoError(t, os.WriteFile(settingsPath, []byte(original), 0o600))
+
+	matcher := "Edit|Write"
+	resp, err := h.UpdateHook(context.Background(), api.UpdateHookRequestObject{
+		Id: globalHookID(t, h, "PreToolUse", 1),
+		Body: &api.UpdateHookJSONRequestBody{
+			Scope:    api.UpdateHookRequestScopeGlobal,
+			Event:    "PreToolUse",
+			Matcher:  &matcher,
+			Commands: &[]string{"b --fix"},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.UpdateHook200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	content := string(data)
+	assert.True(t, strings.HasPrefix(content, "{\n  \"hooks\": {\n    \"PreToolUse\": [\n      "+untouchedPre+",\n"))
+	assert.True(t, strings.HasSuffix(content, "    \"Stop\": [\n      "+untouchedStop+"\n    ]\n  }\n}\n"))
+
+	var settings struct {
+		Hooks map[string][]map[string]any `json:"hooks"`
+	}
+	require.NoError(t, json.Unmarshal(data, &settings))
+	updated := settings.Hooks["PreToolUse"][1]
+	assert.Equal(t, "Edit|Write", updated["matcher"])
+	assert.Equal(t, "mine", updated["x-note"])
+	assert.Equal(t, []any{map[string]any{"type": "command", "command": "b --fix", "timeout": float64(60), "async": true}}, updated["hooks"])
+}
+
+func TestCreateHook_PromptHookWithTimeoutRoundTrips(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	original := "{\n  \"hooks\": {\n    \"Stop\": [\n      {\"hooks\": [{\"type\": \"command\", \"command\": \"b\", \"timeout\": 5}]}\n    ]\n  }\n}\n"
+	require.NoError(t, os.WriteFile(settingsPath, []byte(original), 0o600))
+
+	timeout, prompt := 20, "Did every test pass?"
+	resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+		Body: &api.CreateHookJSONRequestBody{
+			Scope: api.CreateHookRequestScopeGlobal,
+			Event: "Stop",
+			Hooks: &[]api.HookCommand{{
+				Type:                 api.HookCommandTypePrompt,
+				Prompt:               &prompt,
+				Timeout:              &timeout,
+				AdditionalProperties: map[string]interface{}{"model": "haiku"},
+			}},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.CreateHook200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Contains(t, string(data), `{"hooks": [{"type": "command", "command": "b", "timeout": 5}]}`)
+
+	got, err := h.GetHooks(context.Background(), api.GetHooksRequestObject{})
+	require.NoError(t, err)
+	stop := (*got.(api.GetHooks200JSONResponse).Global.Hooks)["Stop"]
+	require.Len(t, stop, 2)
+	require.NotNil(t, stop[0].Hooks[0].Timeout)
+	assert.Equal(t, 5, *stop[0].Hooks[0].Timeout)
+	added := stop[1].Hooks[0]
+	assert.Equal(t, api.HookCommandTypePrompt, added.Type)
+	assert.Nil(t, added.Command)
+	assert.Equal(t, "Did every test pass?", *added.Prompt)
+	assert.Equal(t, 20, *added.Timeout)
+	assert.Equal(t, "haiku", added.AdditionalProperties["model"])
+}
+
+func TestCreateHook_RejectsInvalidHooks(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	zero, echo := 0, "echo"
+	for name, hook := range map[string]api.HookCommand{
+		"command hook without command": {Type: api.HookCommandTypeCommand},
+		"prompt hook without prompt":   {Type: api.HookCommandTypePrompt, Command: &echo},
+		"unknown type":                 {Type: "agent", Command: &echo},
+		"zero timeout":                 {Type: api.HookCommandTypeCommand, Command: &echo, Timeout: &zero},
+	} {
+		resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+			Body: &api.CreateHookJSONRequestBody{
+				Scope: api.CreateHookRequestScopeGlobal,
+				Event: "Stop",
+				Hooks: &[]api.HookCommand{hook},
+			},
+		})
+		require.NoError(t, err, name)
+		_, ok := resp.(api.CreateHook400JSONResponse)
+		assert.True(t, ok, "%s: expected 400, got %T", name, resp)
+	}
+	_, err := os.Stat(filepath.Join(claudeHome, "settings.json"))
+	assert.True(t, os.IsNotExist(err), "nothing is written for an invalid hook")
+}
+
+func TestTestHook_RunsSavedHookWithSyntheticEvent(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	script := filepath.Join(projectDir, "guard.sh")
+	require.NoError(t, os.WriteFile(script, []byte(`#!/bin/sh
+input=$(cat)
+case "$input" in
+  *'"tool_name":"Write"'*) echo "no writes in $CLAUDE_PROJECT_DIR" >&2; exit 2 ;;
+esac
+echo '{"hookSpecificOutput":{"hookEventName":"PreToolUse","permissionDecision":"allow"}}'
+`), 0o700))
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, ".claude"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, ".claude", "settings.json"),
+		[]byte(`{"hooks":{"PreToolUse":[{"matcher":"*","hooks":[{"type":"command","command":"`+script+`","timeout":5}]}]}}`), 0o600))
+
+	hooks, err := h.GetHooks(context.Background(), api.GetHooksRequestObject{Params: api.GetHooksParams{ProjectId: &encoded}})
+	require.NoError(t, err)
+	hookID := (*hooks.(api.GetHooks200JSONResponse).Project.Hooks)["PreToolUse"][0].Id
+	scope := api.HookTestRequestScopeProject
+	run := func(toolName string) api.HookTestResult {
+		t.Helper()
+		resp, err := h.TestHook(context.Background(), api.TestHookRequestObject{
+			Body: &api.HookTestRequest{HookId: &hookID, Scope: &scope, ProjectId: &encoded, ToolName: &toolName},
+		})
+		require.NoError(t, err)
+		result, ok := resp.(api.TestHook200JSONResponse)
+		require.True(t, ok, "expected 200, got %T", resp)
+		return api.HookTestResult(result)
+	}
+
+	allowed := run("Bash")
+	assert.Equal(t, api.Success, allowed.Outcome)
+	require.NotNil(t, allowed.Decision)
+	assert.Equal(t, "allow", (*allowed.Decision)["hookSpecificOutput"].(map[string]interface{})["permissionDecision"])
+	assert.Equal(t, "PreToolUse", allowed.Input["hook_event_name"])
+
+	blocked := run("Write")
+	assert.Equal(t, api.Block, blocked.Outcome)
+	assert.Equal(t, 2, blocked.ExitCode)
+	assert.Equal(t, "no writes in "+projectDir+"\n", blocked.Stderr)
+}
+
+func TestTestHook_RunsDraftAndRejectsPromptHooks(t *testing.T) {
+	h, _ := newTestHandler(t)
+	event, command, prompt := "Stop", "cat >/dev/null; echo '{\"decision\":\"block\",\"reason\":\"tests failing\"}'", "Are we done?"
+
+	resp, err := h.TestHook(context.Background(), api.TestHookRequestObject{
+		Body: &api.HookTestRequest{Event: &event, Hook: &api.HookCommand{Type: api.HookCommandTypeCommand, Command: &command}},
+	})
+	require.NoError(t, err)
+	result, ok := resp.(api.TestHook200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+	assert.Equal(t, api.Success, result.Outcome)
+	assert.Equal(t, "block", (*result.Decision)["decision"])
+	assert.Equal(t, false, result.Input["stop_hook_active"])
+
+	resp, err = h.TestHook(context.Background(), api.TestHookRequestObject{
+		Body: &api.HookTestRequest{Event: &event, Hook: &api.HookCommand{Type: api.HookCommandTypePrompt, Prompt: &prompt}},
+	})
+	require.NoError(t, err)
+	_, ok = resp.(api.TestHook400JSONResponse)
+	assert.True(t, ok, "prompt hooks cannot be dry-run")
+
+	missing := "0123456789abcdef"
+	resp, err = h.TestHook(context.Background(), api.TestHookRequestObject{Body: &api.HookTestRequest{HookId: &missing}})
+	require.NoError(t, err)
+	_, ok = resp.(api.TestHook404JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestTestHook_RejectsTimeoutOverDryRunLimit(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	event, command := "Stop", "echo ok"
+	for _, timeout := range []int{0, 121, math.MaxInt} {
+		resp, err := h.TestHook(context.Background(), api.TestHookRequestObject{
+			Body: &api.HookTestRequest{Event: &event, Hook: &api.HookCommand{Type: api.HookCommandTypeCommand, Command: &command, Timeout: &timeout}},
+		})
+		require.NoError(t, err)
+		_, ok := resp.(api.TestHook400JSONResponse)
+		assert.True(t, ok, "timeout %d: expected 400, got %T", timeout, resp)
+	}
+
+	// A saved hook is held to the same limit.
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"),
+		[]byte(`{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"echo ok","timeout":3600}]}]}}`), 0o600))
+	id := globalHookID(t, h, "Stop", 0)
+	resp, err := h.TestHook(context.Background(), api.TestHookRequestObject{Body: &api.HookTestRequest{HookId: &id}})
+	require.NoError(t, err)
+	_, ok := resp.(api.TestHook400JSONResponse)
+	assert.True(t, ok, "expected 400, got %T", resp)
+}
+
+func TestUpdateHook_ChecksEventAndMatcherAgainstStoredHook(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	original := `{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"a"}]}],"PreToolUse":[{"matcher":"Bash","hooks":[{"type":"command","command":"b"}]}]}}`
+	require.NoError(t, os.WriteFile(settingsPath, []byte(original), 0o600))
+	update := func(id, event, matcher string) api.UpdateHookResponseObject {
+		t.Helper()
+		resp, err := h.UpdateHook(context.Background(), api.UpdateHookRequestObject{
+			Id: id,
+			Body: &api.UpdateHookJSONRequestBody{
+				Scope:    api.UpdateHookRequestScopeGlobal,
+				Event:    event,
+				Matcher:  &matcher,
+				Commands: &[]string{"c"},
+			},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	stopID := globalHookID(t, h, "Stop", 0)
+	_, ok := update(stopID, "PreToolUse", "Bash").(api.UpdateHook400JSONResponse)
+	assert.True(t, ok, "a Stop hook cannot be updated as a PreToolUse hook")
+	_, ok = update(stopID, "Stop", "Bash").(api.UpdateHook400JSONResponse)
+	assert.True(t, ok, "Stop hooks do not take a matcher")
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, original, string(data))
+
+	_, ok = update(globalHookID(t, h, "PreToolUse", 0), "PreToolUse", "Edit").(api.UpdateHook200JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestHookIDs_StaleIDIsRefusedAfterConcurrentInsert(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath,
+		[]byte(`{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"a"}]},{"hooks":[{"type":"command","command":"b"}]}]}}`), 0o600))
+	idA, idB := globalHookID(t, h, "Stop", 0), globalHookID(t, h, "Stop", 1)
+	assert.NotEqual(t, idA, idB)
+
+	// Another writer inserts a hook at index 0; the IDs still address a and b.
+	require.NoError(t, os.WriteFile(settingsPath,
+		[]byte(`{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"new"}]},{"hooks":[{"type":"command","command":"a"}]},{"hooks":[{"type":"command","command":"b"}]}]}}`), 0o600))
+	assert.Equal(t, idA, globalHookID(t, h, "Stop", 1))
+
+	resp, err := h.DeleteHook(context.Background(), api.DeleteHookRequestObject{Id: idA})
+	require.NoError(t, err)
+	_, ok := resp.(api.DeleteHook200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, `{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"new"}]},{"hooks":[{"type":"command","command":"b"}]}]}}`, string(data))
+
+	resp, err = h.DeleteHook(context.Background(), api.DeleteHookRequestObject{Id: idA})
+	require.NoError(t, err)
+	_, ok = resp.(api.DeleteHook409JSONResponse)
+	assert.True(t, ok, "a deleted hook's ID is refused, got %T", resp)
+
+	update, err := h.UpdateHook(context.Background(), api.UpdateHookRequestObject{
+		Id: idA,
+		Body: &api.UpdateHookJSONRequestBody{
+			Scope:    api.UpdateHookRequestScopeGlobal,
+			Event:    "Stop",
+			Commands: &[]string{"a2"},
+		},
+	})
+	require.NoError(t, err)
+	_, ok = update.(api.UpdateHook409JSONResponse)
+	assert.True(t, ok, "expected 409, got %T", update)
+}
+
+func TestHookIDs_IdenticalDefinitionsGetDistinctIDs(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"),
+		[]byte(`{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"a"}]},{"hooks":[{"type":"command","command":"a"}]}]}}`), 0o600))
+	first, second := globalHookID(t, h, "Stop", 0), globalHookID(t, h, "Stop", 1)
+	assert.NotEqual(t, first, second)
+	assert.Equal(t, first+"-2", second)
+}
+
+func TestGetHooks_MergesEveryLayerAndEnabledPlugins(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	stop := func(command string) string {
+		return `{"hooks":{"Stop":[{"hooks":[{"type":"command","command":"` + command + `"}]}]}}`
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(projectDir, ".claude"), 0o750))
+	for path, content := range map[string]string{
+		filepath.Join(claudeHome, "settings.json"):                  `{"enabledPlugins":{"fmt@tools":true,"off@tools":false},` + stop("global")[1:],
+		filepath.Join(claudeHome, "settings.local.json"):            stop("global-local"),
+		filepath.Join(projectDir, ".claude", "settings.json"):       stop("global"),
+		filepath.Join(projectDir, ".claude", "settings.local.json"): stop("project-local"),
+		filepath.Join(claudeHome, "managed-settings.json"):          stop("managed"),
+	} {
+		require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	}
+	for _, name := range []string{"fmt", "off"} {
+		dir := filepath.Join(claudeHome, "plugins", "cache", name)
+		require.NoError(t, os.MkdirAll(filepath.Join(dir, "hooks"), 0o750))
+		require.NoError(t, os.WriteFile(filepath.Join(dir, "hooks", "hooks.json"), []byte(stop("plugin-"+name)), 0o600))
+	}
+	require.NoError(t, os.MkdirAll(filepath.Join(claudeHome, "plugins"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "plugins", "installed_plugins.json"), []byte(`{"plugins":{`+
+		`"fmt@tools":[{"installPath":"`+filepath.Join(claudeHome, "plugins", "cache", "fmt")+`"}],`+
+		`"off@tools":[{"installPath":"`+filepath.Join(claudeHome, "plugins", "cache", "off")+`"}]}}`), 0o600))
+
+	resp, err := h.GetHooks(context.Background(), api.GetHooksRequestObject{Params: api.GetHooksParams{ProjectId: &encoded}})
+	require.NoError(t, err)
+	hooks := resp.(api.GetHooks200JSONResponse)
+
+	var sources []string
+	for _, layer := range hooks.Layers {
+		sources = append(sources, string(layer.Source))
+	}
+	assert.Equal(t, []string{"global", "global-local", "project", "project-local", "managed", "plugin"}, sources)
+	assert.True(t, hooks.Layers[4].ReadOnly)
+	assert.Equal(t, "fmt@tools", *hooks.Layers[5].Plugin)
+
+	var order []string
+	for _, entry := range hooks.Merged["Stop"] {
+		order = append(order, string(entry.Source)+"="+*entry.Hook.Command)
+	}
+	assert.Equal(t, []string{
+		"global=global",
+		"global-local=global-local",
+		"project-local=project-local",
+		"managed=managed",
+		"plugin=plugin-fmt",
+	}, order, "the project's copy of the global hook runs once")
+	assert.Equal(t, globalHookID(t, h, "Stop", 0), hooks.Merged["Stop"][0].DefinitionId)
+
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "managed-settings.json"),
+		[]byte(`{"allowManagedHooksOnly":true,`+stop("managed")[1:]), 0o600))
+	resp, err = h.GetHooks(context.Background(), api.GetHooksRequestObject{Params: api.GetHooksParams{ProjectId: &encoded}})
+	require.NoError(t, err)
+	hooks = resp.(api.GetHooks200JSONResponse)
+	assert.True(t, hooks.ManagedHooksOnly)
+	require.Len(t, hooks.Merged["Stop"], 1)
+	assert.Equal(t, api.MergedHookEntrySourceManaged, hooks.Merged["Stop"][0].Source)
+}
+
+func TestCreateHook_WritesLocalLayers(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+
+	resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+		Body: &api.CreateHookJSONRequestBody{
+			Scope:     api.CreateHookRequestScopeProjectLocal,
+			ProjectId: &encoded,
+			Event:     "Stop",
+			Commands:  &[]string{"echo local"},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.CreateHook200JSONResponse)
+	require.True(t, ok, "expected 200, got %T", resp)
+
+	data, err := os.ReadFile(filepath.Join(projectDir, ".claude", "settings.local.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Contains(t, string(data), "echo local")
+	_, err = os.Stat(filepath.Join(projectDir, ".claude", "settings.json"))
+	assert.True(t, os.IsNotExist(err))
+
+	got, err := h.GetHooks(context.Background(), api.GetHooksRequestObject{Params: api.GetHooksParams{ProjectId: &encoded}})
+	require.NoError(t, err)
+	layers := got.(api.GetHooks200JSONResponse).Layers
+	id := (*layers[3].Hooks)["Stop"][0].Id
+
+	scope := "project-local"
+	del, err := h.DeleteHook(context.Background(), api.DeleteHookRequestObject{
+		Id:     id,
+		Params: api.DeleteHookParams{Scope: &scope, ProjectId: &encoded},
+	})
+	require.NoError(t, err)
+	_, ok = del.(api.DeleteHook200JSONResponse)
+	assert.True(t, ok, "expected 200, got %T", del)
+}
+
+func TestGetHooks_KeepsNewerAndUnknownEvents(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"hooks":{`+
+		`"PreCompact":[{"matcher":"auto","hooks":[{"type":"command","command":"backup"}]}],`+
+		`"SessionEnd":[{"hooks":[{"type":"command","command":"cleanup"}]}],`+
+		`"OnTeaBreak":[{"hooks":[{"type":"command","command":"brew"}]}]}}`), 0o600))
+
+	resp, err := h.GetHooks(context.Background(), api.GetHooksRequestObject{})
+	require.NoError(t, err)
+	hooks := resp.(api.GetHooks200JSONResponse)
+	global := *hooks.Global.Hooks
+	assert.Len(t, global["PreCompact"], 1)
+	assert.Len(t, global["SessionEnd"], 1)
+	assert.Len(t, global["OnTeaBreak"], 1, "hooks under unknown events are still returned")
+	assert.Equal(t, []string{"OnTeaBreak"}, *hooks.Global.UnknownEvents)
+	assert.Contains(t, hooks.Merged, "PreCompact")
+	assert.NotContains(t, hooks.Merged, "OnTeaBreak", "Claude Code never runs unknown events")
+}
+
+func TestCreateHook_ChecksEventAndMatcherAgainstRegistry(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	create := func(event, matcher string) api.CreateHookResponseObject {
+		t.Helper()
+		resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+			Body: &api.CreateHookJSONRequestBody{
+				Scope:    api.CreateHookRequestScopeGlobal,
+				Event:    event,
+				Matcher:  &matcher,
+				Commands: &[]string{"echo"},
+			},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	_, ok := create("PermissionRequest", "Bash").(api.CreateHook200JSONResponse)
+	assert.True(t, ok)
+	_, ok = create("SessionEnd", "").(api.CreateHook200JSONResponse)
+	assert.True(t, ok)
+	_, ok = create("SessionEnd", "Bash").(api.CreateHook400JSONResponse)
+	assert.True(t, ok, "SessionEnd ignores matchers")
+	_, ok = create("OnTeaBreak", "").(api.CreateHook400JSONResponse)
+	assert.True(t, ok, "unknown events are refused")
+
+	data, err := os.ReadFile(filepath.Join(claudeHome, "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.NotContains(t, string(data), "OnTeaBreak")
+
+	events, err := h.GetHookEvents(context.Background(), api.GetHookEventsRequestObject{})
+	require.NoError(t, err)
+	byName := map[string]api.HookEventInfo{}
+	for _, e := range events.(api.GetHookEvents200JSONResponse) {
+		byName[e.Name] = e
+	}
+	assert.True(t, byName["PreToolUse"].AcceptsMatcher)
+	assert.False(t, byName["Stop"].AcceptsMatcher)
+	assert.Contains(t, byName["PreCompact"].Payload, "trigger")
+}
+
+func TestCreateHook_RejectsInvalidMatcherRegex(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	matcher := "Edit|(Write"
+	resp, err := h.CreateHook(context.Background(), api.CreateHookRequestObject{
+		Body: &api.CreateHookJSONRequestBody{
+			Scope:    api.CreateHookRequestScopeGlobal,
+			Event:    "PreToolUse",
+			Matcher:  &matcher,
+			Commands: &[]string{"echo"},
+		},
+	})
+	require.NoError(t, err)
+	bad, ok := resp.(api.CreateHook400JSONResponse)
+	require.True(t, ok)
+	assert.Contains(t, bad.Error, "invalid matcher")
+	assert.NoFileExists(t, filepath.Join(claudeHome, "settings.json"))
+}
+
+func TestPreviewHookMatcher_ListsMatchedToolsAndWarns(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, ".claude.json"),
+		[]byte(`{"mcpServers":{"docs":{"type":"http","url":"https://docs.example.com/mcp"}}}`), 0o600))
+	preview := func(matcher string, probe bool) api.PreviewHookMatcherResponseObject {
+		t.Helper()
+		resp, err := h.PreviewHookMatcher(context.Background(), api.PreviewHookMatcherRequestObject{
+			Body: &api.PreviewHookMatcherJSONRequestBody{Matcher: matcher, ProbeMcp: &probe},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	edits := preview("Edit|Write", false).(api.PreviewHookMatcher200JSONResponse)
+	assert.Equal(t, []string{"Edit", "Write"}, edits.Matched)
+	assert.Empty(t, edits.Warnings)
+	require.Len(t, edits.McpServers, 1)
+	assert.Equal(t, "docs", edits.McpServers[0].Name)
+	assert.False(t, edits.McpServers[0].Probed)
+
+	notebook := preview("Notebook.*", false).(api.PreviewHookMatcher200JSONResponse)
+	assert.Equal(t, []string{"NotebookEdit"}, notebook.Matched)
+
+	none := preview("mcp__docs__.*", false).(api.PreviewHookMatcher200JSONResponse)
+	assert.Empty(t, none.Matched)
+	require.Len(t, none.Warnings, 1)
+	assert.Contains(t, none.Warnings[0], "matches no known tool")
+	assert.Contains(t, none.Warnings[0], "probeMcp")
+
+	all := preview("*", false).(api.PreviewHookMatcher200JSONResponse)
+	assert.True(t, all.MatchesAll)
+	assert.Len(t, all.Matched, len(all.Tools))
+	assert.NotEmpty(t, all.Warnings)
+
+	everything := preview(".*", false).(api.PreviewHookMatcher200JSONResponse)
+	assert.False(t, everything.MatchesAll)
+	require.Len(t, everything.Warnings, 1)
+	assert.Contains(t, everything.Warnings[0], "every known tool")
+
+	probed := preview("Bash", true).(api.PreviewHookMatcher200JSONResponse)
+	require.Len(t, probed.McpServers, 1)
+	assert.False(t, probed.McpServers[0].Probed)
+	require.NotNil(t, probed.McpServers[0].Error)
+	assert.Contains(t, *probed.McpServers[0].Error, "only stdio servers")
+
+	_, ok := preview("Edit|(Write", false).(api.PreviewHookMatcher400JSONResponse)
+	assert.True(t, ok)
+}
@@ -0,0 +1,158 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"slices"
+
+	"fieldstation/lib"
+)
+
+// hookSourcePlugin is the source of hooks shipped by a plugin.
+const hookSourcePlugin = "plugin"
+
+// hookSource is one file hooks are read from. plugin is set for plugin
+// sources, and err when the file cannot be read.
+type hookSource struct {
+	source   string
+	plugin   string
+	filePath string
+	readOnly bool
+	etag     string
+	hooks    map[string][]settingsHookDefinition
+	err      string
+}
+
+// scope returns the scope the IDs of the source's hook definitions are
+// derived from.
+func (s hookSource) scope() string {
+	if s.source == hookSourcePlugin {
+		return hookSourcePlugin + ":" + s.plugin
+	}
+	return s.source
+}
+
+// hookSources returns every source of hooks for config, in the order Claude
+// Code runs them: the settings layers, lowest precedence first, the managed
+// policy, then the hooks/hooks.json of each enabled plugin, by name.
+func (h *FieldStationHandler) hookSources(config lib.EffectiveConfig) []hookSource {
+	sources := make([]hookSource, 0, len(config.Layers))
+	for _, layer := range config.Layers {
+		src := hookSource{
+			source:   string(layer.Source),
+			filePath: layer.FilePath,
+			readOnly: layer.Source.ReadOnly(),
+			etag:     layer.ETag,
+		}
+		if layer.ParseError != nil {
+			src.err = layer.ParseError.Error()
+		} else {
+			src.hooks = hooksFromSettings(layer.Content)
+		}
+		sources = append(sources, src)
+	}
+
+	enabled, _ := config.Merged["enabledPlugins"].(lib.JsonObject)
+	installed := readInstalledPlugins(h.claudeHome)
+	names := make([]string, 0, len(installed))
+	for name, records := range installed {
+		if on, _ := enabled[name].(bool); on && len(records) > 0 {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	for _, name := range names {
+		path := filepath.Join(installed[name][0].InstallPath, "hooks", "hooks.json")
+		if _, err := os.Stat(path); errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		src := hookSource{source: hookSourcePlugin, plugin: name, filePath: path, readOnly: true, etag: lib.FileETag(path)}
+		if content, err := lib.ReadJSONFileStrict(path); err != nil {
+			src.err = err.Error()
+		} else {
+			src.hooks = hooksFromSettings(content)
+		}
+		sources = append(sources, src)
+	}
+	return sources
+}
+
+// mergedHooks lists, per known event, the hooks Claude Code runs from
+// sources, in order. A hook identical to one already listed for the event,
+// with the same matcher, is skipped, as Claude Code runs it once. With
+// managedOnly, only the managed policy's hooks are listed.
+func mergedHooks(sources []hookSource, managedOnly bool, redactor *lib.Redactor) map[string][]MergedHookEntry {
+	type hookKey struct {
+		matcher, kind, command, prompt string
+	}
+	merged := map[string][]MergedHookEntry{}
+	seen := map[string]map[hookKey]bool{}
+	for _, src := range sources {
+		if managedOnly && src.source != string(lib.ConfigLayerManaged) {
+			continue
+		}
+		for event, defs := range src.hooks {
+			if !validHookEvent(event) {
+				continue
+			}
+			if seen[event] == nil {
+				seen[event] = map[hookKey]bool{}
+			}
+			ids := hookDefinitionIDs(src.scope(), event, defs)
+			for i, d := range defs {
+				for _, c := range d.Hooks {
+					key := hookKey{d.Matcher, c.Type, c.Command, c.Prompt}
+					if seen[event][key] {
+						continue
+					}
+					seen[event][key] = true
+					entry := MergedHookEntry{
+						Source:       MergedHookEntrySource(src.source),
+						FilePath:     src.filePath,
+						DefinitionId: ids[i],
+						Hook:         hookCommandToAPI(c, redactor),
+					}
+					if src.plugin != "" {
+						plugin := src.plugin
+						entry.Plugin = &plugin
+					}
+					if d.Matcher != "" {
+						matcher := d.Matcher
+						entry.Matcher = &matcher
+					}
+					merged[event] = append(merged[event], entry)
+				}
+			}
+		}
+	}
+	return merged
+}
+
+// hookSourcesToAPI converts hook sources to their API type.
+func hookSourcesToAPI(sources []hookSource, redactor *lib.Redactor) []HookSource {
+	out := make([]HookSource, 0, len(sources))
+	for _, src := range sources {
+		s := HookSource{
+			Source:        HookSourceSource(src.source),
+			FilePath:      src.filePath,
+			ReadOnly:      src.readOnly,
+			Hooks:         hooksByEventToAPIType(src.hooks, src.scope(), redactor),
+			UnknownEvents: unknownHookEvents(src.hooks),
+		}
+		if src.plugin != "" {
+			plugin := src.plugin
+			s.Plugin = &plugin
+		}
+		if src.etag != "" {
+			etag := src.etag
+			s.Etag = &etag
+		}
+		if src.err != "" {
+			e := src.err
+			s.Error = &e
+		}
+		out = append(out, s)
+	}
+	return out
+}
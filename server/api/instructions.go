@@ -21,16 +21,18 @@ func (h *FieldStationHandler) resolveInstructionsDir(scope string, projectID *st
 	return h.claudeHome, nil
 }
 
-func readInstructionsFile(path string) InstructionsFile {
+func readInstructionsFile(path string, redactor *lib.Redactor) InstructionsFile {
 	content, err := os.ReadFile(path) //nolint:gosec // path is constructed from a validated project or claude home directory
+	etag := lib.ContentETag(content)
 	if err != nil {
-		return InstructionsFile{FilePath: path, Exists: false, Content: nil}
+		return InstructionsFile{FilePath: path, Exists: false, Content: nil, Etag: &etag}
 	}
-	s := string(content)
-	return InstructionsFile{FilePath: path, Exists: true, Content: &s}
+	s := redactor.Text(string(content))
+	return InstructionsFile{FilePath: path, Exists: true, Content: &s, Etag: &etag}
 }
 
-// GetInstructions returns CLAUDE.md and CLAUDE.local.md for the given scope.
+// GetInstructions returns CLAUDE.md and CLAUDE.local.md for the given scope,
+// with secrets redacted.
 func (h *FieldStationHandler) GetInstructions(_ context.Context, request GetInstructionsRequestObject) (GetInstructionsResponseObject, error) {
 	scope := "global"
 	if request.Params.Scope != nil {
@@ -42,13 +44,15 @@ func (h *FieldStationHandler) GetInstructions(_ context.Context, request GetInst
 		return nil, err
 	}
 
-	main := readInstructionsFile(filepath.Join(dir, "CLAUDE.md"))
-	local := readInstructionsFile(filepath.Join(dir, "CLAUDE.local.md"))
+	redactor := h.redactor()
+	main := readInstructionsFile(filepath.Join(dir, "CLAUDE.md"), redactor)
+	local := readInstructionsFile(filepath.Join(dir, "CLAUDE.local.md"), redactor)
 
 	return GetInstructions200JSONResponse(InstructionsResponse{Main: main, Local: local}), nil
 }
 
 // UpdateInstructions writes CLAUDE.md or CLAUDE.local.md for the given scope.
+// Redaction placeholders in the content are replaced by the secrets they hid.
 func (h *FieldStationHandler) UpdateInstructions(_ context.Context, request UpdateInstructionsRequestObject) (UpdateInstructionsResponseObject, error) {
 	if request.Body == nil {
 		return nil, fmt.Errorf("request body is required")
@@ -72,6 +76,15 @@ func (h *FieldStationHandler) UpdateInstructions(_ context.Context, request Upda
 
 	filePath := filepath.Join(dir, fileName)
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateInstructions412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
+	content, err := h.restoreRedactedFile(filePath, body.Content)
+	if resp, ok := redactedWriteConflict(err); ok {
+		return UpdateInstructions409JSONResponse(resp), nil
+	}
+
 	// Backup if the file already exists (makes it visible in Change History).
 	if _, err := os.Stat(filePath); err == nil {
 		lib.BackupFile(filePath, lib.BackupOpUpdate, h.claudeHome)
@@ -81,7 +94,7 @@ func (h *FieldStationHandler) UpdateInstructions(_ context.Context, request Upda
 		return nil, fmt.Errorf("instructions: cannot create directory %s: %w", dir, err)
 	}
 
-	if err := lib.WriteFileAtomic(filePath, []byte(body.Content)); err != nil {
+	if err := lib.WriteFileAtomic(filePath, []byte(content)); err != nil {
 		return nil, fmt.Errorf("instructions: write failed: %w", err)
 	}
 
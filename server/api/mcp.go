@@ -0,0 +1,448 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	"fieldstation/lib"
+)
+
+// GetMcpServers lists the user-scope MCP servers and, with a project, its local
+// and project-scope servers. Sensitive values are redacted.
+func (h *FieldStationHandler) GetMcpServers(_ context.Context, request GetMcpServersRequestObject) (GetMcpServersResponseObject, error) {
+	projectPath := ""
+	if request.Params.ProjectId != nil && *request.Params.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *request.Params.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("mcp: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	servers, files := lib.ListMCPServers(projectPath)
+	resp := McpServersResponse{
+		Servers: make([]McpServer, len(servers)),
+		Files:   make([]McpServerFile, len(files)),
+	}
+	for i, server := range servers {
+		resp.Servers[i] = McpServer{
+			Name:     server.Name,
+			Scope:    McpScope(server.Scope),
+			FilePath: server.FilePath,
+			Config:   mcpServerConfigToAPI(server),
+		}
+	}
+	for i, file := range files {
+		resp.Files[i] = McpServerFile{Scope: McpScope(file.Scope), FilePath: file.FilePath, Exists: file.Exists, Etag: file.ETag}
+	}
+	return GetMcpServers200JSONResponse(resp), nil
+}
+
+// mcpServerConfigToAPI converts the typed fields of a lib server, leaving out
+// those that do not apply to its type.
+func mcpServerConfigToAPI(server lib.MCPServer) McpServerConfig {
+	cfg := McpServerConfig{Type: McpServerType(server.Type)}
+	if server.Type == lib.MCPServerStdio {
+		cfg.Command = &server.Command
+		if server.Args != nil {
+			cfg.Args = &server.Args
+		}
+		if server.Env != nil {
+			cfg.Env = &server.Env
+		}
+		return cfg
+	}
+	cfg.Url = &server.URL
+	if server.Headers != nil {
+		cfg.Headers = &server.Headers
+	}
+	return cfg
+}
+
+// mcpServerFromAPI builds the lib server a create or update request describes.
+func mcpServerFromAPI(name string, cfg McpServerConfig) lib.MCPServer {
+	server := lib.MCPServer{Name: name, Type: lib.MCPServerType(cfg.Type)}
+	if cfg.Command != nil {
+		server.Command = *cfg.Command
+	}
+	if cfg.Args != nil {
+		server.Args = *cfg.Args
+	}
+	if cfg.Env != nil {
+		server.Env = *cfg.Env
+	}
+	if cfg.Url != nil {
+		server.URL = *cfg.Url
+	}
+	if cfg.Headers != nil {
+		server.Headers = *cfg.Headers
+	}
+	return server
+}
+
+// mcpServerFilePath resolves the project and the file a scope's servers live in.
+func (h *FieldStationHandler) mcpServerFilePath(scope McpScope, projectID *string) (projectPath, filePath string, err error) {
+	if projectID != nil && *projectID != "" {
+		projectPath, err = resolveProjectPath(h.claudeHome, *projectID)
+		if err != nil {
+			return "", "", fmt.Errorf("mcp: invalid project id: %w", err)
+		}
+	}
+	filePath, _, err = lib.MCPServerLocation(lib.MCPScope(scope), projectPath)
+	if err != nil {
+		return "", "", fmt.Errorf("mcp: %w", err)
+	}
+	return projectPath, filePath, nil
+}
+
+// CreateMcpServer adds a server definition to a scope.
+func (h *FieldStationHandler) CreateMcpServer(_ context.Context, request CreateMcpServerRequestObject) (CreateMcpServerResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	projectPath, filePath, err := h.mcpServerFilePath(body.Scope, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	server := mcpServerFromAPI(body.Name, body.Config)
+	if issues := lib.ValidateMCPServer(server); len(issues) > 0 {
+		return CreateMcpServer400JSONResponse(validationErrorResponse(lib.SettingsValidation{Errors: issues})), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return CreateMcpServer412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.SaveMCPServer(lib.MCPScope(body.Scope), projectPath, server, true, h.claudeHome); err != nil {
+		var editErr *lib.MCPServerEditError
+		if errors.As(err, &editErr) {
+			return CreateMcpServer409JSONResponse(ErrorResponse{Error: editErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return CreateMcpServer422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return CreateMcpServer200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// UpdateMcpServer replaces a server definition, keeping fields Field Station
+// does not model and any stored value the client sent back redacted.
+func (h *FieldStationHandler) UpdateMcpServer(_ context.Context, request UpdateMcpServerRequestObject) (UpdateMcpServerResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	projectPath, filePath, err := h.mcpServerFilePath(body.Scope, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	server := mcpServerFromAPI(request.Name, body.Config)
+	if issues := lib.ValidateMCPServer(server); len(issues) > 0 {
+		return UpdateMcpServer400JSONResponse(validationErrorResponse(lib.SettingsValidation{Errors: issues})), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateMcpServer412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.SaveMCPServer(lib.MCPScope(body.Scope), projectPath, server, false, h.claudeHome); err != nil {
+		var editErr *lib.MCPServerEditError
+		if errors.As(err, &editErr) {
+			return UpdateMcpServer404JSONResponse(ErrorResponse{Error: editErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateMcpServer422JSONResponse(resp), nil
+		}
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateMcpServer409JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return UpdateMcpServer200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// DeleteMcpServer removes a server definition from a scope.
+func (h *FieldStationHandler) DeleteMcpServer(_ context.Context, request DeleteMcpServerRequestObject) (DeleteMcpServerResponseObject, error) {
+	projectPath, filePath, err := h.mcpServerFilePath(request.Params.Scope, request.Params.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeleteMcpServer412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.DeleteMCPServer(lib.MCPScope(request.Params.Scope), projectPath, request.Name, h.claudeHome); err != nil {
+		var editErr *lib.MCPServerEditError
+		if errors.As(err, &editErr) {
+			return DeleteMcpServer404JSONResponse(ErrorResponse{Error: editErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return DeleteMcpServer422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return DeleteMcpServer200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// maxMcpProbeTimeoutMs caps the timeout a probe request may ask for.
+const maxMcpProbeTimeoutMs = 60000
+
+// ProbeMcpServer launches a stdio server with its configured env, performs the
+// MCP handshake and reports the server's info and tools. A server that fails to
+// start or answer is a 200 with ok false, the error and its stderr.
+func (h *FieldStationHandler) ProbeMcpServer(ctx context.Context, request ProbeMcpServerRequestObject) (ProbeMcpServerResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	timeout := lib.MCPProbeTimeout
+	if body.TimeoutMs != nil {
+		if *body.TimeoutMs <= 0 || *body.TimeoutMs > maxMcpProbeTimeoutMs {
+			return ProbeMcpServer400JSONResponse(ErrorResponse{Error: fmt.Sprintf("timeoutMs must be between 1 and %d", maxMcpProbeTimeoutMs)}), nil
+		}
+		timeout = time.Duration(*body.TimeoutMs) * time.Millisecond
+	}
+
+	projectPath, _, err := h.mcpServerFilePath(body.Scope, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	server, err := lib.LoadMCPServer(lib.MCPScope(body.Scope), projectPath, request.Name)
+	if err != nil {
+		var editErr *lib.MCPServerEditError
+		if errors.As(err, &editErr) {
+			return ProbeMcpServer404JSONResponse(ErrorResponse{Error: editErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return ProbeMcpServer422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	if server.Type != lib.MCPServerStdio {
+		return ProbeMcpServer400JSONResponse(ErrorResponse{Error: fmt.Sprintf("only stdio servers can be probed; %q is %s", server.Name, server.Type)}), nil
+	}
+
+	dir := projectPath
+	if dir == "" {
+		if dir, err = os.UserHomeDir(); err != nil {
+			return nil, fmt.Errorf("mcp: %w", err)
+		}
+	}
+	result, err := lib.ProbeMCPServer(ctx, server, dir, timeout)
+	if err != nil {
+		var probeErr *lib.MCPProbeError
+		if !errors.As(err, &probeErr) {
+			return nil, err
+		}
+		resp := McpProbeResult{Ok: false, Tools: []McpTool{}, Error: &probeErr.Message}
+		if probeErr.Stderr != "" {
+			resp.Stderr = &probeErr.Stderr
+		}
+		return ProbeMcpServer200JSONResponse(resp), nil
+	}
+
+	resp := McpProbeResult{
+		Ok:              true,
+		ProtocolVersion: &result.ProtocolVersion,
+		ServerName:      &result.ServerName,
+		ServerVersion:   &result.ServerVersion,
+		Tools:           make([]McpTool, len(result.Tools)),
+	}
+	for i, tool := range result.Tools {
+		resp.Tools[i] = McpTool{Name: tool.Name}
+		if tool.Description != "" {
+			resp.Tools[i].Description = &tool.Description
+		}
+		if tool.InputSchema != nil {
+			schema := map[string]any(tool.InputSchema)
+			resp.Tools[i].InputSchema = &schema
+		}
+	}
+	if result.Stderr != "" {
+		resp.Stderr = &result.Stderr
+	}
+	return ProbeMcpServer200JSONResponse(resp), nil
+}
+
+// GetMcpApprovals lists the servers of a project's .mcp.json with the approval
+// state Claude Code will apply to them and the layer that decided it.
+func (h *FieldStationHandler) GetMcpApprovals(_ context.Context, request GetMcpApprovalsRequestObject) (GetMcpApprovalsResponseObject, error) {
+	projectPath, err := resolveProjectPath(h.claudeHome, request.Params.ProjectId)
+	if err != nil {
+		return nil, fmt.Errorf("mcp: invalid project id: %w", err)
+	}
+
+	approvals := lib.LoadMCPApprovals(projectPath)
+	resp := McpApprovalsResponse{
+		Servers: make([]McpApproval, len(approvals.Servers)),
+		Layers:  make([]PermissionsLayer, len(approvals.Layers)),
+	}
+	for i, approval := range approvals.Servers {
+		resp.Servers[i] = McpApproval{Name: approval.Name, State: McpApprovalState(approval.State)}
+		if approval.Source != "" {
+			source := ConfigLayerSource(approval.Source)
+			resp.Servers[i].Source = &source
+			resp.Servers[i].FilePath = &approval.FilePath
+		}
+	}
+	for i, layer := range approvals.Layers {
+		resp.Layers[i] = PermissionsLayer{
+			Source:   ConfigLayerSource(layer.Source),
+			FilePath: layer.FilePath,
+			Exists:   layer.Exists,
+			ReadOnly: layer.Source.ReadOnly(),
+			Etag:     layer.ETag,
+		}
+	}
+	if approvals.EnableAll != nil {
+		resp.EnableAll = &ShadowedValue{
+			Source:   ConfigLayerSource(approvals.EnableAll.Source),
+			FilePath: approvals.EnableAll.FilePath,
+			Value:    approvals.EnableAll.Value,
+		}
+	}
+	return GetMcpApprovals200JSONResponse(resp), nil
+}
+
+// mcpApprovalPolicyConflict returns why the approval lists cannot be written,
+// if managed policy pins either of them.
+func mcpApprovalPolicyConflict() string {
+	for _, key := range []string{"enabledMcpjsonServers", "disabledMcpjsonServers"} {
+		if err := lib.CheckManagedPolicy(key); err != nil {
+			return err.Error()
+		}
+	}
+	return ""
+}
+
+// UpdateMcpApprovals approves, rejects or resets servers of one project's
+// .mcp.json, recording the answer where Claude Code does: the project-local
+// settings unless another layer is named.
+func (h *FieldStationHandler) UpdateMcpApprovals(_ context.Context, request UpdateMcpApprovalsRequestObject) (UpdateMcpApprovalsResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	layer := ConfigLayerSourceProjectLocal
+	if body.Layer != nil {
+		layer = *body.Layer
+	}
+	loc, err := h.settingLocation(SettingLayerRef{Layer: layer, ProjectId: &body.ProjectId})
+	if err != nil {
+		return nil, err
+	}
+	if !lib.MCPApprovalState(body.State).Valid() {
+		return UpdateMcpApprovals400JSONResponse(ErrorResponse{Error: fmt.Sprintf("unknown state %q", body.State)}), nil
+	}
+	if loc.Source.ReadOnly() {
+		return UpdateMcpApprovals409JSONResponse(ErrorResponse{Error: fmt.Sprintf("the %s layer is read-only", loc.Source)}), nil
+	}
+	if conflict := mcpApprovalPolicyConflict(); conflict != "" {
+		return UpdateMcpApprovals409JSONResponse(ErrorResponse{Error: conflict}), nil
+	}
+
+	patch, err := lib.PlanMCPApproval(loc, body.Servers, lib.MCPApprovalState(body.State))
+	if err != nil {
+		var approvalErr *lib.MCPApprovalError
+		if errors.As(err, &approvalErr) {
+			return UpdateMcpApprovals400JSONResponse(ErrorResponse{Error: approvalErr.Error()}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateMcpApprovals422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, patch.FilePaths()...); failed != nil {
+		return UpdateMcpApprovals412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	group := ""
+	if len(patch.Files) > 0 {
+		if group, err = patch.Apply(h.claudeHome); err != nil {
+			return nil, err
+		}
+	}
+	return UpdateMcpApprovals200JSONResponse(configPatchResponse(patch, group, lib.SettingsValidation{})), nil
+}
+
+// BulkUpdateMcpApprovals applies one approval state to the .mcp.json servers of
+// every registered project, or of the projects named, in a single grouped
+// write. Projects whose settings cannot be parsed are reported and skipped.
+func (h *FieldStationHandler) BulkUpdateMcpApprovals(_ context.Context, request BulkUpdateMcpApprovalsRequestObject) (BulkUpdateMcpApprovalsResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	layer := ConfigLayerSourceProjectLocal
+	if body.Layer != nil {
+		layer = *body.Layer
+	}
+	if layer != ConfigLayerSourceProject && layer != ConfigLayerSourceProjectLocal {
+		return BulkUpdateMcpApprovals400JSONResponse(ErrorResponse{Error: "bulk approvals must target the project or project-local layer"}), nil
+	}
+	if !lib.MCPApprovalState(body.State).Valid() {
+		return BulkUpdateMcpApprovals400JSONResponse(ErrorResponse{Error: fmt.Sprintf("unknown state %q", body.State)}), nil
+	}
+	if conflict := mcpApprovalPolicyConflict(); conflict != "" {
+		return BulkUpdateMcpApprovals409JSONResponse(ErrorResponse{Error: conflict}), nil
+	}
+
+	var projectPaths []string
+	if body.ProjectIds != nil {
+		for _, id := range *body.ProjectIds {
+			pp, err := resolveProjectPath(h.claudeHome, id)
+			if err != nil {
+				return BulkUpdateMcpApprovals400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+			}
+			projectPaths = append(projectPaths, pp)
+		}
+	} else {
+		projectPaths = RegisteredProjectPaths(h.claudeHome)
+	}
+	var names []string
+	if body.Servers != nil {
+		names = *body.Servers
+	}
+
+	patch, changes, err := lib.PlanMCPApprovalPolicy(projectPaths, lib.ConfigLayerSource(layer), lib.MCPApprovalState(body.State), names)
+	if err != nil {
+		return nil, err
+	}
+	group := ""
+	if len(patch.Files) > 0 {
+		if group, err = patch.Apply(h.claudeHome); err != nil {
+			return nil, err
+		}
+	}
+
+	written := configPatchResponse(patch, group, lib.SettingsValidation{})
+	resp := BulkMcpApprovalsResponse{
+		Success:     true,
+		Files:       written.Files,
+		BackupGroup: written.BackupGroup,
+		Projects:    make([]McpApprovalProjectChange, len(changes)),
+	}
+	for i, change := range changes {
+		resp.Projects[i] = McpApprovalProjectChange{
+			ProjectId: lib.EncodePath(change.ProjectPath),
+			Path:      change.ProjectPath,
+			FilePath:  &change.FilePath,
+			Servers:   change.Servers,
+		}
+		if resp.Projects[i].Servers == nil {
+			resp.Projects[i].Servers = []string{}
+		}
+		if change.Error != "" {
+			resp.Projects[i].Error = &change.Error
+		}
+	}
+	return BulkUpdateMcpApprovals200JSONResponse(resp), nil
+}
@@ -0,0 +1,190 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+)
+
+func TestMcpServerCRUD_ProjectScope(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+
+	command := "npx"
+	env := map[string]string{"API_KEY": "sk-live-123"}
+	create, err := h.CreateMcpServer(context.Background(), api.CreateMcpServerRequestObject{
+		Body: &api.CreateMcpServerJSONRequestBody{
+			Scope: api.McpScopeProject, ProjectId: &encoded, Name: "search",
+			Config: api.McpServerConfig{Type: api.Stdio, Command: &command, Env: &env},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := create.(api.CreateMcpServer200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", create)
+
+	list, err := h.GetMcpServers(context.Background(), api.GetMcpServersRequestObject{Params: api.GetMcpServersParams{ProjectId: &encoded}})
+	require.NoError(t, err)
+	servers, ok := list.(api.GetMcpServers200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", list)
+	require.Len(t, servers.Servers, 1)
+	listed := servers.Servers[0]
+	assert.Equal(t, api.McpScopeProject, listed.Scope)
+	require.NotNil(t, listed.Config.Env)
+	assert.Equal(t, "[REDACTED]", (*listed.Config.Env)["API_KEY"])
+
+	args := []string{"-y", "search-mcp"}
+	listed.Config.Args = &args
+	update, err := h.UpdateMcpServer(context.Background(), api.UpdateMcpServerRequestObject{
+		Name: "search",
+		Body: &api.UpdateMcpServerJSONRequestBody{Scope: api.McpScopeProject, ProjectId: &encoded, Config: listed.Config},
+	})
+	require.NoError(t, err)
+	_, ok = update.(api.UpdateMcpServer200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", update)
+
+	data, err := os.ReadFile(filepath.Join(projectDir, ".mcp.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"mcpServers":{"search":{"type":"stdio","command":"npx","args":["-y","search-mcp"],"env":{"API_KEY":"sk-live-123"}}}}`, string(data))
+
+	del, err := h.DeleteMcpServer(context.Background(), api.DeleteMcpServerRequestObject{
+		Name:   "search",
+		Params: api.DeleteMcpServerParams{Scope: api.McpScopeProject, ProjectId: &encoded},
+	})
+	require.NoError(t, err)
+	_, ok = del.(api.DeleteMcpServer200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", del)
+
+	missing, err := h.DeleteMcpServer(context.Background(), api.DeleteMcpServerRequestObject{
+		Name:   "search",
+		Params: api.DeleteMcpServerParams{Scope: api.McpScopeProject, ProjectId: &encoded},
+	})
+	require.NoError(t, err)
+	_, ok = missing.(api.DeleteMcpServer404JSONResponse)
+	require.True(t, ok, "expected 404 response, got %T", missing)
+}
+
+func TestCreateMcpServer_RejectsInvalidDefinition(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+
+	url := "ftp://example.com"
+	resp, err := h.CreateMcpServer(context.Background(), api.CreateMcpServerRequestObject{
+		Body: &api.CreateMcpServerJSONRequestBody{Scope: api.McpScopeUser, Name: "docs", Config: api.McpServerConfig{Type: api.Http, Url: &url}},
+	})
+	require.NoError(t, err)
+	invalid, ok := resp.(api.CreateMcpServer400JSONResponse)
+	require.True(t, ok, "expected 400 response, got %T", resp)
+	require.Len(t, invalid.Issues, 1)
+	assert.Equal(t, "url", invalid.Issues[0].Path)
+	assert.NoFileExists(t, filepath.Join(claudeHome, ".claude.json"))
+}
+
+func TestProbeMcpServer_ReportsFailureAndRejectsRemoteServers(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	missing := filepath.Join(t.TempDir(), "no-such-server")
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, ".claude.json"),
+		[]byte(`{"mcpServers":{"broken":{"command":"`+missing+`"},"docs":{"type":"http","url":"https://docs.example.com/mcp"}}}`), 0o600))
+
+	resp, err := h.ProbeMcpServer(context.Background(), api.ProbeMcpServerRequestObject{
+		Name: "broken",
+		Body: &api.ProbeMcpServerJSONRequestBody{Scope: api.McpScopeUser},
+	})
+	require.NoError(t, err)
+	result, ok := resp.(api.ProbeMcpServer200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", resp)
+	assert.False(t, result.Ok)
+	require.NotNil(t, result.Error)
+	assert.Contains(t, *result.Error, "no-such-server")
+
+	remote, err := h.ProbeMcpServer(context.Background(), api.ProbeMcpServerRequestObject{
+		Name: "docs",
+		Body: &api.ProbeMcpServerJSONRequestBody{Scope: api.McpScopeUser},
+	})
+	require.NoError(t, err)
+	_, ok = remote.(api.ProbeMcpServer400JSONResponse)
+	require.True(t, ok, "expected 400 response, got %T", remote)
+
+	unknown, err := h.ProbeMcpServer(context.Background(), api.ProbeMcpServerRequestObject{
+		Name: "nope",
+		Body: &api.ProbeMcpServerJSONRequestBody{Scope: api.McpScopeUser},
+	})
+	require.NoError(t, err)
+	_, ok = unknown.(api.ProbeMcpServer404JSONResponse)
+	require.True(t, ok, "expected 404 response, got %T", unknown)
+}
+
+func TestMcpApprovals_SingleAndBulk(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	first, second := t.TempDir(), t.TempDir()
+	firstID := registerProject(t, claudeHome, first)
+	registerProject(t, claudeHome, second)
+	for _, dir := range []string{first, second} {
+		require.NoError(t, os.WriteFile(filepath.Join(dir, ".mcp.json"), []byte(`{"mcpServers":{"github":{"command":"npx"},"search":{"command":"npx"}}}`), 0o600))
+	}
+
+	update, err := h.UpdateMcpApprovals(context.Background(), api.UpdateMcpApprovalsRequestObject{
+		Body: &api.UpdateMcpApprovalsJSONRequestBody{ProjectId: firstID, Servers: []string{"github"}, State: api.Approved},
+	})
+	require.NoError(t, err)
+	updated, ok := update.(api.UpdateMcpApprovals200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", update)
+	require.Len(t, updated.Files, 1)
+	assert.Equal(t, filepath.Join(first, ".claude", "settings.local.json"), updated.Files[0].FilePath)
+
+	unknown, err := h.UpdateMcpApprovals(context.Background(), api.UpdateMcpApprovalsRequestObject{
+		Body: &api.UpdateMcpApprovalsJSONRequestBody{ProjectId: firstID, Servers: []string{"slack"}, State: api.Approved},
+	})
+	require.NoError(t, err)
+	_, ok = unknown.(api.UpdateMcpApprovals400JSONResponse)
+	require.True(t, ok, "expected 400 response, got %T", unknown)
+
+	bulk, err := h.BulkUpdateMcpApprovals(context.Background(), api.BulkUpdateMcpApprovalsRequestObject{
+		Body: &api.BulkUpdateMcpApprovalsJSONRequestBody{State: api.Rejected, Servers: &[]string{"search"}},
+	})
+	require.NoError(t, err)
+	result, ok := bulk.(api.BulkUpdateMcpApprovals200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", bulk)
+	assert.Len(t, result.Files, 2)
+	require.Len(t, result.Projects, 2)
+	assert.Equal(t, []string{"search"}, result.Projects[0].Servers)
+	assert.NotNil(t, result.BackupGroup)
+
+	list, err := h.GetMcpApprovals(context.Background(), api.GetMcpApprovalsRequestObject{Params: api.GetMcpApprovalsParams{ProjectId: firstID}})
+	require.NoError(t, err)
+	approvals, ok := list.(api.GetMcpApprovals200JSONResponse)
+	require.True(t, ok, "expected 200 response, got %T", list)
+	require.Len(t, approvals.Servers, 2)
+	assert.Equal(t, api.Approved, approvals.Servers[0].State)
+	assert.Equal(t, api.Rejected, approvals.Servers[1].State)
+	require.NotNil(t, approvals.Servers[1].Source)
+	assert.Equal(t, api.ConfigLayerSourceProjectLocal, *approvals.Servers[1].Source)
+}
+
+func TestUpdateMcpServer_RefusesRedactedArgsAfterDelete(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	mcpPath := filepath.Join(claudeHome, ".claude.json")
+	stored := `{"mcpServers":{"search":{"type":"stdio","command":"npx","args":["--key1","sk-ant-REDACTED","--key2","sk-ant-REDACTED"]}}}`
+	require.NoError(t, os.WriteFile(mcpPath, []byte(stored), 0o600))
+
+	command := "npx"
+	args := []string{"--key2", "[REDACTED]"}
+	resp, err := h.UpdateMcpServer(context.Background(), api.UpdateMcpServerRequestObject{
+		Name: "search",
+		Body: &api.UpdateMcpServerJSONRequestBody{
+			Scope:  api.McpScopeUser,
+			Config: api.McpServerConfig{Type: api.Stdio, Command: &command, Args: &args},
+		},
+	})
+	require.NoError(t, err)
+	_, ok := resp.(api.UpdateMcpServer409JSONResponse)
+	require.True(t, ok, "expected 409 response, got %T", resp)
+
+	data, err := os.ReadFile(mcpPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, stored, string(data))
+}
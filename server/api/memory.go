@@ -33,7 +33,8 @@ func validateMemoryFilename(filename string) error {
 	return nil
 }
 
-// ListMemory returns all *.md files in the project's memory directory.
+// ListMemory returns all *.md files in the project's memory directory, with
+// secrets redacted from their previews.
 func (h *FieldStationHandler) ListMemory(_ context.Context, request ListMemoryRequestObject) (ListMemoryResponseObject, error) {
 	dir, err := h.memoryDirForProject(request.Params.ProjectId)
 	if err != nil {
@@ -46,6 +47,7 @@ func (h *FieldStationHandler) ListMemory(_ context.Context, request ListMemoryRe
 		return ListMemory200JSONResponse([]MemoryFile{}), nil
 	}
 
+	redactor := h.redactor()
 	result := make([]MemoryFile, 0, len(entries))
 	for _, entry := range entries {
 		if entry.IsDir() || !strings.HasSuffix(entry.Name(), ".md") {
@@ -59,13 +61,14 @@ func (h *FieldStationHandler) ListMemory(_ context.Context, request ListMemoryRe
 		result = append(result, MemoryFile{
 			Filename: entry.Name(),
 			FilePath: filePath,
-			Preview:  lib.TruncateBody(string(content), 3),
+			Preview:  lib.TruncateBody(redactor.Text(string(content)), 3),
 		})
 	}
 	return ListMemory200JSONResponse(result), nil
 }
 
-// GetMemory returns the full content of a single memory file.
+// GetMemory returns the full content of a single memory file, with secrets
+// redacted.
 func (h *FieldStationHandler) GetMemory(_ context.Context, request GetMemoryRequestObject) (GetMemoryResponseObject, error) {
 	if err := validateMemoryFilename(request.Filename); err != nil {
 		return nil, err
@@ -79,11 +82,14 @@ func (h *FieldStationHandler) GetMemory(_ context.Context, request GetMemoryRequ
 	if err != nil {
 		return nil, fmt.Errorf("memory: file not found: %s", request.Filename)
 	}
-	return GetMemory200JSONResponse(MemoryDetail{
-		Filename: request.Filename,
-		FilePath: filePath,
-		Content:  string(content),
-	}), nil
+	return GetMemory200JSONResponse{
+		Body: MemoryDetail{
+			Filename: request.Filename,
+			FilePath: filePath,
+			Content:  h.redactor().Text(string(content)),
+		},
+		Headers: GetMemory200ResponseHeaders{ETag: lib.ContentETag(content)},
+	}, nil
 }
 
 // CreateMemory creates a new memory file.
@@ -110,11 +116,12 @@ func (h *FieldStationHandler) CreateMemory(_ context.Context, request CreateMemo
 	return CreateMemory200JSONResponse(MemoryFile{
 		Filename: body.Filename,
 		FilePath: filePath,
-		Preview:  lib.TruncateBody(body.Content, 3),
+		Preview:  lib.TruncateBody(h.redactor().Text(body.Content), 3),
 	}), nil
 }
 
-// UpdateMemory overwrites an existing memory file.
+// UpdateMemory overwrites an existing memory file. Redaction placeholders in
+// the content are replaced by the secrets they hid.
 func (h *FieldStationHandler) UpdateMemory(_ context.Context, request UpdateMemoryRequestObject) (UpdateMemoryResponseObject, error) {
 	if request.Body == nil {
 		return nil, fmt.Errorf("request body is required")
@@ -127,11 +134,18 @@ func (h *FieldStationHandler) UpdateMemory(_ context.Context, request UpdateMemo
 		return nil, err
 	}
 	filePath := filepath.Join(dir, request.Filename)
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateMemory412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	content, err := h.restoreRedactedFile(filePath, request.Body.Content)
+	if resp, ok := redactedWriteConflict(err); ok {
+		return UpdateMemory409JSONResponse(resp), nil
+	}
 	// Backup if file exists.
 	if _, err := os.Stat(filePath); err == nil {
 		lib.BackupFile(filePath, lib.BackupOpUpdate, h.claudeHome)
 	}
-	if err := lib.WriteFileAtomic(filePath, []byte(request.Body.Content)); err != nil {
+	if err := lib.WriteFileAtomic(filePath, []byte(content)); err != nil {
 		return nil, fmt.Errorf("memory: write failed: %w", err)
 	}
 	return UpdateMemory200JSONResponse(SuccessResponse{Success: true}), nil
@@ -147,6 +161,9 @@ func (h *FieldStationHandler) DeleteMemory(_ context.Context, request DeleteMemo
 		return nil, err
 	}
 	filePath := filepath.Join(dir, request.Filename)
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeleteMemory412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
 	lib.BackupFile(filePath, lib.BackupOpDelete, h.claudeHome)
 	if err := os.Remove(filePath); err != nil {
 		return nil, fmt.Errorf("memory: delete failed: %w", err)
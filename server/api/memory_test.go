@@ -80,8 +80,8 @@ func TestGetMemory_ReturnsContent(t *testing.T) {
 	require.NoError(t, err)
 	result, ok := resp.(api.GetMemory200JSONResponse)
 	require.True(t, ok)
-	assert.Equal(t, "# Memory content", result.Content)
-	assert.Equal(t, "MEMORY.md", result.Filename)
+	assert.Equal(t, "# Memory content", result.Body.Content)
+	assert.Equal(t, "MEMORY.md", result.Body.Filename)
 }
 
 func TestGetMemory_RejectsPathTraversal(t *testing.T) {
@@ -0,0 +1,334 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"slices"
+	"strings"
+
+	"fieldstation/lib"
+)
+
+// resolveOutputStyleDir returns the directory containing output style .md
+// files for the given scope. For project scope, projectPath is the decoded
+// project root and styles live under .claude/output-styles/.
+func (h *FieldStationHandler) resolveOutputStyleDir(scope string, projectPath *string) (string, error) {
+	if scope == "project" {
+		if projectPath == nil || *projectPath == "" {
+			return "", fmt.Errorf("projectId is required for project scope")
+		}
+		return filepath.Join(*projectPath, ".claude", "output-styles"), nil
+	}
+	return filepath.Join(h.claudeHome, "output-styles"), nil
+}
+
+// outputStyleDirFor resolves the scope and optional project ID of a request to
+// its output style directory.
+func (h *FieldStationHandler) outputStyleDirFor(scope string, projectID *string) (string, error) {
+	var projectPath *string
+	if projectID != nil && *projectID != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *projectID)
+		if err != nil {
+			return "", err
+		}
+		projectPath = &pp
+	}
+	return h.resolveOutputStyleDir(scope, projectPath)
+}
+
+// keepCodingInstructions returns the keep-coding-instructions frontmatter
+// field, or nil if it is not set.
+func keepCodingInstructions(rf lib.ResourceFile) *bool {
+	if keep, ok := rf.Frontmatter["keep-coding-instructions"].(bool); ok {
+		return &keep
+	}
+	return nil
+}
+
+// resourceFileToOutputStyleFile converts a lib.ResourceFile to the API
+// OutputStyleFile type, redacting secrets from the body preview.
+func resourceFileToOutputStyleFile(rf lib.ResourceFile, redactor *lib.Redactor) OutputStyleFile {
+	return OutputStyleFile{
+		Name:                   rf.Name,
+		Description:            rf.Description,
+		FileName:               rf.ID + ".md",
+		FilePath:               rf.FilePath,
+		KeepCodingInstructions: keepCodingInstructions(rf),
+		BodyPreview:            lib.TruncateBody(redactor.Text(rf.Body), 5),
+		IsEditable:             lib.IsUserOwned(rf.FilePath),
+	}
+}
+
+// resourceFileToOutputStyleDetail converts a lib.ResourceFile to the API
+// OutputStyleDetail type, redacting secrets from the body.
+func resourceFileToOutputStyleDetail(rf lib.ResourceFile, redactor *lib.Redactor) OutputStyleDetail {
+	return OutputStyleDetail{
+		Name:                   rf.Name,
+		Description:            rf.Description,
+		FileName:               rf.ID + ".md",
+		FilePath:               rf.FilePath,
+		KeepCodingInstructions: keepCodingInstructions(rf),
+		Body:                   redactor.Text(rf.Body),
+		IsEditable:             lib.IsUserOwned(rf.FilePath),
+	}
+}
+
+// outputStyleMarkdown builds the markdown of an output style file.
+func outputStyleMarkdown(name string, description *string, keep *bool, body string) string {
+	fm := lib.FrontmatterDoc{
+		Frontmatter: map[string]any{"name": name},
+		Body:        body,
+	}
+	if description != nil && *description != "" {
+		fm.Frontmatter["description"] = *description
+	}
+	if keep != nil {
+		fm.Frontmatter["keep-coding-instructions"] = *keep
+	}
+	return lib.SerializeMarkdown(fm)
+}
+
+// GetOutputStyles lists all output style files for the given scope.
+func (h *FieldStationHandler) GetOutputStyles(_ context.Context, request GetOutputStylesRequestObject) (GetOutputStylesResponseObject, error) {
+	scope := "global"
+	if request.Params.Scope != nil {
+		scope = string(*request.Params.Scope)
+	}
+	styleDir, err := h.outputStyleDirFor(scope, request.Params.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	files, err := lib.ListResources(lib.ResourceTypeOutputStyle, filepath.Dir(styleDir))
+	if err != nil {
+		return nil, err
+	}
+
+	redactor := h.redactor()
+	result := make([]OutputStyleFile, len(files))
+	for i, rf := range files {
+		result[i] = resourceFileToOutputStyleFile(rf, redactor)
+	}
+	return GetOutputStyles200JSONResponse(result), nil
+}
+
+// GetOutputStyle returns the detail of a single output style by name.
+func (h *FieldStationHandler) GetOutputStyle(_ context.Context, request GetOutputStyleRequestObject) (GetOutputStyleResponseObject, error) {
+	scope := "global"
+	if request.Params.Scope != nil {
+		scope = string(*request.Params.Scope)
+	}
+	styleDir, err := h.outputStyleDirFor(scope, request.Params.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	rf, err := lib.GetResource(lib.ResourceTypeOutputStyle, request.Name, filepath.Dir(styleDir))
+	if err != nil {
+		return nil, err
+	}
+	return GetOutputStyle200JSONResponse{
+		Body:    resourceFileToOutputStyleDetail(rf, h.redactor()),
+		Headers: GetOutputStyle200ResponseHeaders{ETag: lib.ContentETag([]byte(rf.Content))},
+	}, nil
+}
+
+// CreateOutputStyle creates a new output style file.
+func (h *FieldStationHandler) CreateOutputStyle(_ context.Context, request CreateOutputStyleRequestObject) (CreateOutputStyleResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	styleDir, err := h.outputStyleDirFor(string(body.Scope), body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject writes to plugin-managed files (files not owned by the user).
+	filePath := filepath.Join(styleDir, body.Name+".md")
+	if !lib.IsUserOwned(filePath) {
+		return nil, fmt.Errorf("output styles: cannot write plugin-managed file: %s", filePath)
+	}
+
+	content := outputStyleMarkdown(body.Name, body.Description, body.KeepCodingInstructions, body.Body)
+	rf, err := lib.CreateResource(lib.ResourceTypeOutputStyle, body.Name, content, filepath.Dir(styleDir))
+	if err != nil {
+		return nil, err
+	}
+	return CreateOutputStyle200JSONResponse(resourceFileToOutputStyleFile(rf, h.redactor())), nil
+}
+
+// UpdateOutputStyle updates an existing output style file. The name in its
+// frontmatter is kept, since outputStyle settings refer to the style by it.
+func (h *FieldStationHandler) UpdateOutputStyle(_ context.Context, request UpdateOutputStyleRequestObject) (UpdateOutputStyleResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	styleDir, err := h.outputStyleDirFor(string(body.Scope), body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject writes to plugin-managed files.
+	filePath := filepath.Join(styleDir, request.Name+".md")
+	if !lib.IsUserOwned(filePath) {
+		return nil, fmt.Errorf("output styles: cannot write plugin-managed file: %s", filePath)
+	}
+
+	// Restore any redaction placeholders echoed back from GetOutputStyle.
+	name, styleBody := request.Name, body.Body
+	effectiveClaudeHome := filepath.Dir(styleDir)
+	if existing, err := lib.GetResource(lib.ResourceTypeOutputStyle, request.Name, effectiveClaudeHome); err == nil {
+		name = existing.Name
+		styleBody, err = h.redactor().RestoreText(body.Body, existing.Body)
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateOutputStyle409JSONResponse(resp), nil
+		}
+	}
+	content := outputStyleMarkdown(name, body.Description, body.KeepCodingInstructions, styleBody)
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateOutputStyle412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
+	// For project-scope styles, ensure the backup is written to the global
+	// claudeHome so it appears in Change History.
+	if effectiveClaudeHome != h.claudeHome {
+		lib.BackupFile(filePath, lib.BackupOpUpdate, h.claudeHome)
+	}
+
+	rf, err := lib.UpdateResource(lib.ResourceTypeOutputStyle, request.Name, content, effectiveClaudeHome)
+	if err != nil {
+		return nil, err
+	}
+	return UpdateOutputStyle200JSONResponse(resourceFileToOutputStyleDetail(rf, h.redactor())), nil
+}
+
+// DeleteOutputStyle deletes an output style file.
+func (h *FieldStationHandler) DeleteOutputStyle(_ context.Context, request DeleteOutputStyleRequestObject) (DeleteOutputStyleResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	styleDir, err := h.outputStyleDirFor(string(body.Scope), body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+
+	// Reject deletes of plugin-managed files.
+	filePath := filepath.Join(styleDir, request.Name+".md")
+	if !lib.IsUserOwned(filePath) {
+		return nil, fmt.Errorf("output styles: cannot delete plugin-managed file: %s", filePath)
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeleteOutputStyle412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
+	// For project-scope styles, ensure the backup lands in the global
+	// claudeHome so it is visible in Change History.
+	effectiveClaudeHome := filepath.Dir(styleDir)
+	if effectiveClaudeHome != h.claudeHome {
+		lib.BackupFile(filePath, lib.BackupOpDelete, h.claudeHome)
+	}
+
+	if err := lib.DeleteResource(lib.ResourceTypeOutputStyle, request.Name, effectiveClaudeHome); err != nil {
+		return nil, err
+	}
+	return DeleteOutputStyle200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// GetOutputStyleSetting lists every layer with its outputStyle setting, the
+// style Claude Code uses and the styles it can be set to.
+func (h *FieldStationHandler) GetOutputStyleSetting(_ context.Context, request GetOutputStyleSettingRequestObject) (GetOutputStyleSettingResponseObject, error) {
+	projectPath := ""
+	if request.Params.ProjectId != nil && *request.Params.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *request.Params.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("output styles: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	setting := lib.LoadOutputStyleSetting(projectPath)
+	values := map[lib.ConfigLayerSource]any{}
+	for _, entry := range setting.Entries {
+		values[entry.Source] = entry.Value
+	}
+	resp := OutputStyleSettingResponse{
+		Layers:    make([]OutputStyleLayer, len(setting.Layers)),
+		Available: lib.AvailableOutputStyles(h.claudeHome, projectPath),
+	}
+	for i, layer := range setting.Layers {
+		out := OutputStyleLayer{
+			Source:   ConfigLayerSource(layer.Source),
+			FilePath: layer.FilePath,
+			Exists:   layer.Exists,
+			ReadOnly: layer.Source.ReadOnly(),
+			Etag:     layer.ETag,
+		}
+		if name, ok := values[layer.Source].(string); ok {
+			out.OutputStyle = &name
+		}
+		resp.Layers[i] = out
+	}
+	if e := setting.Effective; e != nil {
+		resp.Effective = &ShadowedValue{Source: ConfigLayerSource(e.Source), FilePath: e.FilePath, Value: e.Value}
+	}
+	return GetOutputStyleSetting200JSONResponse(resp), nil
+}
+
+// UpdateOutputStyleSetting sets the outputStyle setting of a layer to one of
+// the available styles, or removes it when the request names none.
+func (h *FieldStationHandler) UpdateOutputStyleSetting(_ context.Context, request UpdateOutputStyleSettingRequestObject) (UpdateOutputStyleSettingResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	loc, err := h.settingLocation(SettingLayerRef{Layer: body.Layer, ProjectId: body.ProjectId})
+	if err != nil {
+		return nil, err
+	}
+	if loc.Source.ReadOnly() {
+		return UpdateOutputStyleSetting409JSONResponse(ErrorResponse{Error: fmt.Sprintf("the %s layer is read-only", loc.Source)}), nil
+	}
+	filePath, err := lib.ResolveLayerPath(loc.Source, loc.ProjectPath)
+	if err != nil {
+		return nil, fmt.Errorf("output styles: %w", err)
+	}
+	if err := lib.CheckManagedPolicy("outputStyle"); err != nil {
+		return UpdateOutputStyleSetting409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	name := body.OutputStyle
+	if name != nil && *name == "" {
+		name = nil
+	}
+	if name != nil {
+		available := lib.AvailableOutputStyles(h.claudeHome, loc.ProjectPath)
+		if !slices.Contains(available, *name) {
+			validation := lib.SettingsValidation{Errors: []lib.SettingsIssue{{
+				Path:    "outputStyle",
+				Message: fmt.Sprintf("unknown output style %q; expected one of %s", *name, strings.Join(available, ", ")),
+			}}}
+			return UpdateOutputStyleSetting400JSONResponse(validationErrorResponse(validation)), nil
+		}
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateOutputStyleSetting412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.SetOutputStyle(filePath, name, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateOutputStyleSetting422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return UpdateOutputStyleSetting200JSONResponse(SuccessResponse{Success: true}), nil
+}
@@ -0,0 +1,94 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+)
+
+func TestOutputStyleCRUD_ProjectScope(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	encoded := registerProject(t, claudeHome, projectDir)
+	description := "Short answers"
+	keep := true
+
+	created, err := h.CreateOutputStyle(context.Background(), api.CreateOutputStyleRequestObject{
+		Body: &api.CreateOutputStyleRequest{
+			Name: "terse", Description: &description, KeepCodingInstructions: &keep,
+			Body: "Answer in one line.\n", Scope: api.CreateOutputStyleRequestScopeProject, ProjectId: &encoded,
+		},
+	})
+	require.NoError(t, err)
+	file, ok := created.(api.CreateOutputStyle200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, filepath.Join(projectDir, ".claude", "output-styles", "terse.md"), file.FilePath)
+	require.NotNil(t, file.KeepCodingInstructions)
+	assert.True(t, *file.KeepCodingInstructions)
+
+	scope := api.GetOutputStylesParamsScopeProject
+	list, err := h.GetOutputStyles(context.Background(), api.GetOutputStylesRequestObject{
+		Params: api.GetOutputStylesParams{Scope: &scope, ProjectId: &encoded},
+	})
+	require.NoError(t, err)
+	require.Len(t, list.(api.GetOutputStyles200JSONResponse), 1)
+
+	updated, err := h.UpdateOutputStyle(context.Background(), api.UpdateOutputStyleRequestObject{
+		Name: "terse",
+		Body: &api.UpdateOutputStyleRequest{Body: "Answer in two lines.\n", Scope: api.UpdateOutputStyleRequestScopeProject, ProjectId: &encoded},
+	})
+	require.NoError(t, err)
+	detail, ok := updated.(api.UpdateOutputStyle200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "terse", detail.Name)
+	assert.Nil(t, detail.KeepCodingInstructions, "omitted fields are cleared")
+
+	deleted, err := h.DeleteOutputStyle(context.Background(), api.DeleteOutputStyleRequestObject{
+		Name: "terse",
+		Body: &api.DeleteOutputStyleRequest{Scope: api.DeleteOutputStyleRequestScopeProject, ProjectId: &encoded},
+	})
+	require.NoError(t, err)
+	_, ok = deleted.(api.DeleteOutputStyle200JSONResponse)
+	assert.True(t, ok)
+}
+
+func TestOutputStyleSetting_SwitchPerLayer(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"outputStyle":"Explanatory"}`), 0o600))
+	require.NoError(t, os.MkdirAll(filepath.Join(claudeHome, "output-styles"), 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "output-styles", "terse.md"), []byte("---\nname: Terse\n---\nBe brief.\n"), 0o600))
+
+	set := func(name string) api.UpdateOutputStyleSettingResponseObject {
+		t.Helper()
+		resp, err := h.UpdateOutputStyleSetting(context.Background(), api.UpdateOutputStyleSettingRequestObject{
+			Body: &api.UpdateOutputStyleSettingRequest{Layer: api.ConfigLayerSourceGlobalLocal, OutputStyle: &name},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+	_, ok := set("Nonexistent").(api.UpdateOutputStyleSetting400JSONResponse)
+	assert.True(t, ok)
+	_, ok = set("Terse").(api.UpdateOutputStyleSetting200JSONResponse)
+	require.True(t, ok)
+
+	resp, err := h.GetOutputStyleSetting(context.Background(), api.GetOutputStyleSettingRequestObject{})
+	require.NoError(t, err)
+	setting := resp.(api.GetOutputStyleSetting200JSONResponse)
+	require.NotNil(t, setting.Effective)
+	assert.Equal(t, "Terse", setting.Effective.Value)
+	assert.Equal(t, api.ConfigLayerSourceGlobalLocal, setting.Effective.Source)
+	assert.Contains(t, setting.Available, "Terse")
+	require.NotNil(t, setting.Layers[0].OutputStyle)
+	assert.Equal(t, "Explanatory", *setting.Layers[0].OutputStyle)
+
+	_, ok = set("").(api.UpdateOutputStyleSetting200JSONResponse)
+	require.True(t, ok)
+	resp, err = h.GetOutputStyleSetting(context.Background(), api.GetOutputStyleSettingRequestObject{})
+	require.NoError(t, err)
+	assert.Equal(t, "Explanatory", resp.(api.GetOutputStyleSetting200JSONResponse).Effective.Value)
+}
@@ -0,0 +1,378 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"fieldstation/lib"
+)
+
+// GetPermissions lists the permission rules, defaultMode and
+// additionalDirectories of every layer, each rule parsed and tagged with the
+// layer it came from.
+func (h *FieldStationHandler) GetPermissions(_ context.Context, request GetPermissionsRequestObject) (GetPermissionsResponseObject, error) {
+	projectPath := ""
+	if request.Params.ProjectId != nil && *request.Params.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *request.Params.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("permissions: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	perms := lib.LoadPermissions(projectPath)
+	redactor := h.redactor()
+	resp := PermissionsResponse{
+		Rules:                 make([]PermissionRule, len(perms.Rules)),
+		AdditionalDirectories: make([]PermissionDirectory, len(perms.AdditionalDirectories)),
+		Layers:                make([]PermissionsLayer, len(perms.Layers)),
+	}
+	for i, entry := range perms.Rules {
+		resp.Rules[i] = permissionRuleToAPI(entry, redactor)
+	}
+	for i, dir := range perms.AdditionalDirectories {
+		resp.AdditionalDirectories[i] = PermissionDirectory{Path: dir.Path, Source: ConfigLayerSource(dir.Source), FilePath: dir.FilePath}
+	}
+	for i, layer := range perms.Layers {
+		resp.Layers[i] = PermissionsLayer{
+			Source:   ConfigLayerSource(layer.Source),
+			FilePath: layer.FilePath,
+			Exists:   layer.Exists,
+			ReadOnly: layer.Source.ReadOnly(),
+			Etag:     layer.ETag,
+		}
+	}
+	if perms.DefaultMode != nil {
+		resp.DefaultMode = &ShadowedValue{
+			Source:   ConfigLayerSource(perms.DefaultMode.Source),
+			FilePath: perms.DefaultMode.FilePath,
+			Value:    perms.DefaultMode.Value,
+		}
+	}
+	return GetPermissions200JSONResponse(resp), nil
+}
+
+// permissionRuleToAPI converts a lib rule entry to its API representation,
+// with secrets in the rule's text, such as a token in a curl command, redacted.
+func permissionRuleToAPI(entry lib.PermissionRuleEntry, redactor *lib.Redactor) PermissionRule {
+	out := PermissionRule{
+		Behavior: PermissionBehavior(entry.Behavior),
+		Rule:     redactor.Text(entry.Rule.Raw),
+		Source:   ConfigLayerSource(entry.Source),
+		FilePath: entry.FilePath,
+		Index:    entry.Index,
+	}
+	if entry.Error != "" {
+		e := redactor.Text(entry.Error)
+		out.Error = &e
+		return out
+	}
+	kind := PermissionPatternKind(entry.Rule.Kind)
+	out.Tool = &entry.Rule.Tool
+	out.Kind = &kind
+	if entry.Rule.Specifier != "" {
+		specifier := redactor.Text(entry.Rule.Specifier)
+		out.Specifier = &specifier
+	}
+	if entry.Rule.Pattern != "" {
+		pattern := redactor.Text(entry.Rule.Pattern)
+		out.Pattern = &pattern
+	}
+	if entry.Rule.MCPServer != "" {
+		out.McpServer = &entry.Rule.MCPServer
+	}
+	return out
+}
+
+// permissionsFilePath resolves the settings file of a writable layer. conflict
+// is set, with no path, when the layer is read-only.
+func (h *FieldStationHandler) permissionsFilePath(layer ConfigLayerSource, projectID *string) (filePath, conflict string, err error) {
+	loc, err := h.settingLocation(SettingLayerRef{Layer: layer, ProjectId: projectID})
+	if err != nil {
+		return "", "", err
+	}
+	if loc.Source.ReadOnly() {
+		return "", fmt.Sprintf("the %s layer is read-only", loc.Source), nil
+	}
+	filePath, err = lib.ResolveLayerPath(loc.Source, loc.ProjectPath)
+	if err != nil {
+		return "", "", fmt.Errorf("permissions: %w", err)
+	}
+	return filePath, "", nil
+}
+
+// storedPermissionRule returns the rule of behavior's list in the settings file
+// at filePath that rule stands for when it was shown redacted, so a rule can be
+// edited or removed by the text permissionRuleToAPI returned. A rule without
+// placeholders, or one that matches no stored rule, is returned unchanged.
+func (h *FieldStationHandler) storedPermissionRule(filePath string, behavior PermissionBehavior, rule string) string {
+	if !strings.Contains(rule, "[REDACTED]") {
+		return rule
+	}
+	content, err := lib.ReadJSONFileStrict(filePath)
+	if err != nil {
+		return rule
+	}
+	perms, _ := content["permissions"].(lib.JsonObject)
+	list, _ := perms[string(behavior)].([]any)
+	redactor := h.redactor()
+	for _, item := range list {
+		if stored, ok := item.(string); ok && redactor.Text(stored) == rule {
+			return stored
+		}
+	}
+	return rule
+}
+
+// permissionRuleValidation checks that behavior names a rule list and that rule
+// parses, returning the 400 body if not.
+func permissionRuleValidation(behavior PermissionBehavior, rule string) (ValidationErrorResponse, bool) {
+	path := lib.JoinPath("permissions", string(behavior))
+	var issue lib.SettingsIssue
+	if !lib.PermissionBehavior(behavior).Valid() {
+		issue = lib.SettingsIssue{Path: "behavior", Message: fmt.Sprintf("unknown behavior %q; expected allow, deny or ask", behavior)}
+	} else if _, err := lib.ParsePermissionRule(rule); err != nil {
+		issue = lib.SettingsIssue{Path: path, Message: err.Error()}
+	} else {
+		return ValidationErrorResponse{}, false
+	}
+	return validationErrorResponse(lib.SettingsValidation{Errors: []lib.SettingsIssue{issue}}), true
+}
+
+// AddPermissionRule appends a rule to one layer's allow, deny or ask list.
+func (h *FieldStationHandler) AddPermissionRule(_ context.Context, request AddPermissionRuleRequestObject) (AddPermissionRuleResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	filePath, conflict, err := h.permissionsFilePath(body.Layer, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	if conflict != "" {
+		return AddPermissionRule409JSONResponse(ErrorResponse{Error: conflict}), nil
+	}
+	if resp, invalid := permissionRuleValidation(body.Behavior, body.Rule); invalid {
+		return AddPermissionRule400JSONResponse(resp), nil
+	}
+	if err := lib.CheckManagedPolicy(lib.JoinPath("permissions", string(body.Behavior))); err != nil {
+		return AddPermissionRule409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return AddPermissionRule412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.AddPermissionRule(filePath, lib.PermissionBehavior(body.Behavior), body.Rule, h.claudeHome); err != nil {
+		var editErr *lib.PermissionEditError
+		if errors.As(err, &editErr) {
+			return AddPermissionRule409JSONResponse(ErrorResponse{Error: h.redactor().Text(editErr.Error())}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return AddPermissionRule422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return AddPermissionRule200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// UpdatePermissionRule replaces a rule in place, or moves it to the end of
+// another list when newBehavior differs.
+func (h *FieldStationHandler) UpdatePermissionRule(_ context.Context, request UpdatePermissionRuleRequestObject) (UpdatePermissionRuleResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+	newBehavior := body.Behavior
+	if body.NewBehavior != nil {
+		newBehavior = *body.NewBehavior
+	}
+
+	filePath, conflict, err := h.permissionsFilePath(body.Layer, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	if conflict != "" {
+		return UpdatePermissionRule409JSONResponse(ErrorResponse{Error: conflict}), nil
+	}
+	rule := h.storedPermissionRule(filePath, body.Behavior, body.Rule)
+	newRule, err := h.redactor().RestoreText(body.NewRule, rule)
+	if err != nil {
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdatePermissionRule409JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	if resp, invalid := permissionRuleValidation(newBehavior, newRule); invalid {
+		return UpdatePermissionRule400JSONResponse(resp), nil
+	}
+	for _, behavior := range []PermissionBehavior{body.Behavior, newBehavior} {
+		if err := lib.CheckManagedPolicy(lib.JoinPath("permissions", string(behavior))); err != nil {
+			return UpdatePermissionRule409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+		}
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdatePermissionRule412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	err = lib.ReplacePermissionRule(filePath, lib.PermissionBehavior(body.Behavior), rule, lib.PermissionBehavior(newBehavior), newRule, h.claudeHome)
+	if err != nil {
+		var editErr *lib.PermissionEditError
+		if errors.As(err, &editErr) {
+			if editErr.NotFound {
+				return UpdatePermissionRule404JSONResponse(ErrorResponse{Error: h.redactor().Text(editErr.Error())}), nil
+			}
+			return UpdatePermissionRule409JSONResponse(ErrorResponse{Error: h.redactor().Text(editErr.Error())}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdatePermissionRule422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return UpdatePermissionRule200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// DeletePermissionRule removes a rule from one layer's list. The rule is matched
+// verbatim, so rules that no longer parse can still be removed.
+func (h *FieldStationHandler) DeletePermissionRule(_ context.Context, request DeletePermissionRuleRequestObject) (DeletePermissionRuleResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	filePath, conflict, err := h.permissionsFilePath(body.Layer, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	if conflict != "" {
+		return DeletePermissionRule409JSONResponse(ErrorResponse{Error: conflict}), nil
+	}
+	if err := lib.CheckManagedPolicy(lib.JoinPath("permissions", string(body.Behavior))); err != nil {
+		return DeletePermissionRule409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return DeletePermissionRule412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	rule := h.storedPermissionRule(filePath, body.Behavior, body.Rule)
+	if err := lib.RemovePermissionRule(filePath, lib.PermissionBehavior(body.Behavior), rule, h.claudeHome); err != nil {
+		var editErr *lib.PermissionEditError
+		if errors.As(err, &editErr) {
+			return DeletePermissionRule404JSONResponse(ErrorResponse{Error: h.redactor().Text(editErr.Error())}), nil
+		}
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return DeletePermissionRule422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return DeletePermissionRule200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// UpdatePermissionSettings sets or clears defaultMode and additionalDirectories
+// in one layer, validating them against the settings schema.
+func (h *FieldStationHandler) UpdatePermissionSettings(_ context.Context, request UpdatePermissionSettingsRequestObject) (UpdatePermissionSettingsResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	filePath, conflict, err := h.permissionsFilePath(body.Layer, body.ProjectId)
+	if err != nil {
+		return nil, err
+	}
+	if conflict != "" {
+		return UpdatePermissionSettings409JSONResponse(ErrorResponse{Error: conflict}), nil
+	}
+
+	var validation lib.SettingsValidation
+	check := func(keyPath string, value any) error {
+		if err := lib.CheckManagedPolicy(keyPath); err != nil {
+			return err
+		}
+		v := lib.ValidateSettingValue(keyPath, value)
+		validation.Errors = append(validation.Errors, v.Errors...)
+		return nil
+	}
+	if body.DefaultMode != nil && *body.DefaultMode != "" {
+		if err := check("permissions.defaultMode", *body.DefaultMode); err != nil {
+			return UpdatePermissionSettings409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+		}
+	}
+	if body.AdditionalDirectories != nil {
+		dirs := make([]any, len(*body.AdditionalDirectories))
+		for i, dir := range *body.AdditionalDirectories {
+			dirs[i] = dir
+			if dir == "" {
+				validation.Errors = append(validation.Errors, lib.SettingsIssue{
+					Path:    fmt.Sprintf("permissions.additionalDirectories[%d]", i),
+					Message: "directory is empty",
+				})
+			}
+		}
+		if err := check("permissions.additionalDirectories", dirs); err != nil {
+			return UpdatePermissionSettings409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+		}
+	}
+	if !validation.Valid() {
+		return UpdatePermissionSettings400JSONResponse(validationErrorResponse(validation)), nil
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdatePermissionSettings412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.SetPermissionSettings(filePath, body.DefaultMode, body.AdditionalDirectories, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdatePermissionSettings422JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return UpdatePermissionSettings200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// EvaluatePermission decides a hypothetical tool call against the merged
+// permission rules of every layer, reporting the rule and layer that decided it.
+func (h *FieldStationHandler) EvaluatePermission(_ context.Context, request EvaluatePermissionRequestObject) (EvaluatePermissionResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	projectPath := ""
+	if body.ProjectId != nil && *body.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *body.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("permissions: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	call := lib.ToolCall{Tool: body.Tool, Cwd: projectPath}
+	if body.Input != nil {
+		call.Input = *body.Input
+	}
+	decision, err := lib.EvaluatePermission(lib.LoadPermissions(projectPath), call)
+	if err != nil {
+		return EvaluatePermission400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	redactor := h.redactor()
+	resp := PermissionEvaluation{Decision: PermissionBehavior(decision.Behavior), Reason: redactor.Text(decision.Reason)}
+	if decision.Rule != nil {
+		rule := permissionRuleToAPI(*decision.Rule, redactor)
+		resp.Rule = &rule
+	}
+	if len(decision.Subcommands) > 0 {
+		subs := make([]PermissionSubcommandDecision, len(decision.Subcommands))
+		for i, sub := range decision.Subcommands {
+			subs[i] = PermissionSubcommandDecision{Command: sub.Command, Decision: PermissionBehavior(sub.Behavior), Reason: redactor.Text(sub.Reason)}
+			if sub.Rule != nil {
+				rule := permissionRuleToAPI(*sub.Rule, redactor)
+				subs[i].Rule = &rule
+			}
+		}
+		resp.Subcommands = &subs
+	}
+	return EvaluatePermission200JSONResponse(resp), nil
+}
@@ -24,23 +24,29 @@ type installedPluginsFile struct {
 	Plugins map[string][]pluginInstallRecord `json:"plugins"`
 }
 
-// GetPlugins lists all installed plugins from ~/.claude/plugins/installed_plugins.json.
-func (h *FieldStationHandler) GetPlugins(_ context.Context, _ GetPluginsRequestObject) (GetPluginsResponseObject, error) {
-	pluginsPath := filepath.Join(h.claudeHome, "plugins", "installed_plugins.json")
+// readInstalledPlugins reads ~/.claude/plugins/installed_plugins.json.
+// Returns nil if the file does not exist or cannot be parsed.
+func readInstalledPlugins(claudeHome string) map[string][]pluginInstallRecord {
+	pluginsPath := filepath.Join(claudeHome, "plugins", "installed_plugins.json")
 
 	data, err := os.ReadFile(pluginsPath) //nolint:gosec // pluginsPath is constructed from a controlled claude home path
 	if err != nil {
-		// Return empty list if file does not exist or cannot be read.
-		return GetPlugins200JSONResponse([]PluginFile{}), nil
+		return nil
 	}
 
 	var parsed installedPluginsFile
 	if err := json.Unmarshal(data, &parsed); err != nil {
-		return GetPlugins200JSONResponse([]PluginFile{}), nil
+		return nil
 	}
+	return parsed.Plugins
+}
+
+// GetPlugins lists all installed plugins from ~/.claude/plugins/installed_plugins.json.
+func (h *FieldStationHandler) GetPlugins(_ context.Context, _ GetPluginsRequestObject) (GetPluginsResponseObject, error) {
+	plugins := readInstalledPlugins(h.claudeHome)
 
-	result := make([]PluginFile, 0, len(parsed.Plugins))
-	for id, records := range parsed.Plugins {
+	result := make([]PluginFile, 0, len(plugins))
+	for id, records := range plugins {
 		if len(records) == 0 {
 			continue
 		}
@@ -22,12 +22,18 @@ func userHomeDir() string {
 // GetProjects lists all project directories from ~/.claude/projects/.
 // Each entry is a directory whose name is the encoded project path.
 func (h *FieldStationHandler) GetProjects(_ context.Context, _ GetProjectsRequestObject) (GetProjectsResponseObject, error) {
-	projectsDir := filepath.Join(h.claudeHome, "projects")
+	return GetProjects200JSONResponse(registeredProjects(h.claudeHome)), nil
+}
+
+// registeredProjects returns the projects registered under claudeHome/projects,
+// leaving out the home directory and any project that contains another.
+func registeredProjects(claudeHome string) []ProjectFile {
+	projectsDir := filepath.Join(claudeHome, "projects")
 
 	entries, err := os.ReadDir(projectsDir)
 	if err != nil {
 		// Return empty list if projects dir does not exist or is unreadable.
-		return GetProjects200JSONResponse([]ProjectFile{}), nil
+		return []ProjectFile{}
 	}
 
 	home := userHomeDir()
@@ -67,7 +73,18 @@ func (h *FieldStationHandler) GetProjects(_ context.Context, _ GetProjectsReques
 			filtered = append(filtered, p)
 		}
 	}
-	return GetProjects200JSONResponse(filtered), nil
+	return filtered
+}
+
+// RegisteredProjectPaths returns the paths of the projects registered under
+// claudeHome/projects, as listed by GET /api/projects.
+func RegisteredProjectPaths(claudeHome string) []string {
+	projects := registeredProjects(claudeHome)
+	paths := make([]string, len(projects))
+	for i, p := range projects {
+		paths[i] = p.Path
+	}
+	return paths
 }
 
 // PostProjects batch-registers one or more project paths under ~/.claude/projects/.
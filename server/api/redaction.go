@@ -0,0 +1,112 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"os"
+
+	"fieldstation/lib"
+)
+
+// redactor returns the redaction rules in effect. A broken rules file is
+// logged and the built-in rules are used instead.
+func (h *FieldStationHandler) redactor() *lib.Redactor {
+	r, err := lib.LoadRedactor(h.claudeHome)
+	if err != nil {
+		log.Printf("redaction rules: %v", err)
+	}
+	return r
+}
+
+// restoreRedactedFile returns content with the redaction placeholders a client
+// echoed back replaced by the secrets they hid in the file at filePath. Content
+// for a file that does not exist yet is returned unchanged.
+func (h *FieldStationHandler) restoreRedactedFile(filePath, content string) (string, error) {
+	existing, err := os.ReadFile(filePath) //nolint:gosec // filePath is resolved by the calling handler
+	if err != nil {
+		return content, nil //nolint:nilerr // nothing stored, so nothing to restore
+	}
+	return h.redactor().RestoreText(content, string(existing))
+}
+
+// redactedWriteConflict maps a *lib.RedactedWriteError to a 409 body.
+func redactedWriteConflict(err error) (ErrorResponse, bool) {
+	var redactedErr *lib.RedactedWriteError
+	if !errors.As(err, &redactedErr) {
+		return ErrorResponse{}, false
+	}
+	return ErrorResponse{Error: redactedErr.Error()}, true
+}
+
+// RevealRedactedValue returns the stored value behind one redacted settings or
+// MCP server value. With authentication enabled the password is checked again,
+// so a stolen session cookie alone cannot reveal secrets. Every attempt is
+// recorded in the audit log; if it cannot be, nothing is revealed.
+func (h *FieldStationHandler) RevealRedactedValue(_ context.Context, request RevealRedactedValueRequestObject) (RevealRedactedValueResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+	if (body.Layer == nil) == (body.McpScope == nil) {
+		return RevealRedactedValue400JSONResponse(ErrorResponse{Error: "name exactly one of layer or mcpScope"}), nil
+	}
+	keys, err := lib.ParsePath(body.KeyPath)
+	if err != nil || len(keys) == 0 {
+		return RevealRedactedValue400JSONResponse(ErrorResponse{Error: fmt.Sprintf("invalid key path %q", body.KeyPath)}), nil
+	}
+
+	projectPath := ""
+	if body.ProjectId != nil && *body.ProjectId != "" {
+		if projectPath, err = resolveProjectPath(h.claudeHome, *body.ProjectId); err != nil {
+			return nil, fmt.Errorf("redaction: invalid project id: %w", err)
+		}
+	}
+	var filePath string
+	if body.Layer != nil {
+		filePath, err = lib.ResolveLayerPath(lib.ConfigLayerSource(*body.Layer), projectPath)
+	} else {
+		var prefix []string
+		filePath, prefix, err = lib.MCPServerLocation(lib.MCPScope(*body.McpScope), projectPath)
+		keys = append(prefix, keys...)
+	}
+	if err != nil {
+		return RevealRedactedValue400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	event := lib.AuditEvent{Action: "reveal", FilePath: filePath, KeyPath: lib.JoinPath(keys...)}
+	audited := func(outcome string) error {
+		event.Outcome = outcome
+		return lib.AppendAuditEvent(h.claudeHome, event)
+	}
+
+	if h.authEnabled {
+		creds, err := lib.LoadCredentials(h.claudeHome)
+		if err != nil {
+			return nil, err
+		}
+		if creds == nil || body.Password == nil || !lib.VerifyPassword(*body.Password, creds.PasswordHash) {
+			if err := audited(lib.AuditDenied); err != nil {
+				return nil, err
+			}
+			return RevealRedactedValue401JSONResponse(ErrorResponse{Error: "password required to reveal secrets"}), nil
+		}
+	}
+
+	obj, err := lib.ReadJSONFileStrict(filePath)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := lib.GetAtPath(obj, lib.JoinPath(keys...))
+	if !ok {
+		if err := audited(lib.AuditNotFound); err != nil {
+			return nil, err
+		}
+		return RevealRedactedValue404JSONResponse(ErrorResponse{Error: fmt.Sprintf("no value at %s in %s", body.KeyPath, filePath)}), nil
+	}
+	if err := audited(lib.AuditAllowed); err != nil {
+		return nil, err
+	}
+	return RevealRedactedValue200JSONResponse(RevealResponse{FilePath: filePath, KeyPath: body.KeyPath, Value: value}), nil
+}
@@ -0,0 +1,111 @@
+package api_test
+
+import (
+	"context"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+	"fieldstation/lib"
+)
+
+func TestGetConfig_RedactsLayersMergedAndProvenance(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"),
+		[]byte(`{"env":{"API_TOKEN":"real-secret-1"},"statusLine":{"command":"status --key=real-secret-2"},"model":"opus"}`), 0o600))
+
+	resp, err := h.GetConfig(context.Background(), api.GetConfigRequestObject{})
+	require.NoError(t, err)
+	w := httptest.NewRecorder()
+	require.NoError(t, resp.VisitGetConfigResponse(w))
+	body := w.Body.String()
+
+	assert.NotContains(t, body, "real-secret")
+	assert.Contains(t, body, `"opus"`)
+
+	config, ok := resp.(api.GetConfig200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "[REDACTED]", (*config.Provenance)["env.API_TOKEN"].Value)
+	assert.Equal(t, "status --key=[REDACTED]", (*config.Provenance)["statusLine.command"].Value)
+}
+
+func TestRevealRedactedValue_RequiresPasswordAndAudits(t *testing.T) {
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	h := api.NewHandler(claudeHome, true)
+	hash, err := lib.HashPassword("correct horse")
+	require.NoError(t, err)
+	require.NoError(t, lib.SaveCredentials(claudeHome, &lib.Credentials{PasswordHash: hash, SigningKey: "k"}))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"env":{"API_TOKEN":"real-secret"}}`), 0o600))
+
+	layer := api.ConfigLayerSourceGlobal
+	reveal := func(password string) api.RevealRedactedValueResponseObject {
+		t.Helper()
+		resp, err := h.RevealRedactedValue(context.Background(), api.RevealRedactedValueRequestObject{
+			Body: &api.RevealRequest{Layer: &layer, KeyPath: "env.API_TOKEN", Password: &password},
+		})
+		require.NoError(t, err)
+		return resp
+	}
+
+	_, ok := reveal("wrong").(api.RevealRedactedValue401JSONResponse)
+	assert.True(t, ok)
+	revealed, ok := reveal("correct horse").(api.RevealRedactedValue200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "real-secret", revealed.Value)
+
+	data, err := os.ReadFile(filepath.Join(claudeHome, lib.AuditLogFileName)) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	assert.Contains(t, lines[0], `"outcome":"denied"`)
+	assert.Contains(t, lines[1], `"outcome":"allowed"`)
+	assert.NotContains(t, string(data), "real-secret")
+
+	resp, err := h.RevealRedactedValue(context.Background(), api.RevealRedactedValueRequestObject{
+		Body: &api.RevealRequest{KeyPath: "env.API_TOKEN"},
+	})
+	require.NoError(t, err)
+	_, ok = resp.(api.RevealRedactedValue400JSONResponse)
+	assert.True(t, ok, "a layer or MCP scope is required")
+}
+
+func TestUpdateMemory_RestoresRedactedSecrets(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	encoded := registerProject(t, claudeHome, t.TempDir())
+	dir := memoryDir(claudeHome, encoded)
+	stored := "Deploy with DEPLOY_TOKEN=abc123xyz9\n"
+	writeMemoryFile(t, dir, "notes.md", stored)
+
+	resp, err := h.GetMemory(context.Background(), api.GetMemoryRequestObject{
+		Filename: "notes.md",
+		Params:   api.GetMemoryParams{ProjectId: encoded},
+	})
+	require.NoError(t, err)
+	shown := resp.(api.GetMemory200JSONResponse).Body.Content
+	assert.Equal(t, "Deploy with DEPLOY_TOKEN=[REDACTED]\n", shown)
+
+	update, err := h.UpdateMemory(context.Background(), api.UpdateMemoryRequestObject{
+		Filename: "notes.md",
+		Body:     &api.UpdateMemoryJSONRequestBody{ProjectId: encoded, Content: shown + "More notes.\n"},
+	})
+	require.NoError(t, err)
+	_, ok := update.(api.UpdateMemory200JSONResponse)
+	require.True(t, ok)
+	data, err := os.ReadFile(filepath.Join(dir, "notes.md")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, stored+"More notes.\n", string(data))
+
+	update, err = h.UpdateMemory(context.Background(), api.UpdateMemoryRequestObject{
+		Filename: "notes.md",
+		Body:     &api.UpdateMemoryJSONRequestBody{ProjectId: encoded, Content: "[REDACTED] and [REDACTED]"},
+	})
+	require.NoError(t, err)
+	_, ok = update.(api.UpdateMemory409JSONResponse)
+	assert.True(t, ok, "placeholders that do not line up are refused")
+}
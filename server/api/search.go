@@ -39,23 +39,25 @@ func (h *FieldStationHandler) Search(_ context.Context, request SearchRequestObj
 		projectPath = pp
 	}
 
+	// Queries match redacted previews, so a search cannot confirm a guessed secret.
+	redactor := h.redactor()
 	var results []SearchResult
 
 	// --- Global Agents ---
-	results = appendAgentResults(results, h.claudeHome, query)
+	results = appendAgentResults(results, h.claudeHome, query, redactor)
 
 	// --- Global Commands ---
-	results = appendCommandResults(results, h.claudeHome, query)
+	results = appendCommandResults(results, h.claudeHome, query, redactor)
 
 	// --- Global Skills ---
-	results = appendSkillResults(results, h.claudeHome, query)
+	results = appendSkillResults(results, h.claudeHome, query, redactor)
 
 	// --- Project resources (if projectPath provided) ---
 	if projectPath != "" {
 		projectClaudeDir := filepath.Join(projectPath, ".claude")
-		results = appendAgentResults(results, projectClaudeDir, query)
-		results = appendCommandResults(results, projectClaudeDir, query)
-		results = appendSkillResults(results, projectClaudeDir, query)
+		results = appendAgentResults(results, projectClaudeDir, query, redactor)
+		results = appendCommandResults(results, projectClaudeDir, query, redactor)
+		results = appendSkillResults(results, projectClaudeDir, query, redactor)
 	}
 
 	if results == nil {
@@ -64,13 +66,13 @@ func (h *FieldStationHandler) Search(_ context.Context, request SearchRequestObj
 	return Search200JSONResponse(results), nil
 }
 
-func appendAgentResults(results []SearchResult, claudeHome, query string) []SearchResult {
+func appendAgentResults(results []SearchResult, claudeHome, query string, redactor *lib.Redactor) []SearchResult {
 	agents, err := lib.ListResources(lib.ResourceTypeAgent, claudeHome)
 	if err != nil {
 		return results
 	}
 	for _, a := range agents {
-		preview := lib.TruncateBody(a.Body, 5)
+		preview := lib.TruncateBody(redactor.Text(a.Body), 5)
 		if !searchMatchesQuery(query, a.Name, a.Description, preview) {
 			continue
 		}
@@ -90,9 +92,9 @@ func appendAgentResults(results []SearchResult, claudeHome, query string) []Sear
 	return results
 }
 
-func appendCommandResults(results []SearchResult, claudeHome, query string) []SearchResult {
+func appendCommandResults(results []SearchResult, claudeHome, query string, redactor *lib.Redactor) []SearchResult {
 	commandDir := filepath.Join(claudeHome, "commands")
-	commands, err := listCommandsFromDir(commandDir)
+	commands, err := listCommandsFromDir(commandDir, redactor)
 	if err != nil {
 		return results
 	}
@@ -111,13 +113,13 @@ func appendCommandResults(results []SearchResult, claudeHome, query string) []Se
 	return results
 }
 
-func appendSkillResults(results []SearchResult, claudeHome, query string) []SearchResult {
+func appendSkillResults(results []SearchResult, claudeHome, query string, redactor *lib.Redactor) []SearchResult {
 	skills, err := lib.ListResources(lib.ResourceTypeSkill, claudeHome)
 	if err != nil {
 		return results
 	}
 	for _, s := range skills {
-		preview := lib.TruncateBody(s.Body, 5)
+		preview := lib.TruncateBody(redactor.Text(s.Body), 5)
 		if !searchMatchesQuery(query, s.Name, s.Description, preview) {
 			continue
 		}
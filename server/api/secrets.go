@@ -0,0 +1,34 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"path/filepath"
+
+	"fieldstation/lib"
+)
+
+// ScanSecrets scans the global home and every registered project for secrets.
+func (h *FieldStationHandler) ScanSecrets(_ context.Context, _ ScanSecretsRequestObject) (ScanSecretsResponseObject, error) {
+	result, err := lib.ScanSecrets(h.claudeHome, RegisteredProjectPaths(h.claudeHome))
+	if err != nil {
+		return nil, err
+	}
+	findings := make([]SecretFinding, len(result.Findings))
+	for i, f := range result.Findings {
+		findings[i] = SecretFinding{
+			FilePath:    f.FilePath,
+			Line:        f.Line,
+			Column:      f.Column,
+			Kind:        SecretFindingKind(f.Kind),
+			Pattern:     f.Pattern,
+			Masked:      f.Masked,
+			Fingerprint: f.Fingerprint,
+		}
+	}
+	return ScanSecrets200JSONResponse(SecretScanResponse{
+		Findings:     findings,
+		FilesScanned: result.FilesScanned,
+		Ignored:      result.Ignored,
+		IgnoreFile:   filepath.Join(h.claudeHome, lib.SecretsIgnoreFileName),
+	}), nil
+}
@@ -0,0 +1,35 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+	"fieldstation/lib"
+)
+
+func TestScanSecrets_ReportsMaskedFindingsInRegisteredProjects(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	projectDir := t.TempDir()
+	registerProject(t, claudeHome, projectDir)
+	secret := "xoxb-" + "1234567890-abcdefghijkl"
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, "CLAUDE.md"), []byte("Post to Slack with "+secret+"\n"), 0o600))
+
+	resp, err := h.ScanSecrets(context.Background(), api.ScanSecretsRequestObject{})
+	require.NoError(t, err)
+	scan, ok := resp.(api.ScanSecrets200JSONResponse)
+	require.True(t, ok)
+
+	require.Len(t, scan.Findings, 1)
+	finding := scan.Findings[0]
+	assert.Equal(t, filepath.Join(projectDir, "CLAUDE.md"), finding.FilePath)
+	assert.Equal(t, 1, finding.Line)
+	assert.Equal(t, api.SecretFindingKind(lib.SecretKindInstructions), finding.Kind)
+	assert.Equal(t, "slack-token", finding.Pattern)
+	assert.NotContains(t, finding.Masked, "abcdefghijkl")
+	assert.Equal(t, filepath.Join(claudeHome, lib.SecretsIgnoreFileName), scan.IgnoreFile)
+}
@@ -23,7 +23,7 @@ func (h *FieldStationHandler) resolveSkillDir(scope string, projectPath *string)
 
 // listSkillsFromDir reads all skill folders from the skills directory.
 // Each skill is a directory containing a SKILL.md file.
-func listSkillsFromDir(dir string) ([]SkillFile, error) {
+func listSkillsFromDir(dir string, redactor *lib.Redactor) ([]SkillFile, error) {
 	entries, err := os.ReadDir(dir)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -65,7 +65,7 @@ func listSkillsFromDir(dir string) ([]SkillFile, error) {
 			Description: description,
 			FolderName:  folderName,
 			FilePath:    skillMdPath,
-			BodyPreview: lib.TruncateBody(doc.Body, 5),
+			BodyPreview: lib.TruncateBody(redactor.Text(doc.Body), 5),
 			IsEditable:  lib.IsUserOwned(skillMdPath),
 		})
 	}
@@ -92,7 +92,7 @@ func (h *FieldStationHandler) GetSkills(_ context.Context, request GetSkillsRequ
 		return nil, err
 	}
 
-	skills, err := listSkillsFromDir(skillDir)
+	skills, err := listSkillsFromDir(skillDir, h.redactor())
 	if err != nil {
 		return nil, err
 	}
@@ -148,14 +148,17 @@ func (h *FieldStationHandler) GetSkill(_ context.Context, request GetSkillReques
 		description = d
 	}
 
-	return GetSkill200JSONResponse(SkillDetail{
-		Name:        name,
-		Description: description,
-		FolderName:  folderName,
-		FilePath:    skillMdPath,
-		Body:        doc.Body,
-		IsEditable:  lib.IsUserOwned(skillMdPath),
-	}), nil
+	return GetSkill200JSONResponse{
+		Body: SkillDetail{
+			Name:        name,
+			Description: description,
+			FolderName:  folderName,
+			FilePath:    skillMdPath,
+			Body:        h.redactor().Text(doc.Body),
+			IsEditable:  lib.IsUserOwned(skillMdPath),
+		},
+		Headers: GetSkill200ResponseHeaders{ETag: lib.ContentETag(data)},
+	}, nil
 }
 
 // CreateSkill creates a new skill folder with a SKILL.md file.
@@ -234,7 +237,7 @@ func (h *FieldStationHandler) CreateSkill(_ context.Context, request CreateSkill
 		Description: description,
 		FolderName:  body.Name,
 		FilePath:    skillMdPath,
-		BodyPreview: lib.TruncateBody(body.Body, 5),
+		BodyPreview: lib.TruncateBody(h.redactor().Text(body.Body), 5),
 		IsEditable:  lib.IsUserOwned(skillMdPath),
 	}), nil
 }
@@ -262,10 +265,21 @@ func (h *FieldStationHandler) UpdateSkill(_ context.Context, request UpdateSkill
 	folderName := request.Name
 	skillMdPath := filepath.Join(skillDir, folderName, "SKILL.md")
 
+	// Restore any redaction placeholders echoed back from GetSkill.
+	skillBody := body.Body
+	if data, err := os.ReadFile(skillMdPath); err == nil { //nolint:gosec // skillMdPath is within the resolved skills directory
+		if doc, err := lib.ParseMarkdownFrontmatter(string(data)); err == nil {
+			skillBody, err = h.redactor().RestoreText(body.Body, doc.Body)
+			if resp, ok := redactedWriteConflict(err); ok {
+				return UpdateSkill409JSONResponse(resp), nil
+			}
+		}
+	}
+
 	// Build updated SKILL.md content.
 	fm := lib.FrontmatterDoc{
 		Frontmatter: map[string]any{},
-		Body:        body.Body,
+		Body:        skillBody,
 	}
 	fm.Frontmatter["name"] = folderName
 	if body.Description != nil && *body.Description != "" {
@@ -273,6 +287,10 @@ func (h *FieldStationHandler) UpdateSkill(_ context.Context, request UpdateSkill
 	}
 	content := lib.SerializeMarkdown(fm)
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, skillMdPath); failed != nil {
+		return UpdateSkill412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
 	lib.BackupFile(skillMdPath, lib.BackupOpUpdate, h.claudeHome)
 
 	if err := lib.WriteFileAtomic(skillMdPath, []byte(content)); err != nil {
@@ -289,7 +307,7 @@ func (h *FieldStationHandler) UpdateSkill(_ context.Context, request UpdateSkill
 		Description: description,
 		FolderName:  folderName,
 		FilePath:    skillMdPath,
-		Body:        body.Body,
+		Body:        h.redactor().Text(skillBody),
 		IsEditable:  lib.IsUserOwned(skillMdPath),
 	}), nil
 }
@@ -320,6 +338,10 @@ func (h *FieldStationHandler) DeleteSkill(_ context.Context, request DeleteSkill
 		return nil, fmt.Errorf("skills: cannot stat %s: %w", skillMdPath, err)
 	}
 
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, skillMdPath); failed != nil {
+		return DeleteSkill412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+
 	lib.BackupFile(skillMdPath, lib.BackupOpDelete, h.claudeHome)
 
 	// Remove the entire skill folder.
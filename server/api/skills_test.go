@@ -52,7 +52,7 @@ func TestGetSkill_AllowsLegitimateRead(t *testing.T) {
 	require.NoError(t, err, "GetSkill must work for a legitimate request")
 	detail, ok := resp.(api.GetSkill200JSONResponse)
 	require.True(t, ok)
-	assert.Equal(t, "My Skill", detail.Name)
+	assert.Equal(t, "My Skill", detail.Body.Name)
 }
 
 // Issue C: IsUserOwned write guard — CreateSkill must reject plugin-cache targets
@@ -0,0 +1,199 @@
+package api //nolint:revive // "api" is a meaningful package name for this HTTP handler package
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"fieldstation/lib"
+)
+
+// maxStatusLineTimeoutMs caps the timeout a status line test may ask for.
+const maxStatusLineTimeoutMs = 30000
+
+// GetStatusLine lists every layer with its statusLine setting, if any, and the
+// setting Claude Code uses.
+func (h *FieldStationHandler) GetStatusLine(_ context.Context, request GetStatusLineRequestObject) (GetStatusLineResponseObject, error) {
+	projectPath := ""
+	if request.Params.ProjectId != nil && *request.Params.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *request.Params.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("statusline: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	settings := lib.LoadStatusLine(projectPath)
+	redactor := h.redactor()
+	entries := map[lib.ConfigLayerSource]lib.StatusLineEntry{}
+	for _, entry := range settings.Entries {
+		entries[entry.Source] = entry
+	}
+	resp := StatusLineResponse{Layers: make([]StatusLineLayer, len(settings.Layers))}
+	for i, layer := range settings.Layers {
+		out := StatusLineLayer{
+			Source:   ConfigLayerSource(layer.Source),
+			FilePath: layer.FilePath,
+			Exists:   layer.Exists,
+			ReadOnly: layer.Source.ReadOnly(),
+			Etag:     layer.ETag,
+		}
+		if entry, ok := entries[layer.Source]; ok {
+			if entry.Error != "" {
+				out.Error = &entry.Error
+			} else {
+				sl := statusLineToAPI(entry.StatusLine, redactor)
+				out.StatusLine = &sl
+			}
+		}
+		resp.Layers[i] = out
+	}
+	if e := settings.Effective; e != nil {
+		resp.Effective = &StatusLineEffective{
+			Source:     ConfigLayerSource(e.Source),
+			FilePath:   e.FilePath,
+			StatusLine: statusLineToAPI(e.StatusLine, redactor),
+		}
+	}
+	return GetStatusLine200JSONResponse(resp), nil
+}
+
+// statusLineToAPI converts a lib status line to its API representation, with
+// secrets redacted.
+func statusLineToAPI(sl lib.StatusLine, redactor *lib.Redactor) StatusLineConfig {
+	out := StatusLineConfig{
+		Type:    StatusLineConfigType(sl.Type),
+		Command: redactor.Text(sl.Command),
+		Padding: sl.Padding,
+	}
+	if len(sl.Extra) > 0 {
+		out.AdditionalProperties = redactor.Object(sl.Extra)
+	}
+	return out
+}
+
+// UpdateStatusLine sets the statusLine setting of a layer, or removes it when
+// the request has none.
+func (h *FieldStationHandler) UpdateStatusLine(_ context.Context, request UpdateStatusLineRequestObject) (UpdateStatusLineResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	loc, err := h.settingLocation(SettingLayerRef{Layer: body.Layer, ProjectId: body.ProjectId})
+	if err != nil {
+		return nil, err
+	}
+	if loc.Source.ReadOnly() {
+		return UpdateStatusLine409JSONResponse(ErrorResponse{Error: fmt.Sprintf("the %s layer is read-only", loc.Source)}), nil
+	}
+	filePath, err := lib.ResolveLayerPath(loc.Source, loc.ProjectPath)
+	if err != nil {
+		return nil, fmt.Errorf("statusline: %w", err)
+	}
+	if err := lib.CheckManagedPolicy("statusLine"); err != nil {
+		return UpdateStatusLine409JSONResponse(ErrorResponse{Error: err.Error()}), nil
+	}
+
+	var statusLine *lib.StatusLine
+	if body.StatusLine != nil {
+		statusLine = &lib.StatusLine{
+			Type:    string(body.StatusLine.Type),
+			Command: body.StatusLine.Command,
+			Padding: body.StatusLine.Padding,
+			Extra:   body.StatusLine.AdditionalProperties,
+		}
+		validation := lib.ValidateSettingValue("statusLine", statusLine.ToJSON())
+		if statusLine.Command == "" {
+			validation.Errors = append(validation.Errors, lib.SettingsIssue{Path: "statusLine.command", Message: "command is empty"})
+		}
+		if !validation.Valid() {
+			return UpdateStatusLine400JSONResponse(validationErrorResponse(validation)), nil
+		}
+	}
+
+	if failed := lib.CheckIfMatch(request.Params.IfMatch, filePath); failed != nil {
+		return UpdateStatusLine412JSONResponse(preconditionFailedResponse(failed, h.redactor())), nil
+	}
+	if err := lib.SetStatusLine(filePath, statusLine, h.claudeHome); err != nil {
+		if resp, ok := settingsParseErrorResponse(err); ok {
+			return UpdateStatusLine422JSONResponse(resp), nil
+		}
+		if resp, ok := redactedWriteConflict(err); ok {
+			return UpdateStatusLine409JSONResponse(resp), nil
+		}
+		return nil, err
+	}
+	return UpdateStatusLine200JSONResponse(SuccessResponse{Success: true}), nil
+}
+
+// TestStatusLine runs a status line command with a synthetic session on stdin
+// and returns what it printed, so a script can be tried without restarting
+// Claude Code. Output is redacted like any other content.
+func (h *FieldStationHandler) TestStatusLine(ctx context.Context, request TestStatusLineRequestObject) (TestStatusLineResponseObject, error) {
+	if request.Body == nil {
+		return nil, fmt.Errorf("request body is required")
+	}
+	body := request.Body
+
+	timeout := lib.StatusLineTimeout
+	if body.TimeoutMs != nil {
+		if *body.TimeoutMs <= 0 || *body.TimeoutMs > maxStatusLineTimeoutMs {
+			return TestStatusLine400JSONResponse(ErrorResponse{Error: fmt.Sprintf("timeoutMs must be between 1 and %d", maxStatusLineTimeoutMs)}), nil
+		}
+		timeout = time.Duration(*body.TimeoutMs) * time.Millisecond
+	}
+
+	projectPath := ""
+	if body.ProjectId != nil && *body.ProjectId != "" {
+		pp, err := resolveProjectPath(h.claudeHome, *body.ProjectId)
+		if err != nil {
+			return nil, fmt.Errorf("statusline: invalid project id: %w", err)
+		}
+		projectPath = pp
+	}
+
+	configured := ""
+	if e := lib.LoadStatusLine(projectPath).Effective; e != nil {
+		configured = e.StatusLine.Command
+	}
+	command := configured
+	if body.Command != nil && *body.Command != "" {
+		restored, err := h.redactor().RestoreText(*body.Command, configured)
+		if err != nil {
+			return TestStatusLine400JSONResponse(ErrorResponse{Error: err.Error()}), nil
+		}
+		command = restored
+	}
+	if command == "" {
+		return TestStatusLine400JSONResponse(ErrorResponse{Error: "no status line is configured; pass a command to test"}), nil
+	}
+
+	dir := projectPath
+	if dir == "" {
+		var err error
+		if dir, err = os.UserHomeDir(); err != nil {
+			return nil, fmt.Errorf("statusline: %w", err)
+		}
+	}
+	model, _ := lib.MergeConfigLayers(projectPath).Merged["model"].(string)
+	session := lib.SyntheticStatusLineSession(dir, model, lib.ReadPersistedVersion(h.claudeHome))
+	if body.Session != nil {
+		session = lib.OverlaySession(session, *body.Session)
+	}
+
+	run, err := lib.RunStatusLine(ctx, command, dir, session, timeout)
+	if err != nil {
+		return nil, err
+	}
+	redactor := h.redactor()
+	return TestStatusLine200JSONResponse(StatusLineTestResult{
+		Output:     redactor.Text(run.Stdout),
+		Stderr:     redactor.Text(run.Stderr),
+		ExitCode:   run.ExitCode,
+		TimedOut:   run.TimedOut,
+		DurationMs: int(run.Duration.Milliseconds()),
+		Input:      session,
+	}), nil
+}
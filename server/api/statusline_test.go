@@ -0,0 +1,59 @@
+package api_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/api"
+)
+
+func TestStatusLine_ReadWriteAndTest(t *testing.T) {
+	h, claudeHome := newTestHandler(t)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"),
+		[]byte(`{"statusLine":{"type":"command","command":"API_TOKEN=abc123def456 echo ok"}}`), 0o600))
+
+	resp, err := h.GetStatusLine(context.Background(), api.GetStatusLineRequestObject{})
+	require.NoError(t, err)
+	got, ok := resp.(api.GetStatusLine200JSONResponse)
+	require.True(t, ok)
+	require.NotNil(t, got.Effective)
+	assert.Equal(t, api.ConfigLayerSourceGlobal, got.Effective.Source)
+	assert.Equal(t, "API_TOKEN=[REDACTED] echo ok", got.Effective.StatusLine.Command)
+
+	update, err := h.UpdateStatusLine(context.Background(), api.UpdateStatusLineRequestObject{
+		Body: &api.UpdateStatusLineRequest{
+			Layer:      api.ConfigLayerSourceGlobalLocal,
+			StatusLine: &api.StatusLineConfig{Type: api.StatusLineConfigTypeCommand, Command: ""},
+		},
+	})
+	require.NoError(t, err)
+	_, ok = update.(api.UpdateStatusLine400JSONResponse)
+	assert.True(t, ok, "an empty command is rejected")
+
+	update, err = h.UpdateStatusLine(context.Background(), api.UpdateStatusLineRequestObject{
+		Body: &api.UpdateStatusLineRequest{
+			Layer:      api.ConfigLayerSourceGlobalLocal,
+			StatusLine: &api.StatusLineConfig{Type: api.StatusLineConfigTypeCommand, Command: `printf '\033[1m%s\033[0m' local`},
+		},
+	})
+	require.NoError(t, err)
+	_, ok = update.(api.UpdateStatusLine200JSONResponse)
+	require.True(t, ok)
+
+	test, err := h.TestStatusLine(context.Background(), api.TestStatusLineRequestObject{Body: &api.StatusLineTestRequest{}})
+	require.NoError(t, err)
+	result, ok := test.(api.TestStatusLine200JSONResponse)
+	require.True(t, ok)
+	assert.Equal(t, "\x1b[1mlocal\x1b[0m", result.Output)
+	assert.Equal(t, 0, result.ExitCode)
+	assert.Equal(t, "Status", result.Input["hook_event_name"])
+
+	command := "exit 2"
+	test, err = h.TestStatusLine(context.Background(), api.TestStatusLineRequestObject{Body: &api.StatusLineTestRequest{Command: &command}})
+	require.NoError(t, err)
+	assert.Equal(t, 2, test.(api.TestStatusLine200JSONResponse).ExitCode)
+}
@@ -0,0 +1,52 @@
+package lib
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// AuditLogFileName is the file in the Claude home directory that records
+// sensitive actions, one JSON object per line.
+const AuditLogFileName = "field-station-audit.log"
+
+// Outcomes recorded for an audited action.
+const (
+	AuditAllowed  = "allowed"
+	AuditDenied   = "denied"
+	AuditNotFound = "not-found"
+)
+
+// AuditEvent is one line of the audit log. It records what was asked for and
+// what happened, never the value involved.
+type AuditEvent struct {
+	Time     time.Time `json:"time"`
+	Action   string    `json:"action"`
+	FilePath string    `json:"filePath,omitempty"`
+	KeyPath  string    `json:"keyPath,omitempty"`
+	Outcome  string    `json:"outcome"`
+}
+
+// AppendAuditEvent appends event to the audit log in claudeHome, creating it
+// with mode 0600. A zero Time is set to now.
+func AppendAuditEvent(claudeHome string, event AuditEvent) error {
+	if event.Time.IsZero() {
+		event.Time = time.Now().UTC()
+	}
+	line, err := json.Marshal(event)
+	if err != nil {
+		return err
+	}
+	path := filepath.Join(claudeHome, AuditLogFileName)
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o600) //nolint:gosec // path is the fixed audit log inside claudeHome
+	if err != nil {
+		return fmt.Errorf("audit log: %w", err)
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		_ = f.Close()
+		return fmt.Errorf("audit log: %w", err)
+	}
+	return f.Close()
+}
@@ -0,0 +1,34 @@
+package lib_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestAppendAuditEvent_AppendsJSONLines(t *testing.T) {
+	claudeHome := t.TempDir()
+	require.NoError(t, lib.AppendAuditEvent(claudeHome, lib.AuditEvent{Action: "reveal", KeyPath: "env.A", Outcome: lib.AuditAllowed}))
+	require.NoError(t, lib.AppendAuditEvent(claudeHome, lib.AuditEvent{Action: "reveal", KeyPath: "env.B", Outcome: lib.AuditDenied}))
+
+	path := filepath.Join(claudeHome, lib.AuditLogFileName)
+	info, err := os.Stat(path)
+	require.NoError(t, err)
+	assert.Equal(t, os.FileMode(0o600), info.Mode().Perm())
+
+	data, err := os.ReadFile(path) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	lines := strings.Split(strings.TrimSpace(string(data)), "\n")
+	require.Len(t, lines, 2)
+	var event lib.AuditEvent
+	require.NoError(t, json.Unmarshal([]byte(lines[1]), &event))
+	assert.Equal(t, "env.B", event.KeyPath)
+	assert.Equal(t, lib.AuditDenied, event.Outcome)
+	assert.False(t, event.Time.IsZero())
+}
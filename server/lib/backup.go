@@ -22,6 +22,8 @@ const (
 	BackupOpUpdate BackupOperation = "update"
 	BackupOpDelete BackupOperation = "delete"
 	BackupOpMove   BackupOperation = "move"
+	BackupOpCopy   BackupOperation = "copy"
+	BackupOpPatch  BackupOperation = "patch"
 )
 
 // BackupEntry is a parsed record of a single backup snapshot.
@@ -30,6 +32,9 @@ type BackupEntry struct {
 	Timestamp    time.Time
 	OriginalPath string
 	Operation    BackupOperation
+	// Group is shared by the backups taken for one multi-file write, and empty
+	// for single-file backups.
+	Group string
 }
 
 // backupMeta is the JSON structure written to meta.json inside each backup dir.
@@ -37,6 +42,7 @@ type backupMeta struct {
 	OriginalPath string `json:"originalPath"`
 	Operation    string `json:"operation"`
 	Timestamp    string `json:"timestamp"`
+	Group        string `json:"group,omitempty"`
 }
 
 const retentionDuration = 30 * 24 * time.Hour
@@ -117,20 +123,41 @@ func copyFile(src, dst string) error {
 // block the caller's write operation.
 // Prune runs asynchronously in a goroutine after a successful backup.
 func BackupFile(filePath string, operation BackupOperation, claudeHome string) string {
-	result, err := doBackupFile(filePath, operation, claudeHome)
+	result, err := doBackupFile(filePath, operation, claudeHome, "")
 	if err != nil {
 		return ""
 	}
 	// Prune asynchronously — does not add latency to the caller
-	go func() {
-		pruneMu.Lock()
-		defer pruneMu.Unlock()
-		PruneOldBackups(claudeHome)
-	}()
+	go pruneBackupsAsync(claudeHome)
 	return result
 }
 
-func doBackupFile(filePath string, operation BackupOperation, claudeHome string) (string, error) {
+// BackupFileGroup backs up each of filePaths as one group, so the snapshots of a
+// multi-file write can be found together. Files that do not exist are skipped.
+// Returns the group ID, or "" if nothing was backed up. Like BackupFile, it never
+// returns an error.
+func BackupFileGroup(filePaths []string, operation BackupOperation, claudeHome string) string {
+	group := generateBackupID()
+	backedUp := false
+	for _, filePath := range filePaths {
+		if _, err := doBackupFile(filePath, operation, claudeHome, group); err == nil {
+			backedUp = true
+		}
+	}
+	if !backedUp {
+		return ""
+	}
+	go pruneBackupsAsync(claudeHome)
+	return group
+}
+
+func pruneBackupsAsync(claudeHome string) {
+	pruneMu.Lock()
+	defer pruneMu.Unlock()
+	PruneOldBackups(claudeHome)
+}
+
+func doBackupFile(filePath string, operation BackupOperation, claudeHome, group string) (string, error) {
 	if _, err := os.Stat(filePath); err != nil {
 		return "", fmt.Errorf("source file does not exist: %w", err)
 	}
@@ -145,6 +172,7 @@ func doBackupFile(filePath string, operation BackupOperation, claudeHome string)
 		OriginalPath: filePath,
 		Operation:    string(operation),
 		Timestamp:    time.Now().UTC().Format(time.RFC3339Nano),
+		Group:        group,
 	}
 	metaBytes, err := json.MarshalIndent(meta, "", "  ")
 	if err != nil {
@@ -196,6 +224,7 @@ func ListBackups(claudeHome string) []BackupEntry {
 			Timestamp:    ts,
 			OriginalPath: meta.OriginalPath,
 			Operation:    BackupOperation(meta.Operation),
+			Group:        meta.Group,
 		})
 	}
 
@@ -1,9 +1,11 @@
 package lib
 
 import (
-	"encoding/json"
+	"errors"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 )
 
 // ConfigLayerSource identifies which configuration layer a file belongs to.
@@ -15,25 +17,58 @@ const (
 	ConfigLayerGlobalLocal  ConfigLayerSource = "global-local"
 	ConfigLayerProject      ConfigLayerSource = "project"
 	ConfigLayerProjectLocal ConfigLayerSource = "project-local"
+	// ConfigLayerManaged is the read-only enterprise policy layer (managed-settings.json).
+	ConfigLayerManaged ConfigLayerSource = "managed"
 )
 
 // ConfigLayer holds the parsed content and metadata of a single config file.
+// ParseError is set when the file exists but does not hold a valid JSON object.
+// ETag is the content hash of the bytes that were read.
 type ConfigLayer struct {
-	Source   ConfigLayerSource
-	FilePath string
-	Exists   bool
-	Content  JsonObject
+	Source     ConfigLayerSource
+	FilePath   string
+	Exists     bool
+	Content    JsonObject
+	ParseError *JSONParseError
+	ETag       string
 }
 
 // EffectiveConfig is the result of merging all applicable config layers.
+// Provenance is keyed by the dot-separated path of every leaf in Merged.
 type EffectiveConfig struct {
-	Merged JsonObject
-	Layers []ConfigLayer
+	Merged     JsonObject
+	Layers     []ConfigLayer
+	Provenance map[string]KeyProvenance
+}
+
+// KeyProvenance records which layer supplied the effective value of a leaf key
+// path and which lower-precedence values it shadowed, nearest layer first.
+// For keys merged with MergeConcatUnique, Source is the highest layer that
+// contributed, Value is the combined array and Contributions holds each lower
+// layer's own array, nearest layer first.
+type KeyProvenance struct {
+	Source        ConfigLayerSource
+	FilePath      string
+	Value         any
+	Shadowed      []ShadowedValue
+	Contributions []ShadowedValue
+
+	// own is the value Source itself set; it differs from Value only for combined keys.
+	own any
+}
+
+// ShadowedValue is a value from a lower-precedence layer that lost or was
+// combined in the merge.
+type ShadowedValue struct {
+	Source   ConfigLayerSource
+	FilePath string
+	Value    any
 }
 
 // GetConfigLayer reads a JSON config file and returns a ConfigLayer describing it.
 // If the file does not exist, Exists is false and Content is nil.
-// If the file exists but cannot be parsed, Exists is true and Content is nil.
+// If the file exists but cannot be parsed, Exists is true, Content is nil and
+// ParseError describes where parsing failed.
 func GetConfigLayer(filePath string, source ConfigLayerSource) ConfigLayer {
 	_, err := os.Stat(filePath)
 	if err != nil {
@@ -42,6 +77,7 @@ func GetConfigLayer(filePath string, source ConfigLayerSource) ConfigLayer {
 			FilePath: filePath,
 			Exists:   false,
 			Content:  nil,
+			ETag:     ContentETag(nil),
 		}
 	}
 
@@ -50,9 +86,11 @@ func GetConfigLayer(filePath string, source ConfigLayerSource) ConfigLayer {
 		return ConfigLayer{Source: source, FilePath: filePath, Exists: true, Content: nil}
 	}
 
-	var obj JsonObject
-	if err := json.Unmarshal(data, &obj); err != nil {
-		return ConfigLayer{Source: source, FilePath: filePath, Exists: true, Content: nil}
+	obj, err := ParseJSONObject(filePath, data)
+	if err != nil {
+		var parseErr *JSONParseError
+		errors.As(err, &parseErr)
+		return ConfigLayer{Source: source, FilePath: filePath, Exists: true, Content: nil, ParseError: parseErr, ETag: ContentETag(data)}
 	}
 
 	return ConfigLayer{
@@ -60,12 +98,14 @@ func GetConfigLayer(filePath string, source ConfigLayerSource) ConfigLayer {
 		FilePath: filePath,
 		Exists:   true,
 		Content:  obj,
+		ETag:     ContentETag(data),
 	}
 }
 
 // MergeConfigLayers builds the effective configuration by reading and deep-merging
-// the global, global-local, and (if projectPath is non-empty) project + project-local
-// config layers. Later layers take precedence.
+// the global, global-local, (if projectPath is non-empty) project + project-local,
+// and managed policy config layers. Later layers take precedence, so managed
+// policy overrides everything.
 func MergeConfigLayers(projectPath string) EffectiveConfig {
 	claudeHome := ResolveClaudeHome()
 
@@ -80,32 +120,91 @@ func MergeConfigLayers(projectPath string) EffectiveConfig {
 			GetConfigLayer(filepath.Join(projectPath, ".claude", "settings.local.json"), ConfigLayerProjectLocal),
 		)
 	}
+	layers = append(layers, GetConfigLayer(ResolveManagedSettingsPath(), ConfigLayerManaged))
 
 	merged := JsonObject{}
+	provenance := map[string]KeyProvenance{}
 	for _, layer := range layers {
 		if layer.Exists && layer.Content != nil {
-			merged = deepMergeObjects(merged, layer.Content)
+			merged = deepMergeObjects(merged, layer.Content, layer, "", provenance)
 		}
 	}
 
-	return EffectiveConfig{Merged: merged, Layers: layers}
+	return EffectiveConfig{Merged: merged, Layers: layers, Provenance: provenance}
 }
 
-// deepMergeObjects recursively merges source into target, returning a new JsonObject.
-// Object values are merged recursively; all other values (including arrays) are replaced.
-func deepMergeObjects(target, source JsonObject) JsonObject {
+// deepMergeObjects recursively merges source (the content of layer) into
+// target, returning a new JsonObject. Object values are merged recursively;
+// arrays are combined according to MergeStrategyFor and all other values are
+// replaced. Every leaf written from source is recorded in provenance under
+// prefix, together with the values it replaced.
+func deepMergeObjects(target, source JsonObject, layer ConfigLayer, prefix string, provenance map[string]KeyProvenance) JsonObject {
 	result := shallowCopy(target)
 	for key, sourceVal := range source {
+		path := appendPath(prefix, key)
 		targetVal, exists := result[key]
 		if exists {
 			targetMap, targetIsMap := targetVal.(JsonObject)
 			sourceMap, sourceIsMap := sourceVal.(JsonObject)
 			if targetIsMap && sourceIsMap {
-				result[key] = deepMergeObjects(targetMap, sourceMap)
+				result[key] = deepMergeObjects(targetMap, sourceMap, layer, path, provenance)
 				continue
 			}
+			if MergeStrategyFor(path) == MergeConcatUnique {
+				if merged, ok := concatUnique(targetVal, sourceVal); ok {
+					result[key] = merged
+					recordCombined(provenance, path, merged, sourceVal, layer)
+					continue
+				}
+			}
+		}
+		var shadowed []ShadowedValue
+		if exists {
+			shadowed = takeProvenance(provenance, path)
 		}
 		result[key] = sourceVal
+		recordLeaves(provenance, path, sourceVal, layer, shadowed)
 	}
 	return result
 }
+
+// recordLeaves records layer as the origin of every leaf under path in value.
+// An empty object is itself treated as a leaf.
+func recordLeaves(provenance map[string]KeyProvenance, path string, value any, layer ConfigLayer, shadowed []ShadowedValue) {
+	if m, ok := value.(JsonObject); ok && len(m) > 0 {
+		for k, v := range m {
+			recordLeaves(provenance, appendPath(path, k), v, layer, shadowed)
+		}
+		return
+	}
+	provenance[path] = KeyProvenance{
+		Source:   layer.Source,
+		FilePath: layer.FilePath,
+		Value:    value,
+		Shadowed: shadowed,
+		own:      value,
+	}
+}
+
+// takeProvenance removes the entries for path and every leaf beneath it and
+// returns them as shadowed values, nearest layer first. Combined keys contribute
+// each layer's own value rather than the merged array.
+func takeProvenance(provenance map[string]KeyProvenance, path string) []ShadowedValue {
+	var keys []string
+	for k := range provenance {
+		if k == path || strings.HasPrefix(k, path+".") || strings.HasPrefix(k, path+"[") {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var shadowed []ShadowedValue
+	for _, k := range keys {
+		entry := provenance[k]
+		shadowed = append(shadowed, ShadowedValue{Source: entry.Source, FilePath: entry.FilePath, Value: entry.own})
+		shadowed = append(shadowed, entry.Contributions...)
+		shadowed = append(shadowed, entry.Shadowed...)
+		delete(provenance, k)
+	}
+	return shadowed
+}
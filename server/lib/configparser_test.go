@@ -39,15 +39,20 @@ func TestGetConfigLayer_InvalidJSON(t *testing.T) {
 	layer := lib.GetConfigLayer(filePath, lib.ConfigLayerProject)
 	assert.True(t, layer.Exists)
 	assert.Nil(t, layer.Content)
+	require.NotNil(t, layer.ParseError)
+	assert.Equal(t, 1, layer.ParseError.Line)
+	assert.Equal(t, 2, layer.ParseError.Column)
 }
 
 func TestMergeConfigLayers_EmptyNoFiles(t *testing.T) {
 	claudeHome := t.TempDir()
 	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
 
 	result := lib.MergeConfigLayers("")
 	assert.Equal(t, lib.JsonObject{}, result.Merged)
-	assert.Len(t, result.Layers, 2)
+	// global, global-local, managed
+	assert.Len(t, result.Layers, 3)
 }
 
 func TestMergeConfigLayers_GlobalSettings(t *testing.T) {
@@ -110,7 +115,8 @@ func TestMergeConfigLayers_ProjectLayers(t *testing.T) {
 	))
 
 	result := lib.MergeConfigLayers(projectDir)
-	assert.Len(t, result.Layers, 4)
+	// global, global-local, project, project-local, managed
+	assert.Len(t, result.Layers, 5)
 	assert.Equal(t, true, result.Merged["global"])
 	assert.Equal(t, true, result.Merged["project"])
 }
@@ -156,3 +162,78 @@ func TestMergeConfigLayers_ArraysReplaced(t *testing.T) {
 	result := lib.MergeConfigLayers("")
 	assert.Equal(t, []any{float64(3)}, result.Merged["items"])
 }
+
+func TestMergeConfigLayers_ProvenanceRecordsWinnerAndShadowed(t *testing.T) {
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
+
+	projectDir := t.TempDir()
+	claudeDir := filepath.Join(projectDir, ".claude")
+	require.NoError(t, os.MkdirAll(claudeDir, 0o750))
+
+	globalPath := filepath.Join(claudeHome, "settings.json")
+	projectPath := filepath.Join(claudeDir, "settings.json")
+	localPath := filepath.Join(claudeDir, "settings.local.json")
+	require.NoError(t, os.WriteFile(globalPath, []byte(`{"model":"sonnet","env":{"A":"1","B":"2"}}`), 0o600))
+	require.NoError(t, os.WriteFile(projectPath, []byte(`{"model":"opus","env":{"B":"3"}}`), 0o600))
+	require.NoError(t, os.WriteFile(localPath, []byte(`{"model":"haiku"}`), 0o600))
+
+	result := lib.MergeConfigLayers(projectDir)
+
+	model := result.Provenance["model"]
+	assert.Equal(t, lib.ConfigLayerProjectLocal, model.Source)
+	assert.Equal(t, localPath, model.FilePath)
+	assert.Equal(t, "haiku", model.Value)
+	require.Len(t, model.Shadowed, 2)
+	assert.Equal(t, lib.ConfigLayerProject, model.Shadowed[0].Source)
+	assert.Equal(t, "opus", model.Shadowed[0].Value)
+	assert.Equal(t, lib.ConfigLayerGlobal, model.Shadowed[1].Source)
+	assert.Equal(t, "sonnet", model.Shadowed[1].Value)
+
+	// Sibling leaves inside a merged object keep their own origin.
+	assert.Equal(t, lib.ConfigLayerGlobal, result.Provenance["env.A"].Source)
+	assert.Empty(t, result.Provenance["env.A"].Shadowed)
+	assert.Equal(t, lib.ConfigLayerProject, result.Provenance["env.B"].Source)
+	require.Len(t, result.Provenance["env.B"].Shadowed, 1)
+	assert.Equal(t, "2", result.Provenance["env.B"].Shadowed[0].Value)
+
+	_, hasObjectEntry := result.Provenance["env"]
+	assert.False(t, hasObjectEntry, "only leaf paths are recorded")
+}
+
+func TestMergeConfigLayers_ProvenanceWhenScalarReplacesObject(t *testing.T) {
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"statusLine":{"type":"command","command":"x"}}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(`{"statusLine":"off"}`), 0o600))
+
+	result := lib.MergeConfigLayers("")
+	entry := result.Provenance["statusLine"]
+	assert.Equal(t, lib.ConfigLayerGlobalLocal, entry.Source)
+	assert.Len(t, entry.Shadowed, 2)
+	_, stale := result.Provenance["statusLine.command"]
+	assert.False(t, stale, "replaced leaves must not keep their own entries")
+}
+
+func TestMergeConfigLayers_ProvenanceQuotesKeysWithDots(t *testing.T) {
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
+
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"env":{"my.var":"1"}}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(`{"env":{"my.var":"2"}}`), 0o600))
+
+	result := lib.MergeConfigLayers("")
+	entry, ok := result.Provenance[`env["my.var"]`]
+	require.True(t, ok)
+	assert.Equal(t, "2", entry.Value)
+	require.Len(t, entry.Shadowed, 1)
+	assert.Equal(t, "1", entry.Shadowed[0].Value)
+
+	value, ok := lib.GetAtPath(result.Merged, `env["my.var"]`)
+	require.True(t, ok)
+	assert.Equal(t, "2", value)
+}
@@ -0,0 +1,483 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"reflect"
+	"sort"
+)
+
+// PatchOperation is one RFC 6902 JSON Patch operation aimed at a settings layer.
+// Path and From are JSON Pointers (RFC 6901) into the layer's settings object.
+// For move and copy, FromLayer names the source layer and defaults to Layer, so
+// a value can be moved between files.
+type PatchOperation struct {
+	Op        string
+	Layer     ConfigLayerSource
+	Path      string
+	From      string
+	FromLayer ConfigLayerSource
+	Value     any
+}
+
+// PatchError reports a patch operation that could not be applied: a malformed
+// operation, a path that does not exist, a failed test, or a read-only layer.
+type PatchError struct {
+	Index   int
+	Op      string
+	Path    string
+	Message string
+}
+
+func (e *PatchError) Error() string {
+	return fmt.Sprintf("patch operation %d (%s %s): %s", e.Index, e.Op, e.Path, e.Message)
+}
+
+// SettingEdit is one key-level change a patch makes to a settings file. Arrays
+// are edited as a whole, so KeyPath never points inside one.
+type SettingEdit struct {
+	KeyPath string
+	Value   any
+	Delete  bool
+}
+
+// PatchedFile is a settings file touched by a patch, before and after.
+type PatchedFile struct {
+	Source   ConfigLayerSource
+	FilePath string
+	Exists   bool
+	Original []byte
+	Updated  []byte
+	Edits    []SettingEdit
+}
+
+// ConfigPatch is a validated, fully computed multi-file settings patch. Nothing
+// is written until Apply. Operation is recorded on the backups Apply takes and
+// defaults to BackupOpPatch.
+type ConfigPatch struct {
+	Files     []*PatchedFile
+	Operation BackupOperation
+}
+
+// patchDoc is the working state of one layer while a patch is being planned.
+type patchDoc struct {
+	file *PatchedFile
+	obj  JsonObject
+	doc  any
+}
+
+// PlanConfigPatch applies ops, in order, to in-memory copies of the settings
+// layers they target and returns the resulting edits. projectPath is required
+// when any operation targets a project layer. Returns a *PatchError if an
+// operation fails, or a *JSONParseError if a targeted file cannot be parsed.
+// Files that end up unchanged are left out of the result.
+func PlanConfigPatch(ops []PatchOperation, projectPath string) (*ConfigPatch, error) {
+	docs := map[ConfigLayerSource]*patchDoc{}
+	var order []ConfigLayerSource
+	redactor, _ := LoadRedactor(ResolveClaudeHome()) //nolint:errcheck // an unreadable rules file falls back to the built-in rules
+	load := func(i int, op PatchOperation, source ConfigLayerSource) (*patchDoc, error) {
+		if d, ok := docs[source]; ok {
+			return d, nil
+		}
+		if source.ReadOnly() {
+			return nil, &PatchError{Index: i, Op: op.Op, Path: op.Path, Message: fmt.Sprintf("the %s layer is read-only", source)}
+		}
+		filePath, err := ResolveLayerPath(source, projectPath)
+		if err != nil {
+			return nil, &PatchError{Index: i, Op: op.Op, Path: op.Path, Message: err.Error()}
+		}
+		data, obj, err := readJSONFileForEdit(filePath)
+		if err != nil {
+			return nil, err
+		}
+		d := &patchDoc{
+			file: &PatchedFile{Source: source, FilePath: filePath, Exists: data != nil, Original: data},
+			obj:  obj,
+			doc:  deepCopyJSON(obj),
+		}
+		docs[source] = d
+		order = append(order, source)
+		return d, nil
+	}
+
+	for i, op := range ops {
+		target, err := load(i, op, op.Layer)
+		if err != nil {
+			return nil, err
+		}
+		source := target
+		if op.Op == "move" || op.Op == "copy" {
+			fromLayer := op.FromLayer
+			if fromLayer == "" {
+				fromLayer = op.Layer
+			}
+			if source, err = load(i, op, fromLayer); err != nil {
+				return nil, err
+			}
+		}
+		if err := applyPatchOperation(op, source, target, redactor); err != nil {
+			return nil, &PatchError{Index: i, Op: op.Op, Path: op.Path, Message: err.Error()}
+		}
+	}
+
+	patch := &ConfigPatch{}
+	for _, source := range order {
+		d := docs[source]
+		after, ok := d.doc.(JsonObject)
+		if !ok {
+			return nil, &PatchError{Index: len(ops) - 1, Op: "patch", Path: "", Message: fmt.Sprintf("the %s layer must remain a JSON object", source)}
+		}
+		edits := diffSettings(nil, d.obj, after, nil)
+		if len(edits) == 0 {
+			continue
+		}
+		updated := d.file.Original
+		for _, edit := range edits {
+			var err error
+			if edit.delete {
+				updated, err = deleteJSONAtKeys(updated, edit.keys)
+			} else {
+				updated, err = setJSONAtKeys(updated, edit.keys, edit.value, false)
+			}
+			if err != nil {
+				return nil, err
+			}
+			d.file.Edits = append(d.file.Edits, SettingEdit{KeyPath: JoinPath(edit.keys...), Value: edit.value, Delete: edit.delete})
+		}
+		d.file.Updated = updated
+		patch.Files = append(patch.Files, d.file)
+	}
+	return patch, nil
+}
+
+// FilePaths returns the paths of every file the patch writes.
+func (p *ConfigPatch) FilePaths() []string {
+	paths := make([]string, len(p.Files))
+	for i, f := range p.Files {
+		paths[i] = f.FilePath
+	}
+	return paths
+}
+
+// Apply backs up every file the patch touches as one group, then writes them.
+// If any write fails, files already written are restored to their original
+// content (or removed, if they did not exist) and the write error is returned.
+// Returns the backup group ID.
+func (p *ConfigPatch) Apply(claudeHome string) (string, error) {
+	op := p.Operation
+	if op == "" {
+		op = BackupOpPatch
+	}
+	group := BackupFileGroup(p.FilePaths(), op, claudeHome)
+	for i, f := range p.Files {
+		if err := writeJSONBytes(f.FilePath, f.Updated); err != nil {
+			if rollbackErr := p.rollback(i); rollbackErr != nil {
+				return group, fmt.Errorf("configpatch: write %s: %w (rollback failed: %v)", f.FilePath, err, rollbackErr)
+			}
+			return group, fmt.Errorf("configpatch: write %s: %w", f.FilePath, err)
+		}
+	}
+	return group, nil
+}
+
+// rollback restores the first n files to their original state.
+func (p *ConfigPatch) rollback(n int) error {
+	var errs []error
+	for _, f := range p.Files[:n] {
+		if !f.Exists {
+			if err := os.Remove(f.FilePath); err != nil && !errors.Is(err, os.ErrNotExist) {
+				errs = append(errs, err)
+			}
+			continue
+		}
+		if err := WriteFileAtomic(f.FilePath, f.Original); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// applyPatchOperation applies op, reading from source and writing to target.
+// Redaction placeholders in the value of an add or replace are restored from
+// the value it overwrites.
+func applyPatchOperation(op PatchOperation, source, target *patchDoc, redactor *Redactor) error {
+	path, err := parseJSONPointer(op.Path)
+	if err != nil {
+		return err
+	}
+	switch op.Op {
+	case "add":
+		value := deepCopyJSON(op.Value)
+		// Adding to an object replaces any member already there; adding to an
+		// array inserts, leaving nothing to restore from.
+		if existing, gerr := pointerGet(target.doc, path); gerr == nil && len(path) > 0 {
+			if parent, _ := pointerGet(target.doc, path[:len(path)-1]); isObject(parent) {
+				if value, err = redactor.RestoreRedacted(value, existing); err != nil {
+					return err
+				}
+			}
+		}
+		target.doc, err = pointerAdd(target.doc, path, value)
+	case "remove":
+		target.doc, err = pointerRemove(target.doc, path)
+	case "replace":
+		var existing any
+		if existing, err = pointerGet(target.doc, path); err == nil {
+			var value any
+			if value, err = redactor.RestoreRedacted(deepCopyJSON(op.Value), existing); err == nil {
+				target.doc, err = pointerReplace(target.doc, path, value)
+			}
+		}
+	case "test":
+		var current any
+		if current, err = pointerGet(target.doc, path); err == nil && !jsonEqual(current, op.Value) {
+			err = errors.New("test failed: value does not match")
+		}
+	case "move", "copy":
+		from, ferr := parseJSONPointer(op.From)
+		if ferr != nil {
+			return fmt.Errorf("from: %w", ferr)
+		}
+		if op.Op == "move" && source == target && isPointerPrefix(from, path) && len(from) < len(path) {
+			return errors.New("cannot move a value into one of its own children")
+		}
+		value, gerr := pointerGet(source.doc, from)
+		if gerr != nil {
+			return fmt.Errorf("from: %w", gerr)
+		}
+		value = deepCopyJSON(value)
+		if op.Op == "move" {
+			if source.doc, err = pointerRemove(source.doc, from); err != nil {
+				return err
+			}
+		}
+		target.doc, err = pointerAdd(target.doc, path, value)
+	default:
+		return fmt.Errorf("unknown op %q", op.Op)
+	}
+	return err
+}
+
+func isObject(v any) bool {
+	_, ok := v.(JsonObject)
+	return ok
+}
+
+func isPointerPrefix(prefix, path []string) bool {
+	if len(prefix) > len(path) {
+		return false
+	}
+	for i := range prefix {
+		if prefix[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// pointerGet returns the value at path within doc.
+func pointerGet(doc any, path []string) (any, error) {
+	current := doc
+	for _, token := range path {
+		child, err := pathChild(current, token)
+		if err != nil {
+			return nil, err
+		}
+		current = child
+	}
+	return current, nil
+}
+
+// pointerUpdate returns doc with the container holding the last token of path
+// replaced by edit(container, lastToken). Containers along the path are copied,
+// never mutated.
+func pointerUpdate(doc any, path []string, edit func(container any, token string) (any, error)) (any, error) {
+	if len(path) == 1 {
+		return edit(doc, path[0])
+	}
+	child, err := pathChild(doc, path[0])
+	if err != nil {
+		return nil, err
+	}
+	updated, err := pointerUpdate(child, path[1:], edit)
+	if err != nil {
+		return nil, err
+	}
+	return pointerReplace(doc, path[:1], updated)
+}
+
+// pointerAdd implements the JSON Patch add operation.
+func pointerAdd(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return pointerUpdate(doc, path, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case JsonObject:
+			out := shallowCopy(c)
+			out[token] = value
+			return out, nil
+		case []any:
+			i := len(c)
+			if token != "-" {
+				var err error
+				if i, err = arrayIndex(token, len(c)); err != nil {
+					return nil, err
+				}
+			}
+			out := make([]any, 0, len(c)+1)
+			out = append(out, c[:i]...)
+			out = append(out, value)
+			return append(out, c[i:]...), nil
+		default:
+			return nil, fmt.Errorf("path not found: %q is not inside an object or array", token)
+		}
+	})
+}
+
+// pointerRemove implements the JSON Patch remove operation.
+func pointerRemove(doc any, path []string) (any, error) {
+	if len(path) == 0 {
+		return nil, errors.New("cannot remove the whole document")
+	}
+	return pointerUpdate(doc, path, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case JsonObject:
+			if _, ok := c[token]; !ok {
+				return nil, fmt.Errorf("path not found: key %q does not exist", token)
+			}
+			out := shallowCopy(c)
+			delete(out, token)
+			return out, nil
+		case []any:
+			i, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			out := make([]any, 0, len(c)-1)
+			out = append(out, c[:i]...)
+			return append(out, c[i+1:]...), nil
+		default:
+			return nil, fmt.Errorf("path not found: %q is not inside an object or array", token)
+		}
+	})
+}
+
+// pointerReplace sets the existing value at path to value.
+func pointerReplace(doc any, path []string, value any) (any, error) {
+	if len(path) == 0 {
+		return value, nil
+	}
+	return pointerUpdate(doc, path, func(container any, token string) (any, error) {
+		switch c := container.(type) {
+		case JsonObject:
+			out := shallowCopy(c)
+			out[token] = value
+			return out, nil
+		case []any:
+			i, err := arrayIndex(token, len(c)-1)
+			if err != nil {
+				return nil, err
+			}
+			out := append([]any(nil), c...)
+			out[i] = value
+			return out, nil
+		default:
+			return nil, fmt.Errorf("path not found: %q is not inside an object or array", token)
+		}
+	})
+}
+
+// deepCopyJSON returns a copy of a decoded JSON value that shares no maps or
+// slices with v. Values that are not plain decoded JSON are normalised through
+// encoding/json.
+func deepCopyJSON(v any) any {
+	switch t := v.(type) {
+	case JsonObject:
+		out := make(JsonObject, len(t))
+		for k, child := range t {
+			out[k] = deepCopyJSON(child)
+		}
+		return out
+	case []any:
+		out := make([]any, len(t))
+		for i, child := range t {
+			out[i] = deepCopyJSON(child)
+		}
+		return out
+	case nil, bool, float64, string:
+		return t
+	default:
+		data, err := json.Marshal(t)
+		if err != nil {
+			return t
+		}
+		var out any
+		if err := json.Unmarshal(data, &out); err != nil {
+			return t
+		}
+		return out
+	}
+}
+
+// jsonEqual compares two JSON values by their encoded form, so numbers of
+// different Go types compare equal when they encode the same.
+func jsonEqual(a, b any) bool {
+	ab, errA := json.Marshal(a)
+	bb, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(ab, bb)
+}
+
+// settingEdit is a SettingEdit with its key path still split into keys.
+type settingEdit struct {
+	keys   []string
+	value  any
+	delete bool
+}
+
+// diffSettings returns the key-level edits that turn before into after.
+// Objects are compared key by key; anything else that differs is replaced
+// whole. Removed keys come first, then changed keys, then new keys, each in
+// sorted order so the result is deterministic.
+func diffSettings(prefix []string, before, after JsonObject, edits []settingEdit) []settingEdit {
+	at := func(key string) []string {
+		return append(append([]string(nil), prefix...), key)
+	}
+	for _, key := range sortedKeys(before) {
+		if _, ok := after[key]; !ok {
+			edits = append(edits, settingEdit{keys: at(key), delete: true})
+		}
+	}
+	var added []string
+	for _, key := range sortedKeys(after) {
+		old, ok := before[key]
+		if !ok {
+			added = append(added, key)
+			continue
+		}
+		oldObj, oldIsObj := old.(JsonObject)
+		newObj, newIsObj := after[key].(JsonObject)
+		switch {
+		case oldIsObj && newIsObj:
+			edits = diffSettings(at(key), oldObj, newObj, edits)
+		case !reflect.DeepEqual(old, after[key]):
+			edits = append(edits, settingEdit{keys: at(key), value: after[key]})
+		}
+	}
+	for _, key := range added {
+		edits = append(edits, settingEdit{keys: at(key), value: after[key]})
+	}
+	return edits
+}
+
+func sortedKeys(obj JsonObject) []string {
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	return keys
+}
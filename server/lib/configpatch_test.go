@@ -0,0 +1,193 @@
+package lib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+// setupPatchLayers points CLAUDE_HOME at a temp dir, writes the given global
+// settings.json and returns claudeHome and a project directory.
+func setupPatchLayers(t *testing.T, global string) (string, string) {
+	t.Helper()
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(global), 0o600))
+	return claudeHome, t.TempDir()
+}
+
+func TestPlanConfigPatch_Operations(t *testing.T) {
+	global := `{"model":"opus","permissions":{"allow":["Bash(ls)","Bash(cat)"]},"env":{"A":"1"}}`
+	tests := []struct {
+		name string
+		ops  []lib.PatchOperation
+		want string
+	}{
+		{
+			name: "add new key",
+			ops:  []lib.PatchOperation{{Op: "add", Path: "/theme", Value: "dark"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(ls)","Bash(cat)"]},"env":{"A":"1"},"theme":"dark"}`,
+		},
+		{
+			name: "append to array",
+			ops:  []lib.PatchOperation{{Op: "add", Path: "/permissions/allow/-", Value: "Bash(pwd)"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(ls)","Bash(cat)","Bash(pwd)"]},"env":{"A":"1"}}`,
+		},
+		{
+			name: "insert into array",
+			ops:  []lib.PatchOperation{{Op: "add", Path: "/permissions/allow/0", Value: "Bash(pwd)"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(pwd)","Bash(ls)","Bash(cat)"]},"env":{"A":"1"}}`,
+		},
+		{
+			name: "remove array item",
+			ops:  []lib.PatchOperation{{Op: "remove", Path: "/permissions/allow/0"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(cat)"]},"env":{"A":"1"}}`,
+		},
+		{
+			name: "replace and test",
+			ops: []lib.PatchOperation{
+				{Op: "test", Path: "/model", Value: "opus"},
+				{Op: "replace", Path: "/model", Value: "sonnet"},
+			},
+			want: `{"model":"sonnet","permissions":{"allow":["Bash(ls)","Bash(cat)"]},"env":{"A":"1"}}`,
+		},
+		{
+			name: "move within file",
+			ops:  []lib.PatchOperation{{Op: "move", From: "/env/A", Path: "/env/B"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(ls)","Bash(cat)"]},"env":{"B":"1"}}`,
+		},
+		{
+			name: "copy within file",
+			ops:  []lib.PatchOperation{{Op: "copy", From: "/env/A", Path: "/env/B"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(ls)","Bash(cat)"]},"env":{"A":"1","B":"1"}}`,
+		},
+		{
+			name: "escaped pointer tokens",
+			ops:  []lib.PatchOperation{{Op: "add", Path: "/env/a~1b~0c", Value: "x"}},
+			want: `{"model":"opus","permissions":{"allow":["Bash(ls)","Bash(cat)"]},"env":{"A":"1","a/b~c":"x"}}`,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupPatchLayers(t, global)
+			for i := range tt.ops {
+				tt.ops[i].Layer = lib.ConfigLayerGlobal
+			}
+			patch, err := lib.PlanConfigPatch(tt.ops, "")
+			require.NoError(t, err)
+			require.Len(t, patch.Files, 1)
+			assert.JSONEq(t, tt.want, string(patch.Files[0].Updated))
+		})
+	}
+}
+
+func TestPlanConfigPatch_Errors(t *testing.T) {
+	tests := []struct {
+		name string
+		op   lib.PatchOperation
+	}{
+		{name: "remove missing key", op: lib.PatchOperation{Op: "remove", Layer: lib.ConfigLayerGlobal, Path: "/missing"}},
+		{name: "replace missing key", op: lib.PatchOperation{Op: "replace", Layer: lib.ConfigLayerGlobal, Path: "/missing", Value: 1}},
+		{name: "failed test", op: lib.PatchOperation{Op: "test", Layer: lib.ConfigLayerGlobal, Path: "/model", Value: "sonnet"}},
+		{name: "array index out of range", op: lib.PatchOperation{Op: "add", Layer: lib.ConfigLayerGlobal, Path: "/list/5", Value: 1}},
+		{name: "pointer without slash", op: lib.PatchOperation{Op: "add", Layer: lib.ConfigLayerGlobal, Path: "model", Value: 1}},
+		{name: "unknown op", op: lib.PatchOperation{Op: "merge", Layer: lib.ConfigLayerGlobal, Path: "/model"}},
+		{name: "move into own child", op: lib.PatchOperation{Op: "move", Layer: lib.ConfigLayerGlobal, From: "/list", Path: "/list/0"}},
+		{name: "managed layer", op: lib.PatchOperation{Op: "add", Layer: lib.ConfigLayerManaged, Path: "/model", Value: "x"}},
+		{name: "project layer without project", op: lib.PatchOperation{Op: "add", Layer: lib.ConfigLayerProject, Path: "/model", Value: "x"}},
+		{name: "root replaced by non-object", op: lib.PatchOperation{Op: "replace", Layer: lib.ConfigLayerGlobal, Path: "", Value: "x"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupPatchLayers(t, `{"model":"opus","list":[1]}`)
+			_, err := lib.PlanConfigPatch([]lib.PatchOperation{tt.op}, "")
+			var patchErr *lib.PatchError
+			require.True(t, errors.As(err, &patchErr), "expected *PatchError, got %v", err)
+		})
+	}
+}
+
+func TestPlanConfigPatch_MoveAcrossLayersPreservesFormatting(t *testing.T) {
+	global := "{\n    \"model\": \"opus\",\n    \"permissions\": {\n        \"allow\": [\"Bash(npm test)\"]\n    }\n}\n"
+	_, projectDir := setupPatchLayers(t, global)
+
+	patch, err := lib.PlanConfigPatch([]lib.PatchOperation{
+		{Op: "add", Layer: lib.ConfigLayerProjectLocal, Path: "/permissions", Value: map[string]any{"allow": []any{}}},
+		{Op: "move", FromLayer: lib.ConfigLayerGlobal, From: "/permissions/allow/0", Layer: lib.ConfigLayerProjectLocal, Path: "/permissions/allow/-"},
+		{Op: "add", Layer: lib.ConfigLayerProjectLocal, Path: "/env", Value: map[string]any{"CI": "1"}},
+	}, projectDir)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 2)
+
+	// Files are listed in the order operations first touched them.
+	local, globalFile := patch.Files[0], patch.Files[1]
+	assert.Equal(t, lib.ConfigLayerProjectLocal, local.Source)
+	assert.False(t, local.Exists)
+	assert.JSONEq(t, `{"permissions":{"allow":["Bash(npm test)"]},"env":{"CI":"1"}}`, string(local.Updated))
+
+	assert.Equal(t, "{\n    \"model\": \"opus\",\n    \"permissions\": {\n        \"allow\": []\n    }\n}\n", string(globalFile.Updated))
+	require.Len(t, globalFile.Edits, 1)
+	assert.Equal(t, "permissions.allow", globalFile.Edits[0].KeyPath)
+}
+
+func TestPlanConfigPatch_OmitsUnchangedFiles(t *testing.T) {
+	setupPatchLayers(t, `{"model":"opus"}`)
+	patch, err := lib.PlanConfigPatch([]lib.PatchOperation{
+		{Op: "test", Layer: lib.ConfigLayerGlobal, Path: "/model", Value: "opus"},
+	}, "")
+	require.NoError(t, err)
+	assert.Empty(t, patch.Files)
+}
+
+func TestConfigPatchApply_WritesAllFilesAsOneBackupGroup(t *testing.T) {
+	claudeHome, _ := setupPatchLayers(t, `{"model":"opus"}`)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(`{}`), 0o600))
+
+	patch, err := lib.PlanConfigPatch([]lib.PatchOperation{
+		{Op: "move", FromLayer: lib.ConfigLayerGlobal, From: "/model", Layer: lib.ConfigLayerGlobalLocal, Path: "/model"},
+	}, "")
+	require.NoError(t, err)
+	group, err := patch.Apply(claudeHome)
+	require.NoError(t, err)
+	require.NotEmpty(t, group)
+
+	backups := lib.ListBackups(claudeHome)
+	require.Len(t, backups, 2)
+	for _, b := range backups {
+		assert.Equal(t, group, b.Group)
+		assert.Equal(t, lib.BackupOpPatch, b.Operation)
+	}
+
+	data, err := os.ReadFile(filepath.Join(claudeHome, "settings.local.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus"}`, string(data))
+}
+
+func TestConfigPatchApply_RollsBackOnWriteFailure(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, `{"model":"opus"}`)
+
+	patch, err := lib.PlanConfigPatch([]lib.PatchOperation{
+		{Op: "replace", Layer: lib.ConfigLayerGlobal, Path: "/model", Value: "sonnet"},
+		{Op: "add", Layer: lib.ConfigLayerGlobalLocal, Path: "/theme", Value: "dark"},
+		{Op: "add", Layer: lib.ConfigLayerProject, Path: "/theme", Value: "dark"},
+	}, projectDir)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 3)
+
+	// Make the project write fail: its .claude directory cannot be created.
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, ".claude"), nil, 0o600))
+
+	_, err = patch.Apply(claudeHome)
+	require.Error(t, err)
+
+	data, err := os.ReadFile(filepath.Join(claudeHome, "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, `{"model":"opus"}`, string(data), "written file must be restored")
+	assert.NoFileExists(t, filepath.Join(claudeHome, "settings.local.json"), "created file must be removed")
+}
@@ -1,12 +1,27 @@
 package lib
 
 import (
+	"bytes"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
 )
 
+// JSONParseError reports a settings file that exists but does not hold a valid
+// JSON object. Line and Column are 1-based and point at the offending byte.
+type JSONParseError struct {
+	FilePath string
+	Line     int
+	Column   int
+	Message  string
+}
+
+func (e *JSONParseError) Error() string {
+	return fmt.Sprintf("%s:%d:%d: %s", e.FilePath, e.Line, e.Column, e.Message)
+}
+
 // ReadJSONFileSafe reads and parses a JSON object from filePath.
 // Returns an empty JsonObject on any error (missing file, invalid JSON, etc.).
 func ReadJSONFileSafe(filePath string) JsonObject {
@@ -21,23 +36,103 @@ func ReadJSONFileSafe(filePath string) JsonObject {
 	return obj
 }
 
+// ReadJSONFileStrict reads and parses a JSON object from filePath for a
+// read-modify-write. A missing or blank file yields an empty JsonObject; a file
+// that cannot be parsed yields a *JSONParseError so the caller can refuse to
+// overwrite it.
+func ReadJSONFileStrict(filePath string) (JsonObject, error) {
+	_, obj, err := readJSONFileForEdit(filePath)
+	return obj, err
+}
+
+// readJSONFileForEdit is ReadJSONFileStrict that also returns the raw bytes, so
+// they can be edited in place. The bytes are nil when the file does not exist.
+func readJSONFileForEdit(filePath string) ([]byte, JsonObject, error) {
+	data, err := os.ReadFile(filePath) //nolint:gosec // filePath is validated by ResolveLayerPath which returns only known config paths
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, JsonObject{}, nil
+	}
+	if err != nil {
+		return nil, nil, fmt.Errorf("configwriter read: %w", err)
+	}
+	obj, err := ParseJSONObject(filePath, data)
+	if err != nil {
+		return nil, nil, err
+	}
+	return data, obj, nil
+}
+
+// ParseJSONObject parses data as a JSON object. Blank input and a literal null
+// yield an empty JsonObject. Any other failure is returned as a *JSONParseError
+// attributed to filePath.
+func ParseJSONObject(filePath string, data []byte) (JsonObject, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		return JsonObject{}, nil
+	}
+	var obj JsonObject
+	if err := json.Unmarshal(data, &obj); err != nil {
+		return nil, newJSONParseError(filePath, data, err)
+	}
+	if obj == nil {
+		obj = JsonObject{}
+	}
+	return obj, nil
+}
+
+// newJSONParseError converts an encoding/json error into a *JSONParseError
+// carrying the line and column of the failure.
+func newJSONParseError(filePath string, data []byte, err error) *JSONParseError {
+	offset := int64(len(data))
+	var syntaxErr *json.SyntaxError
+	var typeErr *json.UnmarshalTypeError
+	message := err.Error()
+	switch {
+	case errors.As(err, &syntaxErr):
+		offset = syntaxErr.Offset
+	case errors.As(err, &typeErr):
+		offset = typeErr.Offset
+		message = "settings file must contain a JSON object, got " + typeErr.Value
+	}
+	// Offset counts the bytes read, including the offending one.
+	if offset > 0 {
+		offset--
+	}
+	offset = min(offset, int64(len(data)))
+	line, column := 1, 1
+	for _, b := range data[:offset] {
+		if b == '\n' {
+			line++
+			column = 1
+		} else {
+			column++
+		}
+	}
+	return &JSONParseError{FilePath: filePath, Line: line, Column: column, Message: message}
+}
+
 // WriteJSONFileSafe writes obj to filePath as pretty-printed JSON followed by a newline.
 // Creates all parent directories if they do not exist. Uses WriteFileAtomic for safe writes.
 func WriteJSONFileSafe(filePath string, obj JsonObject) error {
-	dir := filepath.Dir(filePath)
-	if err := os.MkdirAll(dir, 0o750); err != nil {
-		return fmt.Errorf("configwriter mkdir: %w", err)
-	}
 	out, err := json.MarshalIndent(obj, "", "  ")
 	if err != nil {
 		return fmt.Errorf("configwriter marshal: %w", err)
 	}
 	out = append(out, '\n')
-	return WriteFileAtomic(filePath, out)
+	return writeJSONBytes(filePath, out)
+}
+
+// writeJSONBytes writes already-encoded JSON to filePath, creating parent
+// directories as needed.
+func writeJSONBytes(filePath string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(filePath), 0o750); err != nil {
+		return fmt.Errorf("configwriter mkdir: %w", err)
+	}
+	return WriteFileAtomic(filePath, data)
 }
 
 // ResolveLayerPath maps a ConfigLayerSource to the filesystem path of the corresponding
 // settings file. For project and project-local layers, projectPath must be non-empty.
+// The managed layer resolves to its path, but callers must check ReadOnly before writing.
 func ResolveLayerPath(source ConfigLayerSource, projectPath string) (string, error) {
 	claudeHome := ResolveClaudeHome()
 	switch source {
@@ -55,46 +150,97 @@ func ResolveLayerPath(source ConfigLayerSource, projectPath string) (string, err
 			return "", fmt.Errorf("projectPath required for project-local layer")
 		}
 		return filepath.Join(projectPath, ".claude", "settings.local.json"), nil
+	case ConfigLayerManaged:
+		return ResolveManagedSettingsPath(), nil
 	default:
 		return "", fmt.Errorf("unknown layer source: %s", source)
 	}
 }
 
-// ApplyUpdateSetting reads the JSON file at filePath, sets keyPath to value,
-// backs up the original file, then writes the updated object.
+// ApplyUpdateSetting sets keyPath to value in the JSON file at filePath, backs up
+// the original file, then writes the result. Only the targeted value is
+// rewritten; key order and formatting elsewhere are preserved. Redaction
+// placeholders in value are replaced by the secrets they hid in the value being
+// overwritten.
+// Returns a *JSONParseError, without writing, if the existing file cannot be
+// parsed, or a *RedactedWriteError if placeholders cannot be restored.
 func ApplyUpdateSetting(filePath string, keyPath string, value any, claudeHome string) error {
-	current := ReadJSONFileSafe(filePath)
-	updated := SetAtPath(current, keyPath, value)
+	return applySetSetting(filePath, keyPath, value, claudeHome, SetJSONAtPath, true)
+}
+
+// ApplyInsertSetting is ApplyUpdateSetting, except that when keyPath ends in an
+// array index the value is inserted before that element instead of replacing it.
+func ApplyInsertSetting(filePath string, keyPath string, value any, claudeHome string) error {
+	return applySetSetting(filePath, keyPath, value, claudeHome, InsertJSONAtPath, false)
+}
+
+func applySetSetting(filePath, keyPath string, value any, claudeHome string, set func([]byte, string, any) ([]byte, error), restore bool) error {
+	current, obj, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return err
+	}
+	if existing, ok := GetAtPath(obj, keyPath); ok && restore {
+		r, _ := LoadRedactor(claudeHome) //nolint:errcheck // an unreadable rules file falls back to the built-in rules
+		if value, err = r.RestoreRedacted(value, existing); err != nil {
+			return err
+		}
+	}
+	updated, err := set(current, keyPath, value)
+	if err != nil {
+		return err
+	}
 	BackupFile(filePath, BackupOpUpdate, claudeHome)
-	return WriteJSONFileSafe(filePath, updated)
+	return writeJSONBytes(filePath, updated)
 }
 
-// ApplyDeleteSetting reads the JSON file at filePath, removes the key at keyPath,
-// backs up the original, then writes the updated object.
-// Returns an error if the file does not exist.
+// ApplyDeleteSetting removes the key at keyPath from the JSON file at filePath,
+// backs up the original, then writes the result, preserving the formatting of
+// everything else.
+// Returns an error if the file does not exist, or a *JSONParseError if it cannot be parsed.
 func ApplyDeleteSetting(filePath string, keyPath string, claudeHome string) error {
 	if _, err := os.Stat(filePath); err != nil {
 		return fmt.Errorf("file does not exist: %s", filePath)
 	}
-	current := ReadJSONFileSafe(filePath)
-	updated := DeleteAtPath(current, keyPath)
+	current, _, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return err
+	}
+	updated, err := DeleteJSONAtPath(current, keyPath)
+	if err != nil {
+		return err
+	}
 	BackupFile(filePath, BackupOpDelete, claudeHome)
-	return WriteJSONFileSafe(filePath, updated)
+	return writeJSONBytes(filePath, updated)
 }
 
-// ApplyMoveSetting moves the value at keyPath from fromPath to toPath.
-// Backs up both files before writing. Returns an error if keyPath is not found in fromPath.
+// ApplyMoveSetting moves the value at keyPath from fromPath to toPath, editing
+// both files in place. Backs up both files before writing. Returns an error if
+// keyPath is not found in fromPath, or a *JSONParseError if either file cannot be parsed.
 func ApplyMoveSetting(fromPath, toPath, keyPath string, claudeHome string) error {
-	fromData := ReadJSONFileSafe(fromPath)
-	value, ok := GetAtPath(fromData, keyPath)
+	fromData, fromObj, err := readJSONFileForEdit(fromPath)
+	if err != nil {
+		return err
+	}
+	value, ok := GetAtPath(fromObj, keyPath)
 	if !ok {
 		return fmt.Errorf("key %q not found in source file", keyPath)
 	}
-	toData := ReadJSONFileSafe(toPath)
+	toData, _, err := readJSONFileForEdit(toPath)
+	if err != nil {
+		return err
+	}
+	updatedTo, err := SetJSONAtPath(toData, keyPath, value)
+	if err != nil {
+		return err
+	}
+	updatedFrom, err := DeleteJSONAtPath(fromData, keyPath)
+	if err != nil {
+		return err
+	}
 	BackupFile(fromPath, BackupOpMove, claudeHome)
 	BackupFile(toPath, BackupOpMove, claudeHome)
-	if err := WriteJSONFileSafe(toPath, SetAtPath(toData, keyPath, value)); err != nil {
+	if err := writeJSONBytes(toPath, updatedTo); err != nil {
 		return err
 	}
-	return WriteJSONFileSafe(fromPath, DeleteAtPath(fromData, keyPath))
+	return writeJSONBytes(fromPath, updatedFrom)
 }
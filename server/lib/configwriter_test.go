@@ -36,6 +36,45 @@ func TestReadJSONFileSafe_InvalidJSON(t *testing.T) {
 	assert.Equal(t, lib.JsonObject{}, obj)
 }
 
+// --- ReadJSONFileStrict ---
+
+func TestReadJSONFileStrict_MissingFileIsEmpty(t *testing.T) {
+	obj, err := lib.ReadJSONFileStrict(filepath.Join(t.TempDir(), "nope.json"))
+	require.NoError(t, err)
+	assert.Equal(t, lib.JsonObject{}, obj)
+}
+
+func TestReadJSONFileStrict_BlankFileIsEmpty(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+	require.NoError(t, os.WriteFile(filePath, []byte("\n  \n"), 0o600))
+
+	obj, err := lib.ReadJSONFileStrict(filePath)
+	require.NoError(t, err)
+	assert.Equal(t, lib.JsonObject{}, obj)
+}
+
+func TestReadJSONFileStrict_ReportsLineAndColumn(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+	require.NoError(t, os.WriteFile(filePath, []byte("{\n  \"model\": \"opus\",\n}\n"), 0o600))
+
+	_, err := lib.ReadJSONFileStrict(filePath)
+	var parseErr *lib.JSONParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, filePath, parseErr.FilePath)
+	assert.Equal(t, 3, parseErr.Line)
+	assert.Equal(t, 1, parseErr.Column)
+}
+
+func TestReadJSONFileStrict_NonObjectIsParseError(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`["a"]`), 0o600))
+
+	_, err := lib.ReadJSONFileStrict(filePath)
+	var parseErr *lib.JSONParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Contains(t, parseErr.Message, "JSON object")
+}
+
 // --- WriteJSONFileSafe ---
 
 func TestWriteJSONFileSafe_WritesFile(t *testing.T) {
@@ -131,6 +170,19 @@ func TestApplyUpdateSetting_CreatesNestedKey(t *testing.T) {
 	assert.EqualValues(t, 42, b["c"])
 }
 
+func TestApplyUpdateSetting_PreservesFormatting(t *testing.T) {
+	claudeHome := t.TempDir()
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+	original := "{\n    \"zeta\": true,\n    \"alpha\": [1, 2],\n    \"theme\": \"light\"\n}\n"
+	require.NoError(t, os.WriteFile(filePath, []byte(original), 0o600))
+
+	require.NoError(t, lib.ApplyUpdateSetting(filePath, "theme", "dark", claudeHome))
+
+	data, err := os.ReadFile(filePath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, "{\n    \"zeta\": true,\n    \"alpha\": [1, 2],\n    \"theme\": \"dark\"\n}\n", string(data))
+}
+
 func TestApplyUpdateSetting_CreatesBackup(t *testing.T) {
 	claudeHome := t.TempDir()
 	dir := t.TempDir()
@@ -143,6 +195,22 @@ func TestApplyUpdateSetting_CreatesBackup(t *testing.T) {
 	assert.NotEmpty(t, entries)
 }
 
+func TestApplyUpdateSetting_RefusesUnparseableFile(t *testing.T) {
+	claudeHome := t.TempDir()
+	filePath := filepath.Join(t.TempDir(), "settings.json")
+	original := []byte(`{"model":"opus","permissions":{"allow":["Read"]},}`)
+	require.NoError(t, os.WriteFile(filePath, original, 0o600))
+
+	err := lib.ApplyUpdateSetting(filePath, "theme", "dark", claudeHome)
+	var parseErr *lib.JSONParseError
+	require.ErrorAs(t, err, &parseErr)
+
+	data, err := os.ReadFile(filePath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, original, data, "broken file must be left untouched")
+	assert.Empty(t, lib.ListBackups(claudeHome))
+}
+
 // --- ApplyDeleteSetting ---
 
 func TestApplyDeleteSetting_RemovesKey(t *testing.T) {
@@ -189,6 +257,21 @@ func TestApplyMoveSetting_MovesKey(t *testing.T) {
 	assert.Equal(t, true, toObj["existing"])
 }
 
+func TestApplyMoveSetting_RefusesUnparseableTarget(t *testing.T) {
+	claudeHome := t.TempDir()
+	dir := t.TempDir()
+
+	fromPath := filepath.Join(dir, "from.json")
+	toPath := filepath.Join(dir, "to.json")
+	require.NoError(t, os.WriteFile(fromPath, []byte(`{"theme":"dark"}`), 0o600))
+	require.NoError(t, os.WriteFile(toPath, []byte(`{"a":1,}`), 0o600))
+
+	err := lib.ApplyMoveSetting(fromPath, toPath, "theme", claudeHome)
+	var parseErr *lib.JSONParseError
+	require.ErrorAs(t, err, &parseErr)
+	assert.Equal(t, "dark", lib.ReadJSONFileSafe(fromPath)["theme"], "source must keep the key")
+}
+
 func TestApplyMoveSetting_ErrorIfKeyNotFound(t *testing.T) {
 	claudeHome := t.TempDir()
 	dir := t.TempDir()
@@ -201,3 +284,14 @@ func TestApplyMoveSetting_ErrorIfKeyNotFound(t *testing.T) {
 	err := lib.ApplyMoveSetting(fromPath, toPath, "missing", claudeHome)
 	assert.Error(t, err)
 }
+
+func TestApplyUpdateSetting_KeepsRedactedSecrets(t *testing.T) {
+	claudeHome := t.TempDir()
+	filePath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(filePath, []byte(`{"env":{"API_TOKEN":"real-secret","MODE":"a"}}`), 0o600))
+
+	require.NoError(t, lib.ApplyUpdateSetting(filePath, "env", lib.JsonObject{"API_TOKEN": "[REDACTED]", "MODE": "b"}, claudeHome))
+
+	obj := lib.ReadJSONFileSafe(filePath)
+	assert.Equal(t, lib.JsonObject{"API_TOKEN": "real-secret", "MODE": "b"}, obj["env"])
+}
@@ -0,0 +1,65 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"os"
+	"strings"
+)
+
+// ContentETag returns a strong HTTP entity tag (quoted) derived from a hash of data.
+func ContentETag(data []byte) string {
+	sum := sha256.Sum256(data)
+	return `"` + hex.EncodeToString(sum[:16]) + `"`
+}
+
+// FileETag returns the entity tag of the file at filePath. A missing or
+// unreadable file has the tag of empty content.
+func FileETag(filePath string) string {
+	data, _ := os.ReadFile(filePath) //nolint:gosec,errcheck // callers pass paths they resolved for reading; a read failure hashes as empty
+	return ContentETag(data)
+}
+
+// PreconditionFailedError is returned when a file's current entity tag does not
+// match the If-Match header of a write. It carries the current state of the
+// file so the client can reconcile its edit.
+type PreconditionFailedError struct {
+	FilePath string
+	ETag     string
+	Exists   bool
+	Content  string
+}
+
+func (e *PreconditionFailedError) Error() string {
+	return fmt.Sprintf("%s changed on disk (current ETag %s)", e.FilePath, e.ETag)
+}
+
+// CheckIfMatch verifies the If-Match header value ifMatch against each of
+// filePaths. A nil or blank header skips the check. The header may list several
+// comma-separated tags; each file's current tag must be among them. "*" matches
+// any file that exists. Returns nil when every file matches.
+func CheckIfMatch(ifMatch *string, filePaths ...string) *PreconditionFailedError {
+	if ifMatch == nil || strings.TrimSpace(*ifMatch) == "" {
+		return nil
+	}
+	tags := map[string]bool{}
+	for _, tag := range strings.Split(*ifMatch, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag != "*" && !strings.HasPrefix(tag, `"`) {
+			tag = `"` + tag + `"`
+		}
+		tags[tag] = true
+	}
+
+	for _, filePath := range filePaths {
+		data, err := os.ReadFile(filePath) //nolint:gosec // callers pass paths they have already resolved and validated for writing
+		exists := err == nil
+		current := ContentETag(data)
+		if (tags["*"] && exists) || tags[current] {
+			continue
+		}
+		return &PreconditionFailedError{FilePath: filePath, ETag: current, Exists: exists, Content: string(data)}
+	}
+	return nil
+}
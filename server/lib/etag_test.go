@@ -0,0 +1,76 @@
+package lib_test
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestContentETag_StableAndQuoted(t *testing.T) {
+	tag := lib.ContentETag([]byte(`{"a":1}`))
+	assert.Equal(t, tag, lib.ContentETag([]byte(`{"a":1}`)))
+	assert.NotEqual(t, tag, lib.ContentETag([]byte(`{"a":2}`)))
+	assert.True(t, strings.HasPrefix(tag, `"`) && strings.HasSuffix(tag, `"`))
+}
+
+func TestFileETag_MissingFileHashesAsEmpty(t *testing.T) {
+	assert.Equal(t, lib.ContentETag(nil), lib.FileETag(filepath.Join(t.TempDir(), "missing.json")))
+}
+
+func TestCheckIfMatch(t *testing.T) {
+	dir := t.TempDir()
+	a := filepath.Join(dir, "a.json")
+	b := filepath.Join(dir, "b.json")
+	missing := filepath.Join(dir, "missing.json")
+	require.NoError(t, os.WriteFile(a, []byte(`{"a":1}`), 0o600))
+	require.NoError(t, os.WriteFile(b, []byte(`{"b":1}`), 0o600))
+	tagA := lib.FileETag(a)
+	tagB := lib.FileETag(b)
+
+	ptr := func(s string) *string { return &s }
+	tests := []struct {
+		name    string
+		ifMatch *string
+		paths   []string
+		failed  string
+	}{
+		{name: "nil header skips check", ifMatch: nil, paths: []string{a}},
+		{name: "blank header skips check", ifMatch: ptr("  "), paths: []string{a}},
+		{name: "matching tag", ifMatch: ptr(tagA), paths: []string{a}},
+		{name: "unquoted tag", ifMatch: ptr(strings.Trim(tagA, `"`)), paths: []string{a}},
+		{name: "stale tag", ifMatch: ptr(tagB), paths: []string{a}, failed: a},
+		{name: "list covers both files", ifMatch: ptr(tagA + ", " + tagB), paths: []string{a, b}},
+		{name: "list missing second file", ifMatch: ptr(tagA), paths: []string{a, b}, failed: b},
+		{name: "wildcard matches existing file", ifMatch: ptr("*"), paths: []string{a}},
+		{name: "wildcard rejects missing file", ifMatch: ptr("*"), paths: []string{missing}, failed: missing},
+		{name: "missing file matches empty tag", ifMatch: ptr(lib.ContentETag(nil)), paths: []string{missing}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			failed := lib.CheckIfMatch(tt.ifMatch, tt.paths...)
+			if tt.failed == "" {
+				assert.Nil(t, failed)
+				return
+			}
+			require.NotNil(t, failed)
+			assert.Equal(t, tt.failed, failed.FilePath)
+			assert.Equal(t, lib.FileETag(tt.failed), failed.ETag)
+		})
+	}
+}
+
+func TestCheckIfMatch_ReportsCurrentContent(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "settings.json")
+	require.NoError(t, os.WriteFile(path, []byte(`{"model":"opus"}`), 0o600))
+	stale := lib.ContentETag([]byte(`{"model":"sonnet"}`))
+
+	failed := lib.CheckIfMatch(&stale, path)
+	require.NotNil(t, failed)
+	assert.True(t, failed.Exists)
+	assert.Equal(t, `{"model":"opus"}`, failed.Content)
+}
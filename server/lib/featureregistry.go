@@ -25,7 +25,7 @@ const (
 
 // Feature describes a single manageable feature flag or setting.
 // ID is the unique identifier (the raw key used in env or settings).
-// KeyPath is the dot-separated JSON path used with GetAtPath/SetAtPath.
+// KeyPath is the key path (see ParsePath) used with GetAtPath/SetAtPath.
 // For env features this is the same as ID; for settings it may use dot
 // notation for nested keys (e.g. "sandbox.enabled").
 type Feature struct {
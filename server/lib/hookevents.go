@@ -0,0 +1,116 @@
+package lib
+
+// HookEvent describes one event Claude Code runs hooks for.
+// Matcher says what a definition's matcher is tested against, or is empty for
+// events that ignore matchers. Payload holds sample values of the fields the
+// event adds to the JSON piped into a hook, beyond those every event carries
+// (session_id, transcript_path, cwd, permission_mode and hook_event_name).
+type HookEvent struct {
+	Name        string
+	Description string
+	Matcher     string
+	Payload     JsonObject
+}
+
+// AcceptsMatcher reports whether definitions of the event are filtered by
+// their matcher.
+func (e HookEvent) AcceptsMatcher() bool {
+	return e.Matcher != ""
+}
+
+// allHookEvents is the authoritative list of hook events, in the order they
+// occur in a session.
+var allHookEvents = []HookEvent{
+	{
+		Name:        "SessionStart",
+		Description: "A session starts or resumes.",
+		Matcher:     "how the session started: startup, resume, clear or compact",
+		Payload:     JsonObject{"source": "startup"},
+	},
+	{
+		Name:        "UserPromptSubmit",
+		Description: "The user submits a prompt, before Claude processes it.",
+		Payload:     JsonObject{"prompt": "Write a function that adds two numbers"},
+	},
+	{
+		Name:        "PreToolUse",
+		Description: "Claude has chosen a tool call, before it runs; the hook can allow, deny or ask.",
+		Matcher:     "tool name",
+		Payload: JsonObject{
+			"tool_name":   "Bash",
+			"tool_input":  JsonObject{},
+			"tool_use_id": "toolu_01ABCDEFGHIJKLMNOPQRSTUV",
+		},
+	},
+	{
+		Name:        "PermissionRequest",
+		Description: "A permission dialog is about to be shown; the hook can allow or deny on the user's behalf.",
+		Matcher:     "tool name",
+		Payload: JsonObject{
+			"tool_name":  "Bash",
+			"tool_input": JsonObject{},
+		},
+	},
+	{
+		Name:        "PostToolUse",
+		Description: "A tool call has completed successfully.",
+		Matcher:     "tool name",
+		Payload: JsonObject{
+			"tool_name":     "Bash",
+			"tool_input":    JsonObject{},
+			"tool_response": JsonObject{},
+			"tool_use_id":   "toolu_01ABCDEFGHIJKLMNOPQRSTUV",
+		},
+	},
+	{
+		Name:        "Notification",
+		Description: "Claude Code sends a notification, such as a permission prompt or an idle reminder.",
+		Matcher:     "notification type: permission_prompt, idle_prompt, auth_success or elicitation_dialog",
+		Payload: JsonObject{
+			"message":           "Claude needs your permission to use Bash",
+			"notification_type": "permission_prompt",
+		},
+	},
+	{
+		Name:        "Stop",
+		Description: "The main agent finishes responding; the hook can make it continue.",
+		Payload:     JsonObject{"stop_hook_active": false},
+	},
+	{
+		Name:        "SubagentStop",
+		Description: "A subagent finishes; the hook can make it continue.",
+		Payload:     JsonObject{"stop_hook_active": false},
+	},
+	{
+		Name:        "PreCompact",
+		Description: "The conversation is about to be compacted.",
+		Matcher:     "what started the compaction: manual or auto",
+		Payload: JsonObject{
+			"trigger":             "manual",
+			"custom_instructions": "",
+		},
+	},
+	{
+		Name:        "SessionEnd",
+		Description: "A session ends.",
+		Payload:     JsonObject{"reason": "exit"},
+	},
+}
+
+// AllHookEvents returns the complete static list of hook events.
+func AllHookEvents() []HookEvent {
+	result := make([]HookEvent, len(allHookEvents))
+	copy(result, allHookEvents)
+	return result
+}
+
+// GetHookEvent returns a hook event by name. Returns the zero value and false
+// if Claude Code has no such event.
+func GetHookEvent(name string) (HookEvent, bool) {
+	for _, e := range allHookEvents {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return HookEvent{}, false
+}
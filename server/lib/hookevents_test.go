@@ -0,0 +1,40 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestAllHookEvents_UniqueAndSyntheticPayloads(t *testing.T) {
+	seen := map[string]bool{}
+	for _, e := range lib.AllHookEvents() {
+		assert.False(t, seen[e.Name], "duplicate hook event %q", e.Name)
+		seen[e.Name] = true
+		assert.NotEmpty(t, e.Description, e.Name)
+
+		payload, err := lib.SyntheticHookEvent(e.Name, t.TempDir(), "", nil)
+		require.NoError(t, err, e.Name)
+		for field := range e.Payload {
+			assert.Contains(t, payload, field, "%s payload", e.Name)
+		}
+	}
+	for _, name := range []string{"PreCompact", "SessionEnd", "PermissionRequest"} {
+		assert.True(t, seen[name], "missing hook event %q", name)
+	}
+}
+
+func TestGetHookEvent_Matchers(t *testing.T) {
+	pre, ok := lib.GetHookEvent("PreToolUse")
+	require.True(t, ok)
+	assert.True(t, pre.AcceptsMatcher())
+
+	stop, ok := lib.GetHookEvent("Stop")
+	require.True(t, ok)
+	assert.False(t, stop.AcceptsMatcher())
+
+	_, ok = lib.GetHookEvent("OnTeaBreak")
+	assert.False(t, ok)
+}
@@ -0,0 +1,71 @@
+package lib
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+// BuiltinTools are the names of the tools Claude Code ships with, as hook
+// matchers see them.
+var BuiltinTools = []string{
+	"Bash", "BashOutput", "Edit", "ExitPlanMode", "Glob", "Grep", "KillShell",
+	"LS", "MultiEdit", "NotebookEdit", "Read", "SlashCommand", "Task",
+	"TodoWrite", "WebFetch", "WebSearch", "Write",
+}
+
+// MCPToolName returns the name Claude Code gives tool of an MCP server.
+func MCPToolName(server, tool string) string {
+	return "mcp__" + server + "__" + tool
+}
+
+// HookMatcher is a hook definition's matcher, compiled by CompileHookMatcher.
+type HookMatcher struct {
+	all   bool
+	names map[string]bool
+	re    *regexp.Regexp
+}
+
+// plainMatcher matches matchers that are a name or a |-separated list of
+// names, which Claude Code compares exactly rather than as a pattern.
+var plainMatcher = regexp.MustCompile(`^[A-Za-z0-9_|]+$`)
+
+// CompileHookMatcher compiles matcher the way Claude Code reads it: "" and "*"
+// match everything, a name or |-separated list of names matches exactly those
+// names, and anything else is a regular expression that may match anywhere in
+// the name. Returns an error if the regular expression does not compile.
+func CompileHookMatcher(matcher string) (*HookMatcher, error) {
+	if matcher == "" || matcher == "*" {
+		return &HookMatcher{all: true}, nil
+	}
+	if plainMatcher.MatchString(matcher) {
+		m := &HookMatcher{names: map[string]bool{}}
+		for _, name := range strings.Split(matcher, "|") {
+			m.names[name] = true
+		}
+		return m, nil
+	}
+	re, err := regexp.Compile(matcher)
+	if err != nil {
+		return nil, fmt.Errorf("invalid matcher %q: %w", matcher, err)
+	}
+	return &HookMatcher{re: re}, nil
+}
+
+// MatchesAll reports whether the matcher matches every name without looking
+// at it, as "" and "*" do.
+func (m *HookMatcher) MatchesAll() bool {
+	return m.all
+}
+
+// Match reports whether the matcher matches name.
+func (m *HookMatcher) Match(name string) bool {
+	switch {
+	case m.all:
+		return true
+	case m.re != nil:
+		return m.re.MatchString(name)
+	default:
+		return m.names[name]
+	}
+}
@@ -0,0 +1,38 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestCompileHookMatcher(t *testing.T) {
+	tests := []struct {
+		matcher string
+		matches []string
+		misses  []string
+	}{
+		{matcher: "", matches: []string{"Bash", "mcp__github__create_issue"}},
+		{matcher: "*", matches: []string{"Write"}},
+		{matcher: "Write", matches: []string{"Write"}, misses: []string{"TodoWrite"}},
+		{matcher: "Edit|Write", matches: []string{"Edit", "Write"}, misses: []string{"MultiEdit"}},
+		{matcher: "Notebook.*", matches: []string{"NotebookEdit"}, misses: []string{"Read"}},
+		{matcher: "mcp__github__.*", matches: []string{"mcp__github__create_issue"}, misses: []string{"mcp__slack__post"}},
+	}
+	for _, tt := range tests {
+		m, err := lib.CompileHookMatcher(tt.matcher)
+		require.NoError(t, err, tt.matcher)
+		assert.Equal(t, tt.matcher == "" || tt.matcher == "*", m.MatchesAll(), tt.matcher)
+		for _, name := range tt.matches {
+			assert.True(t, m.Match(name), "%q should match %q", tt.matcher, name)
+		}
+		for _, name := range tt.misses {
+			assert.False(t, m.Match(name), "%q should not match %q", tt.matcher, name)
+		}
+	}
+
+	_, err := lib.CompileHookMatcher("Edit|(Write")
+	require.Error(t, err)
+}
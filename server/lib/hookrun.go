@@ -0,0 +1,153 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// HookTimeout is how long Claude Code lets a hook run when it sets no timeout.
+const HookTimeout = 60 * time.Second
+
+// Outcomes of a hook run, as Claude Code reads its exit status.
+const (
+	// HookOutcomeSuccess is exit status 0: stdout may hold a JSON decision.
+	HookOutcomeSuccess = "success"
+	// HookOutcomeBlock is exit status 2: the action is blocked and stderr is
+	// shown to Claude.
+	HookOutcomeBlock = "block"
+	// HookOutcomeError is any other exit status: stderr is shown to the user
+	// and the action goes ahead.
+	HookOutcomeError = "error"
+	// HookOutcomeTimeout is a hook stopped after its timeout.
+	HookOutcomeTimeout = "timeout"
+)
+
+// HookRun is the outcome of running a hook command. Decision is stdout parsed
+// as JSON when it holds an object; DecisionError says why stdout that looks
+// like JSON could not be parsed.
+type HookRun struct {
+	Stdout        string
+	Stderr        string
+	ExitCode      int
+	TimedOut      bool
+	Duration      time.Duration
+	Outcome       string
+	Decision      JsonObject
+	DecisionError string
+}
+
+// SyntheticHookEvent returns event JSON of the shape Claude Code pipes into a
+// hook for event, for a session in dir, filled in from the event's sample
+// payload. For events about a tool call, toolName and toolInput replace the
+// sample Bash call when set.
+// Returns an error if event is not a hook event.
+func SyntheticHookEvent(event, dir, toolName string, toolInput JsonObject) (JsonObject, error) {
+	def, ok := GetHookEvent(event)
+	if !ok {
+		return nil, fmt.Errorf("unknown hook event %q", event)
+	}
+	payload := JsonObject{
+		"session_id":      "00000000-0000-4000-8000-000000000000",
+		"transcript_path": filepath.Join(os.TempDir(), "field-station-hook-test.jsonl"),
+		"cwd":             dir,
+		"permission_mode": "default",
+		"hook_event_name": event,
+	}
+	maps.Copy(payload, def.Payload)
+	if _, ok := payload["tool_name"]; ok {
+		if toolName == "" {
+			toolName = "Bash"
+		}
+		if toolInput == nil {
+			toolInput = sampleToolInput(toolName, dir)
+		}
+		payload["tool_name"] = toolName
+		payload["tool_input"] = toolInput
+		if _, ok := payload["tool_response"]; ok {
+			payload["tool_response"] = sampleToolResponse(toolName, toolInput)
+		}
+	}
+	return payload, nil
+}
+
+// sampleToolInput returns a plausible tool_input for a call of toolName.
+func sampleToolInput(toolName, dir string) JsonObject {
+	file := filepath.Join(dir, "README.md")
+	switch toolName {
+	case "Bash":
+		return JsonObject{"command": "ls -la", "description": "List files in the current directory"}
+	case "Read":
+		return JsonObject{"file_path": file}
+	case "Write":
+		return JsonObject{"file_path": file, "content": "# Example\n"}
+	case "Edit":
+		return JsonObject{"file_path": file, "old_string": "Example", "new_string": "Sample"}
+	case "Grep", "Glob":
+		return JsonObject{"pattern": "TODO", "path": dir}
+	case "WebFetch":
+		return JsonObject{"url": "https://example.com", "prompt": "Summarise the page"}
+	default:
+		return JsonObject{}
+	}
+}
+
+// sampleToolResponse returns a plausible tool_response for a call of toolName.
+func sampleToolResponse(toolName string, toolInput JsonObject) JsonObject {
+	if toolName == "Bash" {
+		return JsonObject{"stdout": "README.md\n", "stderr": "", "interrupted": false}
+	}
+	response := JsonObject{"success": true}
+	if file, ok := toolInput["file_path"]; ok {
+		response["filePath"] = file
+	}
+	return response
+}
+
+// RunHook runs a command hook through sh in projectDir the way Claude Code
+// does, with payload as JSON on stdin and CLAUDE_PROJECT_DIR set, and reports
+// how Claude Code would read the result. A hook that fails, or is stopped after
+// timeout, is reported in the result rather than as an error. Returns an error
+// only if the shell cannot be started.
+func RunHook(ctx context.Context, command, projectDir string, payload JsonObject, timeout time.Duration) (*HookRun, error) {
+	input, err := json.Marshal(payload)
+	if err != nil {
+		return nil, err
+	}
+	env := append(os.Environ(), "CLAUDE_PROJECT_DIR="+projectDir)
+	run, err := runShell(ctx, command, projectDir, env, input, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("hook: %w", err)
+	}
+	result := &HookRun{
+		Stdout:   run.stdout,
+		Stderr:   run.stderr,
+		ExitCode: run.exitCode,
+		TimedOut: run.timedOut,
+		Duration: run.duration,
+	}
+	switch {
+	case run.timedOut:
+		result.Outcome = HookOutcomeTimeout
+	case run.exitCode == 0:
+		result.Outcome = HookOutcomeSuccess
+	case run.exitCode == 2:
+		result.Outcome = HookOutcomeBlock
+	default:
+		result.Outcome = HookOutcomeError
+	}
+	if out := bytes.TrimSpace([]byte(run.stdout)); bytes.HasPrefix(out, []byte("{")) {
+		var decision JsonObject
+		if err := json.Unmarshal(out, &decision); err != nil {
+			result.DecisionError = err.Error()
+		} else {
+			result.Decision = decision
+		}
+	}
+	return result, nil
+}
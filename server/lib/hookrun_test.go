@@ -0,0 +1,74 @@
+package lib_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestSyntheticHookEvent_ShapesPayloadPerEvent(t *testing.T) {
+	dir := t.TempDir()
+	pre, err := lib.SyntheticHookEvent("PreToolUse", dir, "", nil)
+	require.NoError(t, err)
+	assert.Equal(t, "PreToolUse", pre["hook_event_name"])
+	assert.Equal(t, "Bash", pre["tool_name"])
+	assert.Equal(t, "ls -la", pre["tool_input"].(lib.JsonObject)["command"])
+
+	post, err := lib.SyntheticHookEvent("PostToolUse", dir, "Write", lib.JsonObject{"file_path": "/tmp/a.go"})
+	require.NoError(t, err)
+	assert.Equal(t, "Write", post["tool_name"])
+	assert.Equal(t, lib.JsonObject{"file_path": "/tmp/a.go"}, post["tool_input"])
+	assert.Equal(t, "/tmp/a.go", post["tool_response"].(lib.JsonObject)["filePath"])
+
+	stop, err := lib.SyntheticHookEvent("Stop", dir, "Bash", nil)
+	require.NoError(t, err)
+	assert.Equal(t, false, stop["stop_hook_active"])
+	assert.NotContains(t, stop, "tool_name")
+
+	_, err = lib.SyntheticHookEvent("Bogus", dir, "", nil)
+	require.Error(t, err)
+}
+
+func TestRunHook_ReportsOutcomeAndDecision(t *testing.T) {
+	dir := t.TempDir()
+	script := filepath.Join(dir, "guard.sh")
+	require.NoError(t, os.WriteFile(script, []byte(`#!/bin/sh
+input=$(cat)
+case "$input" in
+  *'rm -rf'*) echo "refusing rm -rf in $CLAUDE_PROJECT_DIR" >&2; exit 2 ;;
+esac
+echo '{"hookSpecificOutput":{"hookEventName":"PreToolUse","permissionDecision":"allow"}}'
+`), 0o700))
+	payload, err := lib.SyntheticHookEvent("PreToolUse", dir, "", nil)
+	require.NoError(t, err)
+
+	run, err := lib.RunHook(context.Background(), script, dir, payload, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, lib.HookOutcomeSuccess, run.Outcome)
+	assert.Equal(t, "allow", run.Decision["hookSpecificOutput"].(lib.JsonObject)["permissionDecision"])
+
+	payload, err = lib.SyntheticHookEvent("PreToolUse", dir, "Bash", lib.JsonObject{"command": "rm -rf /"})
+	require.NoError(t, err)
+	run, err = lib.RunHook(context.Background(), script, dir, payload, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, lib.HookOutcomeBlock, run.Outcome)
+	assert.Equal(t, 2, run.ExitCode)
+	assert.Equal(t, "refusing rm -rf in "+dir+"\n", run.Stderr)
+	assert.Nil(t, run.Decision)
+
+	run, err = lib.RunHook(context.Background(), `echo '{"decision":'; exit 1`, dir, payload, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, lib.HookOutcomeError, run.Outcome)
+	assert.NotEmpty(t, run.DecisionError)
+
+	run, err = lib.RunHook(context.Background(), "sleep 5", dir, payload, 100*time.Millisecond)
+	require.NoError(t, err)
+	assert.Equal(t, lib.HookOutcomeTimeout, run.Outcome)
+	assert.True(t, run.TimedOut)
+}
@@ -0,0 +1,577 @@
+package lib
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+)
+
+// jsonNode is a parsed JSON value that remembers where it sits in the source
+// bytes, so an edit can splice in new text without reformatting anything else.
+type jsonNode struct {
+	kind    byte // '{' for objects, '[' for arrays, 0 for scalars
+	start   int
+	end     int
+	members []jsonMember
+	items   []*jsonNode
+}
+
+// jsonMember is one key/value pair of an object node.
+type jsonMember struct {
+	key      string
+	keyStart int
+	keyEnd   int
+	value    *jsonNode
+}
+
+// memberIndex returns the index of the last member named key, or -1. The last
+// occurrence wins, matching encoding/json's handling of duplicate keys.
+func (n *jsonNode) memberIndex(key string) int {
+	for i := len(n.members) - 1; i >= 0; i-- {
+		if n.members[i].key == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// jsonScanner parses JSON into a tree of jsonNodes with byte offsets.
+type jsonScanner struct {
+	data []byte
+	pos  int
+}
+
+func parseJSONTree(data []byte) (*jsonNode, error) {
+	s := &jsonScanner{data: data}
+	s.skipSpace()
+	node, err := s.parseValue()
+	if err != nil {
+		return nil, err
+	}
+	s.skipSpace()
+	if s.pos != len(data) {
+		return nil, s.errorf("unexpected trailing data")
+	}
+	return node, nil
+}
+
+func (s *jsonScanner) errorf(msg string) error {
+	return fmt.Errorf("jsonedit: %s at offset %d", msg, s.pos)
+}
+
+func (s *jsonScanner) skipSpace() {
+	for s.pos < len(s.data) && isJSONSpace(s.data[s.pos]) {
+		s.pos++
+	}
+}
+
+func (s *jsonScanner) parseValue() (*jsonNode, error) {
+	if s.pos >= len(s.data) {
+		return nil, s.errorf("unexpected end of input")
+	}
+	switch s.data[s.pos] {
+	case '{':
+		return s.parseObject()
+	case '[':
+		return s.parseArray()
+	case '"':
+		start := s.pos
+		if err := s.skipString(); err != nil {
+			return nil, err
+		}
+		return &jsonNode{start: start, end: s.pos}, nil
+	default:
+		start := s.pos
+		for s.pos < len(s.data) && !isJSONSpace(s.data[s.pos]) && !bytes.ContainsRune([]byte(",]}"), rune(s.data[s.pos])) {
+			s.pos++
+		}
+		if s.pos == start {
+			return nil, s.errorf(fmt.Sprintf("unexpected %q", s.data[s.pos]))
+		}
+		return &jsonNode{start: start, end: s.pos}, nil
+	}
+}
+
+func (s *jsonScanner) skipString() error {
+	s.pos++ // opening quote
+	for s.pos < len(s.data) {
+		switch s.data[s.pos] {
+		case '\\':
+			s.pos += 2
+		case '"':
+			s.pos++
+			return nil
+		default:
+			s.pos++
+		}
+	}
+	return s.errorf("unterminated string")
+}
+
+func (s *jsonScanner) parseObject() (*jsonNode, error) {
+	node := &jsonNode{kind: '{', start: s.pos}
+	s.pos++
+	s.skipSpace()
+	if s.pos < len(s.data) && s.data[s.pos] == '}' {
+		s.pos++
+		node.end = s.pos
+		return node, nil
+	}
+	for {
+		s.skipSpace()
+		if s.pos >= len(s.data) || s.data[s.pos] != '"' {
+			return nil, s.errorf("expected object key")
+		}
+		keyStart := s.pos
+		if err := s.skipString(); err != nil {
+			return nil, err
+		}
+		var key string
+		if err := json.Unmarshal(s.data[keyStart:s.pos], &key); err != nil {
+			return nil, s.errorf("invalid object key")
+		}
+		member := jsonMember{key: key, keyStart: keyStart, keyEnd: s.pos}
+		s.skipSpace()
+		if s.pos >= len(s.data) || s.data[s.pos] != ':' {
+			return nil, s.errorf("expected ':'")
+		}
+		s.pos++
+		s.skipSpace()
+		value, err := s.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		member.value = value
+		node.members = append(node.members, member)
+		s.skipSpace()
+		if s.pos >= len(s.data) {
+			return nil, s.errorf("unexpected end of input")
+		}
+		switch s.data[s.pos] {
+		case ',':
+			s.pos++
+		case '}':
+			s.pos++
+			node.end = s.pos
+			return node, nil
+		default:
+			return nil, s.errorf("expected ',' or '}'")
+		}
+	}
+}
+
+func (s *jsonScanner) parseArray() (*jsonNode, error) {
+	node := &jsonNode{kind: '[', start: s.pos}
+	s.pos++
+	s.skipSpace()
+	if s.pos < len(s.data) && s.data[s.pos] == ']' {
+		s.pos++
+		node.end = s.pos
+		return node, nil
+	}
+	for {
+		s.skipSpace()
+		item, err := s.parseValue()
+		if err != nil {
+			return nil, err
+		}
+		node.items = append(node.items, item)
+		s.skipSpace()
+		if s.pos >= len(s.data) {
+			return nil, s.errorf("unexpected end of input")
+		}
+		switch s.data[s.pos] {
+		case ',':
+			s.pos++
+		case ']':
+			s.pos++
+			node.end = s.pos
+			return node, nil
+		default:
+			return nil, s.errorf("expected ',' or ']'")
+		}
+	}
+}
+
+// jsonEditor splices rendered values into source bytes, reusing the file's own
+// indentation unit and line endings.
+type jsonEditor struct {
+	data    []byte
+	unit    string
+	newline string
+	compact bool
+}
+
+func newJSONEditor(data []byte, root *jsonNode) *jsonEditor {
+	e := &jsonEditor{data: data, unit: detectIndentUnit(data), newline: "\n"}
+	if bytes.Contains(data, []byte("\r\n")) {
+		e.newline = "\r\n"
+	}
+	// A single-line file with content is kept on one line; an empty one is
+	// expanded so new files come out pretty-printed.
+	e.compact = !bytes.Contains(bytes.TrimSpace(data), []byte("\n")) && len(root.members) > 0
+	return e
+}
+
+// detectIndentUnit returns the leading whitespace of the first indented line,
+// which in a pretty-printed file is one level of indentation. Defaults to two spaces.
+func detectIndentUnit(data []byte) string {
+	for i := 0; i < len(data); i++ {
+		if data[i] != '\n' {
+			continue
+		}
+		j := i + 1
+		for j < len(data) && (data[j] == ' ' || data[j] == '\t') {
+			j++
+		}
+		if j > i+1 && j < len(data) && data[j] != '\n' && data[j] != '\r' {
+			return string(data[i+1 : j])
+		}
+	}
+	return "  "
+}
+
+// lineIndent returns the leading whitespace of the line containing pos.
+func (e *jsonEditor) lineIndent(pos int) string {
+	start := bytes.LastIndexByte(e.data[:pos], '\n') + 1
+	end := start
+	for end < len(e.data) && (e.data[end] == ' ' || e.data[end] == '\t') {
+		end++
+	}
+	return string(e.data[start:end])
+}
+
+// inline reports whether obj keeps its members on the same line as its brace.
+func (e *jsonEditor) inline(obj *jsonNode) bool {
+	if len(obj.members) == 0 {
+		return e.compact
+	}
+	return !bytes.Contains(e.data[obj.start:obj.members[0].keyStart], []byte("\n"))
+}
+
+// render encodes value without HTML escaping. When indent is non-nil the output
+// is pretty-printed with continuation lines prefixed by *indent.
+func (e *jsonEditor) render(value any, indent *string) (string, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if indent != nil {
+		enc.SetIndent(*indent, e.unit)
+	}
+	if err := enc.Encode(value); err != nil {
+		return "", fmt.Errorf("jsonedit: marshal: %w", err)
+	}
+	out := string(bytes.TrimRight(buf.Bytes(), "\n"))
+	if e.newline != "\n" {
+		out = string(bytes.ReplaceAll([]byte(out), []byte("\n"), []byte(e.newline)))
+	}
+	return out, nil
+}
+
+func (e *jsonEditor) splice(start, end int, text string) []byte {
+	out := make([]byte, 0, len(e.data)-(end-start)+len(text))
+	out = append(out, e.data[:start]...)
+	out = append(out, text...)
+	return append(out, e.data[end:]...)
+}
+
+// replaceValue swaps the value of member m (inside obj) for value.
+func (e *jsonEditor) replaceValue(obj *jsonNode, m jsonMember, value any) ([]byte, error) {
+	var indent *string
+	if !e.inline(obj) {
+		ind := e.lineIndent(m.keyStart)
+		indent = &ind
+	}
+	text, err := e.render(value, indent)
+	if err != nil {
+		return nil, err
+	}
+	return e.splice(m.value.start, m.value.end, text), nil
+}
+
+// insertMember appends key: value as the last member of obj.
+func (e *jsonEditor) insertMember(obj *jsonNode, key string, value any) ([]byte, error) {
+	keyText, err := e.render(key, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(obj.members) == 0 {
+		if e.inline(obj) {
+			valueText, err := e.render(value, nil)
+			if err != nil {
+				return nil, err
+			}
+			return e.splice(obj.start+1, obj.end-1, keyText+":"+valueText), nil
+		}
+		objIndent := e.lineIndent(obj.start)
+		childIndent := objIndent + e.unit
+		valueText, err := e.render(value, &childIndent)
+		if err != nil {
+			return nil, err
+		}
+		text := e.newline + childIndent + keyText + ": " + valueText + e.newline + objIndent
+		return e.splice(obj.start+1, obj.end-1, text), nil
+	}
+
+	last := obj.members[len(obj.members)-1]
+	kvSep := string(e.data[last.keyEnd:last.value.start])
+	if e.inline(obj) {
+		sep := ","
+		if len(obj.members) > 1 {
+			sep = string(e.data[obj.members[0].value.end:obj.members[1].keyStart])
+		} else if bytes.ContainsRune([]byte(kvSep), ' ') {
+			sep = ", "
+		}
+		valueText, err := e.render(value, nil)
+		if err != nil {
+			return nil, err
+		}
+		return e.splice(last.value.end, last.value.end, sep+keyText+kvSep+valueText), nil
+	}
+	indent := e.lineIndent(last.keyStart)
+	valueText, err := e.render(value, &indent)
+	if err != nil {
+		return nil, err
+	}
+	text := "," + e.newline + indent + keyText + kvSep + valueText
+	return e.splice(last.value.end, last.value.end, text), nil
+}
+
+// removeMember deletes member i of obj along with its separator.
+func (e *jsonEditor) removeMember(obj *jsonNode, i int) []byte {
+	switch {
+	case len(obj.members) == 1:
+		return e.splice(obj.start+1, obj.end-1, "")
+	case i < len(obj.members)-1:
+		return e.splice(obj.members[i].keyStart, obj.members[i+1].keyStart, "")
+	default:
+		return e.splice(obj.members[i-1].value.end, obj.members[i].value.end, "")
+	}
+}
+
+// multiline reports whether arr puts its items on their own lines.
+func (e *jsonEditor) multiline(arr *jsonNode) bool {
+	if len(arr.items) == 0 {
+		return !e.compact
+	}
+	return bytes.Contains(e.data[arr.start:arr.items[0].start], []byte("\n"))
+}
+
+// itemSeparator returns the text placed between two items of arr.
+func (e *jsonEditor) itemSeparator(arr *jsonNode) string {
+	if e.multiline(arr) {
+		return "," + e.newline + e.itemIndent(arr)
+	}
+	if len(arr.items) > 1 {
+		return string(e.data[arr.items[0].end:arr.items[1].start])
+	}
+	if e.compact {
+		return ","
+	}
+	return ", "
+}
+
+// itemIndent returns the indentation of the items of a multi-line array.
+func (e *jsonEditor) itemIndent(arr *jsonNode) string {
+	if len(arr.items) == 0 {
+		return e.lineIndent(arr.start) + e.unit
+	}
+	return e.lineIndent(arr.items[0].start)
+}
+
+// renderItem encodes value for placement as an item of arr.
+func (e *jsonEditor) renderItem(arr *jsonNode, value any) (string, error) {
+	if !e.multiline(arr) {
+		return e.render(value, nil)
+	}
+	indent := e.itemIndent(arr)
+	return e.render(value, &indent)
+}
+
+// replaceItem swaps item i of arr for value.
+func (e *jsonEditor) replaceItem(arr *jsonNode, i int, value any) ([]byte, error) {
+	text, err := e.renderItem(arr, value)
+	if err != nil {
+		return nil, err
+	}
+	return e.splice(arr.items[i].start, arr.items[i].end, text), nil
+}
+
+// insertItem inserts value into arr before item i, or appends it when i is
+// the number of items.
+func (e *jsonEditor) insertItem(arr *jsonNode, i int, value any) ([]byte, error) {
+	text, err := e.renderItem(arr, value)
+	if err != nil {
+		return nil, err
+	}
+	if len(arr.items) == 0 {
+		if e.multiline(arr) {
+			text = e.newline + e.itemIndent(arr) + text + e.newline + e.lineIndent(arr.start)
+		}
+		return e.splice(arr.start+1, arr.end-1, text), nil
+	}
+	sep := e.itemSeparator(arr)
+	if i == len(arr.items) {
+		end := arr.items[i-1].end
+		return e.splice(end, end, sep+text), nil
+	}
+	start := arr.items[i].start
+	return e.splice(start, start, text+sep), nil
+}
+
+// removeItem deletes item i of arr along with its separator.
+func (e *jsonEditor) removeItem(arr *jsonNode, i int) []byte {
+	switch {
+	case len(arr.items) == 1:
+		return e.splice(arr.start+1, arr.end-1, "")
+	case i < len(arr.items)-1:
+		return e.splice(arr.items[i].start, arr.items[i+1].start, "")
+	default:
+		return e.splice(arr.items[i-1].end, arr.items[i].end, "")
+	}
+}
+
+// nestValue wraps value in one container per key, innermost last: an array
+// for "-" (append to a list that does not exist yet) and an object otherwise.
+func nestValue(keys []string, value any) any {
+	for i := len(keys) - 1; i >= 0; i-- {
+		if keys[i] == "-" {
+			value = []any{value}
+			continue
+		}
+		value = JsonObject{keys[i]: value}
+	}
+	return value
+}
+
+// parseJSONForEdit parses data as a JSON object for editing. Blank input is
+// treated as an empty object.
+func parseJSONForEdit(data []byte) ([]byte, *jsonNode, error) {
+	if len(bytes.TrimSpace(data)) == 0 {
+		data = []byte("{}\n")
+	}
+	root, err := parseJSONTree(data)
+	if err != nil {
+		return nil, nil, err
+	}
+	if root.kind != '{' {
+		return nil, nil, fmt.Errorf("jsonedit: top-level value is not an object")
+	}
+	return data, root, nil
+}
+
+// SetJSONAtPath returns data with the value at keyPath (see ParsePath) set to
+// value. Only the bytes of the targeted value change: key order, indentation
+// and whitespace elsewhere are preserved. Missing intermediate objects are
+// created, and a scalar intermediate is replaced, as with SetAtPath. New keys
+// are appended after the last existing member. An array index replaces that
+// element; the index one past the end, or "-", appends. Blank data is treated as {}.
+func SetJSONAtPath(data []byte, keyPath string, value any) ([]byte, error) {
+	keys, err := ParsePath(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return setJSONAtKeys(data, keys, value, false)
+}
+
+// InsertJSONAtPath is SetJSONAtPath, except that when keyPath ends in an array
+// index the value is inserted before that element instead of replacing it.
+func InsertJSONAtPath(data []byte, keyPath string, value any) ([]byte, error) {
+	keys, err := ParsePath(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return setJSONAtKeys(data, keys, value, true)
+}
+
+// setJSONAtKeys is SetJSONAtPath (or InsertJSONAtPath) for parsed keys.
+func setJSONAtKeys(data []byte, keys []string, value any, insert bool) ([]byte, error) {
+	data, root, err := parseJSONForEdit(data)
+	if err != nil {
+		return nil, err
+	}
+	e := newJSONEditor(data, root)
+	node := root
+	for i, key := range keys {
+		last := i == len(keys)-1
+		rest := nestValue(keys[i+1:], value)
+		if node.kind == '[' {
+			idx := len(node.items)
+			if key != "-" {
+				if idx, err = arrayIndex(key, len(node.items)); err != nil {
+					return nil, fmt.Errorf("jsonedit: %w", err)
+				}
+			}
+			if idx == len(node.items) || (insert && last) {
+				return e.insertItem(node, idx, rest)
+			}
+			item := node.items[idx]
+			if last || item.kind == 0 {
+				return e.replaceItem(node, idx, rest)
+			}
+			node = item
+			continue
+		}
+		idx := node.memberIndex(key)
+		if idx < 0 {
+			return e.insertMember(node, key, rest)
+		}
+		m := node.members[idx]
+		if last || m.value.kind == 0 {
+			return e.replaceValue(node, m, rest)
+		}
+		node = m.value
+	}
+	return data, nil
+}
+
+// DeleteJSONAtPath returns data with the value at keyPath removed, preserving
+// the formatting of everything else. Removing an array element shifts later
+// elements down. Data is returned unchanged if the path does not exist.
+func DeleteJSONAtPath(data []byte, keyPath string) ([]byte, error) {
+	keys, err := ParsePath(keyPath)
+	if err != nil {
+		return nil, err
+	}
+	return deleteJSONAtKeys(data, keys)
+}
+
+// deleteJSONAtKeys is DeleteJSONAtPath for parsed keys.
+func deleteJSONAtKeys(data []byte, keys []string) ([]byte, error) {
+	data, root, err := parseJSONForEdit(data)
+	if err != nil {
+		return nil, err
+	}
+	e := newJSONEditor(data, root)
+	node := root
+	for i, key := range keys {
+		last := i == len(keys)-1
+		var child *jsonNode
+		switch node.kind {
+		case '[':
+			idx, err := arrayIndex(key, len(node.items)-1)
+			if err != nil {
+				return data, nil
+			}
+			if last {
+				return e.removeItem(node, idx), nil
+			}
+			child = node.items[idx]
+		case '{':
+			idx := node.memberIndex(key)
+			if idx < 0 {
+				return data, nil
+			}
+			if last {
+				return e.removeMember(node, idx), nil
+			}
+			child = node.members[idx].value
+		}
+		if child.kind == 0 {
+			return data, nil
+		}
+		node = child
+	}
+	return data, nil
+}
@@ -0,0 +1,267 @@
+package lib_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+const editFixture = `{
+    "model": "opus",
+    "env": {
+        "B": "2",
+        "A": "1"
+    },
+    "permissions": {
+        "allow": ["Read"]
+    }
+}
+`
+
+func TestSetJSONAtPath(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		keyPath string
+		value   any
+		want    string
+	}{
+		{
+			name: "replace scalar keeps order and indent", in: editFixture, keyPath: "model", value: "sonnet",
+			want: `{
+    "model": "sonnet",
+    "env": {
+        "B": "2",
+        "A": "1"
+    },
+    "permissions": {
+        "allow": ["Read"]
+    }
+}
+`,
+		},
+		{
+			name: "new nested key appended to its object", in: editFixture, keyPath: "env.C", value: "3",
+			want: `{
+    "model": "opus",
+    "env": {
+        "B": "2",
+        "A": "1",
+        "C": "3"
+    },
+    "permissions": {
+        "allow": ["Read"]
+    }
+}
+`,
+		},
+		{
+			name: "new object value uses file indentation", in: editFixture, keyPath: "sandbox.network.httpProxyPort", value: 8080,
+			want: `{
+    "model": "opus",
+    "env": {
+        "B": "2",
+        "A": "1"
+    },
+    "permissions": {
+        "allow": ["Read"]
+    },
+    "sandbox": {
+        "network": {
+            "httpProxyPort": 8080
+        }
+    }
+}
+`,
+		},
+		{
+			name: "replacing an array only touches that array", in: editFixture, keyPath: "permissions.allow", value: []any{"Read", "Edit"},
+			want: `{
+    "model": "opus",
+    "env": {
+        "B": "2",
+        "A": "1"
+    },
+    "permissions": {
+        "allow": [
+            "Read",
+            "Edit"
+        ]
+    }
+}
+`,
+		},
+		{
+			name: "empty file", in: "", keyPath: "model", value: "opus",
+			want: "{\n  \"model\": \"opus\"\n}\n",
+		},
+		{
+			name: "empty object", in: "{}\n", keyPath: "env.A", value: "1",
+			want: "{\n  \"env\": {\n    \"A\": \"1\"\n  }\n}\n",
+		},
+		{
+			name: "compact file stays compact", in: `{"a":1,"b":2}`, keyPath: "c", value: true,
+			want: `{"a":1,"b":2,"c":true}`,
+		},
+		{
+			name: "tab indentation", in: "{\n\t\"a\": 1\n}\n", keyPath: "b", value: "x",
+			want: "{\n\t\"a\": 1,\n\t\"b\": \"x\"\n}\n",
+		},
+		{
+			name: "crlf line endings", in: "{\r\n  \"a\": 1\r\n}\r\n", keyPath: "b", value: "x",
+			want: "{\r\n  \"a\": 1,\r\n  \"b\": \"x\"\r\n}\r\n",
+		},
+		{
+			name: "scalar intermediate is replaced", in: "{\n  \"statusLine\": \"off\"\n}\n", keyPath: "statusLine.type", value: "command",
+			want: "{\n  \"statusLine\": {\n    \"type\": \"command\"\n  }\n}\n",
+		},
+		{
+			name: "no HTML escaping", in: "{}", keyPath: "cmd", value: "a && b > c",
+			want: "{\n  \"cmd\": \"a && b > c\"\n}",
+		},
+		{
+			name: "quoted key containing dots", in: "{\n  \"env\": {}\n}\n", keyPath: `env["my.var"]`, value: "1",
+			want: "{\n  \"env\": {\n    \"my.var\": \"1\"\n  }\n}\n",
+		},
+		{
+			name: "replace inline array item", in: editFixture, keyPath: "permissions.allow[0]", value: "Bash(npm run test.unit)",
+			want: `{
+    "model": "opus",
+    "env": {
+        "B": "2",
+        "A": "1"
+    },
+    "permissions": {
+        "allow": ["Bash(npm run test.unit)"]
+    }
+}
+`,
+		},
+		{
+			name: "append to inline array", in: editFixture, keyPath: "permissions.allow[-]", value: "Edit",
+			want: `{
+    "model": "opus",
+    "env": {
+        "B": "2",
+        "A": "1"
+    },
+    "permissions": {
+        "allow": ["Read", "Edit"]
+    }
+}
+`,
+		},
+		{
+			name: "append to multi-line array", in: "{\n  \"a\": [\n    1,\n    2\n  ]\n}\n", keyPath: "a[2]", value: 3,
+			want: "{\n  \"a\": [\n    1,\n    2,\n    3\n  ]\n}\n",
+		},
+		{
+			name: "append to empty array", in: "{\n  \"a\": []\n}\n", keyPath: "a[-]", value: 1,
+			want: "{\n  \"a\": [\n    1\n  ]\n}\n",
+		},
+		{
+			name: "append creates missing list", in: "{}\n", keyPath: "permissions.deny[-]", value: "WebFetch",
+			want: "{\n  \"permissions\": {\n    \"deny\": [\n      \"WebFetch\"\n    ]\n  }\n}\n",
+		},
+		{
+			name: "key inside array item", in: `{"hooks":{"Stop":[{"matcher":"a"}]}}`, keyPath: "/hooks/Stop/0/matcher", value: "b",
+			want: `{"hooks":{"Stop":[{"matcher":"b"}]}}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := lib.SetJSONAtPath([]byte(tc.in), tc.keyPath, tc.value)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+			assert.True(t, json.Valid(got))
+		})
+	}
+}
+
+func TestDeleteJSONAtPath(t *testing.T) {
+	cases := []struct {
+		name    string
+		in      string
+		keyPath string
+		want    string
+	}{
+		{
+			name: "first member", in: "{\n  \"a\": 1,\n  \"b\": 2,\n  \"c\": 3\n}\n", keyPath: "a",
+			want: "{\n  \"b\": 2,\n  \"c\": 3\n}\n",
+		},
+		{
+			name: "middle member", in: "{\n  \"a\": 1,\n  \"b\": 2,\n  \"c\": 3\n}\n", keyPath: "b",
+			want: "{\n  \"a\": 1,\n  \"c\": 3\n}\n",
+		},
+		{
+			name: "last member", in: "{\n  \"a\": 1,\n  \"b\": 2\n}\n", keyPath: "b",
+			want: "{\n  \"a\": 1\n}\n",
+		},
+		{
+			name: "only member", in: "{\n  \"env\": {\n    \"A\": \"1\"\n  }\n}\n", keyPath: "env.A",
+			want: "{\n  \"env\": {}\n}\n",
+		},
+		{
+			name: "missing key is a no-op", in: "{\n  \"a\": 1\n}\n", keyPath: "x.y",
+			want: "{\n  \"a\": 1\n}\n",
+		},
+		{
+			name: "compact", in: `{"a":1,"b":2}`, keyPath: "a",
+			want: `{"b":2}`,
+		},
+		{
+			name: "quoted key containing dots", in: `{"mcpServers":{"my.server":{},"b":{}}}`, keyPath: `mcpServers["my.server"]`,
+			want: `{"mcpServers":{"b":{}}}`,
+		},
+		{
+			name: "middle array item", in: "{\n  \"a\": [\n    1,\n    2,\n    3\n  ]\n}\n", keyPath: "a[1]",
+			want: "{\n  \"a\": [\n    1,\n    3\n  ]\n}\n",
+		},
+		{
+			name: "last array item", in: `{"a":[1, 2]}`, keyPath: "a[1]",
+			want: `{"a":[1]}`,
+		},
+		{
+			name: "out of range index is a no-op", in: `{"a":[1]}`, keyPath: "a[5]",
+			want: `{"a":[1]}`,
+		},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := lib.DeleteJSONAtPath([]byte(tc.in), tc.keyPath)
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, string(got))
+		})
+	}
+}
+
+func TestSetJSONAtPath_DuplicateKeyEditsLastOccurrence(t *testing.T) {
+	got, err := lib.SetJSONAtPath([]byte(`{"a":1,"a":2}`), "a", 3)
+	require.NoError(t, err)
+	assert.Equal(t, `{"a":1,"a":3}`, string(got))
+}
+
+func TestSetJSONAtPath_RejectsNonObjectRoot(t *testing.T) {
+	_, err := lib.SetJSONAtPath([]byte(`["a"]`), "a", 1)
+	assert.Error(t, err)
+}
+
+func TestInsertJSONAtPath(t *testing.T) {
+	got, err := lib.InsertJSONAtPath([]byte(`{"allow": ["Read", "Edit"]}`), "allow[1]", "Write")
+	require.NoError(t, err)
+	assert.Equal(t, `{"allow": ["Read", "Write", "Edit"]}`, string(got))
+
+	got, err = lib.InsertJSONAtPath([]byte("{\n  \"a\": [\n    2\n  ]\n}\n"), "a[0]", 1)
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"a\": [\n    1,\n    2\n  ]\n}\n", string(got))
+}
+
+func TestSetJSONAtPath_RejectsBadIndex(t *testing.T) {
+	_, err := lib.SetJSONAtPath([]byte(`{"a":[1]}`), "a[3]", 1)
+	assert.Error(t, err)
+	_, err = lib.SetJSONAtPath([]byte(`{"a":[1]}`), "a.b", 1)
+	assert.Error(t, err, "a non-index key cannot address an array element")
+}
@@ -1,92 +1,308 @@
 package lib
 
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+)
+
 // JsonObject is an alias for a JSON object (map of string keys to arbitrary values).
 //
 //nolint:revive // JsonObject is an established API name; renaming to JSONObject would break many call sites
 type JsonObject = map[string]any
 
-// GetAtPath traverses obj following the dot-separated path and returns the value
-// at that location. Returns (nil, false) if any segment is missing or an intermediate
-// value is not a JsonObject. Returns (nil, true) when the key is present with a JSON
-// null value, distinguishing it from the "key not found" case.
-func GetAtPath(obj JsonObject, path string) (any, bool) {
-	keys := splitPath(path)
-	var current any = obj
-	for _, key := range keys {
-		m, ok := current.(JsonObject)
-		if !ok {
-			return nil, false
+// Key paths address a value inside a settings object. Two spellings are accepted:
+//
+//   - Dot notation with brackets: keys are separated by dots, and a key that
+//     contains a dot or bracket is written as a quoted string in brackets. Array
+//     elements are addressed by index in brackets, and [-] is the position after
+//     the last element. For example: mcpServers["my.server"].command,
+//     permissions.allow[3], permissions.allow[-].
+//   - JSON Pointer (RFC 6901), recognised by its leading slash, e.g.
+//     /permissions/allow/3 or /env/a~1b for the key "a/b".
+//
+// Both parse to the same list of keys. A numeric key (or "-") is an array index
+// when the value it is applied to is an array and an object key otherwise, as in
+// JSON Pointer. JoinPath produces the dot spelling.
+
+// ParsePath splits keyPath into its keys.
+func ParsePath(keyPath string) ([]string, error) {
+	if keyPath == "" {
+		return nil, errors.New("key path is empty")
+	}
+	if strings.HasPrefix(keyPath, "/") {
+		return parseJSONPointer(keyPath)
+	}
+
+	var keys []string
+	i := 0
+	for i < len(keyPath) {
+		if keyPath[i] != '[' {
+			end := i
+			for end < len(keyPath) && keyPath[end] != '.' && keyPath[end] != '[' {
+				end++
+			}
+			if end == i {
+				return nil, fmt.Errorf("invalid key path %q: empty key at offset %d", keyPath, i)
+			}
+			if strings.ContainsAny(keyPath[i:end], `]"`) {
+				return nil, fmt.Errorf("invalid key path %q: unexpected character in %q", keyPath, keyPath[i:end])
+			}
+			keys = append(keys, keyPath[i:end])
+			i = end
 		}
-		val, exists := m[key]
-		if !exists {
-			return nil, false
+		for i < len(keyPath) && keyPath[i] == '[' {
+			key, next, err := parseBracket(keyPath, i)
+			if err != nil {
+				return nil, err
+			}
+			keys = append(keys, key)
+			i = next
+		}
+		if i < len(keyPath) {
+			if keyPath[i] != '.' {
+				return nil, fmt.Errorf("invalid key path %q: expected '.' at offset %d", keyPath, i)
+			}
+			i++
+			if i == len(keyPath) {
+				return nil, fmt.Errorf("invalid key path %q: trailing '.'", keyPath)
+			}
 		}
-		current = val
 	}
-	return current, true
+	return keys, nil
 }
 
-// SetAtPath returns a new JsonObject with value placed at the dot-separated path.
-// Intermediate objects are created as needed. The original obj is not mutated.
-func SetAtPath(obj JsonObject, path string, value any) JsonObject {
-	keys := splitPath(path)
-	return setRecursive(obj, keys, value)
+// parseBracket parses the bracketed key starting at keyPath[start] == '[' and
+// returns it with the offset just past the closing bracket.
+func parseBracket(keyPath string, start int) (string, int, error) {
+	i := start + 1
+	if i < len(keyPath) && keyPath[i] == '"' {
+		end := i + 1
+		for end < len(keyPath) && keyPath[end] != '"' {
+			if keyPath[end] == '\\' {
+				end++
+			}
+			end++
+		}
+		if end >= len(keyPath) || end+1 >= len(keyPath) || keyPath[end+1] != ']' {
+			return "", 0, fmt.Errorf("invalid key path %q: unterminated quoted key at offset %d", keyPath, start)
+		}
+		var key string
+		if err := json.Unmarshal([]byte(keyPath[i:end+1]), &key); err != nil {
+			return "", 0, fmt.Errorf("invalid key path %q: bad quoted key: %w", keyPath, err)
+		}
+		return key, end + 2, nil
+	}
+	end := strings.IndexByte(keyPath[i:], ']')
+	if end < 0 {
+		return "", 0, fmt.Errorf("invalid key path %q: unterminated '[' at offset %d", keyPath, start)
+	}
+	index := keyPath[i : i+end]
+	if !isArrayIndexKey(index) {
+		return "", 0, fmt.Errorf("invalid key path %q: invalid array index %q; quote keys that are not array indexes", keyPath, index)
+	}
+	return index, i + end + 1, nil
 }
 
-func setRecursive(obj JsonObject, keys []string, value any) JsonObject {
-	result := shallowCopy(obj)
-	if len(keys) == 1 {
-		result[keys[0]] = value
-		return result
+// isArrayIndexKey reports whether key can address an array element: a
+// non-negative integer without leading zeros, or "-".
+func isArrayIndexKey(key string) bool {
+	if key == "-" {
+		return true
 	}
-	// More than one key: descend into child
-	first := keys[0]
-	rest := keys[1:]
-	child, ok := result[first].(JsonObject)
-	if !ok {
-		child = JsonObject{}
+	_, err := arrayIndex(key, int(^uint(0)>>1))
+	return err == nil
+}
+
+// JoinPath builds a key path from keys, quoting any key that would not survive
+// ParsePath as a bare dotted segment. It is the inverse of ParsePath.
+func JoinPath(keys ...string) string {
+	var b strings.Builder
+	for i, key := range keys {
+		b.WriteString(pathSegment(key, i == 0))
 	}
-	result[first] = setRecursive(child, rest, value)
-	return result
+	return b.String()
 }
 
-// DeleteAtPath returns a new JsonObject with the key at the dot-separated path
-// removed. The original obj is not mutated.
-func DeleteAtPath(obj JsonObject, path string) JsonObject {
-	keys := splitPath(path)
-	return deleteRecursive(obj, keys)
+// appendPath returns the key path of key inside the value at prefix.
+func appendPath(prefix, key string) string {
+	return prefix + pathSegment(key, prefix == "")
 }
 
-func deleteRecursive(obj JsonObject, keys []string) JsonObject {
-	result := shallowCopy(obj)
-	if len(keys) == 1 {
-		delete(result, keys[0])
-		return result
+func pathSegment(key string, first bool) string {
+	if key == "" || strings.ContainsAny(key, `.[]"`) || (first && strings.HasPrefix(key, "/")) {
+		quoted, _ := json.Marshal(key) //nolint:errcheck // marshalling a string cannot fail
+		return "[" + string(quoted) + "]"
 	}
-	first := keys[0]
-	rest := keys[1:]
-	child, ok := result[first].(JsonObject)
-	if !ok {
-		// Intermediate is not an object — nothing to delete, return copy
-		return result
+	if first {
+		return key
 	}
-	result[first] = deleteRecursive(child, rest)
-	return result
+	return "." + key
 }
 
-// splitPath splits a dot-separated path into its component keys.
-func splitPath(path string) []string {
-	// Manual split to avoid importing strings and to handle edge cases cleanly.
-	// We need ALL segments (not just first two), so we iterate.
-	var keys []string
-	start := 0
-	for i := 0; i <= len(path); i++ {
-		if i == len(path) || path[i] == '.' {
-			keys = append(keys, path[start:i])
-			start = i + 1
+// splitPath splits keyPath with ParsePath. A path that does not parse is split on
+// dots, as all paths were before the bracket grammar existed.
+func splitPath(keyPath string) []string {
+	if keys, err := ParsePath(keyPath); err == nil {
+		return keys
+	}
+	return strings.Split(keyPath, ".")
+}
+
+// parseJSONPointer splits an RFC 6901 JSON Pointer into unescaped reference
+// tokens. The empty pointer refers to the whole document.
+func parseJSONPointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("invalid JSON pointer %q: must start with '/'", pointer)
+	}
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = strings.NewReplacer("~1", "/", "~0", "~").Replace(token)
+	}
+	return tokens, nil
+}
+
+// arrayIndex parses token as an array index no greater than maxIndex.
+func arrayIndex(token string, maxIndex int) (int, error) {
+	i, err := strconv.Atoi(token)
+	if err != nil || i < 0 || (len(token) > 1 && token[0] == '0') {
+		return 0, fmt.Errorf("invalid array index %q", token)
+	}
+	if i > maxIndex {
+		return 0, fmt.Errorf("array index %d out of range", i)
+	}
+	return i, nil
+}
+
+// pathChild returns the value at key within container, which must be an object
+// or an array.
+func pathChild(container any, key string) (any, error) {
+	switch c := container.(type) {
+	case JsonObject:
+		v, ok := c[key]
+		if !ok {
+			return nil, fmt.Errorf("path not found: key %q does not exist", key)
+		}
+		return v, nil
+	case []any:
+		i, err := arrayIndex(key, len(c)-1)
+		if err != nil {
+			return nil, err
+		}
+		return c[i], nil
+	default:
+		return nil, fmt.Errorf("path not found: %q is not inside an object or array", key)
+	}
+}
+
+func isContainer(v any) bool {
+	switch v.(type) {
+	case JsonObject, []any:
+		return true
+	}
+	return false
+}
+
+// GetAtPath traverses obj following path and returns the value at that location.
+// Returns (nil, false) if any key is missing or an intermediate value is not an
+// object or array. Returns (nil, true) when the key is present with a JSON null
+// value, distinguishing it from the "key not found" case.
+func GetAtPath(obj JsonObject, path string) (any, bool) {
+	var current any = obj
+	for _, key := range splitPath(path) {
+		child, err := pathChild(current, key)
+		if err != nil {
+			return nil, false
+		}
+		current = child
+	}
+	return current, true
+}
+
+// SetAtPath returns a new JsonObject with value placed at path. Intermediate
+// objects are created as needed and a scalar intermediate is replaced. An array
+// index replaces that element, and the index one past the end (or "-") appends.
+// A path that cannot be applied, such as an out-of-range index, leaves the
+// result equal to obj. The original obj is not mutated.
+func SetAtPath(obj JsonObject, path string, value any) JsonObject {
+	return setOrCopy(obj, path, value, false)
+}
+
+// InsertAtPath is SetAtPath, except that when path ends in an array index the
+// value is inserted before that element instead of replacing it.
+func InsertAtPath(obj JsonObject, path string, value any) JsonObject {
+	return setOrCopy(obj, path, value, true)
+}
+
+func setOrCopy(obj JsonObject, path string, value any, insert bool) JsonObject {
+	result, err := setValueAt(obj, splitPath(path), value, insert)
+	if err != nil {
+		return shallowCopy(obj)
+	}
+	return result.(JsonObject) //nolint:forcetypeassert // setValueAt preserves the container type
+}
+
+// setValueAt returns a copy of container with value set at keys. Containers
+// along the path are copied, never mutated.
+func setValueAt(container any, keys []string, value any, insert bool) (any, error) {
+	key, rest := keys[0], keys[1:]
+	switch c := container.(type) {
+	case JsonObject:
+		result := shallowCopy(c)
+		if child, ok := c[key]; ok && len(rest) > 0 && isContainer(child) {
+			updated, err := setValueAt(child, rest, value, insert)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = updated
+			return result, nil
+		}
+		result[key] = nestValue(rest, value)
+		return result, nil
+	case []any:
+		i := len(c)
+		if key != "-" {
+			var err error
+			if i, err = arrayIndex(key, len(c)); err != nil {
+				return nil, err
+			}
+		}
+		if i == len(c) || (insert && len(rest) == 0) {
+			result := make([]any, 0, len(c)+1)
+			result = append(result, c[:i]...)
+			result = append(result, nestValue(rest, value))
+			return append(result, c[i:]...), nil
+		}
+		result := append([]any(nil), c...)
+		if len(rest) > 0 && isContainer(c[i]) {
+			updated, err := setValueAt(c[i], rest, value, insert)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = updated
+			return result, nil
 		}
+		result[i] = nestValue(rest, value)
+		return result, nil
+	default:
+		return nil, fmt.Errorf("cannot set %q inside a non-container value", key)
+	}
+}
+
+// DeleteAtPath returns a new JsonObject with the value at path removed; an
+// array element is removed and later elements shift down. Returns a copy of obj
+// if path does not exist. The original obj is not mutated.
+func DeleteAtPath(obj JsonObject, path string) JsonObject {
+	result, err := pointerRemove(obj, splitPath(path))
+	if err != nil {
+		return shallowCopy(obj)
 	}
-	return keys
+	return result.(JsonObject) //nolint:forcetypeassert // pointerRemove preserves the container type
 }
 
 // shallowCopy returns a shallow copy of a JsonObject.
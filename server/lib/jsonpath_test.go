@@ -4,6 +4,7 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"fieldstation/lib"
 )
 
@@ -117,3 +118,85 @@ func TestDeleteAtPath_MissingKeyReturnsCopy(t *testing.T) {
 	result := lib.DeleteAtPath(obj, "b")
 	assert.Equal(t, lib.JsonObject{"a": 1}, result)
 }
+
+func TestParsePath(t *testing.T) {
+	tests := []struct {
+		path string
+		want []string
+	}{
+		{path: "model", want: []string{"model"}},
+		{path: "a.b.c", want: []string{"a", "b", "c"}},
+		{path: `mcpServers["my.server"].command`, want: []string{"mcpServers", "my.server", "command"}},
+		{path: `permissions.allow["Bash(npm run test.unit)"]`, want: []string{"permissions", "allow", "Bash(npm run test.unit)"}},
+		{path: "permissions.allow[3]", want: []string{"permissions", "allow", "3"}},
+		{path: "permissions.allow[-]", want: []string{"permissions", "allow", "-"}},
+		{path: "hooks.Stop[0].hooks[1]", want: []string{"hooks", "Stop", "0", "hooks", "1"}},
+		{path: `["a.b"]`, want: []string{"a.b"}},
+		{path: `env["quote\"d"]`, want: []string{"env", `quote"d`}},
+		{path: "/permissions/allow/3", want: []string{"permissions", "allow", "3"}},
+		{path: "/env/a~1b~0c", want: []string{"env", "a/b~c"}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.path, func(t *testing.T) {
+			got, err := lib.ParsePath(tt.path)
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestParsePath_Errors(t *testing.T) {
+	for _, path := range []string{"", "a..b", "a.", ".a", "a[", "a[x]", "a[01]", `a["x"`, `a["x"]b`, "a]b"} {
+		t.Run(path, func(t *testing.T) {
+			_, err := lib.ParsePath(path)
+			assert.Error(t, err)
+		})
+	}
+}
+
+func TestJoinPath_RoundTrips(t *testing.T) {
+	tests := []struct {
+		keys []string
+		want string
+	}{
+		{keys: []string{"permissions", "allow"}, want: "permissions.allow"},
+		{keys: []string{"mcpServers", "my.server"}, want: `mcpServers["my.server"]`},
+		{keys: []string{"/abs"}, want: `["/abs"]`},
+		{keys: []string{"a", "/abs", ""}, want: `a./abs[""]`},
+	}
+	for _, tt := range tests {
+		got := lib.JoinPath(tt.keys...)
+		assert.Equal(t, tt.want, got)
+		parsed, err := lib.ParsePath(got)
+		require.NoError(t, err)
+		assert.Equal(t, tt.keys, parsed)
+	}
+}
+
+func TestGetAtPath_ArrayIndexAndQuotedKey(t *testing.T) {
+	obj := lib.JsonObject{
+		"permissions": lib.JsonObject{"allow": []any{"Read", "Edit"}},
+		"env":         lib.JsonObject{"my.var": "1"},
+	}
+	got, ok := lib.GetAtPath(obj, "permissions.allow[1]")
+	assert.True(t, ok)
+	assert.Equal(t, "Edit", got)
+
+	_, ok = lib.GetAtPath(obj, "permissions.allow[2]")
+	assert.False(t, ok)
+
+	got, ok = lib.GetAtPath(obj, `env["my.var"]`)
+	assert.True(t, ok)
+	assert.Equal(t, "1", got)
+}
+
+func TestArrayEdits_InMemory(t *testing.T) {
+	obj := lib.JsonObject{"allow": []any{"a", "b"}}
+
+	assert.Equal(t, []any{"a", "x"}, lib.SetAtPath(obj, "allow[1]", "x")["allow"])
+	assert.Equal(t, []any{"a", "b", "x"}, lib.SetAtPath(obj, "allow[-]", "x")["allow"])
+	assert.Equal(t, []any{"x", "a", "b"}, lib.InsertAtPath(obj, "allow[0]", "x")["allow"])
+	assert.Equal(t, []any{"b"}, lib.DeleteAtPath(obj, "allow[0]")["allow"])
+	assert.Equal(t, []any{"a", "b"}, lib.SetAtPath(obj, "allow[7]", "x")["allow"], "out of range leaves the array alone")
+	assert.Equal(t, []any{"a", "b"}, obj["allow"], "original not mutated")
+}
@@ -0,0 +1,96 @@
+package lib
+
+import "bytes"
+
+// RepairJSON makes a best-effort attempt to turn hand-edited JSON into valid
+// JSON. It strips a leading byte order mark, // and /* */ comments, and commas
+// that directly precede a closing brace or bracket. String contents are never
+// modified. The result is not guaranteed to parse; callers must check.
+func RepairJSON(data []byte) []byte {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	return stripTrailingCommas(stripJSONComments(data))
+}
+
+// stripJSONComments removes // line and /* */ block comments outside strings.
+// Line comments keep their newline so line numbers stay stable.
+func stripJSONComments(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out = append(out, c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				out = append(out, data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+		if c == '/' && i+1 < len(data) {
+			switch data[i+1] {
+			case '/':
+				for i < len(data) && data[i] != '\n' {
+					i++
+				}
+				if i < len(data) {
+					out = append(out, '\n')
+				}
+				continue
+			case '*':
+				end := bytes.Index(data[i+2:], []byte("*/"))
+				if end < 0 {
+					return out
+				}
+				// Preserve newlines inside the comment.
+				out = append(out, bytes.Repeat([]byte("\n"), bytes.Count(data[i:i+2+end], []byte("\n")))...)
+				i += end + 3
+				continue
+			}
+		}
+		if c == '"' {
+			inString = true
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+// stripTrailingCommas drops commas outside strings whose next non-whitespace
+// byte is '}' or ']'.
+func stripTrailingCommas(data []byte) []byte {
+	out := make([]byte, 0, len(data))
+	inString := false
+	for i := 0; i < len(data); i++ {
+		c := data[i]
+		if inString {
+			out = append(out, c)
+			if c == '\\' && i+1 < len(data) {
+				i++
+				out = append(out, data[i])
+			} else if c == '"' {
+				inString = false
+			}
+			continue
+		}
+		if c == ',' {
+			j := i + 1
+			for j < len(data) && isJSONSpace(data[j]) {
+				j++
+			}
+			if j < len(data) && (data[j] == '}' || data[j] == ']') {
+				continue
+			}
+		}
+		if c == '"' {
+			inString = true
+		}
+		out = append(out, c)
+	}
+	return out
+}
+
+func isJSONSpace(c byte) bool {
+	return c == ' ' || c == '\t' || c == '\n' || c == '\r'
+}
@@ -0,0 +1,37 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestRepairJSON(t *testing.T) {
+	cases := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{"trailing comma in object", "{\"a\":1,\n}", "{\"a\":1\n}"},
+		{"trailing comma in array", `{"a":[1,2, ]}`, `{"a":[1,2 ]}`},
+		{"line comment", "{\n  // model\n  \"model\":\"opus\"\n}", "{\n  \n  \"model\":\"opus\"\n}"},
+		{"block comment", `{/* x */"a":1}`, `{"a":1}`},
+		{"comment markers inside strings kept", `{"url":"http://x/*y*/",}`, `{"url":"http://x/*y*/"}`},
+		{"escaped quote inside string", `{"a":"say \",}\"",}`, `{"a":"say \",}\""}`},
+		{"byte order mark", "\xef\xbb\xbf{}", "{}"},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.want, string(lib.RepairJSON([]byte(tc.in))))
+		})
+	}
+}
+
+func TestRepairJSON_ResultParses(t *testing.T) {
+	broken := []byte("{\n  // allow reads\n  \"permissions\": {\"allow\": [\"Read\",],},\n}\n")
+	obj, err := lib.ParseJSONObject("settings.json", lib.RepairJSON(broken))
+	require.NoError(t, err)
+	assert.Contains(t, obj, "permissions")
+}
@@ -0,0 +1,84 @@
+package lib
+
+import (
+	"fmt"
+	"os"
+	"runtime"
+)
+
+// managedSettingsEnv overrides the OS-default location of managed-settings.json.
+const managedSettingsEnv = "FIELD_STATION_MANAGED_SETTINGS"
+
+// ResolveManagedSettingsPath returns the path of the enterprise managed-settings.json.
+// The FIELD_STATION_MANAGED_SETTINGS environment variable takes precedence; otherwise
+// the location Claude Code reads on the current OS is returned.
+func ResolveManagedSettingsPath() string {
+	if p := os.Getenv(managedSettingsEnv); p != "" {
+		return p
+	}
+	switch runtime.GOOS {
+	case "darwin":
+		return "/Library/Application Support/ClaudeCode/managed-settings.json"
+	case "windows":
+		return `C:\ProgramData\ClaudeCode\managed-settings.json`
+	default:
+		return "/etc/claude-code/managed-settings.json"
+	}
+}
+
+// ReadOnly reports whether the layer is managed outside Field Station and must never be written.
+func (s ConfigLayerSource) ReadOnly() bool {
+	return s == ConfigLayerManaged
+}
+
+// ManagedPolicyError is returned when a write targets a key that the managed
+// policy layer already sets, so the written value could never take effect.
+type ManagedPolicyError struct {
+	KeyPath    string
+	PinnedPath string
+	FilePath   string
+}
+
+func (e *ManagedPolicyError) Error() string {
+	return fmt.Sprintf("%q is pinned by managed policy (%s sets %q)", e.KeyPath, e.FilePath, e.PinnedPath)
+}
+
+// CheckManagedPolicy returns a *ManagedPolicyError if the managed settings file
+// sets keyPath, one of its ancestors as a non-object value, or any key beneath it.
+// Keys merged with MergeConcatUnique are never pinned, since lower layers still
+// add to them. Returns nil when no managed settings file exists.
+func CheckManagedPolicy(keyPath string) error {
+	filePath := ResolveManagedSettingsPath()
+	managed := ReadJSONFileSafe(filePath)
+	if len(managed) == 0 {
+		return nil
+	}
+	if pinned, ok := managedPin(managed, keyPath); ok {
+		return &ManagedPolicyError{KeyPath: keyPath, PinnedPath: pinned, FilePath: filePath}
+	}
+	return nil
+}
+
+// managedPin finds the managed key path that pins keyPath, if any.
+func managedPin(managed JsonObject, keyPath string) (string, bool) {
+	keys := splitPath(keyPath)
+	var current any = managed
+	for i, key := range keys {
+		m, ok := current.(JsonObject)
+		if !ok {
+			// A scalar or array ancestor overrides everything below it, unless
+			// it is an array that layers add to.
+			ancestor := JoinPath(keys[:i]...)
+			if MergeStrategyFor(ancestor) == MergeConcatUnique {
+				return "", false
+			}
+			return ancestor, true
+		}
+		val, exists := m[key]
+		if !exists {
+			return "", false
+		}
+		current = val
+	}
+	return replacePinned(current, keyPath)
+}
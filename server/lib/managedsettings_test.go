@@ -0,0 +1,88 @@
+package lib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func writeManagedSettings(t *testing.T, content string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "managed-settings.json")
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", path)
+	return path
+}
+
+func TestResolveManagedSettingsPath_EnvOverride(t *testing.T) {
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", "/opt/policy/managed.json")
+	assert.Equal(t, "/opt/policy/managed.json", lib.ResolveManagedSettingsPath())
+}
+
+func TestResolveLayerPath_Managed(t *testing.T) {
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", "/opt/policy/managed.json")
+	path, err := lib.ResolveLayerPath(lib.ConfigLayerManaged, "")
+	require.NoError(t, err)
+	assert.Equal(t, "/opt/policy/managed.json", path)
+	assert.True(t, lib.ConfigLayerManaged.ReadOnly())
+	assert.False(t, lib.ConfigLayerGlobal.ReadOnly())
+}
+
+func TestMergeConfigLayers_ManagedOverridesEverything(t *testing.T) {
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	managedPath := writeManagedSettings(t, `{"model":"policy-model"}`)
+
+	projectDir := t.TempDir()
+	claudeDir := filepath.Join(projectDir, ".claude")
+	require.NoError(t, os.MkdirAll(claudeDir, 0o750))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeDir, "settings.local.json"), []byte(`{"model":"local-model"}`), 0o600))
+
+	result := lib.MergeConfigLayers(projectDir)
+	assert.Equal(t, "policy-model", result.Merged["model"])
+	last := result.Layers[len(result.Layers)-1]
+	assert.Equal(t, lib.ConfigLayerManaged, last.Source)
+	assert.Equal(t, managedPath, last.FilePath)
+	assert.True(t, last.Exists)
+}
+
+func TestCheckManagedPolicy(t *testing.T) {
+	writeManagedSettings(t, `{"model":"opus","permissions":{"deny":["WebFetch"],"defaultMode":"plan"},"hooks":{"Stop":[]},"sandbox":{}}`)
+
+	cases := []struct {
+		keyPath string
+		pinned  bool
+	}{
+		{"model", true},
+		{"permissions.deny", false}, // concat-merged: lower layers still add rules
+		{"permissions.deny[0]", false},
+		{"permissions.defaultMode[0]", true},
+		{"permissions.defaultMode", true},
+		{"permissions", true}, // writing the parent object would replace a pinned child
+		{"permissions.allow", false},
+		{"hooks", false},
+		{"hooks.Stop", false},
+		{"model.name", true}, // scalar ancestor
+		{"sandbox.enabled", false},
+		{"env.FOO", false},
+	}
+	for _, tc := range cases {
+		err := lib.CheckManagedPolicy(tc.keyPath)
+		if !tc.pinned {
+			assert.NoError(t, err, tc.keyPath)
+			continue
+		}
+		var policyErr *lib.ManagedPolicyError
+		assert.True(t, errors.As(err, &policyErr), tc.keyPath)
+	}
+}
+
+func TestCheckManagedPolicy_NoManagedFile(t *testing.T) {
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(t.TempDir(), "missing.json"))
+	assert.NoError(t, lib.CheckManagedPolicy("model"))
+}
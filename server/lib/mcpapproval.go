@@ -0,0 +1,243 @@
+package lib
+
+import (
+	"fmt"
+	"path/filepath"
+	"reflect"
+	"slices"
+	"sort"
+)
+
+// MCPApprovalState is whether Claude Code will start a project .mcp.json server.
+type MCPApprovalState string
+
+// Approval states of a project-scope MCP server.
+const (
+	MCPApproved MCPApprovalState = "approved"
+	MCPRejected MCPApprovalState = "rejected"
+	// MCPPending servers are in no list; Claude Code asks about them on startup.
+	MCPPending MCPApprovalState = "pending"
+)
+
+// Valid reports whether s is one of the approval states.
+func (s MCPApprovalState) Valid() bool {
+	return s == MCPApproved || s == MCPRejected || s == MCPPending
+}
+
+// Settings keys Claude Code records project MCP server approvals under.
+const (
+	enabledMcpjsonKey  = "enabledMcpjsonServers"
+	disabledMcpjsonKey = "disabledMcpjsonServers"
+	enableAllMcpjson   = "enableAllProjectMcpServers"
+)
+
+// MCPApproval is the approval state of one server in a project's .mcp.json.
+// Source and FilePath name the layer that decided it: the highest layer listing
+// the server, or the layer setting enableAllProjectMcpServers. They are empty
+// for pending servers.
+type MCPApproval struct {
+	Name     string
+	State    MCPApprovalState
+	Source   ConfigLayerSource
+	FilePath string
+}
+
+// MCPApprovals is the approval state of every server in a project's .mcp.json.
+type MCPApprovals struct {
+	Servers   []MCPApproval
+	EnableAll *ShadowedValue
+	Layers    []ConfigLayer
+}
+
+// MCPApprovalError reports an approval change naming servers that are not in
+// the project's .mcp.json.
+type MCPApprovalError struct {
+	ProjectPath string
+	Names       []string
+}
+
+func (e *MCPApprovalError) Error() string {
+	return fmt.Sprintf("not in %s: %v", filepath.Join(e.ProjectPath, ".mcp.json"), e.Names)
+}
+
+// MCPApprovalChange is what a bulk approval did to one project: the servers
+// whose state it changed, or why the project was skipped.
+type MCPApprovalChange struct {
+	ProjectPath string
+	FilePath    string
+	Servers     []string
+	Error       string
+}
+
+// projectMCPServerNames returns the sorted names of the servers in the
+// project's .mcp.json.
+func projectMCPServerNames(projectPath string) []string {
+	obj := ReadJSONFileSafe(filepath.Join(projectPath, ".mcp.json"))
+	defs := mcpServerDefinitions(obj, []string{"mcpServers"})
+	names := make([]string, 0, len(defs))
+	for name := range defs {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// LoadMCPApprovals returns the approval state of each server in the project's
+// .mcp.json, decided the way Claude Code does: a server listed in
+// disabledMcpjsonServers of any layer is rejected; otherwise one listed in
+// enabledMcpjsonServers, or any server when enableAllProjectMcpServers is true,
+// is approved; anything else is pending.
+func LoadMCPApprovals(projectPath string) MCPApprovals {
+	config := MergeConfigLayers(projectPath)
+	result := MCPApprovals{Layers: config.Layers}
+	if p, ok := config.Provenance[enableAllMcpjson]; ok {
+		result.EnableAll = &ShadowedValue{Source: p.Source, FilePath: p.FilePath, Value: p.Value}
+	}
+
+	for _, name := range projectMCPServerNames(projectPath) {
+		approval := MCPApproval{Name: name, State: MCPPending}
+		if layer, ok := highestLayerListing(config.Layers, disabledMcpjsonKey, name); ok {
+			approval.State, approval.Source, approval.FilePath = MCPRejected, layer.Source, layer.FilePath
+		} else if layer, ok := highestLayerListing(config.Layers, enabledMcpjsonKey, name); ok {
+			approval.State, approval.Source, approval.FilePath = MCPApproved, layer.Source, layer.FilePath
+		} else if result.EnableAll != nil && result.EnableAll.Value == true {
+			approval.State, approval.Source, approval.FilePath = MCPApproved, result.EnableAll.Source, result.EnableAll.FilePath
+		}
+		result.Servers = append(result.Servers, approval)
+	}
+	return result
+}
+
+// highestLayerListing returns the highest-precedence layer whose key array
+// holds name.
+func highestLayerListing(layers []ConfigLayer, key, name string) (ConfigLayer, bool) {
+	for i := len(layers) - 1; i >= 0; i-- {
+		items, _ := layers[i].Content[key].([]any)
+		if slices.Contains(items, any(name)) {
+			return layers[i], true
+		}
+	}
+	return ConfigLayer{}, false
+}
+
+// PlanMCPApproval records state for the named servers of the project's
+// .mcp.json in the settings file at loc: approving adds them to
+// enabledMcpjsonServers and rejecting to disabledMcpjsonServers, each removing
+// them from the other list, and pending removes them from both. A pending server
+// is still approved if enableAllProjectMcpServers is set. Nothing is written
+// until the returned patch is applied. Returns a *MCPApprovalError if a name is
+// not in .mcp.json, or a *JSONParseError if the settings file cannot be parsed.
+func PlanMCPApproval(loc SettingLocation, names []string, state MCPApprovalState) (*ConfigPatch, error) {
+	known := projectMCPServerNames(loc.ProjectPath)
+	var unknown []string
+	for _, name := range names {
+		if !slices.Contains(known, name) {
+			unknown = append(unknown, name)
+		}
+	}
+	if len(unknown) > 0 {
+		return nil, &MCPApprovalError{ProjectPath: loc.ProjectPath, Names: unknown}
+	}
+	patch := &ConfigPatch{Operation: BackupOpUpdate}
+	file, _, err := planMCPApprovalFile(loc, names, state)
+	if err != nil {
+		return nil, err
+	}
+	if file != nil {
+		patch.Files = append(patch.Files, file)
+	}
+	return patch, nil
+}
+
+// PlanMCPApprovalPolicy applies state across projects, writing to the source
+// layer (project or project-local) of each. names limits the change to servers
+// of those names; when empty, every server in each project's .mcp.json is
+// covered. Projects whose settings file cannot be parsed are skipped and
+// reported in the returned changes; the rest are applied together when the
+// returned patch is.
+func PlanMCPApprovalPolicy(projectPaths []string, source ConfigLayerSource, state MCPApprovalState, names []string) (*ConfigPatch, []MCPApprovalChange, error) {
+	if source != ConfigLayerProject && source != ConfigLayerProjectLocal {
+		return nil, nil, fmt.Errorf("bulk MCP approvals must target the project or project-local layer, not %s", source)
+	}
+	patch := &ConfigPatch{Operation: BackupOpUpdate}
+	var changes []MCPApprovalChange
+	for _, projectPath := range projectPaths {
+		targets := projectMCPServerNames(projectPath)
+		if len(names) > 0 {
+			targets = slices.DeleteFunc(targets, func(name string) bool { return !slices.Contains(names, name) })
+		}
+		if len(targets) == 0 {
+			continue
+		}
+		loc := SettingLocation{Source: source, ProjectPath: projectPath}
+		file, changed, err := planMCPApprovalFile(loc, targets, state)
+		if err != nil {
+			filePath, _ := ResolveLayerPath(source, projectPath)
+			changes = append(changes, MCPApprovalChange{ProjectPath: projectPath, FilePath: filePath, Error: err.Error()})
+			continue
+		}
+		if file == nil {
+			continue
+		}
+		patch.Files = append(patch.Files, file)
+		changes = append(changes, MCPApprovalChange{ProjectPath: projectPath, FilePath: file.FilePath, Servers: changed})
+	}
+	return patch, changes, nil
+}
+
+// planMCPApprovalFile computes the edit that records state for names in the
+// settings file at loc, returning nil if the file already does, along with the
+// names whose entries changed.
+func planMCPApprovalFile(loc SettingLocation, names []string, state MCPApprovalState) (*PatchedFile, []string, error) {
+	if loc.Source.ReadOnly() {
+		return nil, nil, fmt.Errorf("the %s layer is read-only", loc.Source)
+	}
+	filePath, err := ResolveLayerPath(loc.Source, loc.ProjectPath)
+	if err != nil {
+		return nil, nil, err
+	}
+	data, obj, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	file := &PatchedFile{Source: loc.Source, FilePath: filePath, Exists: data != nil, Original: data, Updated: data}
+	changed := map[string]bool{}
+	for _, key := range []string{enabledMcpjsonKey, disabledMcpjsonKey} {
+		raw, present := obj[key]
+		items, ok := raw.([]any)
+		if present && !ok {
+			return nil, nil, fmt.Errorf("%s in %s is not an array", key, filePath)
+		}
+		want := (key == enabledMcpjsonKey && state == MCPApproved) || (key == disabledMcpjsonKey && state == MCPRejected)
+		updated := slices.Clone(items)
+		for _, name := range names {
+			has := slices.Contains(updated, any(name))
+			switch {
+			case want && !has:
+				updated = append(updated, name)
+			case !want && has:
+				updated = slices.DeleteFunc(updated, func(item any) bool { return item == name })
+			default:
+				continue
+			}
+			changed[name] = true
+		}
+		// A hand-edited list may hold objects, which == cannot compare.
+		if slices.EqualFunc(items, updated, func(a, b any) bool { return reflect.DeepEqual(a, b) }) || (!present && len(updated) == 0) {
+			continue
+		}
+		if updated == nil {
+			updated = []any{}
+		}
+		if file.Updated, err = setJSONAtKeys(file.Updated, []string{key}, updated, false); err != nil {
+			return nil, nil, err
+		}
+		file.Edits = append(file.Edits, SettingEdit{KeyPath: key, Value: updated})
+	}
+	if len(file.Edits) == 0 {
+		return nil, nil, nil
+	}
+	names = slices.DeleteFunc(slices.Clone(names), func(name string) bool { return !changed[name] })
+	return file, names, nil
+}
@@ -0,0 +1,132 @@
+package lib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+// writeMCPJSON writes a .mcp.json with stdio servers of the given names.
+func writeMCPJSON(t *testing.T, projectDir string, names ...string) {
+	t.Helper()
+	servers := lib.JsonObject{}
+	for _, name := range names {
+		servers[name] = lib.JsonObject{"command": "npx"}
+	}
+	require.NoError(t, lib.WriteJSONFileSafe(filepath.Join(projectDir, ".mcp.json"), lib.JsonObject{"mcpServers": servers}))
+}
+
+func writeProjectSettings(t *testing.T, projectDir, name, content string) string {
+	t.Helper()
+	path := filepath.Join(projectDir, ".claude", name)
+	require.NoError(t, os.MkdirAll(filepath.Dir(path), 0o750))
+	require.NoError(t, os.WriteFile(path, []byte(content), 0o600))
+	return path
+}
+
+func TestLoadMCPApprovals_StatesAndDecidingLayer(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, `{"enableAllProjectMcpServers":true,"disabledMcpjsonServers":["search"]}`)
+	writeMCPJSON(t, projectDir, "github", "search", "docs", "events")
+	localPath := writeProjectSettings(t, projectDir, "settings.local.json", `{"enabledMcpjsonServers":["github","search"],"enableAllProjectMcpServers":false}`)
+	writeProjectSettings(t, projectDir, "settings.json", `{"enabledMcpjsonServers":["docs"]}`)
+
+	approvals := lib.LoadMCPApprovals(projectDir)
+	require.Len(t, approvals.Servers, 4)
+	byName := map[string]lib.MCPApproval{}
+	for _, a := range approvals.Servers {
+		byName[a.Name] = a
+	}
+
+	assert.Equal(t, lib.MCPApproved, byName["github"].State)
+	assert.Equal(t, localPath, byName["github"].FilePath)
+	assert.Equal(t, lib.MCPRejected, byName["search"].State, "disabled in any layer wins over enabled")
+	assert.Equal(t, filepath.Join(claudeHome, "settings.json"), byName["search"].FilePath)
+	assert.Equal(t, lib.ConfigLayerProject, byName["docs"].Source)
+	assert.Equal(t, lib.MCPPending, byName["events"].State, "enableAll is overridden to false by the local layer")
+	assert.Empty(t, byName["events"].Source)
+
+	require.NotNil(t, approvals.EnableAll)
+	assert.Equal(t, false, approvals.EnableAll.Value)
+}
+
+func TestPlanMCPApproval_MovesBetweenLists(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, `{}`)
+	writeMCPJSON(t, projectDir, "github", "search")
+	localPath := writeProjectSettings(t, projectDir, "settings.local.json", "{\n  \"model\": \"opus\",\n  \"disabledMcpjsonServers\": [\"github\"]\n}\n")
+	loc := lib.SettingLocation{Source: lib.ConfigLayerProjectLocal, ProjectPath: projectDir}
+
+	patch, err := lib.PlanMCPApproval(loc, []string{"github", "search"}, lib.MCPApproved)
+	require.NoError(t, err)
+	_, err = patch.Apply(claudeHome)
+	require.NoError(t, err)
+
+	data, err := os.ReadFile(localPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus","enabledMcpjsonServers":["github","search"],"disabledMcpjsonServers":[]}`, string(data))
+	assert.Contains(t, string(data), "{\n  \"model\": \"opus\",\n")
+
+	patch, err = lib.PlanMCPApproval(loc, []string{"github"}, lib.MCPApproved)
+	require.NoError(t, err)
+	assert.Empty(t, patch.Files, "already approved")
+
+	patch, err = lib.PlanMCPApproval(loc, []string{"search"}, lib.MCPPending)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 1)
+	assert.Equal(t, []lib.SettingEdit{{KeyPath: "enabledMcpjsonServers", Value: []any{"github"}}}, patch.Files[0].Edits)
+
+	_, err = lib.PlanMCPApproval(loc, []string{"slack"}, lib.MCPRejected)
+	var approvalErr *lib.MCPApprovalError
+	require.True(t, errors.As(err, &approvalErr))
+	assert.Equal(t, []string{"slack"}, approvalErr.Names)
+}
+
+func TestPlanMCPApproval_ToleratesHandEditedObjects(t *testing.T) {
+	_, projectDir := setupPatchLayers(t, `{}`)
+	writeMCPJSON(t, projectDir, "github")
+	writeProjectSettings(t, projectDir, "settings.local.json", `{"enabledMcpjsonServers":[{"name":"a"}],"disabledMcpjsonServers":[{"name":"b"}]}`)
+	loc := lib.SettingLocation{Source: lib.ConfigLayerProjectLocal, ProjectPath: projectDir}
+
+	patch, err := lib.PlanMCPApproval(loc, []string{"github"}, lib.MCPPending)
+	require.NoError(t, err)
+	assert.Empty(t, patch.Files, "nothing to remove")
+
+	patch, err = lib.PlanMCPApproval(loc, []string{"github"}, lib.MCPApproved)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 1)
+	assert.Equal(t, []lib.SettingEdit{{KeyPath: "enabledMcpjsonServers", Value: []any{map[string]any{"name": "a"}, "github"}}}, patch.Files[0].Edits)
+}
+
+func TestPlanMCPApprovalPolicy_AcrossProjects(t *testing.T) {
+	claudeHome, first := setupPatchLayers(t, `{}`)
+	second, broken, empty := t.TempDir(), t.TempDir(), t.TempDir()
+	writeMCPJSON(t, first, "github", "search")
+	writeMCPJSON(t, second, "search")
+	writeMCPJSON(t, broken, "search")
+	brokenPath := writeProjectSettings(t, broken, "settings.local.json", `{"enabledMcpjsonServers": [`)
+
+	patch, changes, err := lib.PlanMCPApprovalPolicy([]string{first, second, broken, empty}, lib.ConfigLayerProjectLocal, lib.MCPRejected, []string{"search"})
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 2)
+	require.Len(t, changes, 3)
+	assert.Equal(t, []string{"search"}, changes[0].Servers)
+	assert.Equal(t, broken, changes[2].ProjectPath)
+	assert.NotEmpty(t, changes[2].Error)
+
+	_, err = patch.Apply(claudeHome)
+	require.NoError(t, err)
+	approvals := lib.LoadMCPApprovals(first)
+	assert.Equal(t, lib.MCPPending, approvals.Servers[0].State, "github is not covered by the filter")
+	assert.Equal(t, lib.MCPRejected, approvals.Servers[1].State)
+
+	data, err := os.ReadFile(brokenPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, `{"enabledMcpjsonServers": [`, string(data), "unparseable file left untouched")
+
+	_, _, err = lib.PlanMCPApprovalPolicy([]string{first}, lib.ConfigLayerGlobal, lib.MCPApproved, nil)
+	require.Error(t, err)
+}
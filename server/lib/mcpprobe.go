@@ -0,0 +1,285 @@
+package lib
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"sort"
+	"time"
+)
+
+// MCPProbeTimeout is how long ProbeMCPServer waits for a server by default.
+const MCPProbeTimeout = 10 * time.Second
+
+// mcpProtocolVersion is the MCP revision Field Station asks for in initialize.
+const mcpProtocolVersion = "2025-06-18"
+
+// maxProbeStderr caps how much of a server's stderr a probe keeps.
+const maxProbeStderr = 64 * 1024
+
+// maxToolPages bounds tools/list pagination against servers that never stop
+// returning a cursor.
+const maxToolPages = 100
+
+// MCPTool is a tool an MCP server reported from tools/list.
+type MCPTool struct {
+	Name        string
+	Description string
+	InputSchema JsonObject
+}
+
+// MCPProbeResult is what a stdio MCP server reported during a probe.
+type MCPProbeResult struct {
+	ProtocolVersion string
+	ServerName      string
+	ServerVersion   string
+	Tools           []MCPTool
+	Stderr          string
+}
+
+// MCPProbeError reports a probe that failed: the server did not start, exited,
+// timed out or answered with an error. Stderr holds what it wrote before that.
+type MCPProbeError struct {
+	Message string
+	Stderr  string
+}
+
+func (e *MCPProbeError) Error() string {
+	return "mcp probe: " + e.Message
+}
+
+// jsonRPCMessage is any JSON-RPC 2.0 message a server can send.
+type jsonRPCMessage struct {
+	ID     json.RawMessage `json:"id,omitempty"`
+	Method string          `json:"method,omitempty"`
+	Result json.RawMessage `json:"result,omitempty"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error,omitempty"`
+}
+
+// ProbeMCPServer starts a stdio MCP server in dir with its env added to Field
+// Station's own, performs the initialize handshake and lists its tools, then
+// stops it. The whole exchange must finish within timeout.
+// Returns a *MCPProbeError if the server does not complete the handshake.
+func ProbeMCPServer(ctx context.Context, server MCPServer, dir string, timeout time.Duration) (*MCPProbeResult, error) {
+	if server.Type != MCPServerStdio {
+		return nil, fmt.Errorf("mcp probe: only stdio servers can be probed, %q is %s", server.Name, server.Type)
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, server.Command, server.Args...) //nolint:gosec // the command is the user's own MCP server definition
+	cmd.Dir = dir
+	cmd.Env = os.Environ()
+	keys := make([]string, 0, len(server.Env))
+	for k := range server.Env {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		cmd.Env = append(cmd.Env, k+"="+server.Env[k])
+	}
+	stderr := &cappedBuffer{max: maxProbeStderr}
+	cmd.Stderr = stderr
+	cmd.WaitDelay = time.Second
+	stdin, err := cmd.StdinPipe()
+	if err != nil {
+		return nil, fmt.Errorf("mcp probe: %w", err)
+	}
+	stdout, err := cmd.StdoutPipe()
+	if err != nil {
+		return nil, fmt.Errorf("mcp probe: %w", err)
+	}
+	if err := cmd.Start(); err != nil {
+		return nil, &MCPProbeError{Message: fmt.Sprintf("starting %s: %v", server.Command, err)}
+	}
+
+	messages := readJSONRPCMessages(stdout)
+	s := &mcpSession{ctx: ctx, stdin: stdin, messages: messages, timeout: timeout}
+	result, probeErr := s.probe()
+	go func() {
+		for range messages { //nolint:revive // drain so the reader can exit
+		}
+	}()
+
+	// Closing stdin is how MCP asks a stdio server to exit; the context kills
+	// any server that ignores it.
+	_ = stdin.Close()
+	cancel()
+	_ = cmd.Wait()
+
+	if probeErr != nil {
+		return nil, &MCPProbeError{Message: probeErr.Error(), Stderr: stderr.String()}
+	}
+	result.Stderr = stderr.String()
+	return result, nil
+}
+
+// mcpSession is the client side of one probe's JSON-RPC exchange.
+type mcpSession struct {
+	ctx      context.Context
+	stdin    io.Writer
+	messages <-chan jsonRPCMessage
+	timeout  time.Duration
+	nextID   int
+}
+
+func (s *mcpSession) probe() (*MCPProbeResult, error) {
+	var initResult struct {
+		ProtocolVersion string `json:"protocolVersion"`
+		Capabilities    struct {
+			Tools json.RawMessage `json:"tools"`
+		} `json:"capabilities"`
+		ServerInfo struct {
+			Name    string `json:"name"`
+			Version string `json:"version"`
+		} `json:"serverInfo"`
+	}
+	err := s.call("initialize", map[string]any{
+		"protocolVersion": mcpProtocolVersion,
+		"capabilities":    map[string]any{},
+		"clientInfo":      map[string]any{"name": "field-station", "version": "1.0.0"},
+	}, &initResult)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.send(map[string]any{"jsonrpc": "2.0", "method": "notifications/initialized"}); err != nil {
+		return nil, err
+	}
+
+	result := &MCPProbeResult{
+		ProtocolVersion: initResult.ProtocolVersion,
+		ServerName:      initResult.ServerInfo.Name,
+		ServerVersion:   initResult.ServerInfo.Version,
+	}
+	if len(initResult.Capabilities.Tools) == 0 {
+		return result, nil
+	}
+
+	cursor := ""
+	for range maxToolPages {
+		params := map[string]any{}
+		if cursor != "" {
+			params["cursor"] = cursor
+		}
+		var page struct {
+			Tools []struct {
+				Name        string     `json:"name"`
+				Description string     `json:"description"`
+				InputSchema JsonObject `json:"inputSchema"`
+			} `json:"tools"`
+			NextCursor string `json:"nextCursor"`
+		}
+		if err := s.call("tools/list", params, &page); err != nil {
+			return nil, err
+		}
+		for _, tool := range page.Tools {
+			result.Tools = append(result.Tools, MCPTool(tool))
+		}
+		if page.NextCursor == "" {
+			break
+		}
+		cursor = page.NextCursor
+	}
+	return result, nil
+}
+
+// call sends a request and waits for its response, decoding the result into
+// out. Requests the server makes meanwhile are answered with an error, and its
+// notifications are ignored.
+func (s *mcpSession) call(method string, params any, out any) error {
+	s.nextID++
+	id := json.RawMessage(fmt.Sprint(s.nextID))
+	if err := s.send(map[string]any{"jsonrpc": "2.0", "id": id, "method": method, "params": params}); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-s.ctx.Done():
+			return fmt.Errorf("timed out after %s waiting for the %s response", s.timeout, method)
+		case msg, ok := <-s.messages:
+			if !ok {
+				return fmt.Errorf("server exited before answering %s", method)
+			}
+			if msg.Method != "" {
+				if len(msg.ID) > 0 {
+					reply := map[string]any{"jsonrpc": "2.0", "id": msg.ID, "error": map[string]any{"code": -32601, "message": "method not supported by Field Station"}}
+					if msg.Method == "ping" {
+						reply = map[string]any{"jsonrpc": "2.0", "id": msg.ID, "result": map[string]any{}}
+					}
+					if err := s.send(reply); err != nil {
+						return err
+					}
+				}
+				continue
+			}
+			if !bytes.Equal(bytes.TrimSpace(msg.ID), id) {
+				continue
+			}
+			if msg.Error != nil {
+				return fmt.Errorf("%s failed: %s (code %d)", method, msg.Error.Message, msg.Error.Code)
+			}
+			if err := json.Unmarshal(msg.Result, out); err != nil {
+				return fmt.Errorf("%s returned an invalid result: %w", method, err)
+			}
+			return nil
+		}
+	}
+}
+
+func (s *mcpSession) send(msg map[string]any) error {
+	data, err := json.Marshal(msg)
+	if err != nil {
+		return err
+	}
+	if _, err := s.stdin.Write(append(data, '\n')); err != nil {
+		return fmt.Errorf("writing to server: %w", err)
+	}
+	return nil
+}
+
+// readJSONRPCMessages decodes newline-delimited messages from r until it
+// closes. Lines that are not JSON-RPC messages, such as stray logging, are
+// skipped.
+func readJSONRPCMessages(r io.Reader) <-chan jsonRPCMessage {
+	ch := make(chan jsonRPCMessage)
+	go func() {
+		defer close(ch)
+		reader := bufio.NewReader(r)
+		for {
+			line, err := reader.ReadBytes('\n')
+			var msg jsonRPCMessage
+			if len(bytes.TrimSpace(line)) > 0 && json.Unmarshal(line, &msg) == nil {
+				ch <- msg
+			}
+			if err != nil {
+				return
+			}
+		}
+	}()
+	return ch
+}
+
+// cappedBuffer keeps the first max bytes written to it and discards the rest.
+type cappedBuffer struct {
+	buf bytes.Buffer
+	max int
+}
+
+func (b *cappedBuffer) Write(p []byte) (int, error) {
+	if room := b.max - b.buf.Len(); room > 0 {
+		b.buf.Write(p[:min(len(p), room)])
+	}
+	return len(p), nil
+}
+
+func (b *cappedBuffer) String() string {
+	return b.buf.String()
+}
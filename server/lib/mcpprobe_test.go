@@ -0,0 +1,151 @@
+package lib_test
+
+import (
+	"context"
+	"errors"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+// fakeMCPServerSource is a stdio MCP server whose behavior is picked by its
+// first argument: "ok" answers the handshake and pages through two tools,
+// "crash" exits with a message on stderr, and "hang" never answers.
+const fakeMCPServerSource = `package main
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+)
+
+func reply(id json.RawMessage, result any) {
+	data, _ := json.Marshal(map[string]any{"jsonrpc": "2.0", "id": id, "result": result})
+	fmt.Println(string(data))
+}
+
+func main() {
+	mode := os.Args[1]
+	if mode == "crash" {
+		fmt.Fprintln(os.Stderr, "boom: GITHUB_TOKEN is not set")
+		os.Exit(1)
+	}
+	fmt.Fprintln(os.Stderr, "fake server starting")
+	scanner := bufio.NewScanner(os.Stdin)
+	for scanner.Scan() {
+		var msg struct {
+			ID     json.RawMessage ` + "`json:\"id\"`" + `
+			Method string          ` + "`json:\"method\"`" + `
+			Params struct {
+				Cursor string ` + "`json:\"cursor\"`" + `
+			} ` + "`json:\"params\"`" + `
+		}
+		if json.Unmarshal(scanner.Bytes(), &msg) != nil {
+			continue
+		}
+		if mode == "hang" {
+			time.Sleep(time.Hour)
+		}
+		switch msg.Method {
+		case "initialize":
+			fmt.Println("not json: a stray log line")
+			fmt.Println(` + "`" + `{"jsonrpc":"2.0","method":"notifications/message","params":{"level":"info"}}` + "`" + `)
+			fmt.Println(` + "`" + `{"jsonrpc":"2.0","id":"srv-1","method":"ping"}` + "`" + `)
+			reply(msg.ID, map[string]any{
+				"protocolVersion": "2025-06-18",
+				"capabilities":    map[string]any{"tools": map[string]any{}},
+				"serverInfo":      map[string]any{"name": "fake", "version": os.Getenv("FAKE_VERSION")},
+			})
+		case "tools/list":
+			if msg.Params.Cursor == "" {
+				reply(msg.ID, map[string]any{
+					"tools":      []any{map[string]any{"name": "search", "description": "Search things", "inputSchema": map[string]any{"type": "object"}}},
+					"nextCursor": "page2",
+				})
+			} else {
+				reply(msg.ID, map[string]any{"tools": []any{map[string]any{"name": "fetch", "inputSchema": map[string]any{"type": "object"}}}})
+			}
+		}
+	}
+}
+`
+
+// buildFakeMCPServer compiles fakeMCPServerSource into a temp dir.
+func buildFakeMCPServer(t *testing.T) string {
+	t.Helper()
+	goBin, err := exec.LookPath("go")
+	if err != nil {
+		t.Skip("go toolchain not on PATH")
+	}
+	dir := t.TempDir()
+	src := filepath.Join(dir, "main.go")
+	require.NoError(t, os.WriteFile(src, []byte(fakeMCPServerSource), 0o600))
+	bin := filepath.Join(dir, "fake-mcp-server")
+	out, err := exec.Command(goBin, "build", "-o", bin, src).CombinedOutput() //nolint:gosec // builds a test fixture from a constant source
+	require.NoError(t, err, string(out))
+	return bin
+}
+
+func TestProbeMCPServer_ListsToolsAcrossPages(t *testing.T) {
+	bin := buildFakeMCPServer(t)
+	server := lib.MCPServer{Name: "fake", Type: lib.MCPServerStdio, Command: bin, Args: []string{"ok"}, Env: map[string]string{"FAKE_VERSION": "1.2.3"}}
+
+	result, err := lib.ProbeMCPServer(context.Background(), server, t.TempDir(), 10*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "2025-06-18", result.ProtocolVersion)
+	assert.Equal(t, "fake", result.ServerName)
+	assert.Equal(t, "1.2.3", result.ServerVersion, "server env is passed to the process")
+	require.Len(t, result.Tools, 2)
+	assert.Equal(t, "search", result.Tools[0].Name)
+	assert.Equal(t, "Search things", result.Tools[0].Description)
+	assert.Equal(t, lib.JsonObject{"type": "object"}, result.Tools[0].InputSchema)
+	assert.Equal(t, "fetch", result.Tools[1].Name)
+	assert.Contains(t, result.Stderr, "fake server starting")
+}
+
+func TestProbeMCPServer_Failures(t *testing.T) {
+	bin := buildFakeMCPServer(t)
+	tests := []struct {
+		name    string
+		server  lib.MCPServer
+		message string
+		stderr  string
+	}{
+		{
+			name:    "exits",
+			server:  lib.MCPServer{Name: "fake", Type: lib.MCPServerStdio, Command: bin, Args: []string{"crash"}},
+			message: "exited before answering initialize",
+			stderr:  "GITHUB_TOKEN is not set",
+		},
+		{
+			name:    "hangs",
+			server:  lib.MCPServer{Name: "fake", Type: lib.MCPServerStdio, Command: bin, Args: []string{"hang"}},
+			message: "timed out",
+			stderr:  "fake server starting",
+		},
+		{
+			name:    "missing command",
+			server:  lib.MCPServer{Name: "fake", Type: lib.MCPServerStdio, Command: filepath.Join(t.TempDir(), "nope")},
+			message: "starting",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			start := time.Now()
+			_, err := lib.ProbeMCPServer(context.Background(), tt.server, t.TempDir(), 500*time.Millisecond)
+			var probeErr *lib.MCPProbeError
+			require.True(t, errors.As(err, &probeErr), "expected *MCPProbeError, got %v", err)
+			assert.Contains(t, probeErr.Message, tt.message)
+			assert.Contains(t, probeErr.Stderr, tt.stderr)
+			assert.Less(t, time.Since(start), 5*time.Second)
+		})
+	}
+}
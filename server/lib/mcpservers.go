@@ -0,0 +1,354 @@
+package lib
+
+import (
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+)
+
+// MCPScope identifies where an MCP server definition lives.
+type MCPScope string
+
+// MCP server scopes, as Claude Code names them.
+const (
+	// MCPScopeUser servers are in the top-level mcpServers of ~/.claude.json.
+	MCPScopeUser MCPScope = "user"
+	// MCPScopeLocal servers are in ~/.claude.json under projects[<project path>].mcpServers.
+	MCPScopeLocal MCPScope = "local"
+	// MCPScopeProject servers are in the project's .mcp.json, shared through version control.
+	MCPScopeProject MCPScope = "project"
+)
+
+// MCPServerType is the transport an MCP server speaks.
+type MCPServerType string
+
+// MCP server transports.
+const (
+	MCPServerStdio MCPServerType = "stdio"
+	MCPServerSSE   MCPServerType = "sse"
+	MCPServerHTTP  MCPServerType = "http"
+)
+
+// MCPServer is an MCP server definition. Command, Args and Env apply to stdio
+// servers; URL and Headers to sse and http servers.
+type MCPServer struct {
+	Name     string
+	Scope    MCPScope
+	FilePath string
+	Type     MCPServerType
+	Command  string
+	Args     []string
+	Env      map[string]string
+	URL      string
+	Headers  map[string]string
+}
+
+// MCPServerFile is a file MCP servers are read from, with its ETag.
+type MCPServerFile struct {
+	Scope    MCPScope
+	FilePath string
+	Exists   bool
+	ETag     string
+}
+
+// MCPServerEditError reports an edit that conflicts with the file: the server
+// to change is not there, or the server to create already is.
+type MCPServerEditError struct {
+	Scope    MCPScope
+	Name     string
+	NotFound bool
+}
+
+func (e *MCPServerEditError) Error() string {
+	if e.NotFound {
+		return fmt.Sprintf("MCP server %q not found in %s scope", e.Name, e.Scope)
+	}
+	return fmt.Sprintf("MCP server %q already exists in %s scope", e.Name, e.Scope)
+}
+
+var mcpServerName = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+
+// ResolveClaudeJSONPath returns the path of Claude Code's user state file. It
+// lives inside the Claude home when CLAUDE_HOME overrides it, as with Claude
+// Code's own CLAUDE_CONFIG_DIR, and at ~/.claude.json otherwise.
+func ResolveClaudeJSONPath() string {
+	if envHome := os.Getenv("CLAUDE_HOME"); envHome != "" {
+		if _, err := os.Stat(envHome); err == nil { //nolint:gosec // CLAUDE_HOME is a trusted operator-set env var
+			return filepath.Join(envHome, ".claude.json")
+		}
+	}
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return filepath.Join(os.TempDir(), ".claude.json")
+	}
+	return filepath.Join(home, ".claude.json")
+}
+
+// MCPServerLocation returns the file holding scope's servers and the keys of
+// the mcpServers object within it. projectPath is required for the local and
+// project scopes.
+func MCPServerLocation(scope MCPScope, projectPath string) (string, []string, error) {
+	switch scope {
+	case MCPScopeUser:
+		return ResolveClaudeJSONPath(), []string{"mcpServers"}, nil
+	case MCPScopeLocal:
+		if projectPath == "" {
+			return "", nil, fmt.Errorf("projectPath required for local MCP scope")
+		}
+		return ResolveClaudeJSONPath(), []string{"projects", projectPath, "mcpServers"}, nil
+	case MCPScopeProject:
+		if projectPath == "" {
+			return "", nil, fmt.Errorf("projectPath required for project MCP scope")
+		}
+		return filepath.Join(projectPath, ".mcp.json"), []string{"mcpServers"}, nil
+	default:
+		return "", nil, fmt.Errorf("unknown MCP scope: %s", scope)
+	}
+}
+
+// ListMCPServers returns the user-scope servers and, when projectPath is given,
+// that project's local and project-scope servers, each scope sorted by name,
+// along with the files they were read from. Each definition is redacted with the
+// rules in the Claude home directory. Files that are missing or unparseable
+// contribute no servers.
+func ListMCPServers(projectPath string) ([]MCPServer, []MCPServerFile) {
+	scopes := []MCPScope{MCPScopeUser}
+	if projectPath != "" {
+		scopes = append(scopes, MCPScopeLocal, MCPScopeProject)
+	}
+	redactor, _ := LoadRedactor(ResolveClaudeHome()) //nolint:errcheck // an unreadable rules file falls back to the built-in rules
+	var servers []MCPServer
+	var files []MCPServerFile
+	for _, scope := range scopes {
+		filePath, keys, err := MCPServerLocation(scope, projectPath)
+		if err != nil {
+			continue
+		}
+		data, readErr := os.ReadFile(filePath) //nolint:gosec // filePath is from MCPServerLocation, which returns only known config paths
+		files = append(files, MCPServerFile{Scope: scope, FilePath: filePath, Exists: readErr == nil, ETag: ContentETag(data)})
+		if readErr != nil {
+			continue
+		}
+		obj, err := ParseJSONObject(filePath, data)
+		if err != nil {
+			continue
+		}
+
+		defs := mcpServerDefinitions(obj, keys)
+		names := make([]string, 0, len(defs))
+		for name := range defs {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			def, ok := defs[name].(JsonObject)
+			if !ok {
+				continue
+			}
+			server := parseMCPServer(redactor.Object(def))
+			server.Name, server.Scope, server.FilePath = name, scope, filePath
+			servers = append(servers, server)
+		}
+	}
+	return servers, files
+}
+
+// LoadMCPServer returns the named server of scope as stored, without
+// redaction. Returns a *MCPServerEditError if it is not there, or a
+// *JSONParseError if the file cannot be parsed.
+func LoadMCPServer(scope MCPScope, projectPath, name string) (MCPServer, error) {
+	filePath, keys, err := MCPServerLocation(scope, projectPath)
+	if err != nil {
+		return MCPServer{}, err
+	}
+	_, obj, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return MCPServer{}, err
+	}
+	def, exists := mcpServerDefinition(obj, keys, name)
+	if !exists {
+		return MCPServer{}, &MCPServerEditError{Scope: scope, Name: name, NotFound: true}
+	}
+	server := parseMCPServer(def)
+	server.Name, server.Scope, server.FilePath = name, scope, filePath
+	return server, nil
+}
+
+// parseMCPServer reads the typed fields of a server definition. A definition
+// without a type is stdio if it has a command and http if it has a URL.
+func parseMCPServer(def JsonObject) MCPServer {
+	var server MCPServer
+	server.Command, _ = def["command"].(string)
+	server.URL, _ = def["url"].(string)
+	if t, ok := def["type"].(string); ok && t != "" {
+		server.Type = MCPServerType(t)
+	} else if server.Command == "" && server.URL != "" {
+		server.Type = MCPServerHTTP
+	} else {
+		server.Type = MCPServerStdio
+	}
+	if args, ok := def["args"].([]any); ok {
+		for _, arg := range args {
+			if s, ok := arg.(string); ok {
+				server.Args = append(server.Args, s)
+			}
+		}
+	}
+	server.Env = stringMap(def["env"])
+	server.Headers = stringMap(def["headers"])
+	return server
+}
+
+func stringMap(v any) map[string]string {
+	obj, ok := v.(JsonObject)
+	if !ok {
+		return nil
+	}
+	out := make(map[string]string, len(obj))
+	for k, val := range obj {
+		if s, ok := val.(string); ok {
+			out[k] = s
+		}
+	}
+	return out
+}
+
+// ValidateMCPServer checks a server definition's name and the fields its type
+// requires.
+func ValidateMCPServer(server MCPServer) []SettingsIssue {
+	var issues []SettingsIssue
+	if !mcpServerName.MatchString(server.Name) {
+		issues = append(issues, SettingsIssue{Path: "name", Message: "server name must be letters, digits, '_' and '-'"})
+	}
+	switch server.Type {
+	case MCPServerStdio:
+		if server.Command == "" {
+			issues = append(issues, SettingsIssue{Path: "command", Message: "stdio servers need a command"})
+		}
+	case MCPServerSSE, MCPServerHTTP:
+		u, err := url.Parse(server.URL)
+		if server.URL == "" || err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			issues = append(issues, SettingsIssue{Path: "url", Message: fmt.Sprintf("%s servers need an http or https URL", server.Type)})
+		}
+	default:
+		issues = append(issues, SettingsIssue{Path: "type", Message: fmt.Sprintf("unknown server type %q; expected stdio, sse or http", server.Type)})
+	}
+	return issues
+}
+
+// SaveMCPServer writes server into scope, creating it when create is true and
+// replacing an existing definition otherwise. Fields of the existing definition
+// that Field Station does not model are kept. Redaction placeholders, as
+// returned by ListMCPServers, are replaced by the values already on disk. The
+// file is backed up first and edited in place.
+// Returns a *MCPServerEditError if the server's existence does not match create,
+// a *RedactedWriteError if placeholders cannot be restored, or a
+// *JSONParseError, without writing, if the file cannot be parsed.
+func SaveMCPServer(scope MCPScope, projectPath string, server MCPServer, create bool, claudeHome string) error {
+	filePath, keys, err := MCPServerLocation(scope, projectPath)
+	if err != nil {
+		return err
+	}
+	data, obj, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return err
+	}
+	existing, exists := mcpServerDefinition(obj, keys, server.Name)
+	if exists == create {
+		return &MCPServerEditError{Scope: scope, Name: server.Name, NotFound: !create}
+	}
+
+	def := JsonObject{}
+	for k, v := range existing {
+		def[k] = v
+	}
+	for _, k := range []string{"type", "command", "args", "env", "url", "headers"} {
+		delete(def, k)
+	}
+	def["type"] = string(server.Type)
+	if server.Type == MCPServerStdio {
+		def["command"] = server.Command
+		if len(server.Args) > 0 {
+			args := make([]any, len(server.Args))
+			for i, arg := range server.Args {
+				args[i] = arg
+			}
+			def["args"] = args
+		}
+		if len(server.Env) > 0 {
+			def["env"] = stringMapToJSON(server.Env)
+		}
+	} else {
+		def["url"] = server.URL
+		if len(server.Headers) > 0 {
+			def["headers"] = stringMapToJSON(server.Headers)
+		}
+	}
+	redactor, _ := LoadRedactor(claudeHome) //nolint:errcheck // an unreadable rules file falls back to the built-in rules
+	restored, err := redactor.RestoreRedacted(def, existing)
+	if err != nil {
+		return err
+	}
+
+	updated, err := setJSONAtKeys(data, append(append([]string(nil), keys...), server.Name), restored, false)
+	if err != nil {
+		return err
+	}
+	BackupFile(filePath, BackupOpUpdate, claudeHome)
+	return writeJSONBytes(filePath, updated)
+}
+
+// DeleteMCPServer removes the named server from scope, backing up the file
+// first. Returns a *MCPServerEditError if it is not there, or a
+// *JSONParseError if the file cannot be parsed.
+func DeleteMCPServer(scope MCPScope, projectPath, name string, claudeHome string) error {
+	filePath, keys, err := MCPServerLocation(scope, projectPath)
+	if err != nil {
+		return err
+	}
+	data, obj, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return err
+	}
+	if _, exists := mcpServerDefinition(obj, keys, name); !exists {
+		return &MCPServerEditError{Scope: scope, Name: name, NotFound: true}
+	}
+	updated, err := deleteJSONAtKeys(data, append(append([]string(nil), keys...), name))
+	if err != nil {
+		return err
+	}
+	BackupFile(filePath, BackupOpDelete, claudeHome)
+	return writeJSONBytes(filePath, updated)
+}
+
+// mcpServerDefinitions returns the mcpServers object under keys in obj, or nil.
+func mcpServerDefinitions(obj JsonObject, keys []string) JsonObject {
+	var node any = obj
+	for _, key := range keys {
+		o, _ := node.(JsonObject)
+		node = o[key]
+	}
+	defs, _ := node.(JsonObject)
+	return defs
+}
+
+// mcpServerDefinition returns the named definition under keys in obj.
+func mcpServerDefinition(obj JsonObject, keys []string, name string) (JsonObject, bool) {
+	raw, ok := mcpServerDefinitions(obj, keys)[name]
+	if !ok {
+		return nil, false
+	}
+	def, _ := raw.(JsonObject)
+	return def, true
+}
+
+func stringMapToJSON(values map[string]string) JsonObject {
+	out := make(JsonObject, len(values))
+	for k, v := range values {
+		out[k] = v
+	}
+	return out
+}
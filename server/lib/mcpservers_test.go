@@ -0,0 +1,139 @@
+package lib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+// setupMCPScopes points CLAUDE_HOME at a temp dir and writes ~/.claude.json
+// there, returning the Claude home and a project directory.
+func setupMCPScopes(t *testing.T, claudeJSON string) (string, string) {
+	t.Helper()
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	if claudeJSON != "" {
+		require.NoError(t, os.WriteFile(filepath.Join(claudeHome, ".claude.json"), []byte(claudeJSON), 0o600))
+	}
+	return claudeHome, t.TempDir()
+}
+
+func TestListMCPServers_AllScopesRedacted(t *testing.T) {
+	_, projectDir := setupMCPScopes(t, "")
+	claudeJSON := `{
+  "numStartups": 12,
+  "mcpServers": {
+    "github": {"command": "npx", "args": ["-y", "@modelcontextprotocol/server-github"], "env": {"GITHUB_TOKEN": "ghp_secret"}}
+  },
+  "projects": {
+    "` + projectDir + `": {"mcpServers": {"docs": {"type": "http", "url": "https://docs.example.com/mcp", "headers": {"Authorization": "Bearer abc"}}}}
+  }
+}`
+	require.NoError(t, os.WriteFile(lib.ResolveClaudeJSONPath(), []byte(claudeJSON), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(projectDir, ".mcp.json"),
+		[]byte(`{"mcpServers":{"events":{"type":"sse","url":"https://events.example.com/sse"},"local":{"command":"./bin/server"}}}`), 0o600))
+
+	servers, files := lib.ListMCPServers(projectDir)
+	require.Len(t, files, 3)
+	assert.True(t, files[2].Exists)
+	require.Len(t, servers, 4)
+
+	github := servers[0]
+	assert.Equal(t, lib.MCPScopeUser, github.Scope)
+	assert.Equal(t, lib.MCPServerStdio, github.Type)
+	assert.Equal(t, []string{"-y", "@modelcontextprotocol/server-github"}, github.Args)
+	assert.Equal(t, "[REDACTED]", github.Env["GITHUB_TOKEN"])
+
+	docs := servers[1]
+	assert.Equal(t, lib.MCPScopeLocal, docs.Scope)
+	assert.Equal(t, lib.MCPServerHTTP, docs.Type)
+	assert.Equal(t, "[REDACTED]", docs.Headers["Authorization"])
+
+	assert.Equal(t, "events", servers[2].Name)
+	assert.Equal(t, lib.MCPServerSSE, servers[2].Type)
+	assert.Equal(t, lib.MCPScopeProject, servers[3].Scope)
+	assert.Equal(t, lib.MCPServerStdio, servers[3].Type, "untyped server with a command is stdio")
+}
+
+func TestSaveMCPServer_KeepsRedactedValuesAndOtherKeys(t *testing.T) {
+	claudeHome, _ := setupMCPScopes(t, "{\n  \"numStartups\": 12,\n  \"mcpServers\": {\n    \"github\": {\"command\": \"npx\", \"env\": {\"GITHUB_TOKEN\": \"ghp_secret\"}, \"timeout\": 30}\n  }\n}\n")
+
+	server := lib.MCPServer{
+		Name:    "github",
+		Type:    lib.MCPServerStdio,
+		Command: "docker",
+		Args:    []string{"run", "ghcr.io/github/github-mcp-server"},
+		Env:     map[string]string{"GITHUB_TOKEN": "[REDACTED]", "GITHUB_HOST": "github.example.com"},
+	}
+	require.NoError(t, lib.SaveMCPServer(lib.MCPScopeUser, "", server, false, claudeHome))
+
+	data, err := os.ReadFile(lib.ResolveClaudeJSONPath()) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"numStartups":12,"mcpServers":{"github":{"timeout":30,"type":"stdio","command":"docker","args":["run","ghcr.io/github/github-mcp-server"],"env":{"GITHUB_TOKEN":"ghp_secret","GITHUB_HOST":"github.example.com"}}}}`, string(data))
+	assert.Contains(t, string(data), "{\n  \"numStartups\": 12,\n")
+
+	assert.Len(t, lib.ListBackups(claudeHome), 1)
+}
+
+func TestSaveMCPServer_CreatesProjectAndLocalEntries(t *testing.T) {
+	claudeHome, projectDir := setupMCPScopes(t, `{"projects":{}}`)
+
+	server := lib.MCPServer{Name: "docs", Type: lib.MCPServerHTTP, URL: "https://docs.example.com/mcp"}
+	require.NoError(t, lib.SaveMCPServer(lib.MCPScopeLocal, projectDir, server, true, claudeHome))
+	require.NoError(t, lib.SaveMCPServer(lib.MCPScopeProject, projectDir, server, true, claudeHome))
+
+	servers, _ := lib.ListMCPServers(projectDir)
+	require.Len(t, servers, 2)
+	assert.Equal(t, lib.MCPScopeLocal, servers[0].Scope)
+	assert.Equal(t, filepath.Join(projectDir, ".mcp.json"), servers[1].FilePath)
+
+	var editErr *lib.MCPServerEditError
+	err := lib.SaveMCPServer(lib.MCPScopeProject, projectDir, server, true, claudeHome)
+	require.True(t, errors.As(err, &editErr))
+	assert.False(t, editErr.NotFound)
+
+	require.NoError(t, lib.DeleteMCPServer(lib.MCPScopeLocal, projectDir, "docs", claudeHome))
+	err = lib.DeleteMCPServer(lib.MCPScopeLocal, projectDir, "docs", claudeHome)
+	require.True(t, errors.As(err, &editErr))
+	assert.True(t, editErr.NotFound)
+}
+
+func TestSaveMCPServer_RefusesUnparseableFile(t *testing.T) {
+	claudeHome, _ := setupMCPScopes(t, `{"mcpServers": {`)
+
+	server := lib.MCPServer{Name: "github", Type: lib.MCPServerStdio, Command: "npx"}
+	err := lib.SaveMCPServer(lib.MCPScopeUser, "", server, true, claudeHome)
+	var parseErr *lib.JSONParseError
+	require.True(t, errors.As(err, &parseErr))
+}
+
+func TestValidateMCPServer(t *testing.T) {
+	tests := []struct {
+		name   string
+		server lib.MCPServer
+		path   string
+	}{
+		{name: "valid stdio", server: lib.MCPServer{Name: "github", Type: lib.MCPServerStdio, Command: "npx"}},
+		{name: "valid http", server: lib.MCPServer{Name: "docs", Type: lib.MCPServerHTTP, URL: "https://docs.example.com/mcp"}},
+		{name: "bad name", server: lib.MCPServer{Name: "my server", Type: lib.MCPServerStdio, Command: "npx"}, path: "name"},
+		{name: "stdio without command", server: lib.MCPServer{Name: "github", Type: lib.MCPServerStdio}, path: "command"},
+		{name: "sse without scheme", server: lib.MCPServer{Name: "events", Type: lib.MCPServerSSE, URL: "events.example.com"}, path: "url"},
+		{name: "unknown type", server: lib.MCPServer{Name: "ws", Type: "websocket"}, path: "type"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			issues := lib.ValidateMCPServer(tt.server)
+			if tt.path == "" {
+				assert.Empty(t, issues)
+				return
+			}
+			require.Len(t, issues, 1)
+			assert.Equal(t, tt.path, issues[0].Path)
+		})
+	}
+}
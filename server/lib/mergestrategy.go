@@ -0,0 +1,117 @@
+package lib
+
+import (
+	"encoding/json"
+	"sort"
+)
+
+// MergeStrategy describes how values at the same key path combine across config layers.
+type MergeStrategy string
+
+// Merge strategies, mirroring how Claude Code combines settings scopes.
+const (
+	// MergeReplace lets the higher-precedence layer's value win outright.
+	MergeReplace MergeStrategy = "replace"
+	// MergeConcatUnique concatenates arrays from every layer, lowest precedence
+	// first, dropping duplicates.
+	MergeConcatUnique MergeStrategy = "concat-unique"
+	// MergePerEvent merges an object of arrays key by key, concat-unique within each key.
+	MergePerEvent MergeStrategy = "per-event"
+)
+
+// mergeStrategies lists every key path that does not use MergeReplace.
+var mergeStrategies = map[string]MergeStrategy{
+	"permissions.allow":                 MergeConcatUnique,
+	"permissions.deny":                  MergeConcatUnique,
+	"permissions.ask":                   MergeConcatUnique,
+	"permissions.additionalDirectories": MergeConcatUnique,
+	"enabledMcpjsonServers":             MergeConcatUnique,
+	"disabledMcpjsonServers":            MergeConcatUnique,
+	"sandbox.excludedCommands":          MergeConcatUnique,
+	"sandbox.network.allowUnixSockets":  MergeConcatUnique,
+	"hooks":                             MergePerEvent,
+}
+
+// MergeStrategyFor returns the strategy used to merge the value at keyPath.
+// Children of a MergePerEvent key (e.g. "hooks.PreToolUse") are concat-unique.
+func MergeStrategyFor(keyPath string) MergeStrategy {
+	if s, ok := mergeStrategies[keyPath]; ok {
+		return s
+	}
+	if keys := splitPath(keyPath); len(keys) > 1 && mergeStrategies[JoinPath(keys[:len(keys)-1]...)] == MergePerEvent {
+		return MergeConcatUnique
+	}
+	return MergeReplace
+}
+
+// concatUnique appends the items of source to those of target, skipping any item
+// already present. ok is false unless both values are arrays, in which case the
+// caller falls back to replacing.
+func concatUnique(target, source any) (merged []any, ok bool) {
+	targetArr, targetOK := target.([]any)
+	sourceArr, sourceOK := source.([]any)
+	if !targetOK || !sourceOK {
+		return nil, false
+	}
+	seen := make(map[string]bool, len(targetArr)+len(sourceArr))
+	merged = make([]any, 0, len(targetArr)+len(sourceArr))
+	for _, item := range append(targetArr[:len(targetArr):len(targetArr)], sourceArr...) {
+		key := itemKey(item)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		merged = append(merged, item)
+	}
+	return merged, true
+}
+
+// itemKey returns a canonical identity for a decoded JSON value. encoding/json
+// sorts map keys, so structurally equal objects produce the same key.
+func itemKey(item any) string {
+	data, err := json.Marshal(item)
+	if err != nil {
+		return ""
+	}
+	return string(data)
+}
+
+// recordCombined records layer as the latest contributor to the concat-merged
+// array at path. The previous entry's own value moves into Contributions.
+func recordCombined(provenance map[string]KeyProvenance, path string, merged, own any, layer ConfigLayer) {
+	entry := KeyProvenance{
+		Source:   layer.Source,
+		FilePath: layer.FilePath,
+		Value:    merged,
+		own:      own,
+	}
+	if prev, ok := provenance[path]; ok {
+		entry.Contributions = append([]ShadowedValue{{Source: prev.Source, FilePath: prev.FilePath, Value: prev.own}}, prev.Contributions...)
+		entry.Shadowed = prev.Shadowed
+	}
+	provenance[path] = entry
+}
+
+// replacePinned reports the first key path beneath path in value that a lower
+// layer cannot add to, i.e. one not merged with MergeConcatUnique. Empty
+// objects pin nothing.
+func replacePinned(value any, path string) (string, bool) {
+	if MergeStrategyFor(path) == MergeConcatUnique {
+		return "", false
+	}
+	obj, ok := value.(JsonObject)
+	if !ok {
+		return path, true
+	}
+	keys := make([]string, 0, len(obj))
+	for k := range obj {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if pinned, ok := replacePinned(obj[k], appendPath(path, k)); ok {
+			return pinned, true
+		}
+	}
+	return "", false
+}
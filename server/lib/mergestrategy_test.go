@@ -0,0 +1,134 @@
+package lib_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+// mergeGlobalAndLocal writes global and global-local settings and merges them.
+func mergeGlobalAndLocal(t *testing.T, global, local string) lib.EffectiveConfig {
+	t.Helper()
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(global), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(local), 0o600))
+	return lib.MergeConfigLayers("")
+}
+
+func TestMergeStrategyFor_KnownArrayKeys(t *testing.T) {
+	cases := []struct {
+		keyPath  string
+		strategy lib.MergeStrategy
+	}{
+		{"permissions.allow", lib.MergeConcatUnique},
+		{"permissions.deny", lib.MergeConcatUnique},
+		{"permissions.ask", lib.MergeConcatUnique},
+		{"permissions.additionalDirectories", lib.MergeConcatUnique},
+		{"enabledMcpjsonServers", lib.MergeConcatUnique},
+		{"disabledMcpjsonServers", lib.MergeConcatUnique},
+		{"sandbox.excludedCommands", lib.MergeConcatUnique},
+		{"sandbox.network.allowUnixSockets", lib.MergeConcatUnique},
+		{"hooks", lib.MergePerEvent},
+		{"hooks.PreToolUse", lib.MergeConcatUnique},
+		{"hooks.SessionStart", lib.MergeConcatUnique},
+		{"companyAnnouncements", lib.MergeReplace},
+		{"permissions.defaultMode", lib.MergeReplace},
+		{"items", lib.MergeReplace},
+	}
+	for _, tc := range cases {
+		assert.Equal(t, tc.strategy, lib.MergeStrategyFor(tc.keyPath), tc.keyPath)
+	}
+}
+
+func TestMergeConfigLayers_ConcatUniqueKeys(t *testing.T) {
+	cases := []struct {
+		name   string
+		global string
+		local  string
+		path   []string
+		want   []any
+	}{
+		{"allow", `{"permissions":{"allow":["Read","Bash(ls)"]}}`, `{"permissions":{"allow":["Bash(ls)","Edit"]}}`,
+			[]string{"permissions", "allow"}, []any{"Read", "Bash(ls)", "Edit"}},
+		{"deny", `{"permissions":{"deny":["WebFetch"]}}`, `{"permissions":{"deny":["Read(.env)"]}}`,
+			[]string{"permissions", "deny"}, []any{"WebFetch", "Read(.env)"}},
+		{"ask", `{"permissions":{"ask":["Bash(git push:*)"]}}`, `{"permissions":{"ask":["Bash(git push:*)"]}}`,
+			[]string{"permissions", "ask"}, []any{"Bash(git push:*)"}},
+		{"additionalDirectories", `{"permissions":{"additionalDirectories":["../a"]}}`, `{"permissions":{"additionalDirectories":["../b"]}}`,
+			[]string{"permissions", "additionalDirectories"}, []any{"../a", "../b"}},
+		{"enabledMcpjsonServers", `{"enabledMcpjsonServers":["github"]}`, `{"enabledMcpjsonServers":["linear","github"]}`,
+			[]string{"enabledMcpjsonServers"}, []any{"github", "linear"}},
+		{"disabledMcpjsonServers", `{"disabledMcpjsonServers":["a"]}`, `{"disabledMcpjsonServers":["b"]}`,
+			[]string{"disabledMcpjsonServers"}, []any{"a", "b"}},
+		{"excludedCommands", `{"sandbox":{"excludedCommands":["docker"]}}`, `{"sandbox":{"excludedCommands":["git"]}}`,
+			[]string{"sandbox", "excludedCommands"}, []any{"docker", "git"}},
+		{"allowUnixSockets", `{"sandbox":{"network":{"allowUnixSockets":["/a.sock"]}}}`, `{"sandbox":{"network":{"allowUnixSockets":["/b.sock"]}}}`,
+			[]string{"sandbox", "network", "allowUnixSockets"}, []any{"/a.sock", "/b.sock"}},
+	}
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := mergeGlobalAndLocal(t, tc.global, tc.local)
+			var got any = result.Merged
+			for _, key := range tc.path {
+				got = got.(lib.JsonObject)[key]
+			}
+			assert.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestMergeConfigLayers_ReplacedArrayKeys(t *testing.T) {
+	result := mergeGlobalAndLocal(t,
+		`{"companyAnnouncements":["a"],"items":[1]}`,
+		`{"companyAnnouncements":["b"],"items":[2]}`)
+	assert.Equal(t, []any{"b"}, result.Merged["companyAnnouncements"])
+	assert.Equal(t, []any{float64(2)}, result.Merged["items"])
+}
+
+func TestMergeConfigLayers_HooksMergedPerEvent(t *testing.T) {
+	result := mergeGlobalAndLocal(t,
+		`{"hooks":{"PreToolUse":[{"matcher":"Bash","hooks":[{"type":"command","command":"a"}]}],"Stop":[{"hooks":[{"type":"command","command":"s"}]}]}}`,
+		`{"hooks":{"PreToolUse":[{"matcher":"Edit","hooks":[{"type":"command","command":"b"}]},{"matcher":"Bash","hooks":[{"type":"command","command":"a"}]}]}}`)
+
+	hooks := result.Merged["hooks"].(lib.JsonObject)
+	pre := hooks["PreToolUse"].([]any)
+	require.Len(t, pre, 2, "identical matcher groups are de-duplicated")
+	assert.Equal(t, "Bash", pre[0].(lib.JsonObject)["matcher"])
+	assert.Equal(t, "Edit", pre[1].(lib.JsonObject)["matcher"])
+	assert.Len(t, hooks["Stop"], 1, "events defined in one layer only are kept")
+}
+
+func TestMergeConfigLayers_ConcatFallsBackToReplaceForNonArrays(t *testing.T) {
+	result := mergeGlobalAndLocal(t,
+		`{"permissions":{"allow":["Read"]}}`,
+		`{"permissions":{"allow":"Edit"}}`)
+	assert.Equal(t, "Edit", result.Merged["permissions"].(lib.JsonObject)["allow"])
+}
+
+func TestMergeConfigLayers_ProvenanceForCombinedKeys(t *testing.T) {
+	claudeHome := t.TempDir()
+	t.Setenv("CLAUDE_HOME", claudeHome)
+	t.Setenv("FIELD_STATION_MANAGED_SETTINGS", filepath.Join(claudeHome, "managed-settings.json"))
+
+	projectDir := t.TempDir()
+	claudeDir := filepath.Join(projectDir, ".claude")
+	require.NoError(t, os.MkdirAll(claudeDir, 0o750))
+
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.json"), []byte(`{"permissions":{"deny":["WebFetch"]}}`), 0o600))
+	require.NoError(t, os.WriteFile(filepath.Join(claudeDir, "settings.json"), []byte(`{"permissions":{"deny":["Read(.env)"]}}`), 0o600))
+
+	result := lib.MergeConfigLayers(projectDir)
+	entry := result.Provenance["permissions.deny"]
+	assert.Equal(t, lib.ConfigLayerProject, entry.Source)
+	assert.Equal(t, []any{"WebFetch", "Read(.env)"}, entry.Value)
+	assert.Empty(t, entry.Shadowed)
+	require.Len(t, entry.Contributions, 1)
+	assert.Equal(t, lib.ConfigLayerGlobal, entry.Contributions[0].Source)
+	assert.Equal(t, []any{"WebFetch"}, entry.Contributions[0].Value)
+}
@@ -0,0 +1,75 @@
+package lib
+
+import (
+	"path/filepath"
+	"slices"
+)
+
+// BuiltinOutputStyles are the output styles Claude Code ships with.
+var BuiltinOutputStyles = []string{"default", "Explanatory", "Learning"}
+
+// OutputStyleSetting is the outputStyle setting of every layer. Entries lists
+// the layers that set it, lowest precedence first; Effective is the value
+// Claude Code uses, or nil if no layer sets one.
+type OutputStyleSetting struct {
+	Entries   []ShadowedValue
+	Effective *ShadowedValue
+	Layers    []ConfigLayer
+}
+
+// LoadOutputStyleSetting reads the outputStyle setting of every layer
+// MergeConfigLayers reads.
+func LoadOutputStyleSetting(projectPath string) OutputStyleSetting {
+	config := MergeConfigLayers(projectPath)
+	result := OutputStyleSetting{Layers: config.Layers}
+	for _, layer := range config.Layers {
+		if value, ok := layer.Content["outputStyle"]; ok {
+			result.Entries = append(result.Entries, ShadowedValue{Source: layer.Source, FilePath: layer.FilePath, Value: value})
+		}
+	}
+	if p, ok := config.Provenance["outputStyle"]; ok {
+		result.Effective = &ShadowedValue{Source: p.Source, FilePath: p.FilePath, Value: p.Value}
+	}
+	return result
+}
+
+// AvailableOutputStyles returns the names outputStyle can be set to: the
+// built-in styles, then those in claudeHome/output-styles and, when projectPath
+// is set, the project's .claude/output-styles, without duplicates. A custom
+// style is named by its frontmatter name, or its file name without .md.
+func AvailableOutputStyles(claudeHome, projectPath string) []string {
+	names := slices.Clone(BuiltinOutputStyles)
+	homes := []string{claudeHome}
+	if projectPath != "" {
+		homes = append(homes, filepath.Join(projectPath, ".claude"))
+	}
+	for _, home := range homes {
+		styles, err := ListResources(ResourceTypeOutputStyle, home)
+		if err != nil {
+			continue
+		}
+		for _, style := range styles {
+			if !slices.Contains(names, style.Name) {
+				names = append(names, style.Name)
+			}
+		}
+	}
+	return names
+}
+
+// SetOutputStyle sets outputStyle in the settings file at filePath to name, or
+// removes the setting if name is nil.
+// Returns a *JSONParseError, without writing, if the file cannot be parsed.
+func SetOutputStyle(filePath string, name *string, claudeHome string) error {
+	obj, err := ReadJSONFileStrict(filePath)
+	if err != nil {
+		return err
+	}
+	if name == nil {
+		if _, ok := obj["outputStyle"]; !ok {
+			return nil
+		}
+		return ApplyDeleteSetting(filePath, "outputStyle", claudeHome)
+	}
+	return ApplyUpdateSetting(filePath, "outputStyle", *name, claudeHome)
+}
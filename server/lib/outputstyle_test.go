@@ -0,0 +1,40 @@
+package lib_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestAvailableOutputStyles_BuiltinsThenCustom(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, `{}`)
+	writeResourceFile(t, filepath.Join(claudeHome, "output-styles"), "terse", "---\nname: Terse\n---\nBe brief.")
+	writeResourceFile(t, filepath.Join(projectDir, ".claude", "output-styles"), "reviewer", "Review carefully.")
+	writeResourceFile(t, filepath.Join(projectDir, ".claude", "output-styles"), "terse", "---\nname: Terse\n---\nBe briefer.")
+
+	assert.Equal(t, []string{"default", "Explanatory", "Learning", "Terse"}, lib.AvailableOutputStyles(claudeHome, ""))
+	assert.Equal(t, []string{"default", "Explanatory", "Learning", "Terse", "reviewer"}, lib.AvailableOutputStyles(claudeHome, projectDir))
+}
+
+func TestSetOutputStyle_SetsAndRemoves(t *testing.T) {
+	_, projectDir := setupPatchLayers(t, `{"outputStyle":"Explanatory"}`)
+	localPath := filepath.Join(projectDir, ".claude", "settings.local.json")
+
+	name := "Learning"
+	require.NoError(t, lib.SetOutputStyle(localPath, &name, t.TempDir()))
+	setting := lib.LoadOutputStyleSetting(projectDir)
+	require.Len(t, setting.Entries, 2)
+	require.NotNil(t, setting.Effective)
+	assert.Equal(t, lib.ConfigLayerProjectLocal, setting.Effective.Source)
+	assert.Equal(t, "Learning", setting.Effective.Value)
+
+	require.NoError(t, lib.SetOutputStyle(localPath, nil, t.TempDir()))
+	data, err := os.ReadFile(localPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{}`, string(data))
+	assert.Equal(t, "Explanatory", lib.LoadOutputStyleSetting(projectDir).Effective.Value)
+}
@@ -0,0 +1,356 @@
+package lib
+
+import (
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"regexp"
+	"strings"
+)
+
+// ToolCall is a hypothetical tool invocation to check against permission rules.
+// Input is what the tool's rules match: the command for Bash, the file path for
+// file tools, the URL for WebFetch, and the specifier text for any other tool.
+// Cwd is the working directory relative paths resolve against, normally the
+// project root.
+type ToolCall struct {
+	Tool  string
+	Input string
+	Cwd   string
+}
+
+// PermissionDecision is the outcome of evaluating a ToolCall. Rule is the rule
+// that decided it, or nil when no rule matched and the permission mode did. For
+// Bash, Subcommands holds the decision for each command of a compound command.
+type PermissionDecision struct {
+	Behavior    PermissionBehavior
+	Reason      string
+	Rule        *PermissionRuleEntry
+	Subcommands []SubcommandDecision
+}
+
+// SubcommandDecision is the decision for one command of a compound Bash command.
+// Rule is nil when no rule matched it.
+type SubcommandDecision struct {
+	Command  string
+	Behavior PermissionBehavior
+	Reason   string
+	Rule     *PermissionRuleEntry
+}
+
+// permissionEditTools are covered by Edit rules, and permissionReadTools by Read
+// rules, as in Claude Code.
+var (
+	permissionEditTools = map[string]bool{"Edit": true, "Write": true, "MultiEdit": true, "NotebookEdit": true}
+	permissionReadTools = map[string]bool{"Read": true, "Glob": true, "Grep": true, "LS": true}
+)
+
+// EvaluatePermission decides call the way Claude Code does: a matching deny
+// rule wins over a matching ask rule, which wins over a matching allow rule,
+// regardless of layer. When several layers hold a matching rule the highest
+// precedence one is reported. A compound Bash command is split on &&, ||, ;
+// and |, and is allowed only if every part is. With no matching rule the
+// permission mode (perms.DefaultMode) decides.
+func EvaluatePermission(perms PermissionSettings, call ToolCall) (PermissionDecision, error) {
+	if call.Tool == "" {
+		return PermissionDecision{}, errors.New("tool is required")
+	}
+	if call.Tool == "Bash" && call.Input != "" {
+		return evaluateBash(perms, call), nil
+	}
+
+	target := call.Input
+	if isPathTool(call.Tool) && call.Input != "" {
+		var err error
+		if target, err = resolveToolPath(call.Input, call.Cwd); err != nil {
+			return PermissionDecision{}, err
+		}
+	}
+	if entry := matchPermissionRules(perms.Rules, call, target); entry != nil {
+		return PermissionDecision{Behavior: entry.Behavior, Reason: ruleReason(entry), Rule: entry}, nil
+	}
+	return modeDecision(perms, call, target), nil
+}
+
+// evaluateBash evaluates each command of a compound command on its own. The
+// most restrictive part decides the whole.
+func evaluateBash(perms PermissionSettings, call ToolCall) PermissionDecision {
+	var decision PermissionDecision
+	rank := map[PermissionBehavior]int{PermissionAllow: 0, PermissionAsk: 1, PermissionDeny: 2}
+	decider := -1
+	for _, command := range splitBashCommand(call.Input) {
+		part := ToolCall{Tool: "Bash", Input: command, Cwd: call.Cwd}
+		sub := SubcommandDecision{Command: command}
+		if entry := matchPermissionRules(perms.Rules, part, command); entry != nil {
+			sub.Behavior, sub.Reason, sub.Rule = entry.Behavior, ruleReason(entry), entry
+		} else {
+			mode := modeDecision(perms, part, command)
+			sub.Behavior, sub.Reason = mode.Behavior, mode.Reason
+		}
+		decision.Subcommands = append(decision.Subcommands, sub)
+		if decider < 0 || rank[sub.Behavior] > rank[decision.Subcommands[decider].Behavior] {
+			decider = len(decision.Subcommands) - 1
+		}
+	}
+
+	d := decision.Subcommands[decider]
+	decision.Behavior, decision.Reason, decision.Rule = d.Behavior, d.Reason, d.Rule
+	if len(decision.Subcommands) > 1 {
+		decision.Reason = fmt.Sprintf("%q: %s", d.Command, d.Reason)
+	} else {
+		decision.Subcommands = nil
+	}
+	return decision
+}
+
+// matchPermissionRules returns the rule that decides call, checking deny, then
+// ask, then allow rules, highest precedence layer first. target is call.Input
+// with any path resolved. Rules that do not parse never match.
+func matchPermissionRules(rules []PermissionRuleEntry, call ToolCall, target string) *PermissionRuleEntry {
+	for _, behavior := range []PermissionBehavior{PermissionDeny, PermissionAsk, PermissionAllow} {
+		for i := len(rules) - 1; i >= 0; i-- {
+			entry := &rules[i]
+			if entry.Behavior == behavior && entry.Error == "" && permissionRuleMatches(entry, call, target) {
+				return entry
+			}
+		}
+	}
+	return nil
+}
+
+// permissionRuleMatches reports whether a parsed rule covers call.
+func permissionRuleMatches(entry *PermissionRuleEntry, call ToolCall, target string) bool {
+	rule := entry.Rule
+	if rule.Kind == PermissionPatternMCP {
+		server, tool, ok := strings.Cut(strings.TrimPrefix(call.Tool, "mcp__"), "__")
+		if !strings.HasPrefix(call.Tool, "mcp__") || server != rule.MCPServer {
+			return false
+		}
+		return rule.Pattern == "" || rule.Pattern == "*" || (ok && tool == rule.Pattern)
+	}
+
+	switch {
+	case rule.Tool == call.Tool:
+	case rule.Tool == "Edit" && permissionEditTools[call.Tool]:
+	case rule.Tool == "Read" && permissionReadTools[call.Tool]:
+	default:
+		return false
+	}
+
+	switch rule.Kind {
+	case PermissionPatternTool:
+		return true
+	case PermissionPatternPrefix:
+		return target == rule.Pattern || strings.HasPrefix(target, rule.Pattern+" ")
+	case PermissionPatternDomain:
+		u, err := url.Parse(call.Input)
+		if err != nil || u.Hostname() == "" {
+			return false
+		}
+		host := strings.ToLower(u.Hostname())
+		pattern := strings.ToLower(rule.Pattern)
+		if suffix, ok := strings.CutPrefix(pattern, "*."); ok {
+			return strings.HasSuffix(host, "."+suffix)
+		}
+		return host == pattern
+	case PermissionPatternGlob:
+		if isPathTool(call.Tool) {
+			return target != "" && pathRuleMatches(rule.Pattern, entry.FilePath, call.Cwd, target)
+		}
+		return wildcardPattern(rule.Pattern).MatchString(target)
+	default:
+		return target == rule.Pattern
+	}
+}
+
+// modeDecision decides a call no rule matched, from the permission mode.
+func modeDecision(perms PermissionSettings, call ToolCall, target string) PermissionDecision {
+	mode := "default"
+	if perms.DefaultMode != nil {
+		if m, ok := perms.DefaultMode.Value.(string); ok && m != "" {
+			mode = m
+		}
+	}
+	inWorkspace := target != "" && isPathTool(call.Tool) && inWorkingDirectories(perms, call.Cwd, target)
+
+	switch {
+	case mode == "bypassPermissions":
+		return PermissionDecision{Behavior: PermissionAllow, Reason: "no rule matched; bypassPermissions mode allows every call"}
+	case permissionReadTools[call.Tool] && (inWorkspace || target == ""):
+		return PermissionDecision{Behavior: PermissionAllow, Reason: "no rule matched; read-only tools are allowed inside the working directories"}
+	case mode == "plan":
+		return PermissionDecision{Behavior: PermissionDeny, Reason: "no rule matched; plan mode does not run tools that change anything"}
+	case mode == "acceptEdits" && permissionEditTools[call.Tool] && inWorkspace:
+		return PermissionDecision{Behavior: PermissionAllow, Reason: "no rule matched; acceptEdits mode allows edits inside the working directories"}
+	default:
+		return PermissionDecision{Behavior: PermissionAsk, Reason: fmt.Sprintf("no rule matched; %s mode asks", mode)}
+	}
+}
+
+func ruleReason(entry *PermissionRuleEntry) string {
+	return fmt.Sprintf("matched %s rule %q from the %s layer", entry.Behavior, entry.Rule.Raw, entry.Source)
+}
+
+func isPathTool(tool string) bool {
+	return permissionEditTools[tool] || permissionReadTools[tool]
+}
+
+// inWorkingDirectories reports whether path is inside cwd or one of the
+// additional directories.
+func inWorkingDirectories(perms PermissionSettings, cwd, path string) bool {
+	dirs := []string{cwd}
+	for _, dir := range perms.AdditionalDirectories {
+		if resolved, err := resolveToolPath(dir.Path, cwd); err == nil {
+			dirs = append(dirs, resolved)
+		}
+	}
+	for _, dir := range dirs {
+		if dir == "" {
+			continue
+		}
+		if rel, err := filepath.Rel(dir, path); err == nil && rel != ".." && !strings.HasPrefix(rel, "../") {
+			return true
+		}
+	}
+	return false
+}
+
+// resolveToolPath makes path absolute: ~/ is the home directory and a relative
+// path is joined to cwd.
+func resolveToolPath(path, cwd string) (string, error) {
+	if rest, ok := strings.CutPrefix(path, "~/"); ok {
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return "", err
+		}
+		return filepath.Join(home, rest), nil
+	}
+	if filepath.IsAbs(path) {
+		return filepath.Clean(path), nil
+	}
+	if cwd == "" {
+		return "", fmt.Errorf("relative path %q needs a working directory", path)
+	}
+	return filepath.Join(cwd, path), nil
+}
+
+// pathRuleMatches matches a file path against a path rule with gitignore-style
+// semantics. Patterns are anchored by their prefix: //abs is an absolute path,
+// ~/ is the home directory, / is relative to the directory holding the settings
+// file's .claude folder, and ./ or any other path containing a slash is
+// relative to cwd. A bare name such as *.env matches at any depth below cwd. A
+// pattern naming a directory also covers everything inside it.
+func pathRuleMatches(pattern, settingsPath, cwd, path string) bool {
+	var anchored string
+	switch {
+	case strings.HasPrefix(pattern, "//"):
+		anchored = pattern[1:]
+	case strings.HasPrefix(pattern, "~/"):
+		home, err := os.UserHomeDir()
+		if err != nil {
+			return false
+		}
+		anchored = filepath.ToSlash(home) + pattern[1:]
+	case strings.HasPrefix(pattern, "/"):
+		base := filepath.Dir(settingsPath)
+		if filepath.Base(base) == ".claude" {
+			base = filepath.Dir(base)
+		}
+		anchored = filepath.ToSlash(base) + pattern
+	case cwd == "":
+		return false
+	case strings.HasPrefix(pattern, "./"):
+		anchored = filepath.ToSlash(cwd) + pattern[1:]
+	case strings.Contains(strings.TrimSuffix(pattern, "/"), "/"):
+		anchored = filepath.ToSlash(cwd) + "/" + pattern
+	default:
+		anchored = filepath.ToSlash(cwd) + "/**/" + pattern
+	}
+	anchored = strings.TrimSuffix(anchored, "/")
+	path = filepath.ToSlash(path)
+	return pathGlob(anchored).MatchString(path) || pathGlob(anchored+"/**").MatchString(path)
+}
+
+// pathGlob compiles a gitignore-style glob: ** spans directories, * and ? stay
+// within one path segment.
+func pathGlob(pattern string) *regexp.Regexp {
+	var b strings.Builder
+	b.WriteString("^")
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "/**/"):
+			b.WriteString("(?:/.*)?/")
+			i += 3
+		case strings.HasPrefix(pattern[i:], "/**") && i+3 == len(pattern):
+			b.WriteString("(?:/.*)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			b.WriteString(".*")
+			i++
+		case c == '*':
+			b.WriteString("[^/]*")
+		case c == '?':
+			b.WriteString("[^/]")
+		default:
+			b.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+	b.WriteString("$")
+	return regexp.MustCompile(b.String())
+}
+
+// wildcardPattern compiles a Bash rule in which * matches any run of characters.
+func wildcardPattern(pattern string) *regexp.Regexp {
+	parts := strings.Split(pattern, "*")
+	for i, part := range parts {
+		parts[i] = regexp.QuoteMeta(part)
+	}
+	return regexp.MustCompile("^" + strings.Join(parts, ".*") + "$")
+}
+
+// splitBashCommand splits a command line on &&, ||, ;, | and newlines that are
+// outside quotes, trimming each part and dropping empty ones.
+func splitBashCommand(command string) []string {
+	var parts []string
+	var b strings.Builder
+	var quote byte
+	flush := func() {
+		if part := strings.TrimSpace(b.String()); part != "" {
+			parts = append(parts, part)
+		}
+		b.Reset()
+	}
+	for i := 0; i < len(command); i++ {
+		c := command[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else if c == '\\' && quote == '"' && i+1 < len(command) {
+				b.WriteByte(c)
+				i++
+				c = command[i]
+			}
+		case c == '\'' || c == '"':
+			quote = c
+		case c == '\\' && i+1 < len(command):
+			b.WriteByte(c)
+			i++
+			c = command[i]
+		case c == ';' || c == '\n' || c == '|' || (c == '&' && i+1 < len(command) && command[i+1] == '&'):
+			flush()
+			if (c == '|' || c == '&') && i+1 < len(command) && command[i+1] == c {
+				i++
+			}
+			continue
+		}
+		b.WriteByte(c)
+	}
+	flush()
+	if len(parts) == 0 {
+		return []string{strings.TrimSpace(command)}
+	}
+	return parts
+}
@@ -0,0 +1,154 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+const evalProject = "/work/proj"
+
+type ruleSpec struct {
+	source   lib.ConfigLayerSource
+	behavior lib.PermissionBehavior
+	raw      string
+}
+
+func allow(raw string) ruleSpec { return ruleSpec{lib.ConfigLayerGlobal, lib.PermissionAllow, raw} }
+func deny(raw string) ruleSpec  { return ruleSpec{lib.ConfigLayerGlobal, lib.PermissionDeny, raw} }
+func ask(raw string) ruleSpec   { return ruleSpec{lib.ConfigLayerGlobal, lib.PermissionAsk, raw} }
+
+// permissionSettings builds the settings EvaluatePermission sees from rule specs,
+// giving each rule the file path of its layer.
+func permissionSettings(t *testing.T, mode string, dirs []string, specs ...ruleSpec) lib.PermissionSettings {
+	t.Helper()
+	filePaths := map[lib.ConfigLayerSource]string{
+		lib.ConfigLayerGlobal:       "/home/dev/.claude/settings.json",
+		lib.ConfigLayerProject:      evalProject + "/.claude/settings.json",
+		lib.ConfigLayerProjectLocal: evalProject + "/.claude/settings.local.json",
+		lib.ConfigLayerManaged:      "/etc/claude-code/managed-settings.json",
+	}
+	var perms lib.PermissionSettings
+	for i, spec := range specs {
+		rule, err := lib.ParsePermissionRule(spec.raw)
+		require.NoError(t, err)
+		perms.Rules = append(perms.Rules, lib.PermissionRuleEntry{
+			Behavior: spec.behavior, Rule: rule, Source: spec.source, FilePath: filePaths[spec.source], Index: i,
+		})
+	}
+	if mode != "" {
+		perms.DefaultMode = &lib.ShadowedValue{Source: lib.ConfigLayerGlobal, Value: mode}
+	}
+	for _, dir := range dirs {
+		perms.AdditionalDirectories = append(perms.AdditionalDirectories, lib.PermissionDirectory{Path: dir, Source: lib.ConfigLayerGlobal})
+	}
+	return perms
+}
+
+func TestEvaluatePermission(t *testing.T) {
+	t.Setenv("HOME", "/home/dev")
+	tests := []struct {
+		name       string
+		rules      []ruleSpec
+		mode       string
+		dirs       []string
+		tool       string
+		input      string
+		want       lib.PermissionBehavior
+		wantRule   string
+		wantSource lib.ConfigLayerSource
+	}{
+		// Path globs.
+		{name: "glob denies file under directory", rules: []ruleSpec{deny("Read(./secrets/**)")}, tool: "Read", input: "secrets/prod/api.key", want: lib.PermissionDeny, wantRule: "Read(./secrets/**)"},
+		{name: "glob leaves other files to the mode", rules: []ruleSpec{deny("Read(./secrets/**)")}, tool: "Read", input: "src/main.go", want: lib.PermissionAllow},
+		{name: "double star spans directories", rules: []ruleSpec{allow("Edit(src/**/*.go)")}, tool: "Edit", input: "src/pkg/util/a.go", want: lib.PermissionAllow, wantRule: "Edit(src/**/*.go)"},
+		{name: "double star matches zero directories", rules: []ruleSpec{allow("Edit(src/**/*.go)")}, tool: "Edit", input: "src/main.go", want: lib.PermissionAllow, wantRule: "Edit(src/**/*.go)"},
+		{name: "single star stays in one segment", rules: []ruleSpec{allow("Edit(src/*.go)")}, tool: "Edit", input: "src/pkg/a.go", want: lib.PermissionAsk},
+		{name: "bare name matches at any depth", rules: []ruleSpec{deny("Read(*.env)")}, tool: "Read", input: "config/prod.env", want: lib.PermissionDeny, wantRule: "Read(*.env)"},
+		{name: "directory rule covers its contents", rules: []ruleSpec{allow("Edit(./docs)")}, tool: "Edit", input: "docs/guide/intro.md", want: lib.PermissionAllow, wantRule: "Edit(./docs)"},
+		{name: "double slash is absolute", rules: []ruleSpec{deny("Read(//etc/passwd)")}, tool: "Read", input: "/etc/passwd", want: lib.PermissionDeny, wantRule: "Read(//etc/passwd)"},
+		{name: "tilde is the home directory", rules: []ruleSpec{deny("Read(~/.ssh/**)")}, tool: "Read", input: "~/.ssh/id_ed25519", want: lib.PermissionDeny, wantRule: "Read(~/.ssh/**)"},
+		{name: "leading slash is relative to the settings file", rules: []ruleSpec{{lib.ConfigLayerProject, lib.PermissionDeny, "Edit(/build/**)"}}, tool: "Edit", input: "build/out.js", want: lib.PermissionDeny, wantRule: "Edit(/build/**)", wantSource: lib.ConfigLayerProject},
+		{name: "Edit rules cover Write", rules: []ruleSpec{deny("Edit(./vendor/**)")}, tool: "Write", input: "vendor/x.go", want: lib.PermissionDeny, wantRule: "Edit(./vendor/**)"},
+		{name: "Read rules cover Grep", rules: []ruleSpec{deny("Read(./secrets/**)")}, tool: "Grep", input: "secrets/a", want: lib.PermissionDeny, wantRule: "Read(./secrets/**)"},
+		{name: "Read rules do not cover Edit", rules: []ruleSpec{allow("Read(./src/**)")}, tool: "Edit", input: "src/a.go", want: lib.PermissionAsk},
+
+		// Bash prefixes and wildcards.
+		{name: "prefix matches the bare command", rules: []ruleSpec{allow("Bash(npm run test:*)")}, tool: "Bash", input: "npm run test", want: lib.PermissionAllow, wantRule: "Bash(npm run test:*)"},
+		{name: "prefix matches with arguments", rules: []ruleSpec{allow("Bash(npm run test:*)")}, tool: "Bash", input: "npm run test -- --watch", want: lib.PermissionAllow, wantRule: "Bash(npm run test:*)"},
+		{name: "prefix stops at a word boundary", rules: []ruleSpec{allow("Bash(npm run test:*)")}, tool: "Bash", input: "npm run testing", want: lib.PermissionAsk},
+		{name: "exact rule needs the exact command", rules: []ruleSpec{allow("Bash(npm test)")}, tool: "Bash", input: "npm test --coverage", want: lib.PermissionAsk},
+		{name: "deny prefix beats allow prefix", rules: []ruleSpec{allow("Bash(git push:*)"), deny("Bash(git push --force:*)")}, tool: "Bash", input: "git push --force origin main", want: lib.PermissionDeny, wantRule: "Bash(git push --force:*)"},
+		{name: "wildcard in the middle", rules: []ruleSpec{deny("Bash(git * --force)")}, tool: "Bash", input: "git push --force", want: lib.PermissionDeny, wantRule: "Bash(git * --force)"},
+		{name: "compound command needs every part allowed", rules: []ruleSpec{allow("Bash(git status:*)")}, tool: "Bash", input: "git status && rm -rf build", want: lib.PermissionAsk},
+		{name: "compound command denied by any part", rules: []ruleSpec{allow("Bash(ls:*)"), deny("Bash(rm:*)")}, tool: "Bash", input: "ls | rm x", want: lib.PermissionDeny, wantRule: "Bash(rm:*)"},
+		{name: "compound command allowed part by part", rules: []ruleSpec{allow("Bash(go build:*)"), allow("Bash(go test:*)")}, tool: "Bash", input: "go build ./... && go test ./...", want: lib.PermissionAllow, wantRule: "Bash(go build:*)"},
+		{name: "quoted separators do not split", rules: []ruleSpec{allow("Bash(echo:*)")}, tool: "Bash", input: `echo "a && b; c"`, want: lib.PermissionAllow, wantRule: "Bash(echo:*)"},
+		{name: "bare tool rule matches any command", rules: []ruleSpec{allow("Bash")}, tool: "Bash", input: "make", want: lib.PermissionAllow, wantRule: "Bash"},
+
+		// MCP wildcards.
+		{name: "server rule covers every tool", rules: []ruleSpec{allow("mcp__github")}, tool: "mcp__github__create_issue", want: lib.PermissionAllow, wantRule: "mcp__github"},
+		{name: "server wildcard covers every tool", rules: []ruleSpec{allow("mcp__github__*")}, tool: "mcp__github__create_issue", want: lib.PermissionAllow, wantRule: "mcp__github__*"},
+		{name: "tool rule covers only that tool", rules: []ruleSpec{allow("mcp__github__list_issues")}, tool: "mcp__github__create_issue", want: lib.PermissionAsk},
+		{name: "tool deny beats server allow", rules: []ruleSpec{allow("mcp__github__*"), deny("mcp__github__delete_repo")}, tool: "mcp__github__delete_repo", want: lib.PermissionDeny, wantRule: "mcp__github__delete_repo"},
+		{name: "other server does not match", rules: []ruleSpec{allow("mcp__github")}, tool: "mcp__gitlab__create_issue", want: lib.PermissionAsk},
+		{name: "server name is not a prefix match", rules: []ruleSpec{allow("mcp__git")}, tool: "mcp__github__create_issue", want: lib.PermissionAsk},
+
+		// WebFetch domains.
+		{name: "domain matches host", rules: []ruleSpec{allow("WebFetch(domain:example.com)")}, tool: "WebFetch", input: "https://example.com/docs", want: lib.PermissionAllow, wantRule: "WebFetch(domain:example.com)"},
+		{name: "domain does not match subdomain", rules: []ruleSpec{allow("WebFetch(domain:example.com)")}, tool: "WebFetch", input: "https://api.example.com/", want: lib.PermissionAsk},
+
+		// Precedence across behaviors and layers.
+		{name: "ask beats allow", rules: []ruleSpec{allow("Bash"), ask("Bash(git push:*)")}, tool: "Bash", input: "git push", want: lib.PermissionAsk, wantRule: "Bash(git push:*)"},
+		{name: "deny in a lower layer beats allow in a higher one", rules: []ruleSpec{deny("Bash(curl:*)"), {lib.ConfigLayerProjectLocal, lib.PermissionAllow, "Bash(curl:*)"}}, tool: "Bash", input: "curl example.com", want: lib.PermissionDeny, wantRule: "Bash(curl:*)"},
+		{name: "highest layer reported for duplicates", rules: []ruleSpec{deny("Bash(curl:*)"), {lib.ConfigLayerManaged, lib.PermissionDeny, "Bash(curl:*)"}}, tool: "Bash", input: "curl x", want: lib.PermissionDeny, wantRule: "Bash(curl:*)", wantSource: lib.ConfigLayerManaged},
+
+		// Permission modes.
+		{name: "default mode asks for edits", tool: "Edit", input: "src/a.go", want: lib.PermissionAsk},
+		{name: "reads outside the workspace ask", tool: "Read", input: "/var/log/syslog", want: lib.PermissionAsk},
+		{name: "additional directories count as workspace", dirs: []string{"/var/log"}, tool: "Read", input: "/var/log/syslog", want: lib.PermissionAllow},
+		{name: "acceptEdits allows edits in the project", mode: "acceptEdits", tool: "Edit", input: "src/a.go", want: lib.PermissionAllow},
+		{name: "acceptEdits still asks outside the project", mode: "acceptEdits", tool: "Edit", input: "/tmp/a.go", want: lib.PermissionAsk},
+		{name: "plan mode denies commands", mode: "plan", tool: "Bash", input: "make", want: lib.PermissionDeny},
+		{name: "bypass mode allows unmatched calls", mode: "bypassPermissions", tool: "Bash", input: "rm -rf build", want: lib.PermissionAllow},
+		{name: "bypass mode keeps deny rules", mode: "bypassPermissions", rules: []ruleSpec{deny("Bash(rm:*)")}, tool: "Bash", input: "rm -rf build", want: lib.PermissionDeny, wantRule: "Bash(rm:*)"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			perms := permissionSettings(t, tt.mode, tt.dirs, tt.rules...)
+			decision, err := lib.EvaluatePermission(perms, lib.ToolCall{Tool: tt.tool, Input: tt.input, Cwd: evalProject})
+			require.NoError(t, err)
+			assert.Equal(t, tt.want, decision.Behavior, decision.Reason)
+			if tt.wantRule == "" {
+				assert.Nil(t, decision.Rule, decision.Reason)
+				return
+			}
+			require.NotNil(t, decision.Rule, decision.Reason)
+			assert.Equal(t, tt.wantRule, decision.Rule.Rule.Raw)
+			wantSource := tt.wantSource
+			if wantSource == "" {
+				wantSource = lib.ConfigLayerGlobal
+			}
+			assert.Equal(t, wantSource, decision.Rule.Source)
+		})
+	}
+}
+
+func TestEvaluatePermission_ReportsEachSubcommand(t *testing.T) {
+	perms := permissionSettings(t, "", nil, allow("Bash(git status:*)"), deny("Bash(rm:*)"))
+	decision, err := lib.EvaluatePermission(perms, lib.ToolCall{Tool: "Bash", Input: "git status; npm i || rm -rf x", Cwd: evalProject})
+	require.NoError(t, err)
+	require.Len(t, decision.Subcommands, 3)
+	assert.Equal(t, lib.PermissionAllow, decision.Subcommands[0].Behavior)
+	assert.Equal(t, lib.PermissionAsk, decision.Subcommands[1].Behavior)
+	assert.Nil(t, decision.Subcommands[1].Rule)
+	assert.Equal(t, "rm -rf x", decision.Subcommands[2].Command)
+	assert.Equal(t, lib.PermissionDeny, decision.Behavior)
+}
+
+func TestEvaluatePermission_RelativePathNeedsProject(t *testing.T) {
+	_, err := lib.EvaluatePermission(lib.PermissionSettings{}, lib.ToolCall{Tool: "Edit", Input: "src/a.go"})
+	require.Error(t, err)
+}
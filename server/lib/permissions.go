@@ -0,0 +1,396 @@
+package lib
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+)
+
+// PermissionBehavior names the permissions list a rule belongs to.
+type PermissionBehavior string
+
+// Permission behaviors, one per list under the permissions key.
+const (
+	PermissionAllow PermissionBehavior = "allow"
+	PermissionDeny  PermissionBehavior = "deny"
+	PermissionAsk   PermissionBehavior = "ask"
+)
+
+// PermissionBehaviors lists every behavior in the order rule lists appear in
+// settings files.
+var PermissionBehaviors = []PermissionBehavior{PermissionAllow, PermissionDeny, PermissionAsk}
+
+// Valid reports whether b is one of the known behaviors.
+func (b PermissionBehavior) Valid() bool {
+	switch b {
+	case PermissionAllow, PermissionDeny, PermissionAsk:
+		return true
+	}
+	return false
+}
+
+// PermissionPatternKind describes how a rule's specifier is matched.
+type PermissionPatternKind string
+
+// Permission pattern kinds.
+const (
+	// PermissionPatternTool matches every use of the tool: Bash, WebSearch.
+	PermissionPatternTool PermissionPatternKind = "tool"
+	// PermissionPatternExact matches the specifier verbatim: Bash(npm test).
+	PermissionPatternExact PermissionPatternKind = "exact"
+	// PermissionPatternPrefix matches commands starting with Pattern: Bash(npm run test:*).
+	PermissionPatternPrefix PermissionPatternKind = "prefix"
+	// PermissionPatternGlob matches paths gitignore-style, or commands with
+	// wildcards: Read(./secrets/**), Bash(git * --force).
+	PermissionPatternGlob PermissionPatternKind = "glob"
+	// PermissionPatternDomain matches fetched URLs by host: WebFetch(domain:example.com).
+	PermissionPatternDomain PermissionPatternKind = "domain"
+	// PermissionPatternMCP matches MCP tools by server and tool name:
+	// mcp__github, mcp__github__*, mcp__github__create_issue.
+	PermissionPatternMCP PermissionPatternKind = "mcp"
+)
+
+// permissionPathTools take a file path or path glob as their specifier.
+var permissionPathTools = map[string]bool{
+	"Read": true, "Edit": true, "Write": true, "MultiEdit": true, "NotebookEdit": true,
+	"Glob": true, "Grep": true, "LS": true,
+}
+
+var (
+	permissionToolName = regexp.MustCompile(`^[A-Za-z][A-Za-z0-9_]*$`)
+	mcpNamePart        = regexp.MustCompile(`^[A-Za-z0-9_-]+$`)
+)
+
+// PermissionRule is a permission rule parsed into its parts. Specifier is the
+// text between the parentheses, if any; Pattern is what is actually matched
+// against: the command without a trailing :*, the host of a domain: rule, the
+// path glob, or for MCP rules the tool name ("" or "*" for every tool).
+type PermissionRule struct {
+	Raw       string
+	Tool      string
+	Specifier string
+	Kind      PermissionPatternKind
+	Pattern   string
+	MCPServer string
+}
+
+// PermissionRuleError reports a permission rule with invalid syntax.
+type PermissionRuleError struct {
+	Rule    string
+	Message string
+}
+
+func (e *PermissionRuleError) Error() string {
+	return fmt.Sprintf("invalid permission rule %q: %s", e.Rule, e.Message)
+}
+
+// ParsePermissionRule parses a rule such as Bash(npm run test:*),
+// Read(./secrets/**) or mcp__github__create_issue, checking its syntax against
+// what the named tool accepts. Tools it does not know are accepted with any
+// specifier. Returns a *PermissionRuleError if the rule is malformed.
+func ParsePermissionRule(raw string) (PermissionRule, error) {
+	fail := func(format string, args ...any) (PermissionRule, error) {
+		return PermissionRule{}, &PermissionRuleError{Rule: raw, Message: fmt.Sprintf(format, args...)}
+	}
+	if raw == "" {
+		return fail("rule is empty")
+	}
+	if strings.TrimSpace(raw) != raw {
+		return fail("rule has leading or trailing whitespace")
+	}
+
+	rule := PermissionRule{Raw: raw, Tool: raw, Kind: PermissionPatternTool}
+	if open := strings.IndexByte(raw, '('); open >= 0 {
+		if !strings.HasSuffix(raw, ")") {
+			return fail("missing closing parenthesis")
+		}
+		rule.Tool = raw[:open]
+		rule.Specifier = raw[open+1 : len(raw)-1]
+		if rule.Specifier == "" {
+			return fail("empty parentheses; write %s alone to match every use of the tool", rule.Tool)
+		}
+	} else if strings.ContainsRune(raw, ')') {
+		return fail("unexpected closing parenthesis")
+	}
+
+	if strings.HasPrefix(rule.Tool, "mcp__") {
+		return parseMCPRule(rule, fail)
+	}
+	if !permissionToolName.MatchString(rule.Tool) {
+		return fail("tool name %q must be letters, digits and underscores", rule.Tool)
+	}
+	if rule.Specifier == "" {
+		return rule, nil
+	}
+
+	rule.Kind, rule.Pattern = PermissionPatternExact, rule.Specifier
+	switch {
+	case rule.Tool == "Bash":
+		if i := strings.Index(rule.Specifier, ":*"); i >= 0 {
+			if i != len(rule.Specifier)-2 {
+				return fail(":* is only allowed at the end of a Bash rule")
+			}
+			if i == 0 {
+				return fail("prefix before :* is empty")
+			}
+			rule.Kind, rule.Pattern = PermissionPatternPrefix, rule.Specifier[:i]
+		} else if strings.Contains(rule.Specifier, "*") {
+			rule.Kind = PermissionPatternGlob
+		}
+	case permissionPathTools[rule.Tool]:
+		if strings.HasSuffix(rule.Specifier, ":*") {
+			return fail("the :* prefix syntax only applies to Bash; use a path glob such as dir/**")
+		}
+		rule.Kind = PermissionPatternGlob
+	case rule.Tool == "WebFetch":
+		host, ok := strings.CutPrefix(rule.Specifier, "domain:")
+		if !ok {
+			return fail("WebFetch rules take domain:<host>")
+		}
+		if host == "" || strings.ContainsAny(host, "/: ") {
+			return fail("invalid domain %q", host)
+		}
+		rule.Kind, rule.Pattern = PermissionPatternDomain, host
+	case rule.Tool == "WebSearch":
+		return fail("WebSearch rules take no specifier")
+	}
+	return rule, nil
+}
+
+// parseMCPRule parses the mcp__server[__tool] form. MCP rules never take a
+// specifier.
+func parseMCPRule(rule PermissionRule, fail func(string, ...any) (PermissionRule, error)) (PermissionRule, error) {
+	if rule.Specifier != "" {
+		return fail("MCP rules take no parentheses")
+	}
+	server, tool, _ := strings.Cut(strings.TrimPrefix(rule.Tool, "mcp__"), "__")
+	if !mcpNamePart.MatchString(server) {
+		return fail("MCP server name %q must be letters, digits, '_' and '-'; wildcards are only allowed for the tool, as in mcp__server__*", server)
+	}
+	if tool != "*" && tool != "" && !mcpNamePart.MatchString(tool) {
+		return fail("MCP tool name %q must be letters, digits, '_' and '-', or *", tool)
+	}
+	rule.Kind, rule.MCPServer, rule.Pattern = PermissionPatternMCP, server, tool
+	return rule, nil
+}
+
+// PermissionRuleEntry is one rule as it appears in a settings layer. Error is
+// set, and Rule holds only Raw, when the rule does not parse.
+type PermissionRuleEntry struct {
+	Behavior PermissionBehavior
+	Rule     PermissionRule
+	Error    string
+	Source   ConfigLayerSource
+	FilePath string
+	Index    int
+}
+
+// PermissionDirectory is an additionalDirectories entry and the layer it came from.
+type PermissionDirectory struct {
+	Path     string
+	Source   ConfigLayerSource
+	FilePath string
+}
+
+// PermissionSettings is the permissions configuration of every layer. Rules and
+// AdditionalDirectories list each layer's own entries, lowest precedence layer
+// first; DefaultMode is the effective mode, or nil if no layer sets one.
+type PermissionSettings struct {
+	Rules                 []PermissionRuleEntry
+	DefaultMode           *ShadowedValue
+	AdditionalDirectories []PermissionDirectory
+	Layers                []ConfigLayer
+}
+
+// LoadPermissions reads the permissions of every layer MergeConfigLayers reads.
+func LoadPermissions(projectPath string) PermissionSettings {
+	config := MergeConfigLayers(projectPath)
+	result := PermissionSettings{Layers: config.Layers}
+	for _, layer := range config.Layers {
+		perms, _ := layer.Content["permissions"].(JsonObject)
+		for _, behavior := range PermissionBehaviors {
+			items, _ := perms[string(behavior)].([]any)
+			for i, item := range items {
+				entry := PermissionRuleEntry{Behavior: behavior, Source: layer.Source, FilePath: layer.FilePath, Index: i}
+				raw, ok := item.(string)
+				if !ok {
+					data, _ := json.Marshal(item) //nolint:errcheck // item was decoded from JSON
+					entry.Rule.Raw = string(data)
+					entry.Error = "rule is not a string"
+				} else if rule, err := ParsePermissionRule(raw); err != nil {
+					var ruleErr *PermissionRuleError
+					errors.As(err, &ruleErr)
+					entry.Rule.Raw = raw
+					entry.Error = ruleErr.Message
+				} else {
+					entry.Rule = rule
+				}
+				result.Rules = append(result.Rules, entry)
+			}
+		}
+		dirs, _ := perms["additionalDirectories"].([]any)
+		for _, dir := range dirs {
+			if path, ok := dir.(string); ok {
+				result.AdditionalDirectories = append(result.AdditionalDirectories, PermissionDirectory{Path: path, Source: layer.Source, FilePath: layer.FilePath})
+			}
+		}
+	}
+	if p, ok := config.Provenance["permissions.defaultMode"]; ok {
+		result.DefaultMode = &ShadowedValue{Source: p.Source, FilePath: p.FilePath, Value: p.Value}
+	}
+	return result
+}
+
+// PermissionEditError reports a rule edit that conflicts with the file: the rule
+// to change is not there, or the rule to add already is.
+type PermissionEditError struct {
+	Behavior PermissionBehavior
+	Rule     string
+	NotFound bool
+}
+
+func (e *PermissionEditError) Error() string {
+	if e.NotFound {
+		return fmt.Sprintf("rule %q not found in permissions.%s", e.Rule, e.Behavior)
+	}
+	return fmt.Sprintf("rule %q is already in permissions.%s", e.Rule, e.Behavior)
+}
+
+// AddPermissionRule appends rule to the behavior list of the settings file at
+// filePath, creating the list if needed.
+// Returns a *PermissionEditError if the list already holds rule, or a
+// *JSONParseError, without writing, if the file cannot be parsed.
+func AddPermissionRule(filePath string, behavior PermissionBehavior, rule string, claudeHome string) error {
+	return editPermissions(filePath, claudeHome, BackupOpUpdate, func(data []byte, perms JsonObject) ([]byte, error) {
+		i, err := permissionRuleIndex(perms, behavior, rule)
+		if err != nil {
+			return nil, err
+		}
+		if i >= 0 {
+			return nil, &PermissionEditError{Behavior: behavior, Rule: rule}
+		}
+		return setJSONAtKeys(data, []string{"permissions", string(behavior), "-"}, rule, false)
+	})
+}
+
+// ReplacePermissionRule replaces rule in the behavior list with newRule. When
+// newBehavior differs, the rule is removed from its list and appended to
+// newBehavior's instead; otherwise it keeps its position.
+// Returns a *PermissionEditError if rule is missing or newRule would be a
+// duplicate, or a *JSONParseError, without writing, if the file cannot be parsed.
+func ReplacePermissionRule(filePath string, behavior PermissionBehavior, rule string, newBehavior PermissionBehavior, newRule string, claudeHome string) error {
+	return editPermissions(filePath, claudeHome, BackupOpUpdate, func(data []byte, perms JsonObject) ([]byte, error) {
+		i, err := permissionRuleIndex(perms, behavior, rule)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			return nil, &PermissionEditError{Behavior: behavior, Rule: rule, NotFound: true}
+		}
+		if newBehavior != behavior || newRule != rule {
+			j, err := permissionRuleIndex(perms, newBehavior, newRule)
+			if err != nil {
+				return nil, err
+			}
+			if j >= 0 {
+				return nil, &PermissionEditError{Behavior: newBehavior, Rule: newRule}
+			}
+		}
+		if newBehavior == behavior {
+			return setJSONAtKeys(data, []string{"permissions", string(behavior), strconv.Itoa(i)}, newRule, false)
+		}
+		data, err = deleteJSONAtKeys(data, []string{"permissions", string(behavior), strconv.Itoa(i)})
+		if err != nil {
+			return nil, err
+		}
+		return setJSONAtKeys(data, []string{"permissions", string(newBehavior), "-"}, newRule, false)
+	})
+}
+
+// RemovePermissionRule removes rule from the behavior list of the settings file
+// at filePath. Returns a *PermissionEditError if the rule is not there, or a
+// *JSONParseError, without writing, if the file cannot be parsed.
+func RemovePermissionRule(filePath string, behavior PermissionBehavior, rule string, claudeHome string) error {
+	return editPermissions(filePath, claudeHome, BackupOpDelete, func(data []byte, perms JsonObject) ([]byte, error) {
+		i, err := permissionRuleIndex(perms, behavior, rule)
+		if err != nil {
+			return nil, err
+		}
+		if i < 0 {
+			return nil, &PermissionEditError{Behavior: behavior, Rule: rule, NotFound: true}
+		}
+		return deleteJSONAtKeys(data, []string{"permissions", string(behavior), strconv.Itoa(i)})
+	})
+}
+
+// SetPermissionSettings sets permissions.defaultMode and
+// permissions.additionalDirectories in one write. A nil argument leaves that key
+// alone; an empty mode or an empty list removes it.
+// Returns a *JSONParseError, without writing, if the file cannot be parsed.
+func SetPermissionSettings(filePath string, defaultMode *string, additionalDirectories *[]string, claudeHome string) error {
+	return editPermissions(filePath, claudeHome, BackupOpUpdate, func(data []byte, _ JsonObject) ([]byte, error) {
+		var err error
+		if defaultMode != nil {
+			keys := []string{"permissions", "defaultMode"}
+			if *defaultMode == "" {
+				data, err = deleteJSONAtKeys(data, keys)
+			} else {
+				data, err = setJSONAtKeys(data, keys, *defaultMode, false)
+			}
+			if err != nil {
+				return nil, err
+			}
+		}
+		if additionalDirectories != nil {
+			keys := []string{"permissions", "additionalDirectories"}
+			if len(*additionalDirectories) == 0 {
+				return deleteJSONAtKeys(data, keys)
+			}
+			dirs := make([]any, len(*additionalDirectories))
+			for i, dir := range *additionalDirectories {
+				dirs[i] = dir
+			}
+			return setJSONAtKeys(data, keys, dirs, false)
+		}
+		return data, nil
+	})
+}
+
+// editPermissions applies edit to the raw bytes of the settings file at
+// filePath, given its current permissions object (nil if absent), then backs up
+// and writes the file.
+func editPermissions(filePath, claudeHome string, op BackupOperation, edit func(data []byte, perms JsonObject) ([]byte, error)) error {
+	data, obj, err := readJSONFileForEdit(filePath)
+	if err != nil {
+		return err
+	}
+	perms, _ := obj["permissions"].(JsonObject)
+	updated, err := edit(data, perms)
+	if err != nil {
+		return err
+	}
+	BackupFile(filePath, op, claudeHome)
+	return writeJSONBytes(filePath, updated)
+}
+
+// permissionRuleIndex returns the index of rule in the behavior list of perms,
+// or -1. The list must be an array if present.
+func permissionRuleIndex(perms JsonObject, behavior PermissionBehavior, rule string) (int, error) {
+	raw, ok := perms[string(behavior)]
+	if !ok {
+		return -1, nil
+	}
+	items, ok := raw.([]any)
+	if !ok {
+		return 0, fmt.Errorf("permissions.%s is not an array", behavior)
+	}
+	for i, item := range items {
+		if item == rule {
+			return i, nil
+		}
+	}
+	return -1, nil
+}
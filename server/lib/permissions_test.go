@@ -0,0 +1,155 @@
+package lib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestParsePermissionRule(t *testing.T) {
+	tests := []struct {
+		raw       string
+		tool      string
+		specifier string
+		kind      lib.PermissionPatternKind
+		pattern   string
+		server    string
+	}{
+		{raw: "Bash", tool: "Bash", kind: lib.PermissionPatternTool},
+		{raw: "Bash(npm test)", tool: "Bash", specifier: "npm test", kind: lib.PermissionPatternExact, pattern: "npm test"},
+		{raw: "Bash(npm run test:*)", tool: "Bash", specifier: "npm run test:*", kind: lib.PermissionPatternPrefix, pattern: "npm run test"},
+		{raw: "Bash(git * --force)", tool: "Bash", specifier: "git * --force", kind: lib.PermissionPatternGlob, pattern: "git * --force"},
+		{raw: "Read(./secrets/**)", tool: "Read", specifier: "./secrets/**", kind: lib.PermissionPatternGlob, pattern: "./secrets/**"},
+		{raw: "Edit(src/main.go)", tool: "Edit", specifier: "src/main.go", kind: lib.PermissionPatternGlob, pattern: "src/main.go"},
+		{raw: "WebFetch(domain:example.com)", tool: "WebFetch", specifier: "domain:example.com", kind: lib.PermissionPatternDomain, pattern: "example.com"},
+		{raw: "WebSearch", tool: "WebSearch", kind: lib.PermissionPatternTool},
+		{raw: "Task(code-reviewer)", tool: "Task", specifier: "code-reviewer", kind: lib.PermissionPatternExact, pattern: "code-reviewer"},
+		{raw: "mcp__github", tool: "mcp__github", kind: lib.PermissionPatternMCP, server: "github"},
+		{raw: "mcp__github__*", tool: "mcp__github__*", kind: lib.PermissionPatternMCP, pattern: "*", server: "github"},
+		{raw: "mcp__github__create_issue", tool: "mcp__github__create_issue", kind: lib.PermissionPatternMCP, pattern: "create_issue", server: "github"},
+	}
+	for _, tt := range tests {
+		t.Run(tt.raw, func(t *testing.T) {
+			rule, err := lib.ParsePermissionRule(tt.raw)
+			require.NoError(t, err)
+			assert.Equal(t, tt.raw, rule.Raw)
+			assert.Equal(t, tt.tool, rule.Tool)
+			assert.Equal(t, tt.specifier, rule.Specifier)
+			assert.Equal(t, tt.kind, rule.Kind)
+			assert.Equal(t, tt.pattern, rule.Pattern)
+			assert.Equal(t, tt.server, rule.MCPServer)
+		})
+	}
+}
+
+func TestParsePermissionRule_Invalid(t *testing.T) {
+	tests := []string{
+		"",
+		" Bash",
+		"Bash(",
+		"Bash()",
+		"Bash)",
+		"Bash(npm:* test)",
+		"Bash(:*)",
+		"Read(src:*)",
+		"WebFetch(example.com)",
+		"WebFetch(domain:https://example.com)",
+		"WebSearch(golang)",
+		"mcp__*",
+		"mcp__github(create_issue)",
+		"mcp__github__create issue",
+		"Not A Tool",
+	}
+	for _, raw := range tests {
+		t.Run(raw, func(t *testing.T) {
+			_, err := lib.ParsePermissionRule(raw)
+			var ruleErr *lib.PermissionRuleError
+			require.True(t, errors.As(err, &ruleErr), "expected *PermissionRuleError, got %v", err)
+		})
+	}
+}
+
+func TestLoadPermissions_ListsRulesWithTheirLayer(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, `{"permissions":{"allow":["Bash(npm test)"],"defaultMode":"plan","additionalDirectories":["../shared"]}}`)
+	localPath := filepath.Join(projectDir, ".claude", "settings.local.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0o750))
+	require.NoError(t, os.WriteFile(localPath, []byte(`{"permissions":{"deny":["Read(./.env)","Bash(rm:* -rf)"],"defaultMode":"acceptEdits"}}`), 0o600))
+
+	perms := lib.LoadPermissions(projectDir)
+	require.Len(t, perms.Rules, 3)
+
+	assert.Equal(t, lib.ConfigLayerGlobal, perms.Rules[0].Source)
+	assert.Equal(t, lib.PermissionAllow, perms.Rules[0].Behavior)
+	assert.Equal(t, lib.PermissionPatternExact, perms.Rules[0].Rule.Kind)
+
+	assert.Equal(t, lib.ConfigLayerProjectLocal, perms.Rules[1].Source)
+	assert.Equal(t, lib.PermissionDeny, perms.Rules[1].Behavior)
+	assert.Equal(t, localPath, perms.Rules[1].FilePath)
+	assert.Empty(t, perms.Rules[1].Error)
+
+	assert.Equal(t, 1, perms.Rules[2].Index)
+	assert.Equal(t, "Bash(rm:* -rf)", perms.Rules[2].Rule.Raw)
+	assert.NotEmpty(t, perms.Rules[2].Error, "invalid rules are listed with their error")
+
+	require.NotNil(t, perms.DefaultMode)
+	assert.Equal(t, "acceptEdits", perms.DefaultMode.Value)
+	assert.Equal(t, lib.ConfigLayerProjectLocal, perms.DefaultMode.Source)
+
+	require.Len(t, perms.AdditionalDirectories, 1)
+	assert.Equal(t, filepath.Join(claudeHome, "settings.json"), perms.AdditionalDirectories[0].FilePath)
+}
+
+func TestPermissionRuleEdits_PreserveFormatting(t *testing.T) {
+	claudeHome := t.TempDir()
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	original := "{\n  \"model\": \"opus\",\n  \"permissions\": {\n    \"allow\": [\"Bash(ls)\", \"Read\"]\n  }\n}\n"
+	require.NoError(t, os.WriteFile(settingsPath, []byte(original), 0o600))
+
+	require.NoError(t, lib.AddPermissionRule(settingsPath, lib.PermissionDeny, "Read(./.env)", claudeHome))
+	require.NoError(t, lib.ReplacePermissionRule(settingsPath, lib.PermissionAllow, "Bash(ls)", lib.PermissionAllow, "Bash(ls:*)", claudeHome))
+	require.NoError(t, lib.ReplacePermissionRule(settingsPath, lib.PermissionAllow, "Read", lib.PermissionAsk, "Read", claudeHome))
+	require.NoError(t, lib.RemovePermissionRule(settingsPath, lib.PermissionDeny, "Read(./.env)", claudeHome))
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus","permissions":{"allow":["Bash(ls:*)"],"deny":[],"ask":["Read"]}}`, string(data))
+	assert.Contains(t, string(data), "{\n  \"model\": \"opus\",\n  \"permissions\": {\n")
+}
+
+func TestPermissionRuleEdits_Conflicts(t *testing.T) {
+	claudeHome := t.TempDir()
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"permissions":{"allow":["Bash(ls)"],"deny":["Read"]}}`), 0o600))
+
+	var editErr *lib.PermissionEditError
+	err := lib.AddPermissionRule(settingsPath, lib.PermissionAllow, "Bash(ls)", claudeHome)
+	require.True(t, errors.As(err, &editErr))
+	assert.False(t, editErr.NotFound)
+
+	err = lib.RemovePermissionRule(settingsPath, lib.PermissionAsk, "Bash(ls)", claudeHome)
+	require.True(t, errors.As(err, &editErr))
+	assert.True(t, editErr.NotFound)
+
+	err = lib.ReplacePermissionRule(settingsPath, lib.PermissionAllow, "Bash(ls)", lib.PermissionDeny, "Read", claudeHome)
+	require.True(t, errors.As(err, &editErr))
+	assert.False(t, editErr.NotFound)
+}
+
+func TestSetPermissionSettings(t *testing.T) {
+	claudeHome := t.TempDir()
+	settingsPath := filepath.Join(claudeHome, "settings.json")
+	require.NoError(t, os.WriteFile(settingsPath, []byte(`{"permissions":{"allow":["Read"],"defaultMode":"plan"}}`), 0o600))
+
+	noMode := ""
+	dirs := []string{"../shared", "~/notes"}
+	require.NoError(t, lib.SetPermissionSettings(settingsPath, &noMode, &dirs, claudeHome))
+
+	data, err := os.ReadFile(settingsPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Read"],"additionalDirectories":["../shared","~/notes"]}}`, string(data))
+}
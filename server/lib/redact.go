@@ -1,57 +1,193 @@
 package lib
 
-import "regexp"
-
-// sensitiveKeyPatterns holds compiled regexes for keys whose values should be redacted.
-var sensitiveKeyPatterns = []*regexp.Regexp{
-	regexp.MustCompile(`(?i)key`),
-	regexp.MustCompile(`(?i)secret`),
-	regexp.MustCompile(`(?i)token`),
-	regexp.MustCompile(`(?i)password`),
-	regexp.MustCompile(`(?i)credential`),
-	regexp.MustCompile(`(?i)api_key`),
-	regexp.MustCompile(`(?i)auth`),
-	regexp.MustCompile(`(?i)private`),
-}
-
-// sensitiveValuePatterns holds compiled regexes for string values that look like secrets.
-var sensitiveValuePatterns = []*regexp.Regexp{
-	regexp.MustCompile(`^sk-[a-zA-Z0-9\-_]{20,}`),          // Anthropic / OpenAI keys
-	regexp.MustCompile(`^sk-ant-[a-zA-Z0-9\-_]+`),          // Anthropic API keys
-	regexp.MustCompile(`^ghp_[a-zA-Z0-9]{36}`),              // GitHub personal access tokens
-	regexp.MustCompile(`^gho_[a-zA-Z0-9]{36}`),              // GitHub OAuth tokens
-	regexp.MustCompile(`^github_pat_[a-zA-Z0-9_]+`),         // GitHub fine-grained PATs
-	regexp.MustCompile(`(?i)^Bearer\s+\S+`),                 // Bearer tokens
-	regexp.MustCompile(`^xox[bpsa]-[a-zA-Z0-9\-]+`),        // Slack tokens
-	regexp.MustCompile(`^eyJ[a-zA-Z0-9_\-]+\.eyJ`),         // JWTs
-	regexp.MustCompile(`^AKIA[A-Z0-9]{16}`),                 // AWS access keys
-	regexp.MustCompile(`^npm_[a-zA-Z0-9]{36}`),              // npm tokens
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"reflect"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// RedactionRulesFileName is the file in the Claude home directory that extends
+// or replaces the built-in redaction patterns.
+const RedactionRulesFileName = "field-station-redaction.json"
+
+// defaultKeyPatterns match keys whose string values are redacted.
+var defaultKeyPatterns = []string{
+	`(?i)key`,
+	`(?i)secret`,
+	`(?i)token`,
+	`(?i)password`,
+	`(?i)credential`,
+	`(?i)api_key`,
+	`(?i)auth`,
+	`(?i)private`,
+}
+
+// valuePattern is a value pattern and the name secret scan findings report it by.
+type valuePattern struct {
+	name string
+	re   *regexp.Regexp
+}
+
+// customValuePattern names the value patterns that come from the rules file.
+const customValuePattern = "custom"
+
+// defaultValuePatterns match values that look like secrets wherever they
+// appear, keyed by name. A match is redacted through to the end of the token
+// it starts.
+var defaultValuePatterns = []struct{ name, pattern string }{
+	{"anthropic-key", `sk-ant-[a-zA-Z0-9\-_]+`},
+	{"openai-key", `sk-[a-zA-Z0-9\-_]{20,}`},
+	{"github-pat", `ghp_[a-zA-Z0-9]{36}`},
+	{"github-oauth", `gho_[a-zA-Z0-9]{36}`},
+	{"github-fine-grained-pat", `github_pat_[a-zA-Z0-9_]+`},
+	{"bearer-token", `(?i)Bearer\s+\S+`},
+	{"slack-token", `xox[bpsa]-[a-zA-Z0-9\-]+`},
+	{"jwt", `eyJ[a-zA-Z0-9_\-]+\.eyJ`},
+	{"aws-access-key", `AKIA[A-Z0-9]{16}`},
+	{"npm-token", `npm_[a-zA-Z0-9]{36}`},
 }
 
 const redacted = "[REDACTED]"
 
-func isSensitiveKey(key string) bool {
-	for _, p := range sensitiveKeyPatterns {
-		if p.MatchString(key) {
-			return true
+// assignmentPattern finds key=value and key: value pairs in text, with the key
+// and value optionally quoted.
+var assignmentPattern = regexp.MustCompile("[\"']?([A-Za-z_][A-Za-z0-9_.\\-]*)[\"']?[ \\t]*[:=][ \\t]*[\"']?([^\\s\"'`,;&?]+)")
+
+// RedactionRules is the content of the redaction rules file. KeyPatterns and
+// ValuePatterns are regular expressions added to the built-in ones, or used
+// instead of them when ReplaceDefaults is set. AllowKeys names keys that are
+// never redacted because of their name, such as apiKeyHelper.
+type RedactionRules struct {
+	KeyPatterns     []string `json:"keyPatterns,omitempty"`
+	ValuePatterns   []string `json:"valuePatterns,omitempty"`
+	AllowKeys       []string `json:"allowKeys,omitempty"`
+	ReplaceDefaults bool     `json:"replaceDefaults,omitempty"`
+}
+
+// Redactor hides secrets in settings objects and free text. Every response
+// that carries file content goes through one, so the same value is redacted
+// the same way wherever it is shown.
+type Redactor struct {
+	keyPatterns   []*regexp.Regexp
+	valuePatterns []valuePattern
+	allowKeys     map[string]bool
+}
+
+// RedactedWriteError reports a write of text containing redaction placeholders
+// that cannot be matched to the secrets they stand for, so the stored secrets
+// cannot be restored.
+type RedactedWriteError struct {
+	Placeholders int
+	Secrets      int
+	// Array is set when the placeholders are in an array whose other elements
+	// were added, removed, reordered or changed, so which secret each one
+	// stands for is unknown.
+	Array bool
+}
+
+func (e *RedactedWriteError) Error() string {
+	if e.Array {
+		return fmt.Sprintf("an array holding %s placeholders was resized, reordered or changed elsewhere; retype the redacted values in full", redacted)
+	}
+	return fmt.Sprintf("the text has %d %s placeholders but the stored text has %d redacted values; retype the redacted values in full", e.Placeholders, redacted, e.Secrets)
+}
+
+var defaultRedactor = mustRedactor(RedactionRules{})
+
+func mustRedactor(rules RedactionRules) *Redactor {
+	r, err := NewRedactor(rules)
+	if err != nil {
+		panic(err)
+	}
+	return r
+}
+
+// NewRedactor compiles rules into a Redactor.
+func NewRedactor(rules RedactionRules) (*Redactor, error) {
+	keyPatterns := rules.KeyPatterns
+	var valuePatterns []struct{ name, pattern string }
+	if !rules.ReplaceDefaults {
+		keyPatterns = append(slices.Clone(defaultKeyPatterns), keyPatterns...)
+		valuePatterns = slices.Clone(defaultValuePatterns)
+	}
+	for _, p := range rules.ValuePatterns {
+		valuePatterns = append(valuePatterns, struct{ name, pattern string }{customValuePattern, p})
+	}
+	r := &Redactor{allowKeys: map[string]bool{}}
+	for _, p := range keyPatterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("redaction key pattern %q: %w", p, err)
 		}
+		r.keyPatterns = append(r.keyPatterns, re)
 	}
-	return false
+	for _, p := range valuePatterns {
+		re, err := regexp.Compile(`\b(?:` + p.pattern + `)`)
+		if err != nil {
+			return nil, fmt.Errorf("redaction value pattern %q: %w", p.pattern, err)
+		}
+		r.valuePatterns = append(r.valuePatterns, valuePattern{name: p.name, re: re})
+	}
+	for _, k := range rules.AllowKeys {
+		r.allowKeys[k] = true
+	}
+	return r, nil
+}
+
+// LoadRedactor returns the Redactor described by the rules file in claudeHome,
+// or the built-in one if there is no such file. If the file cannot be read or
+// holds an invalid pattern, the built-in Redactor is returned with the error.
+func LoadRedactor(claudeHome string) (*Redactor, error) {
+	path := filepath.Join(claudeHome, RedactionRulesFileName)
+	data, err := os.ReadFile(path) //nolint:gosec // path is the fixed rules file inside claudeHome
+	if errors.Is(err, os.ErrNotExist) {
+		return defaultRedactor, nil
+	}
+	if err != nil {
+		return defaultRedactor, err
+	}
+	var rules RedactionRules
+	if err := json.Unmarshal(data, &rules); err != nil {
+		return defaultRedactor, fmt.Errorf("%s: %w", path, err)
+	}
+	r, err := NewRedactor(rules)
+	if err != nil {
+		return defaultRedactor, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
 }
 
-func isSensitiveValue(value string) bool {
-	for _, p := range sensitiveValuePatterns {
-		if p.MatchString(value) {
+func (r *Redactor) isSensitiveKey(key string) bool {
+	if r.allowKeys[key] {
+		return false
+	}
+	for _, p := range r.keyPatterns {
+		if p.MatchString(key) {
 			return true
 		}
 	}
 	return false
 }
 
-// RedactSensitiveValues recursively traverses obj and replaces values that
-// look like secrets (API keys, tokens, JWTs, bearer headers) with "[REDACTED]".
+// RedactSensitiveValues redacts obj with the built-in rules.
 // Returns a new JsonObject — does not mutate the input.
 func RedactSensitiveValues(obj JsonObject) JsonObject {
+	return defaultRedactor.Object(obj)
+}
+
+// Object recursively replaces values that look like secrets (API keys, tokens,
+// JWTs, bearer headers) with "[REDACTED]": every value of an "env" object, the
+// string value of any sensitive key, and secrets found in any other string by
+// Text. Returns a new JsonObject — does not mutate the input.
+func (r *Redactor) Object(obj JsonObject) JsonObject {
 	result := make(JsonObject, len(obj))
 	for key, value := range obj {
 		// Special case: "env" key — redact all environment variable values wholesale.
@@ -67,7 +203,7 @@ func RedactSensitiveValues(obj JsonObject) JsonObject {
 		}
 
 		// Sensitive key with a string value.
-		if isSensitiveKey(key) {
+		if r.isSensitiveKey(key) {
 			if _, ok := value.(string); ok {
 				result[key] = redacted
 				continue
@@ -75,28 +211,250 @@ func RedactSensitiveValues(obj JsonObject) JsonObject {
 		}
 
 		// Recurse into all values.
-		result[key] = redactAny(value)
+		result[key] = r.Value(value)
 	}
 	return result
 }
 
-// redactAny applies redaction logic to any value type.
-func redactAny(value any) any {
+// Value redacts any JSON value as Object would if it were nested under a key
+// that is not sensitive.
+func (r *Redactor) Value(value any) any {
 	switch v := value.(type) {
 	case string:
-		if isSensitiveValue(v) {
-			return redacted
-		}
-		return v
+		return r.Text(v)
 	case JsonObject:
-		return RedactSensitiveValues(v)
+		return r.Object(v)
 	case []any:
 		out := make([]any, len(v))
 		for i, item := range v {
-			out[i] = redactAny(item)
+			out[i] = r.Value(item)
 		}
 		return out
 	default:
 		return value
 	}
 }
+
+// AtPath redacts value as Object would if it were found at keyPath, so a leaf
+// reported on its own is redacted the same way as inside its document.
+func (r *Redactor) AtPath(keyPath string, value any) any {
+	if value == nil {
+		return nil
+	}
+	nested, ok := nestValue(splitPath(keyPath), value).(JsonObject)
+	if !ok {
+		return r.Value(value)
+	}
+	var current any = r.Object(nested)
+	for _, key := range splitPath(keyPath) {
+		obj, ok := current.(JsonObject)
+		if !ok {
+			return r.Value(value)
+		}
+		current = obj[key]
+	}
+	return current
+}
+
+// Text replaces each secret in s with "[REDACTED]": matches of the value
+// patterns, and values assigned to sensitive keys in key=value or key: value
+// form. Assigned values that are short, purely alphabetic or environment
+// variable references such as $TOKEN are left alone.
+func (r *Redactor) Text(s string) string {
+	spans := r.secretSpans(s)
+	if len(spans) == 0 {
+		return s
+	}
+	var b strings.Builder
+	last := 0
+	for _, sp := range spans {
+		b.WriteString(s[last:sp[0]])
+		b.WriteString(redacted)
+		last = sp[1]
+	}
+	b.WriteString(s[last:])
+	return b.String()
+}
+
+// secretSpans returns the sorted, non-overlapping byte ranges of s that Text
+// replaces. Placeholders already in s are included, so the ranges line up
+// one-to-one with the placeholders in Text(s).
+func (r *Redactor) secretSpans(s string) [][2]int {
+	var spans [][2]int
+	for _, m := range r.valueMatches(s) {
+		spans = append(spans, m.span)
+	}
+	for _, m := range assignmentPattern.FindAllStringSubmatchIndex(s, -1) {
+		key, value := s[m[2]:m[3]], s[m[4]:m[5]]
+		if r.isSensitiveKey(key) && looksLikeSecret(value) {
+			spans = append(spans, [2]int{m[4], m[5]})
+		}
+	}
+	for i := 0; ; {
+		j := strings.Index(s[i:], redacted)
+		if j < 0 {
+			break
+		}
+		spans = append(spans, [2]int{i + j, i + j + len(redacted)})
+		i += j + len(redacted)
+	}
+	if len(spans) == 0 {
+		return nil
+	}
+
+	sort.Slice(spans, func(a, b int) bool { return spans[a][0] < spans[b][0] })
+	merged := spans[:1]
+	for _, sp := range spans[1:] {
+		if top := &merged[len(merged)-1]; sp[0] < top[1] {
+			top[1] = max(top[1], sp[1])
+		} else {
+			merged = append(merged, sp)
+		}
+	}
+	return merged
+}
+
+// valueMatch is a match of a value pattern in text.
+type valueMatch struct {
+	pattern string
+	span    [2]int
+}
+
+// valueMatches returns every match of the value patterns in s, each extended
+// through to the end of the token it starts. Matches may overlap.
+func (r *Redactor) valueMatches(s string) []valueMatch {
+	var matches []valueMatch
+	for _, p := range r.valuePatterns {
+		for _, m := range p.re.FindAllStringIndex(s, -1) {
+			end := m[1]
+			for end > m[0] && strings.ContainsRune(tokenTerminators, rune(s[end-1])) {
+				end--
+			}
+			matches = append(matches, valueMatch{pattern: p.name, span: [2]int{m[0], tokenEnd(s, end)}})
+		}
+	}
+	return matches
+}
+
+// tokenTerminators are the characters that end a secret found in text.
+const tokenTerminators = " \t\r\n\"'`<>()[]{},;"
+
+// tokenEnd extends a match ending at end through the rest of its token.
+func tokenEnd(s string, end int) int {
+	for end < len(s) && !strings.ContainsRune(tokenTerminators, rune(s[end])) {
+		end++
+	}
+	return end
+}
+
+// looksLikeSecret reports whether a value assigned to a sensitive key in text
+// is worth redacting.
+func looksLikeSecret(value string) bool {
+	if len(value) < 8 || strings.HasPrefix(value, "$") || value == redacted {
+		return false
+	}
+	return strings.IndexFunc(value, func(c rune) bool { return !unicode.IsLetter(c) }) >= 0
+}
+
+// RestoreRedacted returns incoming with every "[REDACTED]" placeholder that a
+// client echoed back replaced by the value it stands for in existing, the
+// stored value incoming is about to overwrite. A string that is exactly the
+// placeholder takes the existing value whole; placeholders inside a longer
+// string are restored as RestoreText does. Placeholders in an array are only
+// restored from the element at the same index, so the array must keep its
+// length and every element without a placeholder must be unchanged.
+// Placeholders with no existing value are kept. Returns a *RedactedWriteError
+// if a string's placeholders do not line up with the existing secrets, or an
+// array holding placeholders was otherwise changed.
+func (r *Redactor) RestoreRedacted(incoming, existing any) (any, error) {
+	switch in := incoming.(type) {
+	case string:
+		if in == redacted && existing != nil {
+			return existing, nil
+		}
+		if prev, ok := existing.(string); ok {
+			return r.RestoreText(in, prev)
+		}
+		return in, nil
+	case JsonObject:
+		prev, _ := existing.(JsonObject)
+		out := make(JsonObject, len(in))
+		for k, v := range in {
+			restored, err := r.RestoreRedacted(v, prev[k])
+			if err != nil {
+				return nil, err
+			}
+			out[k] = restored
+		}
+		return out, nil
+	case []any:
+		prev, ok := existing.([]any)
+		if !ok || !containsPlaceholder(in) {
+			return slices.Clone(in), nil
+		}
+		if len(in) != len(prev) {
+			return nil, &RedactedWriteError{Array: true}
+		}
+		for i, v := range in {
+			if !containsPlaceholder(v) && !reflect.DeepEqual(v, prev[i]) {
+				return nil, &RedactedWriteError{Array: true}
+			}
+		}
+		out := make([]any, len(in))
+		for i, v := range in {
+			restored, err := r.RestoreRedacted(v, prev[i])
+			if err != nil {
+				return nil, err
+			}
+			out[i] = restored
+		}
+		return out, nil
+	default:
+		return incoming, nil
+	}
+}
+
+// containsPlaceholder reports whether value, or any value nested in it, holds
+// a redaction placeholder.
+func containsPlaceholder(value any) bool {
+	switch v := value.(type) {
+	case string:
+		return strings.Contains(v, redacted)
+	case JsonObject:
+		for _, child := range v {
+			if containsPlaceholder(child) {
+				return true
+			}
+		}
+	case []any:
+		for _, child := range v {
+			if containsPlaceholder(child) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// RestoreText replaces the placeholders in incoming, text edited from
+// Text(existing), with the secrets they hid, in order. Text without
+// placeholders is returned unchanged. Returns a *RedactedWriteError if the
+// number of placeholders differs from the number of secrets in existing.
+func (r *Redactor) RestoreText(incoming, existing string) (string, error) {
+	n := strings.Count(incoming, redacted)
+	if n == 0 {
+		return incoming, nil
+	}
+	spans := r.secretSpans(existing)
+	if n != len(spans) {
+		return "", &RedactedWriteError{Placeholders: n, Secrets: len(spans)}
+	}
+	var b strings.Builder
+	for i, part := range strings.Split(incoming, redacted) {
+		b.WriteString(part)
+		if i < len(spans) {
+			b.WriteString(existing[spans[i][0]:spans[i][1]])
+		}
+	}
+	return b.String(), nil
+}
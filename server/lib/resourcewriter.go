@@ -14,9 +14,10 @@ type ResourceType string
 
 // Resource type constants identifying which category of markdown resource to operate on.
 const (
-	ResourceTypeAgent   ResourceType = "agent"
-	ResourceTypeCommand ResourceType = "command"
-	ResourceTypeSkill   ResourceType = "skill"
+	ResourceTypeAgent       ResourceType = "agent"
+	ResourceTypeCommand     ResourceType = "command"
+	ResourceTypeSkill       ResourceType = "skill"
+	ResourceTypeOutputStyle ResourceType = "output-style"
 )
 
 // ResourceFile represents a single parsed markdown resource file.
@@ -52,6 +53,8 @@ func ResolveResourceDir(resourceType ResourceType, claudeHome string) string {
 		return filepath.Join(claudeHome, "commands")
 	case ResourceTypeSkill:
 		return filepath.Join(claudeHome, "skills")
+	case ResourceTypeOutputStyle:
+		return filepath.Join(claudeHome, "output-styles")
 	default:
 		return filepath.Join(claudeHome, string(resourceType)+"s")
 	}
@@ -38,6 +38,12 @@ func TestResolveResourceDir_Skill(t *testing.T) {
 	assert.Equal(t, "/fake/home/skills", got)
 }
 
+func TestResolveResourceDir_OutputStyle(t *testing.T) {
+	home := "/fake/home"
+	got := lib.ResolveResourceDir(lib.ResourceTypeOutputStyle, home)
+	assert.Equal(t, "/fake/home/output-styles", got)
+}
+
 // ListResources
 
 func TestListResources_Empty(t *testing.T) {
@@ -0,0 +1,315 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"math"
+	"os"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strings"
+	"unicode"
+)
+
+// SecretsIgnoreFileName is the file in the Claude home directory that lists
+// secret scan findings to suppress, one entry per line:
+//
+//	# a comment
+//	pattern:high-entropy     every finding of a pattern
+//	3f2a9c0d1e4b5a6f         every finding of one secret, by fingerprint
+//	~/work/fixtures          a file, or everything under a directory (globs allowed)
+//	*.example.md             a file name, when the glob has no slash
+const SecretsIgnoreFileName = "field-station-secrets-ignore"
+
+// HighEntropyPattern is the pattern name reported for tokens that match no
+// value pattern but look random enough to be a secret.
+const HighEntropyPattern = "high-entropy"
+
+// Kinds of content a secret scan finding is reported in.
+const (
+	SecretKindSettings     = "settings"
+	SecretKindHook         = "hook"
+	SecretKindInstructions = "instructions"
+	SecretKindMemory       = "memory"
+	SecretKindAgent        = "agent"
+	SecretKindCommand      = "command"
+	SecretKindSkill        = "skill"
+)
+
+// SecretFinding is one likely secret found by ScanSecrets. The secret itself
+// is never reported: Masked keeps only its ends, and Fingerprint identifies it
+// for the ignore list.
+type SecretFinding struct {
+	FilePath    string `json:"filePath"`
+	Line        int    `json:"line"`
+	Column      int    `json:"column"`
+	Kind        string `json:"kind"`
+	Pattern     string `json:"pattern"`
+	Masked      string `json:"masked"`
+	Fingerprint string `json:"fingerprint"`
+}
+
+// SecretScanResult is the outcome of ScanSecrets.
+type SecretScanResult struct {
+	Findings     []SecretFinding `json:"findings"`
+	FilesScanned int             `json:"filesScanned"`
+	Ignored      int             `json:"ignored"`
+}
+
+// SecretIgnoreList is the parsed secrets ignore file.
+type SecretIgnoreList struct {
+	patterns     map[string]bool
+	fingerprints map[string]bool
+	paths        []string
+}
+
+var fingerprintPattern = regexp.MustCompile(`^[0-9a-f]{16}$`)
+
+// LoadSecretIgnoreList reads the secrets ignore file in claudeHome. A missing
+// file is an empty list. Returns an error naming the line of a malformed glob.
+func LoadSecretIgnoreList(claudeHome string) (*SecretIgnoreList, error) {
+	list := &SecretIgnoreList{patterns: map[string]bool{}, fingerprints: map[string]bool{}}
+	path := filepath.Join(claudeHome, SecretsIgnoreFileName)
+	data, err := os.ReadFile(path) //nolint:gosec // path is the fixed ignore file inside claudeHome
+	if errors.Is(err, os.ErrNotExist) {
+		return list, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	home, _ := os.UserHomeDir()
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "" || strings.HasPrefix(line, "#"):
+		case strings.HasPrefix(line, "pattern:"):
+			list.patterns[strings.TrimSpace(strings.TrimPrefix(line, "pattern:"))] = true
+		case fingerprintPattern.MatchString(line):
+			list.fingerprints[line] = true
+		default:
+			if strings.HasPrefix(line, "~/") && home != "" {
+				line = filepath.Join(home, line[2:])
+			}
+			if _, err := filepath.Match(line, ""); err != nil {
+				return nil, fmt.Errorf("%s:%d: %w", path, i+1, err)
+			}
+			list.paths = append(list.paths, filepath.Clean(line))
+		}
+	}
+	return list, nil
+}
+
+// Ignores reports whether finding is suppressed by the list.
+func (l *SecretIgnoreList) Ignores(finding SecretFinding) bool {
+	if l.patterns[finding.Pattern] || l.fingerprints[finding.Fingerprint] {
+		return true
+	}
+	for _, glob := range l.paths {
+		if !strings.Contains(glob, "/") {
+			if ok, _ := filepath.Match(glob, filepath.Base(finding.FilePath)); ok {
+				return true
+			}
+			continue
+		}
+		for p := finding.FilePath; p != filepath.Dir(p); p = filepath.Dir(p) {
+			if ok, _ := filepath.Match(glob, p); ok {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// secretScanTarget is a file ScanSecrets reads and the kind of content in it.
+type secretScanTarget struct {
+	path string
+	kind string
+}
+
+// ScanSecrets looks for secrets in the settings, instructions, memory, agents,
+// commands and skills of claudeHome and of each project in projectPaths. Each
+// line is checked against the value patterns of the redaction rules in
+// claudeHome and for high-entropy tokens; findings inside the "hooks" object of
+// a settings file are reported as hooks. Findings suppressed by the ignore file
+// are counted but not returned. Unreadable files are skipped. Returns an error
+// if the redaction rules or the ignore file are invalid.
+func ScanSecrets(claudeHome string, projectPaths []string) (*SecretScanResult, error) {
+	redactor, err := LoadRedactor(claudeHome)
+	if err != nil {
+		return nil, err
+	}
+	ignore, err := LoadSecretIgnoreList(claudeHome)
+	if err != nil {
+		return nil, err
+	}
+
+	targets := secretScanTargets(claudeHome, claudeHome)
+	memoryDirs, _ := filepath.Glob(filepath.Join(claudeHome, "projects", "*", "memory"))
+	for _, dir := range memoryDirs {
+		targets = append(targets, markdownTargets(dir, SecretKindMemory)...)
+	}
+	for _, projectPath := range projectPaths {
+		targets = append(targets, secretScanTargets(filepath.Join(projectPath, ".claude"), projectPath)...)
+	}
+
+	result := &SecretScanResult{Findings: []SecretFinding{}}
+	seen := map[string]bool{}
+	for _, target := range targets {
+		if seen[target.path] {
+			continue
+		}
+		seen[target.path] = true
+		data, err := os.ReadFile(target.path) //nolint:gosec // targets are fixed names and directory listings under claudeHome and registered projects
+		if err != nil {
+			continue
+		}
+		result.FilesScanned++
+		for _, finding := range scanFileForSecrets(redactor, target, data) {
+			if ignore.Ignores(finding) {
+				result.Ignored++
+				continue
+			}
+			result.Findings = append(result.Findings, finding)
+		}
+	}
+	return result, nil
+}
+
+// secretScanTargets lists the files of one scope: the settings and resources
+// in configDir and the instructions in instructionsDir.
+func secretScanTargets(configDir, instructionsDir string) []secretScanTarget {
+	targets := []secretScanTarget{
+		{filepath.Join(configDir, "settings.json"), SecretKindSettings},
+		{filepath.Join(configDir, "settings.local.json"), SecretKindSettings},
+		{filepath.Join(instructionsDir, "CLAUDE.md"), SecretKindInstructions},
+		{filepath.Join(instructionsDir, "CLAUDE.local.md"), SecretKindInstructions},
+	}
+	targets = append(targets, markdownTargets(filepath.Join(configDir, "agents"), SecretKindAgent)...)
+	targets = append(targets, markdownTargets(filepath.Join(configDir, "commands"), SecretKindCommand)...)
+	return append(targets, markdownTargets(filepath.Join(configDir, "skills"), SecretKindSkill)...)
+}
+
+// markdownTargets lists the .md files under dir, which need not exist.
+func markdownTargets(dir, kind string) []secretScanTarget {
+	var targets []secretScanTarget
+	_ = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return nil //nolint:nilerr // a missing or unreadable directory has nothing to scan
+		}
+		if !d.IsDir() && strings.HasSuffix(d.Name(), ".md") {
+			targets = append(targets, secretScanTarget{path, kind})
+		}
+		return nil
+	})
+	return targets
+}
+
+// scanFileForSecrets returns the findings in one file, in line order.
+func scanFileForSecrets(redactor *Redactor, target secretScanTarget, data []byte) []SecretFinding {
+	hooksStart, hooksEnd := -1, -1
+	if target.kind == SecretKindSettings {
+		if root, err := parseJSONTree(data); err == nil && root.kind == '{' {
+			if i := root.memberIndex("hooks"); i >= 0 {
+				hooksStart, hooksEnd = root.members[i].value.start, root.members[i].value.end
+			}
+		}
+	}
+
+	var findings []SecretFinding
+	offset := 0
+	for i, line := range strings.Split(string(data), "\n") {
+		for _, m := range scanSecretLine(redactor, line) {
+			secret := line[m.span[0]:m.span[1]]
+			kind := target.kind
+			if at := offset + m.span[0]; at >= hooksStart && at < hooksEnd {
+				kind = SecretKindHook
+			}
+			findings = append(findings, SecretFinding{
+				FilePath:    target.path,
+				Line:        i + 1,
+				Column:      m.span[0] + 1,
+				Kind:        kind,
+				Pattern:     m.pattern,
+				Masked:      maskSecret(secret),
+				Fingerprint: secretFingerprint(secret),
+			})
+		}
+		offset += len(line) + 1
+	}
+	return findings
+}
+
+// highEntropyCandidate matches tokens long enough to be checked for entropy.
+// Slashes are left out so that paths and URLs are not taken for secrets.
+var highEntropyCandidate = regexp.MustCompile(`[A-Za-z0-9_+\-]{20,}={0,2}`)
+
+// minSecretEntropy is the Shannon entropy, in bits per character, above which
+// a candidate token is reported. Hex strings such as commit hashes and UUIDs
+// cannot exceed 4 bits per character.
+const minSecretEntropy = 4.0
+
+// scanSecretLine returns the non-overlapping secrets in line, by position.
+// Where value pattern matches overlap, the earlier pattern wins.
+func scanSecretLine(redactor *Redactor, line string) []valueMatch {
+	matches := redactor.valueMatches(line)
+	sort.SliceStable(matches, func(a, b int) bool { return matches[a].span[0] < matches[b].span[0] })
+	var kept []valueMatch
+	overlaps := func(span [2]int) bool {
+		for _, k := range kept {
+			if span[0] < k.span[1] && k.span[0] < span[1] {
+				return true
+			}
+		}
+		return false
+	}
+	for _, m := range matches {
+		if !overlaps(m.span) {
+			kept = append(kept, m)
+		}
+	}
+	for _, loc := range highEntropyCandidate.FindAllStringIndex(line, -1) {
+		span := [2]int{loc[0], loc[1]}
+		if !overlaps(span) && looksRandom(line[loc[0]:loc[1]]) {
+			kept = append(kept, valueMatch{pattern: HighEntropyPattern, span: span})
+		}
+	}
+	sort.Slice(kept, func(a, b int) bool { return kept[a].span[0] < kept[b].span[0] })
+	return kept
+}
+
+// looksRandom reports whether token mixes letters and digits and has more
+// than minSecretEntropy bits of entropy per character.
+func looksRandom(token string) bool {
+	if strings.IndexFunc(token, unicode.IsLetter) < 0 || strings.IndexFunc(token, unicode.IsDigit) < 0 {
+		return false
+	}
+	counts := map[rune]int{}
+	for _, c := range token {
+		counts[c]++
+	}
+	entropy := 0.0
+	for _, n := range counts {
+		p := float64(n) / float64(len(token))
+		entropy -= p * math.Log2(p)
+	}
+	return entropy > minSecretEntropy
+}
+
+// maskSecret keeps the first and last four characters of a secret, or none of
+// a secret too short for that to hide most of it.
+func maskSecret(secret string) string {
+	if len(secret) < 16 {
+		return "****"
+	}
+	return secret[:4] + "****" + secret[len(secret)-4:]
+}
+
+// secretFingerprint identifies a secret in the ignore list without storing it.
+func secretFingerprint(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:8])
+}
@@ -0,0 +1,341 @@
+package lib
+
+import (
+	_ "embed"
+	"encoding/json"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+)
+
+// settingsSchemaJSON is the bundled JSON Schema describing Claude Code's settings.json.
+//
+//go:embed settings.schema.json
+var settingsSchemaJSON []byte
+
+// SettingsIssue describes a single schema violation or warning at a key path.
+type SettingsIssue struct {
+	Path    string
+	Message string
+}
+
+// SettingsValidation is the result of validating settings against the bundled schema.
+// Errors block a write; Warnings (unknown keys) are reported but do not.
+type SettingsValidation struct {
+	Errors   []SettingsIssue
+	Warnings []SettingsIssue
+}
+
+// Valid reports whether the validation produced no errors. Warnings are ignored.
+func (v SettingsValidation) Valid() bool {
+	return len(v.Errors) == 0
+}
+
+// SettingsValidationError is returned when a write is rejected by schema validation.
+type SettingsValidationError struct {
+	Issues []SettingsIssue
+}
+
+func (e *SettingsValidationError) Error() string {
+	parts := make([]string, 0, len(e.Issues))
+	for _, issue := range e.Issues {
+		parts = append(parts, issue.Path+": "+issue.Message)
+	}
+	return "settings validation failed: " + strings.Join(parts, "; ")
+}
+
+// schemaTypes accepts both the string and array forms of the JSON Schema "type" keyword.
+type schemaTypes []string
+
+func (t *schemaTypes) UnmarshalJSON(data []byte) error {
+	var single string
+	if err := json.Unmarshal(data, &single); err == nil {
+		*t = schemaTypes{single}
+		return nil
+	}
+	var many []string
+	if err := json.Unmarshal(data, &many); err != nil {
+		return err
+	}
+	*t = many
+	return nil
+}
+
+// schemaNode is the subset of JSON Schema used by settings.schema.json:
+// type, enum, properties, additionalProperties, items, minimum and maximum.
+type schemaNode struct {
+	Type                 schemaTypes            `json:"type"`
+	Enum                 []any                  `json:"enum"`
+	Properties           map[string]*schemaNode `json:"properties"`
+	AdditionalProperties *schemaNode            `json:"additionalProperties"`
+	Items                *schemaNode            `json:"items"`
+	Minimum              *float64               `json:"minimum"`
+	Maximum              *float64               `json:"maximum"`
+
+	// closed is set when additionalProperties is the literal false.
+	closed bool
+}
+
+func (n *schemaNode) UnmarshalJSON(data []byte) error {
+	switch strings.TrimSpace(string(data)) {
+	case "true":
+		*n = schemaNode{}
+		return nil
+	case "false":
+		*n = schemaNode{closed: true}
+		return nil
+	}
+	type plain schemaNode
+	var p plain
+	if err := json.Unmarshal(data, &p); err != nil {
+		return err
+	}
+	*n = schemaNode(p)
+	return nil
+}
+
+// settingsSchema is the parsed form of settingsSchemaJSON. The schema is bundled
+// at build time, so a parse failure is a programming error.
+var settingsSchema = func() *schemaNode {
+	var root schemaNode
+	if err := json.Unmarshal(settingsSchemaJSON, &root); err != nil {
+		panic(fmt.Sprintf("settingsschema: invalid bundled schema: %v", err))
+	}
+	return &root
+}()
+
+// ValidateSettings validates a complete settings object against the bundled schema.
+func ValidateSettings(obj JsonObject) SettingsValidation {
+	var v SettingsValidation
+	validateNode(settingsSchema, obj, "", &v)
+	return v
+}
+
+// ValidateSettingValue validates value as if it were written at keyPath in a
+// settings file. Keys along keyPath that the schema does not know about produce a
+// warning rather than an error, so settings added by newer Claude Code releases
+// can still be written.
+func ValidateSettingValue(keyPath string, value any) SettingsValidation {
+	var v SettingsValidation
+	node := settingsSchema
+	path := ""
+	for _, key := range splitPath(keyPath) {
+		if isArrayIndexKey(key) && len(node.Type) > 0 && node.allows("array") && !node.allows("object") {
+			path += "[" + key + "]"
+			if node.Items == nil {
+				return v
+			}
+			node = node.Items
+			continue
+		}
+		path = appendPath(path, key)
+		if len(node.Type) > 0 && !node.allows("object") {
+			v.Errors = append(v.Errors, SettingsIssue{
+				Path:    path,
+				Message: fmt.Sprintf("cannot set a key inside a value of type %s", node.typeName()),
+			})
+			return v
+		}
+		child, known := node.child(key)
+		if !known {
+			v.Warnings = append(v.Warnings, unknownKeyIssue(node, key, path))
+			return v
+		}
+		if child == nil {
+			// Schema does not describe this subtree; nothing further to check.
+			return v
+		}
+		node = child
+	}
+	validateNode(node, value, keyPath, &v)
+	return v
+}
+
+// child returns the schema for key within an object node. known is false when the
+// key is not declared and the node does not accept additional properties.
+func (n *schemaNode) child(key string) (child *schemaNode, known bool) {
+	if p, ok := n.Properties[key]; ok {
+		return p, true
+	}
+	if n.AdditionalProperties != nil && !n.AdditionalProperties.closed {
+		return n.AdditionalProperties, true
+	}
+	if n.Properties == nil && n.AdditionalProperties == nil {
+		// Free-form object: any key is acceptable and unconstrained.
+		return nil, true
+	}
+	return nil, false
+}
+
+func (n *schemaNode) allows(typeName string) bool {
+	if len(n.Type) == 0 {
+		return true
+	}
+	for _, t := range n.Type {
+		if t == typeName || (t == "number" && typeName == "integer") {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *schemaNode) typeName() string {
+	return strings.Join(n.Type, " or ")
+}
+
+// validateNode checks value against node, appending issues at path to v.
+func validateNode(node *schemaNode, value any, path string, v *SettingsValidation) {
+	if node == nil {
+		return
+	}
+	displayPath := path
+	if displayPath == "" {
+		displayPath = "(root)"
+	}
+
+	actual := jsonTypeOf(value)
+	if !node.allows(actual) {
+		v.Errors = append(v.Errors, SettingsIssue{
+			Path:    displayPath,
+			Message: fmt.Sprintf("expected %s, got %s", node.typeName(), actual),
+		})
+		return
+	}
+
+	if len(node.Enum) > 0 && !enumContains(node.Enum, value) {
+		v.Errors = append(v.Errors, SettingsIssue{
+			Path:    displayPath,
+			Message: fmt.Sprintf("must be one of %s", formatEnum(node.Enum)),
+		})
+		return
+	}
+
+	if num, ok := toFloat(value); ok {
+		if node.Minimum != nil && num < *node.Minimum {
+			v.Errors = append(v.Errors, SettingsIssue{Path: displayPath, Message: fmt.Sprintf("must be >= %v", *node.Minimum)})
+		}
+		if node.Maximum != nil && num > *node.Maximum {
+			v.Errors = append(v.Errors, SettingsIssue{Path: displayPath, Message: fmt.Sprintf("must be <= %v", *node.Maximum)})
+		}
+	}
+
+	switch val := value.(type) {
+	case JsonObject:
+		keys := make([]string, 0, len(val))
+		for k := range val {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+		for _, k := range keys {
+			childPath := appendPath(path, k)
+			child, known := node.child(k)
+			if !known {
+				v.Warnings = append(v.Warnings, unknownKeyIssue(node, k, childPath))
+				continue
+			}
+			validateNode(child, val[k], childPath, v)
+		}
+	case []any:
+		for i, item := range val {
+			validateNode(node.Items, item, fmt.Sprintf("%s[%d]", path, i), v)
+		}
+	}
+}
+
+// unknownKeyIssue builds a warning for key, suggesting the closest declared
+// property name when one is within a small edit distance.
+func unknownKeyIssue(node *schemaNode, key, path string) SettingsIssue {
+	msg := fmt.Sprintf("unknown key %q", key)
+	best, bestDist := "", 3
+	for name := range node.Properties {
+		if d := editDistance(strings.ToLower(key), strings.ToLower(name)); d < bestDist || (d == bestDist && best != "" && name < best) {
+			best, bestDist = name, d
+		}
+	}
+	if best != "" {
+		msg += fmt.Sprintf(" (did you mean %q?)", best)
+	}
+	return SettingsIssue{Path: path, Message: msg}
+}
+
+// jsonTypeOf returns the JSON Schema type name for a decoded JSON value.
+func jsonTypeOf(value any) string {
+	switch v := value.(type) {
+	case nil:
+		return "null"
+	case bool:
+		return "boolean"
+	case string:
+		return "string"
+	case JsonObject:
+		return "object"
+	case []any:
+		return "array"
+	default:
+		if f, ok := toFloat(v); ok {
+			if f == math.Trunc(f) {
+				return "integer"
+			}
+			return "number"
+		}
+		return fmt.Sprintf("%T", value)
+	}
+}
+
+func toFloat(value any) (float64, bool) {
+	switch n := value.(type) {
+	case float64:
+		return n, true
+	case float32:
+		return float64(n), true
+	case int:
+		return float64(n), true
+	case int64:
+		return float64(n), true
+	case int32:
+		return float64(n), true
+	case json.Number:
+		f, err := n.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+func enumContains(enum []any, value any) bool {
+	for _, e := range enum {
+		if e == value {
+			return true
+		}
+	}
+	return false
+}
+
+func formatEnum(enum []any) string {
+	parts := make([]string, len(enum))
+	for i, e := range enum {
+		parts[i] = fmt.Sprintf("%q", fmt.Sprint(e))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// editDistance returns the Levenshtein distance between a and b.
+func editDistance(a, b string) int {
+	prev := make([]int, len(b)+1)
+	curr := make([]int, len(b)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(a); i++ {
+		curr[0] = i
+		for j := 1; j <= len(b); j++ {
+			cost := 1
+			if a[i-1] == b[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(b)]
+}
@@ -0,0 +1,100 @@
+package lib_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestValidateSettingValue_ValidArray(t *testing.T) {
+	v := lib.ValidateSettingValue("permissions.allow", []any{"Bash(npm test)", "Read"})
+	assert.True(t, v.Valid())
+	assert.Empty(t, v.Warnings)
+}
+
+func TestValidateSettingValue_StringWhereArrayExpected(t *testing.T) {
+	v := lib.ValidateSettingValue("permissions.allow", "Bash(npm test)")
+	require.False(t, v.Valid())
+	require.Len(t, v.Errors, 1)
+	assert.Equal(t, "permissions.allow", v.Errors[0].Path)
+	assert.Contains(t, v.Errors[0].Message, "expected array")
+}
+
+func TestValidateSettingValue_ArrayItemErrorsReportIndex(t *testing.T) {
+	v := lib.ValidateSettingValue("permissions.deny", []any{"Read", float64(3)})
+	require.Len(t, v.Errors, 1)
+	assert.Equal(t, "permissions.deny[1]", v.Errors[0].Path)
+}
+
+func TestValidateSettingValue_ArrayElementPath(t *testing.T) {
+	assert.True(t, lib.ValidateSettingValue("permissions.allow[-]", "Bash(npm run test.unit)").Valid())
+
+	v := lib.ValidateSettingValue("permissions.allow[2]", float64(3))
+	require.Len(t, v.Errors, 1)
+	assert.Equal(t, "permissions.allow[2]", v.Errors[0].Path)
+}
+
+func TestValidateSettingValue_QuotedKeyPath(t *testing.T) {
+	assert.True(t, lib.ValidateSettingValue(`env["my.var"]`, "1").Valid())
+	v := lib.ValidateSettingValue(`env["my.var"]`, true)
+	require.Len(t, v.Errors, 1)
+	assert.Equal(t, `env["my.var"]`, v.Errors[0].Path)
+}
+
+func TestValidateSettingValue_EnumViolation(t *testing.T) {
+	v := lib.ValidateSettingValue("permissions.defaultMode", "yolo")
+	require.False(t, v.Valid())
+	assert.Contains(t, v.Errors[0].Message, "acceptEdits")
+}
+
+func TestValidateSettingValue_UnknownTopLevelKeyIsWarning(t *testing.T) {
+	v := lib.ValidateSettingValue("permisions.allow", []any{"Read"})
+	assert.True(t, v.Valid(), "unknown keys must not block writes")
+	require.Len(t, v.Warnings, 1)
+	assert.Equal(t, "permisions", v.Warnings[0].Path)
+	assert.Contains(t, v.Warnings[0].Message, `did you mean "permissions"`)
+}
+
+func TestValidateSettingValue_EnvValuesMustBeStrings(t *testing.T) {
+	assert.True(t, lib.ValidateSettingValue("env.FOO", "1").Valid())
+	assert.False(t, lib.ValidateSettingValue("env.FOO", true).Valid())
+}
+
+func TestValidateSettingValue_KeyInsideScalar(t *testing.T) {
+	v := lib.ValidateSettingValue("model.name", "opus")
+	require.False(t, v.Valid())
+	assert.Equal(t, "model.name", v.Errors[0].Path)
+}
+
+func TestValidateSettingValue_NestedObjectValue(t *testing.T) {
+	v := lib.ValidateSettingValue("sandbox", lib.JsonObject{
+		"enabled": "yes",
+		"network": lib.JsonObject{"httpProxyPort": float64(70000)},
+	})
+	require.Len(t, v.Errors, 2)
+	assert.Equal(t, "sandbox.enabled", v.Errors[0].Path)
+	assert.Equal(t, "sandbox.network.httpProxyPort", v.Errors[1].Path)
+}
+
+func TestValidateSettingValue_IntegerAcceptsGoInts(t *testing.T) {
+	assert.True(t, lib.ValidateSettingValue("cleanupPeriodDays", 30).Valid())
+	assert.False(t, lib.ValidateSettingValue("cleanupPeriodDays", 1.5).Valid())
+}
+
+func TestValidateSettings_HooksShape(t *testing.T) {
+	v := lib.ValidateSettings(lib.JsonObject{
+		"hooks": lib.JsonObject{
+			"PreToolUse": []any{
+				lib.JsonObject{
+					"matcher": "Bash",
+					"hooks":   []any{lib.JsonObject{"type": "command", "command": "echo", "timeout": float64(30)}},
+				},
+			},
+			"Stop": "not-an-array",
+		},
+	})
+	require.Len(t, v.Errors, 1)
+	assert.Equal(t, "hooks.Stop", v.Errors[0].Path)
+}
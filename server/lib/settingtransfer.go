@@ -0,0 +1,157 @@
+package lib
+
+import (
+	"bytes"
+	"fmt"
+	"maps"
+	"strings"
+)
+
+// SettingLocation names a writable settings file by layer and, for the project
+// layers, the project it belongs to.
+type SettingLocation struct {
+	Source      ConfigLayerSource
+	ProjectPath string
+}
+
+// SettingTransferError reports a move or copy that cannot be carried out as
+// requested: the key is missing from the source, a layer is read-only, or a
+// destination is the source itself or repeats another destination.
+type SettingTransferError struct {
+	KeyPath string
+	Message string
+}
+
+func (e *SettingTransferError) Error() string {
+	return fmt.Sprintf("cannot transfer %q: %s", e.KeyPath, e.Message)
+}
+
+// PlanSettingTransfer copies the value at keyPath from one settings file to
+// each of to, and when move is true also deletes it from the source. A value
+// already at a destination is replaced, except that lists Claude Code
+// concatenates across layers, such as permissions.allow, are combined with the
+// destination's as mergeTransferValue describes, so promoting rules never drops
+// any. Paths are resolved with ResolveLayerPath, so any layer of any project
+// can be a source or destination. Nothing is written until the returned patch
+// is applied, which writes and backs up every touched file together. Returns a
+// *SettingTransferError for a request that cannot be honoured, or a
+// *JSONParseError if a file involved cannot be parsed.
+func PlanSettingTransfer(keyPath string, from SettingLocation, to []SettingLocation, move bool) (*ConfigPatch, error) {
+	keys, err := ParsePath(keyPath)
+	if err != nil {
+		return nil, &SettingTransferError{KeyPath: keyPath, Message: err.Error()}
+	}
+	normalized := JoinPath(keys...)
+	if len(to) == 0 {
+		return nil, &SettingTransferError{KeyPath: keyPath, Message: "no destination given"}
+	}
+	if move && from.Source.ReadOnly() {
+		return nil, &SettingTransferError{KeyPath: keyPath, Message: fmt.Sprintf("the %s layer is read-only", from.Source)}
+	}
+
+	fromPath, err := ResolveLayerPath(from.Source, from.ProjectPath)
+	if err != nil {
+		return nil, &SettingTransferError{KeyPath: keyPath, Message: err.Error()}
+	}
+	fromData, fromObj, err := readJSONFileForEdit(fromPath)
+	if err != nil {
+		return nil, err
+	}
+	value, ok := GetAtPath(fromObj, keyPath)
+	if !ok {
+		return nil, &SettingTransferError{KeyPath: keyPath, Message: fmt.Sprintf("key not found in %s", fromPath)}
+	}
+
+	op := BackupOpCopy
+	if move {
+		op = BackupOpMove
+	}
+	patch := &ConfigPatch{Operation: op}
+	seen := map[string]bool{fromPath: true}
+	for _, dest := range to {
+		if dest.Source.ReadOnly() {
+			return nil, &SettingTransferError{KeyPath: keyPath, Message: fmt.Sprintf("the %s layer is read-only", dest.Source)}
+		}
+		toPath, err := ResolveLayerPath(dest.Source, dest.ProjectPath)
+		if err != nil {
+			return nil, &SettingTransferError{KeyPath: keyPath, Message: err.Error()}
+		}
+		if seen[toPath] {
+			return nil, &SettingTransferError{KeyPath: keyPath, Message: fmt.Sprintf("%s is the source or an earlier destination", toPath)}
+		}
+		seen[toPath] = true
+
+		toData, toObj, err := readJSONFileForEdit(toPath)
+		if err != nil {
+			return nil, err
+		}
+		existing, _ := GetAtPath(toObj, normalized)
+		merged := mergeTransferValue(existing, value, normalized)
+		updated, err := setJSONAtKeys(toData, keys, merged, false)
+		if err != nil {
+			return nil, &SettingTransferError{KeyPath: keyPath, Message: fmt.Sprintf("%s: %v", toPath, err)}
+		}
+		if toData != nil && bytes.Equal(updated, toData) {
+			continue
+		}
+		patch.Files = append(patch.Files, &PatchedFile{
+			Source:   dest.Source,
+			FilePath: toPath,
+			Exists:   toData != nil,
+			Original: toData,
+			Updated:  updated,
+			Edits:    []SettingEdit{{KeyPath: normalized, Value: merged}},
+		})
+	}
+
+	if move {
+		updated, err := deleteJSONAtKeys(fromData, keys)
+		if err != nil {
+			return nil, err
+		}
+		patch.Files = append(patch.Files, &PatchedFile{
+			Source:   from.Source,
+			FilePath: fromPath,
+			Exists:   true,
+			Original: fromData,
+			Updated:  updated,
+			Edits:    []SettingEdit{{KeyPath: normalized, Delete: true}},
+		})
+	}
+	return patch, nil
+}
+
+// mergeTransferValue returns what transferring value onto existing, the value
+// at keyPath in a destination, leaves there. Arrays merged with
+// MergeConcatUnique are combined, destination items first, and objects holding
+// such keys (hooks, permissions, sandbox) are merged key by key; anything else
+// is replaced by value.
+func mergeTransferValue(existing, value any, keyPath string) any {
+	if MergeStrategyFor(keyPath) == MergeConcatUnique {
+		if merged, ok := concatUnique(existing, value); ok {
+			return merged
+		}
+		return value
+	}
+	existingObj, ok := existing.(JsonObject)
+	valueObj, ok2 := value.(JsonObject)
+	if !ok || !ok2 || !holdsMergedKeys(keyPath) {
+		return value
+	}
+	out := maps.Clone(existingObj)
+	for k, v := range valueObj {
+		out[k] = mergeTransferValue(existingObj[k], v, appendPath(keyPath, k))
+	}
+	return out
+}
+
+// holdsMergedKeys reports whether keyPath is, or is an ancestor of, a key that
+// does not use MergeReplace.
+func holdsMergedKeys(keyPath string) bool {
+	for path := range mergeStrategies {
+		if path == keyPath || strings.HasPrefix(path, keyPath+".") {
+			return true
+		}
+	}
+	return false
+}
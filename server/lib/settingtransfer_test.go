@@ -0,0 +1,156 @@
+package lib_test
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestPlanSettingTransfer_PromotesProjectLocalToGlobal(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, "{\n  \"model\": \"opus\"\n}\n")
+	localPath := filepath.Join(projectDir, ".claude", "settings.local.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0o750))
+	require.NoError(t, os.WriteFile(localPath, []byte(`{"env":{"CI":"1"},"theme":"dark"}`), 0o600))
+
+	patch, err := lib.PlanSettingTransfer("env",
+		lib.SettingLocation{Source: lib.ConfigLayerProjectLocal, ProjectPath: projectDir},
+		[]lib.SettingLocation{{Source: lib.ConfigLayerGlobal}}, true)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 2)
+
+	group, err := patch.Apply(claudeHome)
+	require.NoError(t, err)
+
+	global, err := os.ReadFile(filepath.Join(claudeHome, "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.Equal(t, "{\n  \"model\": \"opus\",\n  \"env\": {\n    \"CI\": \"1\"\n  }\n}\n", string(global))
+
+	local, err := os.ReadFile(localPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"theme":"dark"}`, string(local))
+
+	backups := lib.ListBackups(claudeHome)
+	require.Len(t, backups, 2)
+	for _, b := range backups {
+		assert.Equal(t, group, b.Group)
+		assert.Equal(t, lib.BackupOpMove, b.Operation)
+	}
+}
+
+func TestPlanSettingTransfer_CopiesToSeveralProjects(t *testing.T) {
+	claudeHome, projectA := setupPatchLayers(t, `{"permissions":{"deny":["Bash(rm -rf *)"]}}`)
+	projectB := t.TempDir()
+
+	patch, err := lib.PlanSettingTransfer("permissions.deny",
+		lib.SettingLocation{Source: lib.ConfigLayerGlobal},
+		[]lib.SettingLocation{
+			{Source: lib.ConfigLayerProject, ProjectPath: projectA},
+			{Source: lib.ConfigLayerProjectLocal, ProjectPath: projectB},
+		}, false)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 2, "the source is not written by a copy")
+	_, err = patch.Apply(claudeHome)
+	require.NoError(t, err)
+
+	for _, path := range []string{
+		filepath.Join(projectA, ".claude", "settings.json"),
+		filepath.Join(projectB, ".claude", "settings.local.json"),
+	} {
+		data, err := os.ReadFile(path) //nolint:gosec // path is from t.TempDir(), safe in tests
+		require.NoError(t, err)
+		assert.JSONEq(t, `{"permissions":{"deny":["Bash(rm -rf *)"]}}`, string(data))
+	}
+	global, err := os.ReadFile(filepath.Join(claudeHome, "settings.json")) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"deny":["Bash(rm -rf *)"]}}`, string(global))
+
+	// Neither destination existed, so there was nothing to back up.
+	assert.Empty(t, lib.ListBackups(claudeHome))
+}
+
+func TestPlanSettingTransfer_CombinesRuleListsWithDestination(t *testing.T) {
+	claudeHome, projectDir := setupPatchLayers(t, `{"permissions":{"allow":["Read","Bash(git status)"],"defaultMode":"default"}}`)
+	localPath := filepath.Join(projectDir, ".claude", "settings.local.json")
+	require.NoError(t, os.MkdirAll(filepath.Dir(localPath), 0o750))
+	require.NoError(t, os.WriteFile(localPath, []byte(`{"permissions":{"allow":["Bash(git status)","Bash(npm test)"],"deny":["WebFetch"],"defaultMode":"plan"}}`), 0o600))
+	from := lib.SettingLocation{Source: lib.ConfigLayerProjectLocal, ProjectPath: projectDir}
+	global := []lib.SettingLocation{{Source: lib.ConfigLayerGlobal}}
+	globalPath := filepath.Join(claudeHome, "settings.json")
+
+	patch, err := lib.PlanSettingTransfer("permissions.allow", from, global, false)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 1)
+	assert.Equal(t, []any{"Read", "Bash(git status)", "Bash(npm test)"}, patch.Files[0].Edits[0].Value)
+	_, err = patch.Apply(claudeHome)
+	require.NoError(t, err)
+	data, err := os.ReadFile(globalPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Read","Bash(git status)","Bash(npm test)"],"defaultMode":"default"}}`, string(data))
+
+	// Transferring the whole object combines its lists too, while plain keys
+	// such as defaultMode are replaced.
+	patch, err = lib.PlanSettingTransfer("permissions", from, global, false)
+	require.NoError(t, err)
+	_, err = patch.Apply(claudeHome)
+	require.NoError(t, err)
+	data, err = os.ReadFile(globalPath) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"permissions":{"allow":["Read","Bash(git status)","Bash(npm test)"],"deny":["WebFetch"],"defaultMode":"plan"}}`, string(data))
+}
+
+func TestPlanSettingTransfer_SkipsDestinationAlreadyHoldingValue(t *testing.T) {
+	claudeHome, _ := setupPatchLayers(t, `{"model":"opus"}`)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "settings.local.json"), []byte(`{"model":"opus"}`), 0o600))
+
+	patch, err := lib.PlanSettingTransfer("model",
+		lib.SettingLocation{Source: lib.ConfigLayerGlobal},
+		[]lib.SettingLocation{{Source: lib.ConfigLayerGlobalLocal}}, false)
+	require.NoError(t, err)
+	assert.Empty(t, patch.Files)
+}
+
+func TestPlanSettingTransfer_Errors(t *testing.T) {
+	global := lib.SettingLocation{Source: lib.ConfigLayerGlobal}
+	globalLocal := lib.SettingLocation{Source: lib.ConfigLayerGlobalLocal}
+	tests := []struct {
+		name    string
+		keyPath string
+		from    lib.SettingLocation
+		to      []lib.SettingLocation
+		move    bool
+	}{
+		{name: "key missing", keyPath: "theme", from: global, to: []lib.SettingLocation{globalLocal}, move: true},
+		{name: "no destination", keyPath: "model", from: global, move: true},
+		{name: "destination is source", keyPath: "model", from: global, to: []lib.SettingLocation{global}},
+		{name: "repeated destination", keyPath: "model", from: global, to: []lib.SettingLocation{globalLocal, globalLocal}},
+		{name: "managed destination", keyPath: "model", from: global, to: []lib.SettingLocation{{Source: lib.ConfigLayerManaged}}},
+		{name: "move out of managed", keyPath: "model", from: lib.SettingLocation{Source: lib.ConfigLayerManaged}, to: []lib.SettingLocation{global}, move: true},
+		{name: "project layer without project", keyPath: "model", from: global, to: []lib.SettingLocation{{Source: lib.ConfigLayerProject}}},
+		{name: "malformed key path", keyPath: "model[", from: global, to: []lib.SettingLocation{globalLocal}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			setupPatchLayers(t, `{"model":"opus"}`)
+			_, err := lib.PlanSettingTransfer(tt.keyPath, tt.from, tt.to, tt.move)
+			var transferErr *lib.SettingTransferError
+			require.True(t, errors.As(err, &transferErr), "expected *SettingTransferError, got %v", err)
+		})
+	}
+}
+
+func TestPlanSettingTransfer_CopiesOutOfManagedLayer(t *testing.T) {
+	claudeHome, _ := setupPatchLayers(t, `{}`)
+	require.NoError(t, os.WriteFile(filepath.Join(claudeHome, "managed-settings.json"), []byte(`{"model":"opus"}`), 0o600))
+
+	patch, err := lib.PlanSettingTransfer("model",
+		lib.SettingLocation{Source: lib.ConfigLayerManaged},
+		[]lib.SettingLocation{{Source: lib.ConfigLayerGlobal}}, false)
+	require.NoError(t, err)
+	require.Len(t, patch.Files, 1)
+	assert.JSONEq(t, `{"model":"opus"}`, string(patch.Files[0].Updated))
+}
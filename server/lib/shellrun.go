@@ -0,0 +1,63 @@
+package lib
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"os/exec"
+	"time"
+)
+
+// maxShellOutput caps how much of a test-run command's stdout and stderr is
+// kept.
+const maxShellOutput = 64 * 1024
+
+// shellRun is the outcome of runShell.
+type shellRun struct {
+	stdout   string
+	stderr   string
+	exitCode int
+	timedOut bool
+	duration time.Duration
+}
+
+// runShell runs command through sh in dir with env and input on stdin, the way
+// Claude Code runs status lines and hooks, and collects what it prints. A
+// command that fails, or is stopped after timeout, is reported in the result
+// rather than as an error, with exit code -1 for a timeout. Returns an error
+// only if the shell cannot be started.
+func runShell(ctx context.Context, command, dir string, env []string, input []byte, timeout time.Duration) (*shellRun, error) {
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", command) //nolint:gosec // the command is the user's own configuration
+	cmd.Dir = dir
+	cmd.Env = env
+	cmd.Stdin = bytes.NewReader(input)
+	stdout := &cappedBuffer{max: maxShellOutput}
+	stderr := &cappedBuffer{max: maxShellOutput}
+	cmd.Stdout, cmd.Stderr = stdout, stderr
+	// Background children that keep the output pipes open must not hold the
+	// run past its timeout.
+	cmd.WaitDelay = time.Second
+
+	start := time.Now()
+	err := cmd.Run()
+	run := &shellRun{duration: time.Since(start)}
+	run.stdout, run.stderr = stdout.String(), stderr.String()
+	if errors.Is(ctx.Err(), context.DeadlineExceeded) {
+		run.timedOut = true
+		run.exitCode = -1
+		return run, nil
+	}
+	var exitErr *exec.ExitError
+	switch {
+	case err == nil, errors.Is(err, exec.ErrWaitDelay):
+	case errors.As(err, &exitErr):
+		run.exitCode = exitErr.ExitCode()
+	default:
+		return nil, fmt.Errorf("sh: %w", err)
+	}
+	return run, nil
+}
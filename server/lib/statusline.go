@@ -0,0 +1,233 @@
+package lib
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// StatusLineTimeout is how long RunStatusLine waits for a command by default.
+const StatusLineTimeout = 5 * time.Second
+
+// StatusLine is the statusLine setting: a command Claude Code runs with the
+// session as JSON on stdin, printing the line to show. Extra holds any other
+// keys of the setting, which are kept when it is rewritten.
+type StatusLine struct {
+	Type    string
+	Command string
+	Padding *int
+	Extra   JsonObject
+}
+
+// StatusLineEntry is the statusLine setting of one layer. Error is set, and
+// the setting left zero, when the layer holds a statusLine of the wrong shape.
+type StatusLineEntry struct {
+	StatusLine StatusLine
+	Source     ConfigLayerSource
+	FilePath   string
+	Error      string
+}
+
+// StatusLineSettings is the statusLine setting of every layer. Entries lists
+// the layers that set it, lowest precedence first; Effective is the one Claude
+// Code uses, or nil if no layer sets it.
+type StatusLineSettings struct {
+	Entries   []StatusLineEntry
+	Effective *StatusLineEntry
+	Layers    []ConfigLayer
+}
+
+// LoadStatusLine reads the statusLine setting of every layer MergeConfigLayers
+// reads. The highest-precedence layer that sets it wins as a whole.
+func LoadStatusLine(projectPath string) StatusLineSettings {
+	config := MergeConfigLayers(projectPath)
+	result := StatusLineSettings{Layers: config.Layers}
+	for _, layer := range config.Layers {
+		value, ok := layer.Content["statusLine"]
+		if !ok {
+			continue
+		}
+		entry := StatusLineEntry{Source: layer.Source, FilePath: layer.FilePath}
+		if sl, err := parseStatusLine(value); err != nil {
+			entry.Error = err.Error()
+		} else {
+			entry.StatusLine = sl
+		}
+		result.Entries = append(result.Entries, entry)
+	}
+	for i := len(result.Entries) - 1; i >= 0; i-- {
+		if result.Entries[i].Error == "" {
+			result.Effective = &result.Entries[i]
+			break
+		}
+	}
+	return result
+}
+
+// parseStatusLine reads a statusLine value as decoded from a settings file.
+func parseStatusLine(value any) (StatusLine, error) {
+	obj, ok := value.(JsonObject)
+	if !ok {
+		return StatusLine{}, fmt.Errorf("statusLine is not an object")
+	}
+	var sl StatusLine
+	if sl.Type, ok = obj["type"].(string); !ok && obj["type"] != nil {
+		return StatusLine{}, fmt.Errorf("statusLine.type is not a string")
+	}
+	if sl.Command, ok = obj["command"].(string); !ok && obj["command"] != nil {
+		return StatusLine{}, fmt.Errorf("statusLine.command is not a string")
+	}
+	if p, ok := obj["padding"]; ok {
+		n, isNum := p.(float64)
+		if !isNum {
+			return StatusLine{}, fmt.Errorf("statusLine.padding is not a number")
+		}
+		padding := int(n)
+		sl.Padding = &padding
+	}
+	for k, v := range obj {
+		switch k {
+		case "type", "command", "padding":
+		default:
+			if sl.Extra == nil {
+				sl.Extra = JsonObject{}
+			}
+			sl.Extra[k] = v
+		}
+	}
+	return sl, nil
+}
+
+// ToJSON returns the statusLine setting as written to a settings file.
+func (sl StatusLine) ToJSON() JsonObject {
+	obj := maps.Clone(sl.Extra)
+	if obj == nil {
+		obj = JsonObject{}
+	}
+	obj["type"] = sl.Type
+	obj["command"] = sl.Command
+	if sl.Padding != nil {
+		obj["padding"] = *sl.Padding
+	}
+	return obj
+}
+
+// SetStatusLine writes statusLine to the settings file at filePath, replacing
+// any statusLine there, or removes the setting if statusLine is nil. Keys of
+// the stored setting that StatusLine does not model are kept, and redaction
+// placeholders in the command are replaced by the secrets they hid.
+// Returns a *JSONParseError, without writing, if the file cannot be parsed, or
+// a *RedactedWriteError if placeholders cannot be restored.
+func SetStatusLine(filePath string, statusLine *StatusLine, claudeHome string) error {
+	obj, err := ReadJSONFileStrict(filePath)
+	if err != nil {
+		return err
+	}
+	existing, exists := obj["statusLine"]
+	if statusLine == nil {
+		if !exists {
+			return nil
+		}
+		return ApplyDeleteSetting(filePath, "statusLine", claudeHome)
+	}
+	sl := *statusLine
+	if stored, err := parseStatusLine(existing); err == nil {
+		extra := maps.Clone(stored.Extra)
+		if extra == nil {
+			extra = JsonObject{}
+		}
+		maps.Copy(extra, sl.Extra)
+		sl.Extra = extra
+	}
+	return ApplyUpdateSetting(filePath, "statusLine", sl.ToJSON(), claudeHome)
+}
+
+// SyntheticStatusLineSession returns session JSON of the shape Claude Code
+// pipes into a status line command, for a session in dir. model and version
+// fill in the fields of the same name when not empty.
+func SyntheticStatusLineSession(dir, model, version string) JsonObject {
+	if model == "" {
+		model = "claude-sonnet-4-5"
+	}
+	if version == "" {
+		version = "0.0.0"
+	}
+	return JsonObject{
+		"hook_event_name": "Status",
+		"session_id":      "00000000-0000-4000-8000-000000000000",
+		"transcript_path": filepath.Join(os.TempDir(), "field-station-status-line-test.jsonl"),
+		"cwd":             dir,
+		"model": JsonObject{
+			"id":           model,
+			"display_name": model,
+		},
+		"workspace": JsonObject{
+			"current_dir": dir,
+			"project_dir": dir,
+		},
+		"version": version,
+		"output_style": JsonObject{
+			"name": "default",
+		},
+		"cost": JsonObject{
+			"total_cost_usd":        0.0123,
+			"total_duration_ms":     45000,
+			"total_api_duration_ms": 2300,
+			"total_lines_added":     156,
+			"total_lines_removed":   23,
+		},
+		"exceeds_200k_tokens": false,
+	}
+}
+
+// OverlaySession returns base with the keys of overrides laid over it, merging
+// nested objects key by key, so a test can change one field of the session.
+func OverlaySession(base, overrides JsonObject) JsonObject {
+	out := maps.Clone(base)
+	for k, v := range overrides {
+		if over, ok := v.(JsonObject); ok {
+			if under, ok := out[k].(JsonObject); ok {
+				out[k] = OverlaySession(under, over)
+				continue
+			}
+		}
+		out[k] = v
+	}
+	return out
+}
+
+// StatusLineRun is the outcome of running a status line command. Stdout is
+// exactly what the command printed, ANSI escape codes included.
+type StatusLineRun struct {
+	Stdout   string
+	Stderr   string
+	ExitCode int
+	TimedOut bool
+	Duration time.Duration
+}
+
+// RunStatusLine runs command through sh in dir the way Claude Code does, with
+// session as JSON on stdin, and collects what it prints. A command that fails,
+// or is stopped after timeout, is reported in the result rather than as an
+// error. Returns an error only if the shell cannot be started.
+func RunStatusLine(ctx context.Context, command, dir string, session JsonObject, timeout time.Duration) (*StatusLineRun, error) {
+	input, err := json.Marshal(session)
+	if err != nil {
+		return nil, err
+	}
+	run, err := runShell(ctx, command, dir, os.Environ(), input, timeout)
+	if err != nil {
+		return nil, fmt.Errorf("status line: %w", err)
+	}
+	return &StatusLineRun{
+		Stdout:   run.stdout,
+		Stderr:   run.stderr,
+		ExitCode: run.exitCode,
+		TimedOut: run.timedOut,
+		Duration: run.duration,
+	}, nil
+}
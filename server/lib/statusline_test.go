@@ -0,0 +1,65 @@
+package lib_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"fieldstation/lib"
+)
+
+func TestLoadStatusLine_HighestLayerWins(t *testing.T) {
+	_, projectDir := setupPatchLayers(t, `{"statusLine":{"type":"command","command":"~/bin/status.sh","padding":1}}`)
+	writeProjectSettings(t, projectDir, "settings.json", `{"statusLine":{"type":"command","command":"./status.sh"}}`)
+	writeProjectSettings(t, projectDir, "settings.local.json", `{"statusLine":"oops"}`)
+
+	settings := lib.LoadStatusLine(projectDir)
+	require.Len(t, settings.Entries, 3)
+	assert.Equal(t, 1, *settings.Entries[0].StatusLine.Padding)
+	assert.NotEmpty(t, settings.Entries[2].Error)
+
+	require.NotNil(t, settings.Effective, "a malformed layer does not hide the ones below it")
+	assert.Equal(t, lib.ConfigLayerProject, settings.Effective.Source)
+	assert.Equal(t, "./status.sh", settings.Effective.StatusLine.Command)
+}
+
+func TestSetStatusLine_KeepsUnmodelledKeysAndSecrets(t *testing.T) {
+	claudeHome, _ := setupPatchLayers(t, `{
+  "model": "opus",
+  "statusLine": {"type": "command", "command": "status --token=abc123def456", "refreshMs": 500}
+}
+`)
+	path := filepath.Join(claudeHome, "settings.json")
+
+	padding := 2
+	require.NoError(t, lib.SetStatusLine(path, &lib.StatusLine{Type: "command", Command: "status --token=[REDACTED] --short", Padding: &padding}, claudeHome))
+	data, err := os.ReadFile(path) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus","statusLine":{"type":"command","command":"status --token=abc123def456 --short","refreshMs":500,"padding":2}}`, string(data))
+
+	require.NoError(t, lib.SetStatusLine(path, nil, claudeHome))
+	data, err = os.ReadFile(path) //nolint:gosec // path is from t.TempDir(), safe in tests
+	require.NoError(t, err)
+	assert.JSONEq(t, `{"model":"opus"}`, string(data))
+}
+
+func TestRunStatusLine_PipesSessionAndReportsExit(t *testing.T) {
+	dir := t.TempDir()
+	session := lib.OverlaySession(lib.SyntheticStatusLineSession(dir, "opus", "2.0.1"), lib.JsonObject{"model": lib.JsonObject{"display_name": "Opus"}})
+
+	run, err := lib.RunStatusLine(context.Background(), `read -r s; printf '\033[32m%s\033[0m' "$(echo "$s" | grep -o '"display_name":"[^"]*"')"; echo warn >&2; exit 3`, dir, session, 5*time.Second)
+	require.NoError(t, err)
+	assert.Equal(t, "\x1b[32m\"display_name\":\"Opus\"\x1b[0m", run.Stdout)
+	assert.Equal(t, "warn\n", run.Stderr)
+	assert.Equal(t, 3, run.ExitCode)
+	assert.False(t, run.TimedOut)
+
+	run, err = lib.RunStatusLine(context.Background(), "sleep 5", dir, session, 100*time.Millisecond)
+	require.NoError(t, err)
+	assert.True(t, run.TimedOut)
+	assert.Equal(t, -1, run.ExitCode)
+}
@@ -15,6 +15,9 @@ import (
 
 func main() {
 	claudeHome := lib.ResolveClaudeHome()
+	if len(os.Args) > 1 && os.Args[1] == "scan-secrets" {
+		os.Exit(runScanSecrets(claudeHome, os.Args[2:], os.Stdout, os.Stderr))
+	}
 	authEnabled := os.Getenv("FIELD_STATION_AUTH") != "false"
 	isDev := os.Getenv("FIELD_STATION_DEV") == "1"
 
@@ -0,0 +1,49 @@
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"path/filepath"
+
+	"fieldstation/api"
+	"fieldstation/lib"
+)
+
+// runScanSecrets implements "field-station scan-secrets [-json]": it scans the
+// global home and every registered project for secrets and prints the
+// findings. The exit status is 0 when nothing is found, 1 when there are
+// findings and 2 when the scan cannot run, so the command can gate CI.
+func runScanSecrets(claudeHome string, args []string, stdout, stderr io.Writer) int {
+	flags := flag.NewFlagSet("scan-secrets", flag.ContinueOnError)
+	flags.SetOutput(stderr)
+	asJSON := flags.Bool("json", false, "print findings as JSON")
+	if err := flags.Parse(args); err != nil {
+		return 2
+	}
+
+	result, err := lib.ScanSecrets(claudeHome, api.RegisteredProjectPaths(claudeHome))
+	if err != nil {
+		_, _ = fmt.Fprintf(stderr, "scan-secrets: %v\n", err)
+		return 2
+	}
+	if *asJSON {
+		enc := json.NewEncoder(stdout)
+		enc.SetIndent("", "  ")
+		if err := enc.Encode(result); err != nil {
+			_, _ = fmt.Fprintf(stderr, "scan-secrets: %v\n", err)
+			return 2
+		}
+	} else {
+		for _, f := range result.Findings {
+			_, _ = fmt.Fprintf(stdout, "%s:%d:%d: %s %s %s (%s)\n", f.FilePath, f.Line, f.Column, f.Kind, f.Pattern, f.Masked, f.Fingerprint)
+		}
+		_, _ = fmt.Fprintf(stderr, "%d findings in %d files, %d ignored by %s\n",
+			len(result.Findings), result.FilesScanned, result.Ignored, filepath.Join(claudeHome, lib.SecretsIgnoreFileName))
+	}
+	if len(result.Findings) > 0 {
+		return 1
+	}
+	return 0
+}